@@ -95,15 +95,25 @@ func randomCoordinatesWithinMunicipality(municipality string) Coordinates {
 	}
 }
 
-// pointInPolygon performs a point-in-polygon test using the ray-casting algorithm.
+// pointInPolygon performs a point-in-polygon test using the ray-casting algorithm, a point in a hole is outside.
+// The backend assigns municipalities with the same test in its geo package, the seeder is a separate module so it
+// keeps this copy.
 func pointInPolygon(lon, lat float64, feature *geojson.Feature) bool {
 	if feature.Geometry.Type == geojson.GeometryMultiPolygon {
 		for _, polygon := range feature.Geometry.MultiPolygon {
-			for _, ring := range polygon {
-				if isPointInRing(lon, lat, ring) {
-					return true
+			if len(polygon) == 0 || !isPointInRing(lon, lat, polygon[0]) {
+				continue
+			}
+			inHole := false
+			for _, hole := range polygon[1:] {
+				if isPointInRing(lon, lat, hole) {
+					inHole = true
+					break
 				}
 			}
+			if !inHole {
+				return true
+			}
 		}
 	}
 	return false
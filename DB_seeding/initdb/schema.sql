@@ -4,7 +4,8 @@ CREATE TABLE
     name VARCHAR(100) NOT NULL,
     description TEXT,
     is_admin BOOLEAN DEFAULT FALSE,
-    is_restricted BOOLEAN DEFAULT FALSE
+    is_restricted BOOLEAN DEFAULT FALSE,
+    access_area GEOMETRY NULL -- MULTIPOLYGON of (longitude latitude) positions, devices inside it are accessible to restricted roles on top of their tags
 );
 
 CREATE TABLE
@@ -14,9 +15,26 @@ CREATE TABLE
     status ENUM('online', 'offline', 'error', 'app_issue') NOT NULL,
     last_contact TIMESTAMP,
     connection_type ENUM('wireless', 'wired') NOT NULL,
-    coordinates POINT,
+    coordinates POINT NOT NULL, -- POINT(longitude, latitude) in WGS84, so ST_X is the longitude and ST_Y the latitude
     ip_address VARCHAR(45),
-    performance_metric DECIMAL(5, 2) -- Placeholder for performance metric, adjust as needed
+    performance_metric DECIMAL(5, 2), -- Placeholder for performance metric, adjust as needed
+    last_heartbeat TIMESTAMP NULL, -- Set once the device reports heartbeats, only those devices are checked for going offline
+    state_reported_at TIMESTAMP NULL, -- Last time the device reported its actual application state
+    quarantined_at TIMESTAMP NULL, -- Set while the device is isolated because it may be compromised
+    quarantined_by INT NULL,
+    quarantine_reason VARCHAR(255) NULL,
+    credentials_revoked_at TIMESTAMP NULL, -- Device tokens issued before this moment are rejected
+    decommissioned_at TIMESTAMP NULL, -- Set when the unit was replaced, it is kept for its history
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Historical views leave the device out before this moment
+    device_type_id INT NULL, -- Hardware model from device_types, NULL for devices registered before the catalog
+    serial_number VARCHAR(100) NULL UNIQUE,
+    vendor VARCHAR(100) NULL,
+    firmware_version VARCHAR(50) NULL,
+    installed_on DATE NULL,
+    warranty_end DATE NULL,
+    lifecycle_stage ENUM('ordered', 'installed', 'active', 'retired') NOT NULL DEFAULT 'active',
+    lifecycle_stage_changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Used to find devices stuck in a stage
+    SPATIAL INDEX idx_edge_devices_coordinates (coordinates)
 );
 
 CREATE TABLE
@@ -136,3 +154,346 @@ CREATE TABLE
     FOREIGN KEY (device_id) REFERENCES edge_devices(id),
     FOREIGN KEY (app_instance_id) REFERENCES application_instances(id)
 );
+
+CREATE TABLE
+    performance_metric_samples (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    device_id INT NOT NULL,
+    value DECIMAL(5, 2) NOT NULL,
+    timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    INDEX idx_metric_samples_device_time (device_id, timestamp)
+);
+
+CREATE TABLE
+    performance_metric_aggregates (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    device_id INT NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    bucket_seconds INT NOT NULL, -- Width of the bucket the raw samples were rolled into
+    min_value DECIMAL(5, 2) NOT NULL,
+    max_value DECIMAL(5, 2) NOT NULL,
+    avg_value DECIMAL(7, 4) NOT NULL,
+    sample_count INT NOT NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    UNIQUE KEY uq_metric_aggregates_bucket (device_id, bucket_start, bucket_seconds)
+);
+
+CREATE TABLE
+    device_groups (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    type ENUM('static', 'filter') NOT NULL, -- Static groups have explicit members, filter groups are saved filters
+    filter JSON NULL,
+    owner_id INT NOT NULL,
+    role_id INT NULL, -- When set, the group is shared with every meber holding this role
+    FOREIGN KEY (owner_id) REFERENCES mebers(id),
+    FOREIGN KEY (role_id) REFERENCES roles(id)
+);
+
+CREATE TABLE
+    device_group_members (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT NOT NULL,
+    device_id INT NOT NULL,
+    FOREIGN KEY (group_id) REFERENCES device_groups(id),
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    UNIQUE KEY uq_device_group_members (group_id, device_id)
+);
+
+CREATE TABLE
+    device_commands (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    device_id INT NOT NULL,
+    type ENUM('reboot', 'restart_application', 'collect_diagnostics', 'resync') NOT NULL,
+    app_instance_id INT NULL, -- Only used by restart_application
+    status ENUM('queued', 'sent', 'succeeded', 'failed', 'timed_out', 'cancelled') NOT NULL DEFAULT 'queued',
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL DEFAULT 3,
+    timeout_seconds INT NOT NULL DEFAULT 300, -- Time the device gets to report a result before the command is resent
+    result TEXT NULL,
+    created_by INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    sent_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (app_instance_id) REFERENCES application_instances(id),
+    FOREIGN KEY (created_by) REFERENCES mebers(id),
+    INDEX idx_device_commands_device_status (device_id, status)
+);
+
+CREATE TABLE
+    audit_log (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    meber_id INT NULL, -- NULL when the action was performed by a device or the system
+    device_id INT NULL,
+    action VARCHAR(100) NOT NULL,
+    details TEXT,
+    timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (meber_id) REFERENCES mebers(id),
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id)
+);
+
+CREATE TABLE
+    device_status_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    device_id INT NOT NULL,
+    previous_status ENUM('online', 'offline', 'error', 'app_issue') NULL,
+    status ENUM('online', 'offline', 'error', 'app_issue') NOT NULL,
+    in_maintenance BOOLEAN DEFAULT FALSE,
+    timestamp TIMESTAMP NOT NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    INDEX idx_status_history_device_time (device_id, timestamp),
+    INDEX idx_status_history_time (timestamp)
+);
+
+CREATE TABLE
+    maintenance_windows (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    target_type ENUM('device', 'group', 'municipality') NOT NULL,
+    target_id INT NOT NULL, -- edge_devices.id, device_groups.id or the tags.id of a location tag
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL, -- End of the first occurrence
+    recurrence ENUM('none', 'daily', 'weekly') NOT NULL DEFAULT 'none',
+    recurrence_until TIMESTAMP NULL,
+    installs_only_in_window BOOLEAN DEFAULT FALSE, -- Application installs on the targets are only allowed during the window
+    created_by INT NOT NULL,
+    FOREIGN KEY (created_by) REFERENCES mebers(id)
+);
+
+-- Device twin: the applications the backend wants on a device, the device reports what actually runs
+CREATE TABLE
+    device_desired_applications (
+    device_id INT NOT NULL,
+    app_id INT NOT NULL,
+    version VARCHAR(10) NOT NULL,
+    config JSON NULL,
+    updated_by INT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (device_id, app_id),
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (app_id) REFERENCES applications(id),
+    FOREIGN KEY (updated_by) REFERENCES mebers(id)
+);
+
+CREATE TABLE
+    device_reported_applications (
+    device_id INT NOT NULL,
+    app_id INT NOT NULL,
+    version VARCHAR(10) NOT NULL,
+    config JSON NULL,
+    status ENUM('online', 'offline', 'error', 'warning') NOT NULL,
+    reported_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (device_id, app_id),
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (app_id) REFERENCES applications(id)
+);
+
+-- WireGuard tunnel managed by the backend, the hub key pair is generated on first use
+CREATE TABLE
+    wireguard_hub (
+    id TINYINT PRIMARY KEY,
+    private_key VARCHAR(44) NOT NULL,
+    public_key VARCHAR(44) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE
+    wireguard_peers (
+    device_id INT PRIMARY KEY,
+    address VARCHAR(45) NOT NULL UNIQUE, -- Tunnel address, mirrored into edge_devices.ip_address
+    public_key VARCHAR(44) NOT NULL UNIQUE, -- The private key is only handed out when the peer is provisioned
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    latest_handshake TIMESTAMP NULL,
+    endpoint VARCHAR(64) NULL, -- Public address the hub last saw the device on
+    transfer_rx BIGINT DEFAULT 0,
+    transfer_tx BIGINT DEFAULT 0,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id)
+);
+
+-- Local certificate authority for device identity, the CA is generated on first use
+CREATE TABLE
+    certificate_authority (
+    id TINYINT PRIMARY KEY,
+    certificate_pem TEXT NOT NULL,
+    private_key_pem TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE
+    device_enrollment_tokens (
+    token_hash CHAR(64) PRIMARY KEY, -- SHA-256 of the token, the token itself is only shown once
+    device_id INT NOT NULL,
+    created_by INT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (created_by) REFERENCES mebers(id)
+);
+
+CREATE TABLE
+    device_certificates (
+    serial VARCHAR(40) PRIMARY KEY, -- Hexadecimal serial number
+    device_id INT NOT NULL,
+    fingerprint CHAR(64) NOT NULL, -- SHA-256 of the DER encoded certificate
+    not_before TIMESTAMP NOT NULL,
+    not_after TIMESTAMP NOT NULL,
+    issued_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP NULL,
+    revocation_reason VARCHAR(32) NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    INDEX idx_device_certificates_device (device_id)
+);
+
+-- Hardware replacements, linking the decommissioned unit to the device that took its place
+CREATE TABLE
+    device_replacements (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    old_device_id INT NOT NULL UNIQUE, -- A unit can only be replaced once
+    new_device_id INT NOT NULL UNIQUE,
+    replaced_by INT NULL,
+    reason VARCHAR(255) NULL,
+    replaced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (old_device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (new_device_id) REFERENCES edge_devices(id),
+    FOREIGN KEY (replaced_by) REFERENCES mebers(id)
+);
+
+-- Device type catalog: what a hardware model can do, which sensors it supports and its resource capacity
+CREATE TABLE
+    device_types (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    vendor VARCHAR(100) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    cpu_cores INT NOT NULL DEFAULT 0, -- 0 when unknown
+    memory_mb INT NOT NULL DEFAULT 0,
+    storage_mb INT NOT NULL DEFAULT 0,
+    UNIQUE KEY uq_device_types_model (vendor, model)
+);
+
+CREATE TABLE
+    device_type_capabilities (
+    device_type_id INT NOT NULL,
+    capability VARCHAR(50) NOT NULL,
+    PRIMARY KEY (device_type_id, capability),
+    FOREIGN KEY (device_type_id) REFERENCES device_types(id)
+);
+
+CREATE TABLE
+    device_type_sensors (
+    device_type_id INT NOT NULL,
+    sensor_id INT NOT NULL,
+    PRIMARY KEY (device_type_id, sensor_id),
+    FOREIGN KEY (device_type_id) REFERENCES device_types(id),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id)
+);
+
+-- Capabilities the type of a device needs before an application can be installed on it
+CREATE TABLE
+    application_capabilities (
+    app_id INT NOT NULL,
+    capability VARCHAR(50) NOT NULL,
+    PRIMARY KEY (app_id, capability),
+    FOREIGN KEY (app_id) REFERENCES applications(id)
+);
+
+-- Provisioning templates are applied when a device is registered, a template without a type or municipality
+-- matches every device
+CREATE TABLE
+    provisioning_templates (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    device_type_id INT NULL,
+    municipality_id INT NULL, -- Location tag
+    created_by INT NOT NULL,
+    FOREIGN KEY (device_type_id) REFERENCES device_types(id),
+    FOREIGN KEY (municipality_id) REFERENCES tags(id),
+    FOREIGN KEY (created_by) REFERENCES mebers(id)
+);
+
+CREATE TABLE
+    provisioning_template_tags (
+    template_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (template_id, tag_id),
+    FOREIGN KEY (template_id) REFERENCES provisioning_templates(id),
+    FOREIGN KEY (tag_id) REFERENCES tags(id)
+);
+
+CREATE TABLE
+    provisioning_template_sensors (
+    template_id INT NOT NULL,
+    sensor_id INT NOT NULL,
+    PRIMARY KEY (template_id, sensor_id),
+    FOREIGN KEY (template_id) REFERENCES provisioning_templates(id),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id)
+);
+
+CREATE TABLE
+    provisioning_template_applications (
+    template_id INT NOT NULL,
+    app_id INT NOT NULL,
+    PRIMARY KEY (template_id, app_id),
+    FOREIGN KEY (template_id) REFERENCES provisioning_templates(id),
+    FOREIGN KEY (app_id) REFERENCES applications(id)
+);
+
+-- Latest resource usage and capacity reported by each device
+CREATE TABLE
+    device_resources (
+    device_id INT PRIMARY KEY,
+    cpu_cores INT NOT NULL,
+    cpu_usage_percent DECIMAL(5, 2) NOT NULL,
+    memory_total_mb INT NOT NULL,
+    memory_used_mb INT NOT NULL,
+    disk_total_mb INT NOT NULL,
+    disk_used_mb INT NOT NULL,
+    reported_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id)
+);
+
+-- Resources an application needs on top of what already runs on a device
+CREATE TABLE
+    application_resource_requirements (
+    app_id INT PRIMARY KEY,
+    cpu_millicores INT NOT NULL DEFAULT 0,
+    memory_mb INT NOT NULL DEFAULT 0,
+    disk_mb INT NOT NULL DEFAULT 0,
+    FOREIGN KEY (app_id) REFERENCES applications(id)
+);
+
+-- Connection quality reported by devices, signal strength is only known for wireless connections
+CREATE TABLE
+    connectivity_samples (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    device_id INT NOT NULL,
+    signal_dbm SMALLINT NULL,
+    latency_ms DECIMAL(8, 2) NOT NULL,
+    packet_loss_percent DECIMAL(5, 2) NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id),
+    INDEX idx_connectivity_device_time (device_id, timestamp),
+    INDEX idx_connectivity_time (timestamp)
+);
+
+-- Outages of an area, detected when neighbouring devices go offline together
+CREATE TABLE
+    area_outages (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    started_at TIMESTAMP NOT NULL, -- When the first device of the outage went offline
+    detected_at TIMESTAMP NOT NULL,
+    resolved_at TIMESTAMP NULL,
+    area JSON NOT NULL, -- GeoJSON Polygon around the devices
+    INDEX idx_area_outages_resolved (resolved_at)
+);
+
+CREATE TABLE
+    area_outage_devices (
+    outage_id INT NOT NULL,
+    device_id INT NOT NULL,
+    PRIMARY KEY (outage_id, device_id),
+    FOREIGN KEY (outage_id) REFERENCES area_outages(id),
+    FOREIGN KEY (device_id) REFERENCES edge_devices(id)
+);
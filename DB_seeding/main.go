@@ -19,6 +19,7 @@ import (
 const (
 	numDevices          = 20000
 	seedFilePath        = "seed.sql"
+	boundariesFilePath  = "../go-backend/geo/municipalities.geojson" // Shared with the backend, which serves it
 	maxWorkers          = 50
 	errorRatePercent    = 0.2 // 0.1% error rate for edge devices
 	appIssueRatePercent = 0.1 // 0.1% app issue rate
@@ -61,8 +62,12 @@ func main() {
 	fmt.Println("Connected to the database successfully!")
 	rand.Seed(time.Now().UnixNano())
 
-	// Load GeoJSON data
-	LoadMunicipalityGeojson("StedinGeojson.jsx")
+	// Load GeoJSON data, MUNICIPALITY_GEOJSON overrides the location of the boundaries
+	boundariesFile := os.Getenv("MUNICIPALITY_GEOJSON")
+	if boundariesFile == "" {
+		boundariesFile = boundariesFilePath
+	}
+	LoadMunicipalityGeojson(boundariesFile)
 
 	// Print all municipalities names
 	municipalities = getMunicipalityNames()
@@ -129,6 +134,18 @@ func resetDatabase(db *sql.DB) error {
 		"application_instances", "application_sensors", "applications", "device_sensors",
 		"device_tags", "edge_devices", "logs", "meber_applications", "meber_roles",
 		"mebers", "role_tags", "roles", "sensors", "tags",
+		"performance_metric_samples", "performance_metric_aggregates",
+		"device_group_members", "device_groups", "device_commands", "audit_log",
+		"device_status_history", "maintenance_windows",
+		"device_desired_applications", "device_reported_applications",
+		"wireguard_hub", "wireguard_peers",
+		"certificate_authority", "device_enrollment_tokens", "device_certificates",
+		"device_replacements",
+		"device_types", "device_type_capabilities", "device_type_sensors", "application_capabilities",
+		"provisioning_templates", "provisioning_template_tags", "provisioning_template_sensors",
+		"provisioning_template_applications",
+		"device_resources", "application_resource_requirements", "connectivity_samples",
+		"area_outages", "area_outage_devices",
 	}
 
 	// Temporarily disable foreign key checks
@@ -190,11 +207,18 @@ func seedEdgeDevice(db *sql.DB, index int) {
 	ipAddress := faker.IPv4()
 	performanceMetric := randomPerformanceMetric(status)
 	lastContact := randomTimestamp()
+	deviceTypeID := rand.Intn(2) + 1 // One of the device types in seed.sql
+	vendor := []string{"Siemens", "Advantech"}[deviceTypeID-1]
+	serialNumber := fmt.Sprintf("SN-%06d", index)
+	installedOn := time.Now().AddDate(0, 0, -rand.Intn(5*365))
+	warrantyEnd := installedOn.AddDate(5, 0, 0)
 
 	// Insert device into the database
-	query := `INSERT INTO edge_devices (name, status, last_contact, connection_type, coordinates, ip_address, performance_metric)
-              VALUES (?, ?, ?, ?, POINT(?, ?), ?, ?)`
-	res, err := db.Exec(query, name, status, lastContact, connectionType, coordinates.lon, coordinates.lat, ipAddress, performanceMetric)
+	query := `INSERT INTO edge_devices (name, status, last_contact, connection_type, coordinates, ip_address, performance_metric, device_type_id,
+              serial_number, vendor, firmware_version, installed_on, warranty_end, created_at)
+              VALUES (?, ?, ?, ?, POINT(?, ?), ?, ?, ?, ?, ?, '1.0.0', ?, ?, ?)`
+	res, err := db.Exec(query, name, status, lastContact, connectionType, coordinates.lon, coordinates.lat, ipAddress, performanceMetric, deviceTypeID,
+		serialNumber, vendor, installedOn.Format("2006-01-02"), warrantyEnd.Format("2006-01-02"), installedOn.UTC())
 	if err != nil {
 		log.Printf("Error inserting edge device %d: %v\n", index, err)
 		return
@@ -330,6 +354,18 @@ func seedApplicationInstance(db *sql.DB, deviceID int64, appName string, status
 		log.Printf("Error inserting application instance %s for device %d: %v\n", appName, deviceID, err)
 	}
 
+	// Seeded instances are already deployed, so the device twin wants and reports the same application
+	_, err = db.Exec(`INSERT INTO device_desired_applications (device_id, app_id, version)
+              SELECT ?, id, version FROM applications WHERE name = ? LIMIT 1`, deviceID, appName)
+	if err != nil {
+		log.Printf("Error inserting desired application %s for device %d: %v\n", appName, deviceID, err)
+	}
+	_, err = db.Exec(`INSERT INTO device_reported_applications (device_id, app_id, version, status, reported_at)
+              SELECT ?, id, version, ?, NOW() FROM applications WHERE name = ? LIMIT 1`, deviceID, status, appName)
+	if err != nil {
+		log.Printf("Error inserting reported application %s for device %d: %v\n", appName, deviceID, err)
+	}
+
 	// Return the application instance ID (last inserted ID)
 	appInstanceID, _ := result.LastInsertId()
 	return appInstanceID
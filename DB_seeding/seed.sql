@@ -66,4 +66,34 @@ VALUES
     (1, 2, 1), -- straatlampen app requires straatlampen sensor
     (2, 1, 2), -- fraude detectie requires spanningssensor
     (3, 1, 3), -- temperatuur monitoring requires spanningssensor
-    (4, 3, 3); -- temperatuur monitoring also requires temperatuur sensor
\ No newline at end of file
+    (4, 3, 3); -- temperatuur monitoring also requires temperatuur sensor
+
+-- Insert device types and what they can do
+INSERT INTO device_types (id, vendor, model, cpu_cores, memory_mb, storage_mb)
+VALUES
+    (1, 'Siemens', 'SICAM A8000', 2, 1024, 8192),
+    (2, 'Advantech', 'UNO-2484G', 4, 8192, 65536);
+
+INSERT INTO device_type_capabilities (device_type_id, capability)
+VALUES
+    (1, 'iec61850'),
+    (2, 'iec61850'),
+    (2, 'audio_processing');
+
+INSERT INTO device_type_sensors (device_type_id, sensor_id)
+VALUES
+    (1, 1), (1, 2), (1, 3),
+    (2, 1), (2, 2), (2, 3), (2, 4);
+
+-- Audio Analysis needs hardware that can process audio
+INSERT INTO application_capabilities (app_id, capability)
+VALUES
+    (4, 'audio_processing');
+
+-- Insert resource requirements of the applications
+INSERT INTO application_resource_requirements (app_id, cpu_millicores, memory_mb, disk_mb)
+VALUES
+    (1, 100, 128, 256),
+    (2, 500, 512, 1024),
+    (3, 100, 64, 128),
+    (4, 1000, 1024, 2048);
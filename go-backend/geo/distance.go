@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"main/structs"
+	"math"
+	"sort"
+)
+
+// earthRadiusMeters is the mean radius of the earth, as used by MariaDB's ST_Distance_Sphere
+const earthRadiusMeters = 6370986
+
+// metersPerDegreeLatitude is the length of a degree of latitude
+const metersPerDegreeLatitude = earthRadiusMeters * math.Pi / 180
+
+// Distance returns the great-circle distance between two coordinates in meters
+func Distance(a, b structs.Coordinate) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// BufferedHull returns the convex hull around the coordinates widened by about bufferMeters, as a closed
+// counterclockwise ring of [longitude, latitude] positions. Every coordinate is replaced by an octagon around it
+// first, so a single coordinate or a line of them still gives an area.
+func BufferedHull(coordinates []structs.Coordinate, bufferMeters float64) [][2]float64 {
+	var points [][2]float64
+	for _, coordinate := range coordinates {
+		dLat := bufferMeters / metersPerDegreeLatitude
+		dLon := dLat / math.Cos(coordinate.Latitude*math.Pi/180)
+		for i := 0; i < 8; i++ {
+			angle := float64(i) * math.Pi / 4
+			points = append(points, [2]float64{coordinate.Longitude + dLon*math.Cos(angle), coordinate.Latitude + dLat*math.Sin(angle)})
+		}
+	}
+	if len(points) == 0 {
+		return nil
+	}
+
+	// Andrew's monotone chain
+	sort.Slice(points, func(i, j int) bool {
+		if points[i][0] != points[j][0] {
+			return points[i][0] < points[j][0]
+		}
+		return points[i][1] < points[j][1]
+	})
+	cross := func(o, a, b [2]float64) float64 {
+		return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+	}
+	var hull [][2]float64
+	for _, point := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], point) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, point)
+	}
+	lower := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], points[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, points[i])
+	}
+	// The chain ends on its first point, which closes the ring
+	return hull
+}
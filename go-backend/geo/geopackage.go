@@ -0,0 +1,184 @@
+package geo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	// geoPackageApplicationID is "GPKG", the application_id that marks a SQLite file as GeoPackage
+	geoPackageApplicationID = 0x47504B47
+	// geoPackageVersion is the user_version of GeoPackage 1.3
+	geoPackageVersion = 10300
+	// wgs84SRSID is the spatial reference system of all coordinates in the backend
+	wgs84SRSID = 4326
+	// geoPackageTimeFormat is the DATETIME format of GeoPackage, ISO-8601 in UTC with milliseconds
+	geoPackageTimeFormat = "2006-01-02T15:04:05.000Z"
+	geometryColumn       = "geom"
+)
+
+const wgs84Definition = `GEOGCS["WGS 84",DATUM["WGS_1984",SPHEROID["WGS 84",6378137,298.257223563,AUTHORITY["EPSG","7030"]],` +
+	`AUTHORITY["EPSG","6326"]],PRIMEM["Greenwich",0,AUTHORITY["EPSG","8901"]],` +
+	`UNIT["degree",0.0174532925199433,AUTHORITY["EPSG","9122"]],AUTHORITY["EPSG","4326"]]`
+
+// The metadata tables as defined in the GeoPackage specification, their constraints determine the automatic
+// indexes written alongside them
+var geoPackageTables = []struct {
+	name    string
+	sql     string
+	indexes [][]int
+}{
+	{
+		name: "gpkg_spatial_ref_sys",
+		sql: `CREATE TABLE gpkg_spatial_ref_sys (srs_name TEXT NOT NULL, srs_id INTEGER NOT NULL PRIMARY KEY, ` +
+			`organization TEXT NOT NULL, organization_coordsys_id INTEGER NOT NULL, definition TEXT NOT NULL, description TEXT)`,
+	},
+	{
+		name: "gpkg_contents",
+		sql: `CREATE TABLE gpkg_contents (table_name TEXT NOT NULL PRIMARY KEY, data_type TEXT NOT NULL, identifier TEXT UNIQUE, ` +
+			`description TEXT DEFAULT '', last_change DATETIME NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ','now')), ` +
+			`min_x DOUBLE, min_y DOUBLE, max_x DOUBLE, max_y DOUBLE, srs_id INTEGER, ` +
+			`CONSTRAINT fk_gc_r_srs_id FOREIGN KEY (srs_id) REFERENCES gpkg_spatial_ref_sys(srs_id))`,
+		indexes: [][]int{{0}, {2}},
+	},
+	{
+		name: "gpkg_geometry_columns",
+		sql: `CREATE TABLE gpkg_geometry_columns (table_name TEXT NOT NULL, column_name TEXT NOT NULL, ` +
+			`geometry_type_name TEXT NOT NULL, srs_id INTEGER NOT NULL, z TINYINT NOT NULL, m TINYINT NOT NULL, ` +
+			`CONSTRAINT pk_geom_cols PRIMARY KEY (table_name, column_name), CONSTRAINT uk_gc_table_name UNIQUE (table_name), ` +
+			`CONSTRAINT fk_gc_tn FOREIGN KEY (table_name) REFERENCES gpkg_contents(table_name), ` +
+			`CONSTRAINT fk_gc_srs FOREIGN KEY (srs_id) REFERENCES gpkg_spatial_ref_sys (srs_id))`,
+		indexes: [][]int{{0, 1}, {0}},
+	},
+}
+
+// WriteGeoPackage writes the layers as a GeoPackage with a feature table per layer. The feature ID of a feature
+// is its ID, the geometry is stored in the geom column and the attributes in a column each.
+func WriteGeoPackage(w io.Writer, now time.Time, layers ...Layer) error {
+	rows := [][]sqliteRow{
+		{
+			{RowID: -1, Values: []interface{}{"Undefined cartesian SRS", nil, "NONE", int64(-1), "undefined", "undefined cartesian coordinate reference system"}},
+			{RowID: 0, Values: []interface{}{"Undefined geographic SRS", nil, "NONE", int64(0), "undefined", "undefined geographic coordinate reference system"}},
+			{RowID: wgs84SRSID, Values: []interface{}{"WGS 84 geodetic", nil, "EPSG", int64(wgs84SRSID), wgs84Definition, "longitude/latitude coordinates in decimal degrees on the WGS 84 spheroid"}},
+		},
+		nil,
+		nil,
+	}
+	var featureTables []sqliteTable
+	for i, layer := range layers {
+		var minX, minY, maxX, maxY interface{}
+		if bounds, ok := layer.Bounds(); ok {
+			minX, minY, maxX, maxY = bounds.MinLongitude, bounds.MinLatitude, bounds.MaxLongitude, bounds.MaxLatitude
+		}
+		rows[1] = append(rows[1], sqliteRow{RowID: int64(i + 1), Values: []interface{}{
+			layer.Name, "features", layer.Name, "", now.UTC().Format(geoPackageTimeFormat), minX, minY, maxX, maxY, int64(wgs84SRSID),
+		}})
+		rows[2] = append(rows[2], sqliteRow{RowID: int64(i + 1), Values: []interface{}{
+			layer.Name, geometryColumn, layer.GeometryType, int64(wgs84SRSID), int64(0), int64(0),
+		}})
+
+		table, err := featureTable(layer)
+		if err != nil {
+			return err
+		}
+		featureTables = append(featureTables, table)
+	}
+
+	var tables []sqliteTable
+	for i, definition := range geoPackageTables {
+		table := sqliteTable{Name: definition.name, SQL: definition.sql, Rows: rows[i]}
+		for n, columns := range definition.indexes {
+			table.Indexes = append(table.Indexes, sqliteIndex{Name: fmt.Sprintf("sqlite_autoindex_%s_%d", definition.name, n+1), Columns: columns})
+		}
+		tables = append(tables, table)
+	}
+	tables = append(tables, featureTables...)
+
+	file, err := writeSQLite(geoPackageApplicationID, geoPackageVersion, tables)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(file)
+	return err
+}
+
+// featureTable builds the feature table of a layer
+func featureTable(layer Layer) (sqliteTable, error) {
+	columns := []string{"fid INTEGER PRIMARY KEY", geometryColumn + " " + layer.GeometryType}
+	for _, column := range layer.Columns {
+		columns = append(columns, quoteIdentifier(column.Name)+" "+column.Type)
+	}
+	table := sqliteTable{
+		Name: layer.Name,
+		SQL:  "CREATE TABLE " + quoteIdentifier(layer.Name) + " (" + strings.Join(columns, ", ") + ")",
+	}
+
+	for _, feature := range layer.Features {
+		if feature.ID <= 0 {
+			return sqliteTable{}, fmt.Errorf("feature %q of layer %s has no ID", feature.Name, layer.Name)
+		}
+		values := []interface{}{nil, geoPackageGeometry(layer.GeometryType, feature)}
+		for i := range layer.Columns {
+			var value interface{}
+			if i < len(feature.Values) {
+				value = feature.Values[i]
+			}
+			if t, ok := value.(time.Time); ok {
+				value = t.UTC().Format(geoPackageTimeFormat)
+			}
+			values = append(values, value)
+		}
+		table.Rows = append(table.Rows, sqliteRow{RowID: feature.ID, Values: values})
+	}
+	return table, nil
+}
+
+// geoPackageGeometry encodes the geometry of a feature as a GeoPackage geometry blob: a header with the spatial
+// reference system and the envelope of areas, followed by little-endian WKB
+func geoPackageGeometry(geometryType string, feature Feature) []byte {
+	blob := []byte{'G', 'P', 0}
+	if geometryType == GeometryPoint {
+		blob = append(blob, 0x01) // Little-endian without envelope
+		blob = binary.LittleEndian.AppendUint32(blob, wgs84SRSID)
+		blob = append(blob, 1)
+		blob = binary.LittleEndian.AppendUint32(blob, 1)
+		return appendWKBPosition(blob, feature.Point.Longitude, feature.Point.Latitude)
+	}
+
+	bounds := feature.Area.Bounds()
+	blob = append(blob, 0x03) // Little-endian with an [minx, maxx, miny, maxy] envelope
+	blob = binary.LittleEndian.AppendUint32(blob, wgs84SRSID)
+	for _, value := range []float64{bounds.MinLongitude, bounds.MaxLongitude, bounds.MinLatitude, bounds.MaxLatitude} {
+		blob = binary.LittleEndian.AppendUint64(blob, math.Float64bits(value))
+	}
+
+	blob = append(blob, 1)
+	blob = binary.LittleEndian.AppendUint32(blob, 6)
+	blob = binary.LittleEndian.AppendUint32(blob, uint32(len(feature.Area)))
+	for _, polygon := range feature.Area {
+		blob = append(blob, 1)
+		blob = binary.LittleEndian.AppendUint32(blob, 3)
+		blob = binary.LittleEndian.AppendUint32(blob, uint32(len(polygon)))
+		for _, ring := range polygon {
+			blob = binary.LittleEndian.AppendUint32(blob, uint32(len(ring)))
+			for _, position := range ring {
+				blob = appendWKBPosition(blob, position[0], position[1])
+			}
+		}
+	}
+	return blob
+}
+
+func appendWKBPosition(blob []byte, x, y float64) []byte {
+	blob = binary.LittleEndian.AppendUint64(blob, math.Float64bits(x))
+	return binary.LittleEndian.AppendUint64(blob, math.Float64bits(y))
+}
+
+// quoteIdentifier quotes a table or column name for SQL
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
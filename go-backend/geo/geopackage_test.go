@@ -0,0 +1,139 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"main/structs"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testArea is a square around Utrecht with a triangular hole
+var testArea = MultiPolygon{{
+	{{5.0, 52.0}, {5.2, 52.0}, {5.2, 52.2}, {5.0, 52.2}, {5.0, 52.0}},
+	{{5.05, 52.05}, {5.1, 52.05}, {5.1, 52.1}, {5.05, 52.05}},
+}}
+
+func TestWriteGeoPackage(t *testing.T) {
+	now := time.Date(2024, 3, 1, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+	devices := Layer{
+		Name:         "devices",
+		GeometryType: GeometryPoint,
+		Columns:      []Column{{Name: "name", Type: "TEXT"}, {Name: "last_seen", Type: "DATETIME"}, {Name: `odd "name"`, Type: "INTEGER"}},
+		Features: []Feature{
+			{ID: 7, Name: "MSR_7", Point: structs.Coordinate{Latitude: 52.1, Longitude: 5.1}, Values: []interface{}{"MSR_7", now, int64(3)}},
+			{ID: 2, Name: "MSR_2", Point: structs.Coordinate{Latitude: 51.9, Longitude: 4.4}, Values: []interface{}{"MSR_2"}},
+		},
+	}
+	areas := Layer{
+		Name:         "areas",
+		GeometryType: GeometryMultiPolygon,
+		Columns:      []Column{{Name: "name", Type: "TEXT"}},
+		Features:     []Feature{{ID: 1, Area: testArea, Values: []interface{}{"Utrecht"}}},
+	}
+
+	var out bytes.Buffer
+	if err := WriteGeoPackage(&out, now, devices, areas); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file := openTestSQLite(t, out.Bytes())
+	if string(out.Bytes()[68:72]) != "GPKG" || binary.BigEndian.Uint32(out.Bytes()[60:]) != geoPackageVersion {
+		t.Errorf("Expected the GeoPackage application ID and version in the header")
+	}
+
+	schema := file.schema()
+	for _, name := range []string{"gpkg_spatial_ref_sys", "gpkg_contents", "gpkg_geometry_columns", "devices", "areas",
+		"sqlite_autoindex_gpkg_contents_1", "sqlite_autoindex_gpkg_contents_2", "sqlite_autoindex_gpkg_geometry_columns_1", "sqlite_autoindex_gpkg_geometry_columns_2"} {
+		if _, ok := schema[name]; !ok {
+			t.Errorf("Expected %s in the schema", name)
+		}
+	}
+	if sql := schema["devices"].sql; sql != `CREATE TABLE "devices" (fid INTEGER PRIMARY KEY, geom POINT, "name" TEXT, "last_seen" DATETIME, "odd ""name""" INTEGER)` {
+		t.Errorf("Expected the feature table with quoted columns, got %v", sql)
+	}
+
+	srs := file.tableRows(schema["gpkg_spatial_ref_sys"].root)
+	if len(srs) != 3 || srs[0].RowID != -1 || srs[1].RowID != 0 || srs[2].RowID != wgs84SRSID || srs[2].Values[2] != "EPSG" {
+		t.Errorf("Expected the two undefined systems and WGS 84, got %v", srs)
+	}
+
+	// The extent of a layer is the bounding box of its features
+	contents := file.tableRows(schema["gpkg_contents"].root)
+	expected := []sqliteRow{
+		{RowID: 1, Values: []interface{}{"devices", "features", "devices", "", "2024-03-01T12:30:00.000Z", 4.4, 51.9, 5.1, 52.1, int64(wgs84SRSID)}},
+		{RowID: 2, Values: []interface{}{"areas", "features", "areas", "", "2024-03-01T12:30:00.000Z", 5.0, 52.0, 5.2, 52.2, int64(wgs84SRSID)}},
+	}
+	if !reflect.DeepEqual(contents, expected) {
+		t.Errorf("Expected contents %v, got %v", expected, contents)
+	}
+	columns := file.tableRows(schema["gpkg_geometry_columns"].root)
+	if len(columns) != 2 || columns[0].Values[2] != GeometryPoint || columns[1].Values[2] != GeometryMultiPolygon || columns[1].Values[1] != geometryColumn {
+		t.Errorf("Expected the geometry columns of both layers, got %v", columns)
+	}
+	if keys := file.indexKeys(schema["sqlite_autoindex_gpkg_geometry_columns_1"].root); !reflect.DeepEqual(keys, [][]interface{}{
+		{"areas", geometryColumn, int64(2)}, {"devices", geometryColumn, int64(1)},
+	}) {
+		t.Errorf("Expected the primary key index sorted by table name, got %v", keys)
+	}
+
+	// Features use their ID as fid, missing values are NULL and times are stored in UTC
+	rows := file.tableRows(schema["devices"].root)
+	if len(rows) != 2 || rows[0].RowID != 2 || rows[1].RowID != 7 {
+		t.Fatalf("Expected the features by ID, got %v", rows)
+	}
+	if !reflect.DeepEqual(rows[0].Values[2:], []interface{}{"MSR_2", nil, nil}) ||
+		!reflect.DeepEqual(rows[1].Values[2:], []interface{}{"MSR_7", "2024-03-01T12:30:00.000Z", int64(3)}) {
+		t.Errorf("Expected the attributes of the features, got %v and %v", rows[0].Values[2:], rows[1].Values[2:])
+	}
+
+	point := rows[1].Values[1].([]byte)
+	if !bytes.Equal(point[:8], []byte{'G', 'P', 0, 0x01, 0xe6, 0x10, 0, 0}) {
+		t.Errorf("Expected a little-endian header without envelope in SRS 4326, got %x", point[:8])
+	}
+	if !bytes.Equal(point[8:13], []byte{1, 1, 0, 0, 0}) || readTestWKBFloat(point[13:]) != 5.1 || readTestWKBFloat(point[21:]) != 52.1 || len(point) != 29 {
+		t.Errorf("Expected a WKB point at (5.1 52.1), got %x", point[8:])
+	}
+
+	rows = file.tableRows(schema["areas"].root)
+	area := rows[0].Values[1].([]byte)
+	if !bytes.Equal(area[:8], []byte{'G', 'P', 0, 0x03, 0xe6, 0x10, 0, 0}) {
+		t.Errorf("Expected a little-endian header with envelope in SRS 4326, got %x", area[:8])
+	}
+	envelope := []float64{readTestWKBFloat(area[8:]), readTestWKBFloat(area[16:]), readTestWKBFloat(area[24:]), readTestWKBFloat(area[32:])}
+	if !reflect.DeepEqual(envelope, []float64{5.0, 5.2, 52.0, 52.2}) {
+		t.Errorf("Expected the envelope [minx, maxx, miny, maxy], got %v", envelope)
+	}
+	wkb := area[40:]
+	if wkb[0] != 1 || binary.LittleEndian.Uint32(wkb[1:]) != 6 || binary.LittleEndian.Uint32(wkb[5:]) != 1 ||
+		wkb[9] != 1 || binary.LittleEndian.Uint32(wkb[10:]) != 3 || binary.LittleEndian.Uint32(wkb[14:]) != 2 {
+		t.Fatalf("Expected a WKB multipolygon of one polygon with two rings, got %x", wkb[:18])
+	}
+	outer := wkb[18:]
+	if binary.LittleEndian.Uint32(outer) != 5 || readTestWKBFloat(outer[4+16:]) != 5.2 || readTestWKBFloat(outer[4+24:]) != 52.0 {
+		t.Errorf("Expected the outer ring of 5 positions")
+	}
+	hole := outer[4+5*16:]
+	if binary.LittleEndian.Uint32(hole) != 4 || len(hole) != 4+4*16 {
+		t.Errorf("Expected the hole of 4 positions to end the geometry")
+	}
+
+	// An empty layer has no extent
+	out.Reset()
+	if err := WriteGeoPackage(&out, now, Layer{Name: "empty", GeometryType: GeometryPoint}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file = openTestSQLite(t, out.Bytes())
+	if contents := file.tableRows(file.schema()["gpkg_contents"].root); contents[0].Values[5] != nil || contents[0].Values[8] != nil {
+		t.Errorf("Expected no extent for an empty layer, got %v", contents[0].Values)
+	}
+
+	if err := WriteGeoPackage(&out, now, Layer{Name: "invalid", GeometryType: GeometryPoint, Features: []Feature{{Name: "no ID"}}}); err == nil {
+		t.Errorf("Expected a feature without ID to be rejected")
+	}
+}
+
+func readTestWKBFloat(b []byte) float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
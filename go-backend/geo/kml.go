@@ -0,0 +1,162 @@
+package geo
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// kmlPolygonOpacity is the alpha of the fill of areas, so the map underneath stays visible
+const kmlPolygonOpacity = "99"
+
+type kmlDocument struct {
+	XMLName xml.Name    `xml:"kml"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Name    string      `xml:"Document>name"`
+	Styles  []kmlStyle  `xml:"Document>Style"`
+	Folders []kmlFolder `xml:"Document>Folder"`
+}
+
+type kmlStyle struct {
+	ID        string         `xml:"id,attr"`
+	IconStyle *kmlColorStyle `xml:"IconStyle,omitempty"`
+	LineStyle *kmlColorStyle `xml:"LineStyle,omitempty"`
+	PolyStyle *kmlColorStyle `xml:"PolyStyle,omitempty"`
+}
+
+type kmlColorStyle struct {
+	Color string `xml:"color"`
+}
+
+type kmlFolder struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	ID            string            `xml:"id,attr"`
+	Name          string            `xml:"name"`
+	StyleURL      string            `xml:"styleUrl,omitempty"`
+	Data          []kmlData         `xml:"ExtendedData>Data"`
+	Point         *kmlPoint         `xml:"Point,omitempty"`
+	MultiGeometry *kmlMultiGeometry `xml:"MultiGeometry,omitempty"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlMultiGeometry struct {
+	Polygons []kmlPolygon `xml:"Polygon"`
+}
+
+type kmlPolygon struct {
+	Outer string   `xml:"outerBoundaryIs>LinearRing>coordinates"`
+	Inner []string `xml:"innerBoundaryIs>LinearRing>coordinates"`
+}
+
+// WriteKML writes the layers as a KML document with a folder per layer. Styles become shared KML styles, points
+// are coloured through their icon and areas through a half transparent fill.
+func WriteKML(w io.Writer, name string, layers ...Layer) error {
+	document := kmlDocument{Xmlns: "http://www.opengis.net/kml/2.2", Name: name}
+	for _, layer := range layers {
+		for _, style := range layer.Styles {
+			color, err := kmlColor(style.Color)
+			if err != nil {
+				return err
+			}
+			kml := kmlStyle{ID: layer.Name + "-" + style.ID}
+			if layer.GeometryType == GeometryPoint {
+				kml.IconStyle = &kmlColorStyle{Color: "ff" + color}
+			} else {
+				kml.LineStyle = &kmlColorStyle{Color: "ff" + color}
+				kml.PolyStyle = &kmlColorStyle{Color: kmlPolygonOpacity + color}
+			}
+			document.Styles = append(document.Styles, kml)
+		}
+
+		folder := kmlFolder{Name: layer.Name}
+		for _, feature := range layer.Features {
+			placemark := kmlPlacemark{ID: layer.Name + "-" + strconv.FormatInt(feature.ID, 10), Name: feature.Name}
+			if feature.Style != "" {
+				placemark.StyleURL = "#" + layer.Name + "-" + feature.Style
+			}
+			for i, column := range layer.Columns {
+				if i < len(feature.Values) && feature.Values[i] != nil {
+					placemark.Data = append(placemark.Data, kmlData{Name: column.Name, Value: formatKMLValue(feature.Values[i])})
+				}
+			}
+			if layer.GeometryType == GeometryPoint {
+				placemark.Point = &kmlPoint{Coordinates: kmlPosition(feature.Point.Longitude, feature.Point.Latitude)}
+			} else {
+				placemark.MultiGeometry = &kmlMultiGeometry{}
+				for _, polygon := range feature.Area {
+					if len(polygon) == 0 {
+						continue
+					}
+					kml := kmlPolygon{Outer: kmlRing(polygon[0])}
+					for _, hole := range polygon[1:] {
+						kml.Inner = append(kml.Inner, kmlRing(hole))
+					}
+					placemark.MultiGeometry.Polygons = append(placemark.MultiGeometry.Polygons, kml)
+				}
+			}
+			folder.Placemarks = append(folder.Placemarks, placemark)
+		}
+		document.Folders = append(document.Folders, folder)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// kmlColor converts a #rrggbb colour to the bbggrr order of KML, the alpha is prefixed by the caller
+func kmlColor(color string) (string, error) {
+	if len(color) != 7 || color[0] != '#' {
+		return "", fmt.Errorf("invalid colour %q, expected #rrggbb", color)
+	}
+	if _, err := strconv.ParseUint(color[1:], 16, 32); err != nil {
+		return "", fmt.Errorf("invalid colour %q, expected #rrggbb", color)
+	}
+	return strings.ToLower(color[5:7] + color[3:5] + color[1:3]), nil
+}
+
+func kmlPosition(longitude, latitude float64) string {
+	return strconv.FormatFloat(longitude, 'f', -1, 64) + "," + strconv.FormatFloat(latitude, 'f', -1, 64)
+}
+
+func kmlRing(ring [][2]float64) string {
+	positions := make([]string, len(ring))
+	for i, position := range ring {
+		positions[i] = kmlPosition(position[0], position[1])
+	}
+	return strings.Join(positions, " ")
+}
+
+func formatKMLValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,108 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"main/structs"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKMLColor(t *testing.T) {
+	for color, expected := range map[string]string{"#22c55e": "5ec522", "#FF0000": "0000ff", "#000000": "000000"} {
+		if kml, err := kmlColor(color); err != nil || kml != expected {
+			t.Errorf("Expected %s to become %s, got %s (%v)", color, expected, kml, err)
+		}
+	}
+	for _, color := range []string{"", "22c55e", "#22c55", "#22c55e0", "#22c5zz", "#-2c55e"} {
+		if _, err := kmlColor(color); err == nil {
+			t.Errorf("Expected %q to be rejected", color)
+		}
+	}
+}
+
+func TestWriteKML(t *testing.T) {
+	lastSeen := time.Date(2024, 3, 1, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+	devices := Layer{
+		Name:         "devices",
+		GeometryType: GeometryPoint,
+		Columns:      []Column{{Name: "status", Type: "TEXT"}, {Name: "load", Type: "DOUBLE"}, {Name: "apps", Type: "INTEGER"}, {Name: "last_seen", Type: "DATETIME"}},
+		Styles:       []Style{{ID: "online", Color: "#22c55e"}},
+		Features: []Feature{
+			{ID: 1, Name: "MSR <1>", Style: "online", Point: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214},
+				Values: []interface{}{"online", 0.25, int64(3), lastSeen}},
+			{ID: 2, Name: "MSR_2", Point: structs.Coordinate{Latitude: 51.9, Longitude: 4.4}, Values: []interface{}{nil, 1.0}},
+		},
+	}
+	areas := Layer{
+		Name:         "areas",
+		GeometryType: GeometryMultiPolygon,
+		Styles:       []Style{{ID: "area", Color: "#3B82F6"}},
+		Features:     []Feature{{ID: 5, Name: "Utrecht", Style: "area", Area: MultiPolygon{testArea[0], nil}}},
+	}
+
+	var out bytes.Buffer
+	if err := WriteKML(&out, "Export", devices, areas); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), xml.Header) {
+		t.Errorf("Expected the XML declaration")
+	}
+
+	var document kmlDocument
+	if err := xml.Unmarshal(out.Bytes(), &document); err != nil {
+		t.Fatalf("Expected valid XML, got %v", err)
+	}
+	if document.XMLName.Space != "http://www.opengis.net/kml/2.2" || document.Name != "Export" || len(document.Folders) != 2 {
+		t.Fatalf("Expected a KML 2.2 document with a folder per layer, got %+v", document)
+	}
+
+	// Points are coloured through their icon, areas through their outline and a half transparent fill
+	if len(document.Styles) != 2 {
+		t.Fatalf("Expected a style per layer style, got %+v", document.Styles)
+	}
+	if style := document.Styles[0]; style.ID != "devices-online" || style.IconStyle == nil || style.IconStyle.Color != "ff5ec522" || style.PolyStyle != nil {
+		t.Errorf("Expected an opaque icon style, got %+v", style)
+	}
+	if style := document.Styles[1]; style.ID != "areas-area" || style.LineStyle == nil || style.PolyStyle == nil || style.LineStyle.Color != "fff6823b" || style.PolyStyle.Color != "99f6823b" || style.IconStyle != nil {
+		t.Errorf("Expected an outline and fill style, got %+v", style)
+	}
+
+	placemarks := document.Folders[0].Placemarks
+	if document.Folders[0].Name != "devices" || len(placemarks) != 2 {
+		t.Fatalf("Expected both devices in their folder, got %+v", document.Folders[0])
+	}
+	first := placemarks[0]
+	if first.ID != "devices-1" || first.Name != "MSR <1>" || first.StyleURL != "#devices-online" || first.Point == nil ||
+		first.Point.Coordinates != "5.1214,52.0907" || first.MultiGeometry != nil {
+		t.Errorf("Expected the first device as a styled point, got %+v", first)
+	}
+	data := map[string]string{}
+	for _, d := range first.Data {
+		data[d.Name] = d.Value
+	}
+	if len(data) != 4 || data["status"] != "online" || data["load"] != "0.25" || data["apps"] != "3" || data["last_seen"] != "2024-03-01T12:30:00Z" {
+		t.Errorf("Expected the formatted attributes, got %v", data)
+	}
+
+	// Missing and nil values are left out, as is the style of a feature without one
+	second := placemarks[1]
+	if second.StyleURL != "" || len(second.Data) != 1 || second.Data[0].Name != "load" || second.Data[0].Value != "1" {
+		t.Errorf("Expected only the load of the second device, got %+v", second)
+	}
+
+	// Empty polygons are skipped, holes become inner boundaries
+	area := document.Folders[1].Placemarks[0]
+	if area.ID != "areas-5" || area.Point != nil || area.MultiGeometry == nil || len(area.MultiGeometry.Polygons) != 1 {
+		t.Fatalf("Expected the area as a multigeometry of one polygon, got %+v", area)
+	}
+	polygon := area.MultiGeometry.Polygons[0]
+	if polygon.Outer != "5,52 5.2,52 5.2,52.2 5,52.2 5,52" || len(polygon.Inner) != 1 || polygon.Inner[0] != "5.05,52.05 5.1,52.05 5.1,52.1 5.05,52.05" {
+		t.Errorf("Expected the outer ring and hole, got %+v", polygon)
+	}
+
+	if err := WriteKML(&out, "Invalid", Layer{Name: "invalid", Styles: []Style{{ID: "bad", Color: "green"}}}); err == nil {
+		t.Errorf("Expected an invalid style colour to be rejected")
+	}
+}
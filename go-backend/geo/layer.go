@@ -0,0 +1,72 @@
+package geo
+
+import "main/structs"
+
+// Geometry types of a layer, named as in GeoPackage
+const (
+	GeometryPoint        = "POINT"
+	GeometryMultiPolygon = "MULTIPOLYGON"
+)
+
+// Layer is a set of features with the same geometry type and attributes, written as a KML folder or a
+// GeoPackage table
+type Layer struct {
+	Name         string
+	GeometryType string
+	Columns      []Column
+	Styles       []Style
+	Features     []Feature
+}
+
+// Column is an attribute of the features in a layer. Type is the GeoPackage data type: TEXT, INTEGER, DOUBLE or
+// DATETIME.
+type Column struct {
+	Name string
+	Type string
+}
+
+// Style is a colour the features of a layer can refer to by ID
+type Style struct {
+	ID    string
+	Color string // #rrggbb
+}
+
+// Feature is a point or an area with a value per column of its layer. Values can be strings, int64, float64,
+// time.Time or nil.
+type Feature struct {
+	ID     int64 // Unique and positive, used as feature ID in the GeoPackage
+	Name   string
+	Style  string
+	Point  structs.Coordinate // For point layers
+	Area   MultiPolygon       // For multipolygon layers
+	Values []interface{}
+}
+
+// Bounds returns the bounding box around the features of the layer, false when it has none
+func (l Layer) Bounds() (structs.BoundingBox, bool) {
+	var bounds structs.BoundingBox
+	found := false
+	for _, feature := range l.Features {
+		featureBounds := feature.Bounds(l.GeometryType)
+		if !found {
+			bounds, found = featureBounds, true
+			continue
+		}
+		bounds.MinLongitude = min(bounds.MinLongitude, featureBounds.MinLongitude)
+		bounds.MinLatitude = min(bounds.MinLatitude, featureBounds.MinLatitude)
+		bounds.MaxLongitude = max(bounds.MaxLongitude, featureBounds.MaxLongitude)
+		bounds.MaxLatitude = max(bounds.MaxLatitude, featureBounds.MaxLatitude)
+	}
+	return bounds, found
+}
+
+// Bounds returns the bounding box around the geometry of the feature
+func (f Feature) Bounds(geometryType string) structs.BoundingBox {
+	if geometryType == GeometryPoint {
+		return structs.BoundingBox{
+			MinLongitude: f.Point.Longitude, MinLatitude: f.Point.Latitude,
+			MaxLongitude: f.Point.Longitude, MaxLatitude: f.Point.Latitude,
+		}
+	}
+	return f.Area.Bounds()
+}
@@ -0,0 +1,104 @@
+// Package geo holds the municipality boundaries of the Stedin service area and the geometry used on them
+package geo
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"main/structs"
+	"sync"
+)
+
+// municipalitiesGeoJSON is the FeatureCollection of municipality boundaries. It is the single copy of the
+// boundaries, the seeder reads the same file and the frontend fetches it from the backend.
+//
+//go:embed municipalities.geojson
+var municipalitiesGeoJSON []byte
+
+// MultiPolygon is a list of polygons, each a list of rings (the outer boundary followed by its holes) of
+// [longitude, latitude] positions
+type MultiPolygon [][][][2]float64
+
+// Municipality is a municipality with its boundary
+type Municipality struct {
+	Code       string
+	Name       string
+	Boundary   MultiPolygon
+	Bounds     structs.BoundingBox    // Bounding box of the boundary, to skip the polygon test for distant points
+	Properties map[string]interface{} // All properties from the source, including code and name
+}
+
+var (
+	loadOnce       sync.Once
+	municipalities []Municipality
+	loadErr        error
+)
+
+// Municipalities returns the municipalities in the order of the source file, parsing it on first use
+func Municipalities() ([]Municipality, error) {
+	loadOnce.Do(func() {
+		municipalities, loadErr = parseMunicipalities(municipalitiesGeoJSON)
+	})
+	return municipalities, loadErr
+}
+
+// MunicipalityByName returns the municipality with the name, false when there is none
+func MunicipalityByName(name string) (Municipality, bool, error) {
+	all, err := Municipalities()
+	if err != nil {
+		return Municipality{}, false, err
+	}
+	for _, municipality := range all {
+		if municipality.Name == name {
+			return municipality, true, nil
+		}
+	}
+	return Municipality{}, false, nil
+}
+
+// Feature returns the municipality as a MultiPolygon feature with its source properties
+func (m Municipality) Feature() structs.Feature {
+	return structs.Feature{
+		Type:       "Feature",
+		ID:         m.Code,
+		Geometry:   structs.Geometry{Type: "MultiPolygon", Coordinates: m.Boundary},
+		Properties: m.Properties,
+	}
+}
+
+// parseMunicipalities parses a FeatureCollection of MultiPolygon features with a code and name property
+func parseMunicipalities(data []byte) ([]Municipality, error) {
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string       `json:"type"`
+				Coordinates MultiPolygon `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("error parsing municipality boundaries: %w", err)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("municipality boundaries must be a FeatureCollection, got %q", collection.Type)
+	}
+
+	parsed := make([]Municipality, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		name, _ := feature.Properties["name"].(string)
+		code, _ := feature.Properties["code"].(string)
+		if name == "" || feature.Geometry.Type != "MultiPolygon" {
+			return nil, fmt.Errorf("municipality boundary %d must be a named MultiPolygon", i)
+		}
+		parsed = append(parsed, Municipality{
+			Code:       code,
+			Name:       name,
+			Boundary:   feature.Geometry.Coordinates,
+			Bounds:     feature.Geometry.Coordinates.Bounds(),
+			Properties: feature.Properties,
+		})
+	}
+	return parsed, nil
+}
@@ -0,0 +1,173 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// TileLayer is a named layer of point features in a Mapbox Vector Tile
+type TileLayer struct {
+	Name     string
+	Extent   int
+	Features []TileFeature
+}
+
+// TileFeature is a point in tile units with its attributes. Attribute values can be strings, booleans, integers
+// or floats.
+type TileFeature struct {
+	ID         uint64
+	X, Y       int
+	Properties map[string]interface{}
+}
+
+// Field numbers and wire types of the vector tile protobuf schema (version 2.1)
+const (
+	wireVarint    = 0
+	wireFixed64   = 1
+	wireBytes     = 2
+	tileLayers    = 3
+	layerName     = 1
+	layerFeatures = 2
+	layerKeys     = 3
+	layerValues   = 4
+	layerExtent   = 5
+	layerVersion  = 15
+	featureID     = 1
+	featureTags   = 2
+	featureType   = 3
+	featureGeom   = 4
+	valueString   = 1
+	valueDouble   = 3
+	valueInt      = 4
+	valueBool     = 7
+	geomPoint     = 1
+	commandMoveTo = 1
+)
+
+// EncodeVectorTile encodes the layers as a Mapbox Vector Tile. Layers without features are left out, so a tile
+// without any features is empty.
+func EncodeVectorTile(layers ...TileLayer) []byte {
+	var tile []byte
+	for _, layer := range layers {
+		if len(layer.Features) == 0 {
+			continue
+		}
+		tile = appendBytesField(tile, tileLayers, encodeLayer(layer))
+	}
+	return tile
+}
+
+// encodeLayer encodes a layer, keys and values are shared by the features and stored once
+func encodeLayer(layer TileLayer) []byte {
+	var keys []string
+	keyIndex := make(map[string]uint64)
+	var values [][]byte
+	valueIndex := make(map[string]uint64)
+
+	var encoded []byte
+	encoded = appendVarintField(encoded, layerVersion, 2)
+	encoded = appendBytesField(encoded, layerName, []byte(layer.Name))
+
+	for _, feature := range layer.Features {
+		names := make([]string, 0, len(feature.Properties))
+		for name := range feature.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var tags []uint64
+		for _, name := range names {
+			value, ok := encodeValue(feature.Properties[name])
+			if !ok {
+				continue
+			}
+			if _, seen := keyIndex[name]; !seen {
+				keyIndex[name] = uint64(len(keys))
+				keys = append(keys, name)
+			}
+			if _, seen := valueIndex[string(value)]; !seen {
+				valueIndex[string(value)] = uint64(len(values))
+				values = append(values, value)
+			}
+			tags = append(tags, keyIndex[name], valueIndex[string(value)])
+		}
+
+		// A single MoveTo to the point, relative to the origin of the tile
+		geometry := []uint64{commandMoveTo&0x7 | 1<<3, zigzag(int64(feature.X)), zigzag(int64(feature.Y))}
+
+		var encodedFeature []byte
+		encodedFeature = appendVarintField(encodedFeature, featureID, feature.ID)
+		encodedFeature = appendBytesField(encodedFeature, featureTags, packVarints(tags))
+		encodedFeature = appendVarintField(encodedFeature, featureType, geomPoint)
+		encodedFeature = appendBytesField(encodedFeature, featureGeom, packVarints(geometry))
+		encoded = appendBytesField(encoded, layerFeatures, encodedFeature)
+	}
+
+	for _, key := range keys {
+		encoded = appendBytesField(encoded, layerKeys, []byte(key))
+	}
+	for _, value := range values {
+		encoded = appendBytesField(encoded, layerValues, value)
+	}
+	return appendVarintField(encoded, layerExtent, uint64(layer.Extent))
+}
+
+// encodeValue encodes an attribute value as a Value message, false for unsupported types
+func encodeValue(value interface{}) ([]byte, bool) {
+	switch v := value.(type) {
+	case string:
+		return appendBytesField(nil, valueString, []byte(v)), true
+	case bool:
+		var b uint64
+		if v {
+			b = 1
+		}
+		return appendVarintField(nil, valueBool, b), true
+	case int:
+		return appendVarintField(nil, valueInt, uint64(v)), true
+	case int64:
+		return appendVarintField(nil, valueInt, uint64(v)), true
+	case float64:
+		encoded := appendTag(nil, valueDouble, wireFixed64)
+		bits := math.Float64bits(v)
+		for i := 0; i < 8; i++ {
+			encoded = append(encoded, byte(bits>>(8*i)))
+		}
+		return encoded, true
+	}
+	return nil, false
+}
+
+// zigzag maps signed integers to unsigned ones so small negative numbers stay small
+func zigzag(n int64) uint64 {
+	return uint64((n << 1) ^ (n >> 63))
+}
+
+func packVarints(values []uint64) []byte {
+	var packed []byte
+	for _, value := range values {
+		packed = appendVarint(packed, value)
+	}
+	return packed
+}
+
+func appendTag(b []byte, field int, wireType int) []byte {
+	return appendVarint(b, uint64(field<<3|wireType))
+}
+
+func appendVarintField(b []byte, field int, value uint64) []byte {
+	return appendVarint(appendTag(b, field, wireVarint), value)
+}
+
+func appendBytesField(b []byte, field int, value []byte) []byte {
+	b = appendVarint(appendTag(b, field, wireBytes), uint64(len(value)))
+	return append(b, value...)
+}
+
+func appendVarint(b []byte, value uint64) []byte {
+	for value >= 0x80 {
+		b = append(b, byte(value)|0x80)
+		value >>= 7
+	}
+	return append(b, byte(value))
+}
@@ -0,0 +1,260 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"main/structs"
+	"math"
+	"testing"
+)
+
+// protoField is a decoded protobuf field, either a varint, a fixed 64 bit value or length-delimited bytes
+type protoField struct {
+	number int
+	value  uint64
+	data   []byte
+}
+
+// decodeProto splits a protobuf message into its fields, failing the test on malformed input
+func decodeProto(t *testing.T, message []byte) []protoField {
+	t.Helper()
+	var fields []protoField
+	for len(message) > 0 {
+		tag, n := binary.Uvarint(message)
+		if n <= 0 {
+			t.Fatalf("Invalid field tag in %x", message)
+		}
+		message = message[n:]
+		field := protoField{number: int(tag >> 3)}
+		switch tag & 0x7 {
+		case wireVarint:
+			field.value, n = binary.Uvarint(message)
+			if n <= 0 {
+				t.Fatalf("Invalid varint in field %d", field.number)
+			}
+			message = message[n:]
+		case wireFixed64:
+			field.value = binary.LittleEndian.Uint64(message)
+			message = message[8:]
+		case wireBytes:
+			length, n := binary.Uvarint(message)
+			if n <= 0 || int(length) > len(message)-n {
+				t.Fatalf("Invalid length of field %d", field.number)
+			}
+			field.data = message[n : n+int(length)]
+			message = message[n+int(length):]
+		default:
+			t.Fatalf("Unexpected wire type %d of field %d", tag&0x7, field.number)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// unpackVarints decodes a packed repeated varint field
+func unpackVarints(t *testing.T, packed []byte) []uint64 {
+	t.Helper()
+	var values []uint64
+	for len(packed) > 0 {
+		value, n := binary.Uvarint(packed)
+		if n <= 0 {
+			t.Fatalf("Invalid packed varint in %x", packed)
+		}
+		values = append(values, value)
+		packed = packed[n:]
+	}
+	return values
+}
+
+func TestZigzagAndVarint(t *testing.T) {
+	for n, expected := range map[int64]uint64{0: 0, -1: 1, 1: 2, -2: 3, 2: 4, 2147483647: 4294967294, -2147483648: 4294967295} {
+		if encoded := zigzag(n); encoded != expected {
+			t.Errorf("Expected zigzag(%d) to be %d, got %d", n, expected, encoded)
+		}
+	}
+
+	for value, expected := range map[uint64][]byte{0: {0x00}, 1: {0x01}, 127: {0x7f}, 128: {0x80, 0x01}, 300: {0xac, 0x02}, 4096: {0x80, 0x20}} {
+		if encoded := appendVarint(nil, value); !bytes.Equal(encoded, expected) {
+			t.Errorf("Expected varint %d to be %x, got %x", value, expected, encoded)
+		}
+	}
+	// The largest value takes the full ten bytes and decodes back
+	encoded := appendVarint(nil, math.MaxUint64)
+	if decoded, n := binary.Uvarint(encoded); len(encoded) != 10 || n != 10 || decoded != math.MaxUint64 {
+		t.Errorf("Expected the maximum to round trip in 10 bytes, got %x", encoded)
+	}
+}
+
+func TestTileBounds(t *testing.T) {
+	world := TileBounds(0, 0, 0)
+	if world.MinLongitude != -180 || world.MaxLongitude != 180 ||
+		math.Abs(world.MaxLatitude-MaxMercatorLatitude) > 1e-9 || math.Abs(world.MinLatitude+MaxMercatorLatitude) > 1e-9 {
+		t.Errorf("Expected tile 0/0/0 to cover the Web Mercator world, got %+v", world)
+	}
+
+	// Utrecht lies on tile 526/337 at zoom level 10, the tiles next to it share its edges
+	utrecht := structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}
+	tile := TileBounds(10, 526, 337)
+	if utrecht.Longitude < tile.MinLongitude || utrecht.Longitude > tile.MaxLongitude ||
+		utrecht.Latitude < tile.MinLatitude || utrecht.Latitude > tile.MaxLatitude {
+		t.Errorf("Expected tile 10/526/337 to contain Utrecht, got %+v", tile)
+	}
+	if east := TileBounds(10, 527, 337); east.MinLongitude != tile.MaxLongitude {
+		t.Errorf("Expected the tile to the east to start at %f, got %f", tile.MaxLongitude, east.MinLongitude)
+	}
+	if south := TileBounds(10, 526, 338); math.Abs(south.MaxLatitude-tile.MinLatitude) > 1e-12 {
+		t.Errorf("Expected the tile to the south to start at %f, got %f", tile.MinLatitude, south.MaxLatitude)
+	}
+}
+
+func TestTileCoordinate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		z, x, y    int
+		coordinate structs.Coordinate
+		px, py     int
+	}{
+		{"Top Left Of The World", 0, 0, 0, structs.Coordinate{Latitude: MaxMercatorLatitude, Longitude: -180}, 0, 0},
+		{"Centre Of The World", 0, 0, 0, structs.Coordinate{Latitude: 0, Longitude: 0}, 2048, 2048},
+		{"Corner Of A Quarter", 1, 0, 0, structs.Coordinate{Latitude: 0, Longitude: 0}, 4096, 4096},
+		{"Clamped Near The Pole", 0, 0, 0, structs.Coordinate{Latitude: 89.9, Longitude: 180}, 4096, 0},
+		{"Outside The Tile", 1, 1, 1, structs.Coordinate{Latitude: 10, Longitude: -10}, -228, -229},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			px, py := TileCoordinate(tc.z, tc.x, tc.y, tc.coordinate, TileExtent)
+			if px != tc.px || py != tc.py {
+				t.Errorf("Expected (%d, %d), got (%d, %d)", tc.px, tc.py, px, py)
+			}
+		})
+	}
+
+	// Utrecht lies inside its tile
+	px, py := TileCoordinate(10, 526, 337, structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}, TileExtent)
+	if px < 0 || px > TileExtent || py < 0 || py > TileExtent {
+		t.Errorf("Expected Utrecht inside tile 10/526/337, got (%d, %d)", px, py)
+	}
+}
+
+func TestEncodeVectorTileBytes(t *testing.T) {
+	// A single feature without attributes at the origin of the tile
+	tile := EncodeVectorTile(TileLayer{Name: "d", Extent: TileExtent, Features: []TileFeature{{ID: 1}}})
+	expected := []byte{
+		0x1a, 0x15, // layer, 21 bytes
+		0x78, 0x02, // version 2
+		0x0a, 0x01, 'd', // name
+		0x12, 0x0b, // feature, 11 bytes
+		0x08, 0x01, // id 1
+		0x12, 0x00, // no tags
+		0x18, 0x01, // point
+		0x22, 0x03, 0x09, 0x00, 0x00, // MoveTo(0, 0)
+		0x28, 0x80, 0x20, // extent 4096
+	}
+	if !bytes.Equal(tile, expected) {
+		t.Errorf("Expected tile %x, got %x", expected, tile)
+	}
+
+	if tile := EncodeVectorTile(TileLayer{Name: "empty", Extent: TileExtent}); len(tile) != 0 {
+		t.Errorf("Expected a tile without features to be empty, got %x", tile)
+	}
+}
+
+func TestEncodeVectorTile(t *testing.T) {
+	tile := EncodeVectorTile(TileLayer{
+		Name:   "devices",
+		Extent: TileExtent,
+		Features: []TileFeature{
+			{ID: 1, X: 10, Y: -3, Properties: map[string]interface{}{"status": "online", "name": "MSR_1", "load": 0.5, "quarantined": false}},
+			{ID: 2, X: 4100, Y: 20, Properties: map[string]interface{}{"status": "online", "name": "MSR_2", "apps": int64(-2), "skipped": []string{"x"}}},
+		},
+	})
+
+	layers := decodeProto(t, tile)
+	if len(layers) != 1 || layers[0].number != tileLayers {
+		t.Fatalf("Expected a single layer, got %+v", layers)
+	}
+
+	var name string
+	var version, extent uint64
+	var features [][]protoField
+	var keys []string
+	var values [][]protoField
+	for _, field := range decodeProto(t, layers[0].data) {
+		switch field.number {
+		case layerVersion:
+			version = field.value
+		case layerName:
+			name = string(field.data)
+		case layerExtent:
+			extent = field.value
+		case layerFeatures:
+			features = append(features, decodeProto(t, field.data))
+		case layerKeys:
+			keys = append(keys, string(field.data))
+		case layerValues:
+			values = append(values, decodeProto(t, field.data))
+		}
+	}
+	if name != "devices" || version != 2 || extent != TileExtent || len(features) != 2 {
+		t.Fatalf("Expected layer devices version 2 with extent %d and 2 features, got %s version %d extent %d with %d features",
+			TileExtent, name, version, extent, len(features))
+	}
+
+	// Keys and values shared by the features are stored once, unsupported values are left out
+	if len(keys) != 5 {
+		t.Errorf("Expected the keys load, name, quarantined, status and apps once each, got %v", keys)
+	}
+	if len(values) != 6 {
+		t.Errorf("Expected 0.5, MSR_1, false, online, -2 and MSR_2 once each, got %d values", len(values))
+	}
+
+	decoded := make([]map[string]interface{}, len(features))
+	for i, feature := range features {
+		decoded[i] = make(map[string]interface{})
+		for _, field := range feature {
+			switch field.number {
+			case featureID:
+				decoded[i]["id"] = field.value
+			case featureType:
+				decoded[i]["type"] = field.value
+			case featureGeom:
+				decoded[i]["geometry"] = unpackVarints(t, field.data)
+			case featureTags:
+				tags := unpackVarints(t, field.data)
+				for j := 0; j+1 < len(tags); j += 2 {
+					value := values[tags[j+1]][0]
+					switch value.number {
+					case valueString:
+						decoded[i][keys[tags[j]]] = string(value.data)
+					case valueDouble:
+						decoded[i][keys[tags[j]]] = math.Float64frombits(value.value)
+					case valueInt:
+						decoded[i][keys[tags[j]]] = int64(value.value)
+					case valueBool:
+						decoded[i][keys[tags[j]]] = value.value == 1
+					}
+				}
+			}
+		}
+	}
+
+	first, second := decoded[0], decoded[1]
+	if first["id"] != uint64(1) || first["type"] != uint64(geomPoint) || first["status"] != "online" || first["name"] != "MSR_1" ||
+		first["load"] != 0.5 || first["quarantined"] != false {
+		t.Errorf("Expected the attributes of the first feature, got %v", first)
+	}
+	if second["id"] != uint64(2) || second["status"] != "online" || second["name"] != "MSR_2" || second["apps"] != int64(-2) {
+		t.Errorf("Expected the attributes of the second feature, got %v", second)
+	}
+	if _, ok := second["skipped"]; ok {
+		t.Errorf("Expected the unsupported attribute to be left out")
+	}
+
+	// A single MoveTo with zigzag encoded positions, which may lie in the buffer outside the tile
+	if geometry := first["geometry"].([]uint64); len(geometry) != 3 || geometry[0] != 9 || geometry[1] != 20 || geometry[2] != 5 {
+		t.Errorf("Expected MoveTo(10, -3), got %v", geometry)
+	}
+	if geometry := second["geometry"].([]uint64); len(geometry) != 3 || geometry[1] != 8200 || geometry[2] != 40 {
+		t.Errorf("Expected MoveTo(4100, 20), got %v", geometry)
+	}
+}
@@ -0,0 +1,163 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/structs"
+	"strconv"
+	"strings"
+)
+
+// Contains reports whether the coordinate lies within one of the polygons, inside its outer ring and outside its
+// holes
+func (p MultiPolygon) Contains(coordinate structs.Coordinate) bool {
+	for _, polygon := range p {
+		if len(polygon) == 0 || !isPointInRing(coordinate.Longitude, coordinate.Latitude, polygon[0]) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if isPointInRing(coordinate.Longitude, coordinate.Latitude, hole) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// Bounds returns the smallest bounding box around all polygons
+func (p MultiPolygon) Bounds() structs.BoundingBox {
+	var bounds structs.BoundingBox
+	first := true
+	for _, polygon := range p {
+		for _, ring := range polygon {
+			for _, position := range ring {
+				lon, lat := position[0], position[1]
+				if first {
+					bounds = structs.BoundingBox{MinLongitude: lon, MinLatitude: lat, MaxLongitude: lon, MaxLatitude: lat}
+					first = false
+					continue
+				}
+				bounds.MinLongitude = min(bounds.MinLongitude, lon)
+				bounds.MinLatitude = min(bounds.MinLatitude, lat)
+				bounds.MaxLongitude = max(bounds.MaxLongitude, lon)
+				bounds.MaxLatitude = max(bounds.MaxLatitude, lat)
+			}
+		}
+	}
+	return bounds
+}
+
+// MunicipalityAt returns the municipality the coordinate lies in, false when it lies outside all of them
+func MunicipalityAt(coordinate structs.Coordinate) (Municipality, bool, error) {
+	all, err := Municipalities()
+	if err != nil {
+		return Municipality{}, false, err
+	}
+	for _, municipality := range all {
+		bounds := municipality.Bounds
+		if coordinate.Longitude < bounds.MinLongitude || coordinate.Longitude > bounds.MaxLongitude ||
+			coordinate.Latitude < bounds.MinLatitude || coordinate.Latitude > bounds.MaxLatitude {
+			continue
+		}
+		if municipality.Boundary.Contains(coordinate) {
+			return municipality, true, nil
+		}
+	}
+	return Municipality{}, false, nil
+}
+
+// isPointInRing checks whether a point lies within a ring using the ray-casting algorithm
+func isPointInRing(lon, lat float64, ring [][2]float64) bool {
+	inside := false
+	j := len(ring) - 1
+	for i := 0; i < len(ring); i++ {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if ((yi > lat) != (yj > lat)) && (lon < (xj-xi)*(lat-yi)/(yj-yi)+xi) {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
+
+// ParseArea parses a GeoJSON Polygon or MultiPolygon, bare or as the geometry of a Feature. Every ring must be
+// closed, have at least four positions and lie within the longitude and latitude ranges.
+func ParseArea(data []byte) (MultiPolygon, error) {
+	type geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	var object struct {
+		geometry
+		Geometry *geometry `json:"geometry"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	area := object.geometry
+	if area.Type == "Feature" {
+		if object.Geometry == nil {
+			return nil, fmt.Errorf("the feature has no geometry")
+		}
+		area = *object.Geometry
+	}
+
+	var polygons MultiPolygon
+	switch area.Type {
+	case "Polygon":
+		var polygon [][][2]float64
+		if err := json.Unmarshal(area.Coordinates, &polygon); err != nil {
+			return nil, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		polygons = MultiPolygon{polygon}
+	case "MultiPolygon":
+		if err := json.Unmarshal(area.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("expected a Polygon or MultiPolygon, got %q", area.Type)
+	}
+
+	if len(polygons) == 0 {
+		return nil, fmt.Errorf("the area has no polygons")
+	}
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			return nil, fmt.Errorf("every polygon needs an outer ring")
+		}
+		for _, ring := range polygon {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+				return nil, fmt.Errorf("every ring must be closed and have at least four positions")
+			}
+			for _, position := range ring {
+				if position[0] < -180 || position[0] > 180 || position[1] < -90 || position[1] > 90 {
+					return nil, fmt.Errorf("position %v lies outside the longitude and latitude ranges", position)
+				}
+			}
+		}
+	}
+	return polygons, nil
+}
+
+// WKT returns the polygons as WKT in the (longitude latitude) axis order of the coordinates column
+func (p MultiPolygon) WKT() string {
+	polygons := make([]string, len(p))
+	for i, polygon := range p {
+		rings := make([]string, len(polygon))
+		for j, ring := range polygon {
+			positions := make([]string, len(ring))
+			for k, position := range ring {
+				positions[k] = strconv.FormatFloat(position[0], 'f', -1, 64) + " " + strconv.FormatFloat(position[1], 'f', -1, 64)
+			}
+			rings[j] = "(" + strings.Join(positions, ", ") + ")"
+		}
+		polygons[i] = "(" + strings.Join(rings, ", ") + ")"
+	}
+	return "MULTIPOLYGON(" + strings.Join(polygons, ", ") + ")"
+}
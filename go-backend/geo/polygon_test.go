@@ -0,0 +1,117 @@
+package geo
+
+import (
+	"main/structs"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	// testArea with a second square further east
+	area := MultiPolygon{testArea[0], {{{6.0, 52.0}, {6.1, 52.0}, {6.1, 52.1}, {6.0, 52.1}, {6.0, 52.0}}}}
+	testCases := []struct {
+		name       string
+		coordinate structs.Coordinate
+		expected   bool
+	}{
+		{"Inside The Outer Ring", structs.Coordinate{Latitude: 52.15, Longitude: 5.15}, true},
+		{"Inside The Hole", structs.Coordinate{Latitude: 52.06, Longitude: 5.08}, false},
+		{"Inside The Second Polygon", structs.Coordinate{Latitude: 52.05, Longitude: 6.05}, true},
+		{"Between The Polygons", structs.Coordinate{Latitude: 52.05, Longitude: 5.5}, false},
+		{"Outside All Polygons", structs.Coordinate{Latitude: 51.0, Longitude: 5.1}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if contains := area.Contains(tc.coordinate); contains != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, contains)
+			}
+		})
+	}
+
+	if (MultiPolygon{{}}).Contains(structs.Coordinate{Latitude: 52.1, Longitude: 5.1}) {
+		t.Errorf("Expected a polygon without rings to contain nothing")
+	}
+}
+
+func TestBounds(t *testing.T) {
+	area := MultiPolygon{testArea[0], {{{4.9, 52.1}, {5.1, 52.3}, {5.0, 52.1}, {4.9, 52.1}}}}
+	expected := structs.BoundingBox{MinLongitude: 4.9, MinLatitude: 52.0, MaxLongitude: 5.2, MaxLatitude: 52.3}
+	if bounds := area.Bounds(); bounds != expected {
+		t.Errorf("Expected %+v, got %+v", expected, bounds)
+	}
+	if bounds := (MultiPolygon{}).Bounds(); bounds != (structs.BoundingBox{}) {
+		t.Errorf("Expected empty bounds without positions, got %+v", bounds)
+	}
+}
+
+func TestMunicipalityAt(t *testing.T) {
+	testCases := []struct {
+		name       string
+		coordinate structs.Coordinate
+		code       string
+		found      bool
+	}{
+		{"Delft", structs.Coordinate{Latitude: 52.0116, Longitude: 4.3571}, "0503", true},
+		{"Utrecht", structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}, "0344", true},
+		{"North Sea", structs.Coordinate{Latitude: 52.5, Longitude: 3.5}, "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			municipality, found, err := MunicipalityAt(tc.coordinate)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if found != tc.found || municipality.Code != tc.code {
+				t.Errorf("Expected municipality %q (%v), got %q (%v)", tc.code, tc.found, municipality.Code, found)
+			}
+		})
+	}
+}
+
+func TestParseArea(t *testing.T) {
+	square := `[[[5.0, 52.0], [5.2, 52.0], [5.2, 52.2], [5.0, 52.2], [5.0, 52.0]]]`
+	testCases := []struct {
+		name     string
+		data     string
+		expected int // Number of polygons, 0 when invalid
+	}{
+		{"Polygon", `{"type": "Polygon", "coordinates": ` + square + `}`, 1},
+		{"MultiPolygon", `{"type": "MultiPolygon", "coordinates": [` + square + `, ` + square + `]}`, 2},
+		{"Feature", `{"type": "Feature", "properties": {}, "geometry": {"type": "Polygon", "coordinates": ` + square + `}}`, 1},
+		{"Feature Without Geometry", `{"type": "Feature", "properties": {}}`, 0},
+		{"Point", `{"type": "Point", "coordinates": [5.0, 52.0]}`, 0},
+		{"Invalid JSON", `{"type": "Polygon"`, 0},
+		{"Invalid Coordinates", `{"type": "Polygon", "coordinates": [[5.0, 52.0]]}`, 0},
+		{"No Polygons", `{"type": "MultiPolygon", "coordinates": []}`, 0},
+		{"No Outer Ring", `{"type": "MultiPolygon", "coordinates": [[]]}`, 0},
+		{"Open Ring", `{"type": "Polygon", "coordinates": [[[5.0, 52.0], [5.2, 52.0], [5.2, 52.2], [5.0, 52.2]]]}`, 0},
+		{"Too Few Positions", `{"type": "Polygon", "coordinates": [[[5.0, 52.0], [5.2, 52.0], [5.0, 52.0]]]}`, 0},
+		{"Latitude Out Of Range", `{"type": "Polygon", "coordinates": [[[5.0, 52.0], [5.2, 91.0], [5.2, 52.2], [5.0, 52.0]]]}`, 0},
+		{"Longitude Out Of Range", `{"type": "Polygon", "coordinates": [[[5.0, 52.0], [-181, 52.0], [5.2, 52.2], [5.0, 52.0]]]}`, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			area, err := ParseArea([]byte(tc.data))
+			if tc.expected == 0 {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", area)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(area) != tc.expected || !area.Contains(structs.Coordinate{Latitude: 52.1, Longitude: 5.1}) {
+				t.Errorf("Expected %d polygons around (52.1, 5.1), got %v", tc.expected, area)
+			}
+		})
+	}
+}
+
+func TestWKT(t *testing.T) {
+	area := MultiPolygon{testArea[0], {{{-4.5, 0.125}, {6, 1e-7}, {6, 1}, {-4.5, 0.125}}}}
+	expected := "MULTIPOLYGON(((5 52, 5.2 52, 5.2 52.2, 5 52.2, 5 52), (5.05 52.05, 5.1 52.05, 5.1 52.1, 5.05 52.05)), " +
+		"((-4.5 0.125, 6 0.0000001, 6 1, -4.5 0.125)))"
+	if wkt := area.WKT(); wkt != expected {
+		t.Errorf("Expected %s, got %s", expected, wkt)
+	}
+}
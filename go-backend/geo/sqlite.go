@@ -0,0 +1,429 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// The GeoPackage is a SQLite database. The writer below produces the SQLite file format directly, so no SQLite
+// driver (and cgo) is needed: a page of sqlite_schema, a table b-tree per table and an index b-tree per automatic
+// index. It only writes new files, with fixed rows, and leaves out everything a fresh database doesn't need
+// (freelists, pointer maps, WAL).
+const (
+	sqlitePageSize      = 4096
+	sqliteHeaderSize    = 100
+	sqliteLeafTable     = 0x0d
+	sqliteInteriorTable = 0x05
+	sqliteLeafIndex     = 0x0a
+	// sqliteVersionNumber is the library version recorded as the last writer of the file
+	sqliteVersionNumber = 3040000
+)
+
+// sqliteTable is a table with its rows and automatic indexes. Rows are written in the order of their rowid. A
+// column declared INTEGER PRIMARY KEY is an alias for the rowid and must hold nil in the row values.
+type sqliteTable struct {
+	Name    string
+	SQL     string
+	Rows    []sqliteRow
+	Indexes []sqliteIndex
+}
+
+type sqliteRow struct {
+	RowID  int64
+	Values []interface{}
+}
+
+// sqliteIndex is an automatic index SQLite keeps for a PRIMARY KEY or UNIQUE constraint on the columns. It is
+// named sqlite_autoindex_<table>_<n> after the order of the constraints in the CREATE TABLE statement.
+type sqliteIndex struct {
+	Name    string
+	Columns []int
+}
+
+// sqliteFile collects the pages of the database, page numbers start at 1
+type sqliteFile struct {
+	pages [][]byte
+}
+
+// writeSQLite returns the database file holding the tables
+func writeSQLite(applicationID, userVersion uint32, tables []sqliteTable) ([]byte, error) {
+	file := &sqliteFile{pages: [][]byte{make([]byte, sqlitePageSize)}} // Page 1 holds the header and sqlite_schema
+
+	var schema []sqliteRow
+	for _, table := range tables {
+		root, err := file.writeTable(table.Rows)
+		if err != nil {
+			return nil, fmt.Errorf("error writing table %s: %w", table.Name, err)
+		}
+		schema = append(schema, sqliteRow{RowID: int64(len(schema) + 1), Values: []interface{}{"table", table.Name, table.Name, int64(root), table.SQL}})
+
+		for _, index := range table.Indexes {
+			root, err := file.writeIndex(table.Rows, index.Columns)
+			if err != nil {
+				return nil, fmt.Errorf("error writing index %s: %w", index.Name, err)
+			}
+			schema = append(schema, sqliteRow{RowID: int64(len(schema) + 1), Values: []interface{}{"index", index.Name, table.Name, int64(root), nil}})
+		}
+	}
+
+	cells := make([][]byte, len(schema))
+	for i, row := range schema {
+		cell, overflow := tableLeafCell(row.RowID, encodeSQLiteRecord(row.Values))
+		if overflow != nil {
+			return nil, fmt.Errorf("schema entry %d is too large", row.RowID)
+		}
+		cells[i] = cell
+	}
+	if !fitsSQLitePage(sqliteHeaderSize+8, cells) {
+		return nil, fmt.Errorf("the schema doesn't fit on the first page")
+	}
+	fillSQLitePage(file.pages[0], sqliteHeaderSize, sqliteLeafTable, cells, 0)
+	file.writeHeader(applicationID, userVersion)
+
+	var out bytes.Buffer
+	for _, page := range file.pages {
+		out.Write(page)
+	}
+	return out.Bytes(), nil
+}
+
+// allocate adds a page to the file and returns its number
+func (f *sqliteFile) allocate(page []byte) uint32 {
+	f.pages = append(f.pages, page)
+	return uint32(len(f.pages))
+}
+
+func (f *sqliteFile) writeHeader(applicationID, userVersion uint32) {
+	header := f.pages[0][:sqliteHeaderSize]
+	copy(header, "SQLite format 3\x00")
+	binary.BigEndian.PutUint16(header[16:], sqlitePageSize)
+	header[18], header[19] = 1, 1 // Legacy journal mode for both writing and reading
+	header[21], header[22], header[23] = 64, 32, 32
+	binary.BigEndian.PutUint32(header[24:], 1) // File change counter
+	binary.BigEndian.PutUint32(header[28:], uint32(len(f.pages)))
+	binary.BigEndian.PutUint32(header[40:], 1) // Schema cookie
+	binary.BigEndian.PutUint32(header[44:], 4) // Schema format
+	binary.BigEndian.PutUint32(header[56:], 1) // UTF-8
+	binary.BigEndian.PutUint32(header[60:], userVersion)
+	binary.BigEndian.PutUint32(header[68:], applicationID)
+	binary.BigEndian.PutUint32(header[92:], 1) // The size in the header is valid for change counter 1
+	binary.BigEndian.PutUint32(header[96:], sqliteVersionNumber)
+}
+
+// writeTable writes the rows as a table b-tree and returns its root page. Leaves are filled in rowid order and
+// interior pages are added on top until a single root remains.
+func (f *sqliteFile) writeTable(rows []sqliteRow) (uint32, error) {
+	sorted := append([]sqliteRow(nil), rows...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].RowID < sorted[j].RowID })
+
+	type child struct {
+		page   uint32
+		maxKey int64
+	}
+	var level []child
+	var cells [][]byte
+	var lastKey int64
+	flush := func() {
+		page := make([]byte, sqlitePageSize)
+		fillSQLitePage(page, 0, sqliteLeafTable, cells, 0)
+		level = append(level, child{page: f.allocate(page), maxKey: lastKey})
+		cells = nil
+	}
+	for i, row := range sorted {
+		if i > 0 && row.RowID == sorted[i-1].RowID {
+			return 0, fmt.Errorf("duplicate rowid %d", row.RowID)
+		}
+		cell, overflow := tableLeafCell(row.RowID, encodeSQLiteRecord(row.Values))
+		if overflow != nil {
+			binary.BigEndian.PutUint32(cell[len(cell)-4:], f.writeOverflow(overflow))
+		}
+		if len(cells) > 0 && !fitsSQLitePage(8, append(cells, cell)) {
+			flush()
+		}
+		cells = append(cells, cell)
+		lastKey = row.RowID
+	}
+	if len(cells) > 0 || len(level) == 0 {
+		flush()
+	}
+
+	for len(level) > 1 {
+		var next []child
+		for start := 0; start < len(level); {
+			// The last child of the page goes into the right-most pointer, the others get a cell with their key
+			end := start + 1
+			var interior [][]byte
+			for end < len(level) {
+				cell := binary.BigEndian.AppendUint32(nil, level[end-1].page)
+				cell = appendSQLiteVarint(cell, uint64(level[end-1].maxKey))
+				if !fitsSQLitePage(12, append(interior, cell)) {
+					break
+				}
+				interior = append(interior, cell)
+				end++
+			}
+			page := make([]byte, sqlitePageSize)
+			fillSQLitePage(page, 0, sqliteInteriorTable, interior, level[end-1].page)
+			next = append(next, child{page: f.allocate(page), maxKey: level[end-1].maxKey})
+			start = end
+		}
+		level = next
+	}
+	return level[0].page, nil
+}
+
+// writeIndex writes an index b-tree of the columns, each entry ends with the rowid of its row. Index b-trees keep
+// keys in their interior pages, the automatic indexes of the GeoPackage tables are small enough for a single
+// leaf so only that is supported.
+func (f *sqliteFile) writeIndex(rows []sqliteRow, columns []int) (uint32, error) {
+	keys := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		key := make([]interface{}, 0, len(columns)+1)
+		for _, column := range columns {
+			key = append(key, row.Values[column])
+		}
+		keys[i] = append(key, row.RowID)
+	}
+	sort.Slice(keys, func(i, j int) bool { return compareSQLiteKeys(keys[i], keys[j]) < 0 })
+
+	cells := make([][]byte, len(keys))
+	for i, key := range keys {
+		record := encodeSQLiteRecord(key)
+		if len(record) > sqliteMaxLocal(false) {
+			return 0, fmt.Errorf("index entry too large")
+		}
+		cells[i] = append(appendSQLiteVarint(nil, uint64(len(record))), record...)
+	}
+	if !fitsSQLitePage(8, cells) {
+		return 0, fmt.Errorf("index doesn't fit on a single page")
+	}
+	page := make([]byte, sqlitePageSize)
+	fillSQLitePage(page, 0, sqliteLeafIndex, cells, 0)
+	return f.allocate(page), nil
+}
+
+// writeOverflow stores the part of a payload that doesn't fit in its cell in a chain of overflow pages and
+// returns the first page
+func (f *sqliteFile) writeOverflow(payload []byte) uint32 {
+	const capacity = sqlitePageSize - 4
+	var chunks [][]byte
+	for len(payload) > 0 {
+		n := min(capacity, len(payload))
+		chunks = append(chunks, payload[:n])
+		payload = payload[n:]
+	}
+	// Written back to front, so each page knows the number of the next one
+	var next uint32
+	for i := len(chunks) - 1; i >= 0; i-- {
+		page := make([]byte, sqlitePageSize)
+		binary.BigEndian.PutUint32(page, next)
+		copy(page[4:], chunks[i])
+		next = f.allocate(page)
+	}
+	return next
+}
+
+// tableLeafCell builds the cell of a row in a table leaf page. When the record is too large for the page, the
+// cell ends with room for the first overflow page and the remainder of the record is returned.
+func tableLeafCell(rowID int64, record []byte) ([]byte, []byte) {
+	cell := appendSQLiteVarint(nil, uint64(len(record)))
+	cell = appendSQLiteVarint(cell, uint64(rowID))
+
+	local := len(record)
+	if local > sqliteMaxLocal(true) {
+		// The number of bytes kept on the page as defined by the file format
+		usable := sqlitePageSize
+		minLocal := (usable-12)*32/255 - 23
+		local = minLocal + (len(record)-minLocal)%(usable-4)
+		if local > sqliteMaxLocal(true) {
+			local = minLocal
+		}
+	}
+	cell = append(cell, record[:local]...)
+	if local == len(record) {
+		return cell, nil
+	}
+	return append(cell, 0, 0, 0, 0), record[local:]
+}
+
+// sqliteMaxLocal is the largest payload stored on a page without overflow
+func sqliteMaxLocal(table bool) int {
+	if table {
+		return sqlitePageSize - 35
+	}
+	return (sqlitePageSize-12)*64/255 - 23
+}
+
+// fitsSQLitePage reports whether the cells fit on a page after a header of headerSize bytes, counting a two byte
+// pointer per cell
+func fitsSQLitePage(headerSize int, cells [][]byte) bool {
+	size := headerSize
+	for _, cell := range cells {
+		size += 2 + len(cell)
+	}
+	return size <= sqlitePageSize
+}
+
+// fillSQLitePage writes a b-tree page header at offset with the cell pointers after it, the cells are stacked
+// from the end of the page. rightMost is only used for interior pages.
+func fillSQLitePage(page []byte, offset int, pageType byte, cells [][]byte, rightMost uint32) {
+	headerSize := 8
+	if pageType == sqliteInteriorTable {
+		headerSize = 12
+		binary.BigEndian.PutUint32(page[offset+8:], rightMost)
+	}
+
+	content := sqlitePageSize
+	pointer := offset + headerSize
+	for _, cell := range cells {
+		content -= len(cell)
+		copy(page[content:], cell)
+		binary.BigEndian.PutUint16(page[pointer:], uint16(content))
+		pointer += 2
+	}
+
+	page[offset] = pageType
+	binary.BigEndian.PutUint16(page[offset+3:], uint16(len(cells)))
+	binary.BigEndian.PutUint16(page[offset+5:], uint16(content))
+}
+
+// encodeSQLiteRecord encodes values in the record format: a header with the serial type of every value followed
+// by the values. Values can be nil, int64, float64, string or []byte.
+func encodeSQLiteRecord(values []interface{}) []byte {
+	var types, body []byte
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			types = appendSQLiteVarint(types, 0)
+		case int64:
+			serialType, size := sqliteIntegerType(v)
+			types = appendSQLiteVarint(types, serialType)
+			for i := size - 1; i >= 0; i-- {
+				body = append(body, byte(v>>(8*i)))
+			}
+		case float64:
+			types = appendSQLiteVarint(types, 7)
+			body = binary.BigEndian.AppendUint64(body, math.Float64bits(v))
+		case string:
+			types = appendSQLiteVarint(types, uint64(len(v))*2+13)
+			body = append(body, v...)
+		case []byte:
+			types = appendSQLiteVarint(types, uint64(len(v))*2+12)
+			body = append(body, v...)
+		default:
+			panic(fmt.Sprintf("unsupported SQLite value %T", value))
+		}
+	}
+
+	// The header size includes the varint holding it
+	headerSize := len(types) + 1
+	for len(appendSQLiteVarint(nil, uint64(headerSize)))+len(types) != headerSize {
+		headerSize++
+	}
+	record := appendSQLiteVarint(nil, uint64(headerSize))
+	record = append(record, types...)
+	return append(record, body...)
+}
+
+// sqliteIntegerType returns the smallest serial type holding the integer and its size in bytes
+func sqliteIntegerType(v int64) (uint64, int) {
+	switch {
+	case v == 0:
+		return 8, 0
+	case v == 1:
+		return 9, 0
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return 1, 1
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return 2, 2
+	case v >= -1<<23 && v < 1<<23:
+		return 3, 3
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return 4, 4
+	case v >= -1<<47 && v < 1<<47:
+		return 5, 6
+	}
+	return 6, 8
+}
+
+// appendSQLiteVarint appends a big-endian varint of one to nine bytes, the ninth byte holds eight bits
+func appendSQLiteVarint(b []byte, v uint64) []byte {
+	if v > 1<<56-1 {
+		var buf [9]byte
+		buf[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			buf[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return append(b, buf[:]...)
+	}
+
+	var groups [8]byte
+	n := 0
+	for {
+		groups[n] = byte(v & 0x7f)
+		n++
+		v >>= 7
+		if v == 0 {
+			break
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if i > 0 {
+			b = append(b, groups[i]|0x80)
+		} else {
+			b = append(b, groups[i])
+		}
+	}
+	return b
+}
+
+// compareSQLiteKeys compares index keys column by column with the BINARY collation, NULL sorts before numbers
+// and numbers before text
+func compareSQLiteKeys(a, b []interface{}) int {
+	rank := func(value interface{}) int {
+		switch value.(type) {
+		case nil:
+			return 0
+		case int64, float64:
+			return 1
+		case string:
+			return 2
+		}
+		return 3
+	}
+	for i := range a {
+		if ra, rb := rank(a[i]), rank(b[i]); ra != rb {
+			return ra - rb
+		}
+		switch x := a[i].(type) {
+		case int64, float64:
+			if x, y := sqliteNumber(x), sqliteNumber(b[i]); x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		case string:
+			if c := bytes.Compare([]byte(x), []byte(b[i].(string))); c != 0 {
+				return c
+			}
+		case []byte:
+			if c := bytes.Compare(x, b[i].([]byte)); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+func sqliteNumber(value interface{}) float64 {
+	if v, ok := value.(int64); ok {
+		return float64(v)
+	}
+	return value.(float64)
+}
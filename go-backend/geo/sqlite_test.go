@@ -0,0 +1,358 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testSQLiteFile reads back the files written by writeSQLite, following the file format independently of the
+// writer: the header, table b-trees with interior and overflow pages, and index leaves
+type testSQLiteFile struct {
+	t    *testing.T
+	data []byte
+}
+
+type testSchemaEntry struct {
+	kind, table string
+	root        int64
+	sql         interface{}
+}
+
+func openTestSQLite(t *testing.T, data []byte) *testSQLiteFile {
+	t.Helper()
+	if len(data) < sqliteHeaderSize || string(data[:16]) != "SQLite format 3\x00" {
+		t.Fatalf("Expected a SQLite file")
+	}
+	pageSize := int(binary.BigEndian.Uint16(data[16:]))
+	if pageSize != sqlitePageSize || len(data)%pageSize != 0 || int(binary.BigEndian.Uint32(data[28:]))*pageSize != len(data) {
+		t.Fatalf("Expected the file to consist of the pages in its header, got %d bytes of %d byte pages", len(data), pageSize)
+	}
+	return &testSQLiteFile{t: t, data: data}
+}
+
+// page returns page n and the offset of its b-tree header, page 1 starts with the file header
+func (f *testSQLiteFile) page(n int64) ([]byte, int) {
+	f.t.Helper()
+	if n < 1 || int(n)*sqlitePageSize > len(f.data) {
+		f.t.Fatalf("Page %d is outside the file", n)
+	}
+	page := f.data[(n-1)*sqlitePageSize : n*sqlitePageSize]
+	if n == 1 {
+		return page, sqliteHeaderSize
+	}
+	return page, 0
+}
+
+// cells returns the cells of a b-tree page by their pointers
+func (f *testSQLiteFile) cells(page []byte, offset int) (byte, [][]byte) {
+	pageType := page[offset]
+	headerSize := 8
+	if pageType == sqliteInteriorTable {
+		headerSize = 12
+	}
+	count := int(binary.BigEndian.Uint16(page[offset+3:]))
+	cells := make([][]byte, count)
+	for i := range cells {
+		cells[i] = page[binary.BigEndian.Uint16(page[offset+headerSize+2*i:]):]
+	}
+	return pageType, cells
+}
+
+// tableRows walks a table b-tree and returns its rows in rowid order
+func (f *testSQLiteFile) tableRows(root int64) []sqliteRow {
+	f.t.Helper()
+	page, offset := f.page(root)
+	pageType, cells := f.cells(page, offset)
+
+	var rows []sqliteRow
+	switch pageType {
+	case sqliteInteriorTable:
+		for _, cell := range cells {
+			rows = append(rows, f.tableRows(int64(binary.BigEndian.Uint32(cell)))...)
+		}
+		return append(rows, f.tableRows(int64(binary.BigEndian.Uint32(page[offset+8:])))...)
+	case sqliteLeafTable:
+		for _, cell := range cells {
+			size, n := readTestSQLiteVarint(cell)
+			rowID, m := readTestSQLiteVarint(cell[n:])
+			rows = append(rows, sqliteRow{RowID: int64(rowID), Values: decodeTestSQLiteRecord(f.t, f.payload(cell[n+m:], int(size)))})
+		}
+		return rows
+	}
+	f.t.Fatalf("Unexpected page type %d of table page %d", pageType, root)
+	return nil
+}
+
+// payload reassembles a table payload from its cell and overflow pages, using the spill rules of the file format
+func (f *testSQLiteFile) payload(cell []byte, size int) []byte {
+	f.t.Helper()
+	maxLocal := sqlitePageSize - 35
+	if size <= maxLocal {
+		return cell[:size]
+	}
+	minLocal := (sqlitePageSize-12)*32/255 - 23
+	local := minLocal + (size-minLocal)%(sqlitePageSize-4)
+	if local > maxLocal {
+		local = minLocal
+	}
+
+	payload := append([]byte(nil), cell[:local]...)
+	next := int64(binary.BigEndian.Uint32(cell[local:]))
+	for len(payload) < size {
+		if next == 0 {
+			f.t.Fatalf("Overflow chain ends after %d of %d bytes", len(payload), size)
+		}
+		page, _ := f.page(next)
+		n := min(sqlitePageSize-4, size-len(payload))
+		payload = append(payload, page[4:4+n]...)
+		next = int64(binary.BigEndian.Uint32(page))
+	}
+	if next != 0 {
+		f.t.Fatalf("Overflow chain continues after the payload")
+	}
+	return payload
+}
+
+// indexKeys returns the keys of a single leaf index b-tree in stored order
+func (f *testSQLiteFile) indexKeys(root int64) [][]interface{} {
+	f.t.Helper()
+	page, offset := f.page(root)
+	pageType, cells := f.cells(page, offset)
+	if pageType != sqliteLeafIndex {
+		f.t.Fatalf("Unexpected page type %d of index page %d", pageType, root)
+	}
+	keys := make([][]interface{}, len(cells))
+	for i, cell := range cells {
+		size, n := readTestSQLiteVarint(cell)
+		keys[i] = decodeTestSQLiteRecord(f.t, cell[n:n+int(size)])
+	}
+	return keys
+}
+
+// schema returns the entries of sqlite_schema by name
+func (f *testSQLiteFile) schema() map[string]testSchemaEntry {
+	f.t.Helper()
+	entries := make(map[string]testSchemaEntry)
+	for _, row := range f.tableRows(1) {
+		if len(row.Values) != 5 {
+			f.t.Fatalf("Expected schema rows of 5 columns, got %v", row.Values)
+		}
+		entries[row.Values[1].(string)] = testSchemaEntry{
+			kind:  row.Values[0].(string),
+			table: row.Values[2].(string),
+			root:  row.Values[3].(int64),
+			sql:   row.Values[4],
+		}
+	}
+	return entries
+}
+
+func readTestSQLiteVarint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+	return v<<8 | uint64(b[8]), 9
+}
+
+func decodeTestSQLiteRecord(t *testing.T, record []byte) []interface{} {
+	t.Helper()
+	headerSize, n := readTestSQLiteVarint(record)
+	header, body := record[n:headerSize], record[headerSize:]
+
+	var values []interface{}
+	for len(header) > 0 {
+		serialType, n := readTestSQLiteVarint(header)
+		header = header[n:]
+
+		var size int
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 6:
+			size = []int{0, 1, 2, 3, 4, 6, 8}[serialType]
+			v := int64(int8(body[0])) // Sign extended from the first byte
+			for _, b := range body[1:size] {
+				v = v<<8 | int64(b)
+			}
+			values = append(values, v)
+		case serialType == 7:
+			size = 8
+			values = append(values, math.Float64frombits(binary.BigEndian.Uint64(body)))
+		case serialType == 8 || serialType == 9:
+			values = append(values, int64(serialType-8))
+		case serialType >= 12 && serialType%2 == 0:
+			size = int(serialType-12) / 2
+			values = append(values, append([]byte(nil), body[:size]...))
+		case serialType >= 13:
+			size = int(serialType-13) / 2
+			values = append(values, string(body[:size]))
+		default:
+			t.Fatalf("Unexpected serial type %d", serialType)
+		}
+		body = body[size:]
+	}
+	if len(body) != 0 {
+		t.Fatalf("Expected the record body to be consumed, %d bytes left", len(body))
+	}
+	return values
+}
+
+func TestAppendSQLiteVarint(t *testing.T) {
+	testCases := []struct {
+		value    uint64
+		expected []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x81, 0x00}},
+		{240, []byte{0x81, 0x70}},
+		{16383, []byte{0xff, 0x7f}},
+		{16384, []byte{0x81, 0x80, 0x00}},
+		{1<<56 - 1, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}},
+		{1 << 56, []byte{0x80, 0xc0, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00}},
+		{math.MaxUint64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tc := range testCases {
+		encoded := appendSQLiteVarint(nil, tc.value)
+		if !bytes.Equal(encoded, tc.expected) {
+			t.Errorf("Expected %d to be encoded as %x, got %x", tc.value, tc.expected, encoded)
+		}
+		if decoded, n := readTestSQLiteVarint(encoded); decoded != tc.value || n != len(encoded) {
+			t.Errorf("Expected %x to decode to %d, got %d", encoded, tc.value, decoded)
+		}
+	}
+}
+
+func TestEncodeSQLiteRecord(t *testing.T) {
+	record := encodeSQLiteRecord([]interface{}{nil, int64(1), int64(300), "ab", 1.5, []byte{0xca, 0xfe}})
+	expected := []byte{
+		0x07,                               // Header size, including this byte
+		0x00, 0x09, 0x02, 0x11, 0x07, 0x10, // NULL, one, 16 bit integer, text of 2, float, blob of 2
+		0x01, 0x2c, // 300
+		'a', 'b',
+		0x3f, 0xf8, 0, 0, 0, 0, 0, 0, // 1.5
+		0xca, 0xfe,
+	}
+	if !bytes.Equal(record, expected) {
+		t.Errorf("Expected record %x, got %x", expected, record)
+	}
+
+	// Integers use the smallest serial type holding them and keep their sign
+	for _, v := range []int64{0, 1, -1, 127, -128, 128, 32767, -32769, 1<<23 - 1, -1 << 23, math.MaxInt32, math.MinInt32, 1 << 40, -1 << 47, math.MaxInt64, math.MinInt64} {
+		decoded := decodeTestSQLiteRecord(t, encodeSQLiteRecord([]interface{}{v}))
+		if len(decoded) != 1 || decoded[0] != v {
+			t.Errorf("Expected %d to round trip, got %v", v, decoded)
+		}
+	}
+
+	// A long header takes a two byte size
+	values := make([]interface{}, 200)
+	for i := range values {
+		values[i] = fmt.Sprintf("value %d", i)
+	}
+	if decoded := decodeTestSQLiteRecord(t, encodeSQLiteRecord(values)); !reflect.DeepEqual(decoded, values) {
+		t.Errorf("Expected a record with a long header to round trip")
+	}
+}
+
+func TestCompareSQLiteKeys(t *testing.T) {
+	// Sorted as SQLite sorts with the BINARY collation
+	ordered := [][]interface{}{
+		{nil, int64(1)},
+		{int64(-5), int64(1)},
+		{1.5, int64(1)},
+		{int64(2), int64(1)},
+		{int64(2), int64(2)},
+		{"B", int64(1)},
+		{"a", int64(1)},
+		{"ab", int64(1)},
+	}
+	for i := range ordered {
+		for j := range ordered {
+			c := compareSQLiteKeys(ordered[i], ordered[j])
+			if (i < j && c >= 0) || (i > j && c <= 0) || (i == j && c != 0) {
+				t.Errorf("Expected %v and %v to compare as %d, got %d", ordered[i], ordered[j], j-i, c)
+			}
+		}
+	}
+}
+
+func TestWriteSQLite(t *testing.T) {
+	// Enough rows for interior pages, and a blob spilling onto several overflow pages
+	var many []sqliteRow
+	for i := int64(1); i <= 3000; i++ {
+		many = append(many, sqliteRow{RowID: i, Values: []interface{}{nil, fmt.Sprintf("row %d %s", i, strings.Repeat("x", int(i%50)))}})
+	}
+	large := bytes.Repeat([]byte("0123456789"), 1500)
+	tables := []sqliteTable{
+		{
+			Name: "small",
+			SQL:  "CREATE TABLE small (id INTEGER PRIMARY KEY, name TEXT UNIQUE, data BLOB)",
+			Rows: []sqliteRow{
+				{RowID: 3, Values: []interface{}{nil, "c", large}},
+				{RowID: -1, Values: []interface{}{nil, "a", nil}},
+				{RowID: 2, Values: []interface{}{nil, "b", 2.5}},
+			},
+			Indexes: []sqliteIndex{{Name: "sqlite_autoindex_small_1", Columns: []int{1}}},
+		},
+		{Name: "many", SQL: "CREATE TABLE many (id INTEGER PRIMARY KEY, value TEXT)", Rows: many},
+		{Name: "empty", SQL: "CREATE TABLE empty (id INTEGER PRIMARY KEY)"},
+	}
+
+	data, err := writeSQLite(0x01020304, 42, tables)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	file := openTestSQLite(t, data)
+	if binary.BigEndian.Uint32(data[68:]) != 0x01020304 || binary.BigEndian.Uint32(data[60:]) != 42 {
+		t.Errorf("Expected the application ID and user version in the header")
+	}
+
+	schema := file.schema()
+	if len(schema) != 4 || schema["small"].kind != "table" || schema["sqlite_autoindex_small_1"].kind != "index" ||
+		schema["sqlite_autoindex_small_1"].table != "small" || schema["sqlite_autoindex_small_1"].sql != nil {
+		t.Fatalf("Expected three tables and an index in the schema, got %+v", schema)
+	}
+	if schema["many"].sql != tables[1].SQL {
+		t.Errorf("Expected the CREATE TABLE statement in the schema, got %v", schema["many"].sql)
+	}
+
+	// Rows are stored in rowid order, with the overflowing blob reassembled
+	small := file.tableRows(schema["small"].root)
+	if len(small) != 3 || small[0].RowID != -1 || small[1].RowID != 2 || small[2].RowID != 3 {
+		t.Fatalf("Expected the rows in rowid order, got %v", small)
+	}
+	if !bytes.Equal(small[2].Values[2].([]byte), large) || small[1].Values[2] != 2.5 || small[0].Values[2] != nil {
+		t.Errorf("Expected the values to round trip")
+	}
+
+	if page, offset := file.page(schema["many"].root); page[offset] != sqliteInteriorTable {
+		t.Errorf("Expected a large table to have an interior root page")
+	}
+	if rows := file.tableRows(schema["many"].root); !reflect.DeepEqual(rows, many) {
+		t.Errorf("Expected all %d rows of the large table to round trip, got %d", len(many), len(rows))
+	}
+	if rows := file.tableRows(schema["empty"].root); len(rows) != 0 {
+		t.Errorf("Expected an empty table, got %v", rows)
+	}
+
+	// The index holds the column value followed by the rowid, sorted
+	keys := file.indexKeys(schema["sqlite_autoindex_small_1"].root)
+	expected := [][]interface{}{{"a", int64(-1)}, {"b", int64(2)}, {"c", int64(3)}}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected index keys %v, got %v", expected, keys)
+	}
+
+	if _, err := writeSQLite(0, 0, []sqliteTable{{Name: "twice", Rows: []sqliteRow{{RowID: 1}, {RowID: 1}}}}); err == nil {
+		t.Errorf("Expected a duplicate rowid to be rejected")
+	}
+}
@@ -0,0 +1,38 @@
+package geo
+
+import (
+	"main/structs"
+	"math"
+)
+
+// TileExtent is the number of units along each side of a vector tile
+const TileExtent = 4096
+
+// MaxMercatorLatitude is the latitude at which the Web Mercator projection is cut off, making the world square
+const MaxMercatorLatitude = 85.0511287798
+
+// TileBounds returns the area covered by tile x, y at zoom level z in the Web Mercator tiling scheme
+func TileBounds(z, x, y int) structs.BoundingBox {
+	n := math.Exp2(float64(z))
+	return structs.BoundingBox{
+		MinLongitude: float64(x)/n*360 - 180,
+		MaxLongitude: float64(x+1)/n*360 - 180,
+		MinLatitude:  tileLatitude(float64(y+1), n),
+		MaxLatitude:  tileLatitude(float64(y), n),
+	}
+}
+
+// TileCoordinate returns the position of the coordinate within tile x, y at zoom level z, in tile units with the
+// origin at the top left. Positions outside the tile fall outside 0 to extent.
+func TileCoordinate(z, x, y int, coordinate structs.Coordinate, extent int) (int, int) {
+	n := math.Exp2(float64(z))
+	latitude := math.Max(-MaxMercatorLatitude, math.Min(MaxMercatorLatitude, coordinate.Latitude)) * math.Pi / 180
+	worldX := (coordinate.Longitude + 180) / 360 * n
+	worldY := (1 - math.Log(math.Tan(latitude)+1/math.Cos(latitude))/math.Pi) / 2 * n
+	return int(math.Round((worldX - float64(x)) * float64(extent))), int(math.Round((worldY - float64(y)) * float64(extent)))
+}
+
+// tileLatitude returns the northern latitude of tile row y out of n rows
+func tileLatitude(y, n float64) float64 {
+	return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+}
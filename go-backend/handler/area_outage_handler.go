@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"net/http"
+)
+
+// AreaOutagesHandler handles the /outages endpoint returning the active area outages affecting accessible devices
+func AreaOutagesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	outages, err := service.GetActiveAreaOutages(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving area outages")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(outages)
+}
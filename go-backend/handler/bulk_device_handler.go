@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+)
+
+// BulkDevicesHandler handles the /devices/bulk endpoint to change tags, status or attributes of many devices at once
+func BulkDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the operation from the request body
+	var request structs.BulkDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Apply the operation and report the result per device
+	report, err := service.ApplyBulkDeviceOperation(meberID, request)
+	if err != nil {
+		writeServiceError(w, err, "Error applying bulk operation")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
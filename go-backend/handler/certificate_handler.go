@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// EnrollmentTokenHandler handles creating a one-time enrollment token for a device
+func EnrollmentTokenHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the device
+	var request struct {
+		DeviceID int64 `json:"device_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Create the token, it is only shown in this response
+	token, err := service.CreateEnrollmentToken(meberID, request.DeviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error creating enrollment token")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(token)
+}
+
+// EnrollDeviceHandler handles a device exchanging its enrollment token and CSR for a client certificate.
+// The token authenticates the request, so the endpoint needs no other authorization.
+func EnrollDeviceHandler(w http.ResponseWriter, r *http.Request) {
+	var request structs.EnrollmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	bundle, err := service.EnrollDevice(request, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error enrolling device")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(bundle)
+}
+
+// RenewDeviceCertificateHandler handles a device renewing its client certificate over mutual TLS
+func RenewDeviceCertificateHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var request struct {
+		CSR string `json:"csr"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Renewal is only possible with the current certificate, not with a device token
+	var current *x509.Certificate
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		current = r.TLS.PeerCertificates[0]
+	}
+
+	bundle, err := service.RenewDeviceCertificate(deviceID, current, request.CSR, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error renewing certificate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(bundle)
+}
+
+// DeviceCertificatesHandler handles the /certificates endpoint listing the certificates of a device
+func DeviceCertificatesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	certificates, err := service.GetDeviceCertificates(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving certificates")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(certificates)
+}
+
+// RevokeDeviceCertificateHandler handles revoking a device certificate
+func RevokeDeviceCertificateHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var request struct {
+		Serial string `json:"serial"`
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Serial == "" {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.RevokeDeviceCertificate(meberID, request.Serial, request.Reason); err != nil {
+		writeServiceError(w, err, "Error revoking certificate")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CACertificateHandler handles the /ca/certificate endpoint returning the PEM encoded CA certificate
+func CACertificateHandler(w http.ResponseWriter, r *http.Request) {
+	certificatePEM, err := service.GetCACertificatePEM()
+	if err != nil {
+		http.Error(w, "Error retrieving CA certificate", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(certificatePEM))
+}
+
+// CertificateRevocationListHandler handles the /ca/crl endpoint returning the DER encoded revocation list
+func CertificateRevocationListHandler(w http.ResponseWriter, r *http.Request) {
+	crl, err := service.GetCertificateRevocationList(time.Now())
+	if err != nil {
+		http.Error(w, "Error creating revocation list", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	w.WriteHeader(http.StatusOK)
+	w.Write(crl)
+}
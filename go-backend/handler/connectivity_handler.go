@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ReportConnectivityHandler handles a device reporting its signal strength, latency and packet loss
+func ReportConnectivityHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract device ID from the device token
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the samples from the request body
+	var requestBody struct {
+		Samples []structs.ConnectivitySample `json:"samples"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Store the samples
+	if err := service.RecordConnectivitySamples(deviceID, requestBody.Samples); err != nil {
+		writeServiceError(w, err, "Error storing connectivity samples")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ConnectivityHistoryHandler handles the /connectivity endpoint returning the connection quality history of a device
+func ConnectivityHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the query parameters, the range defaults to the last 24 hours
+	queryParams := r.URL.Query()
+	deviceID, err := strconv.ParseInt(queryParams.Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	end := time.Now()
+	if endStr := queryParams.Get("end"); endStr != "" {
+		end, err = time.Parse(time.RFC3339, endStr)
+		if err != nil {
+			http.Error(w, "Invalid end format, expected RFC3339", http.StatusBadRequest)
+			return
+		}
+	}
+
+	start := end.Add(-24 * time.Hour)
+	if startStr := queryParams.Get("start"); startStr != "" {
+		start, err = time.Parse(time.RFC3339, startStr)
+		if err != nil {
+			http.Error(w, "Invalid start format, expected RFC3339", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var points int64
+	if pointsStr := queryParams.Get("points"); pointsStr != "" {
+		points, err = strconv.ParseInt(pointsStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid points", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Fetch the averaged history
+	history, err := service.GetConnectivityHistory(meberID, deviceID, start, end, points)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving connectivity history")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+// FlappingDevicesHandler handles the /connectivity/flapping endpoint listing devices that keep going offline
+func FlappingDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	period, ok := connectivityPeriod(w, r)
+	if !ok {
+		return
+	}
+
+	devices, err := service.GetFlappingDevices(meberID, period, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving flapping devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(devices)
+}
+
+// ConnectivityRankingHandler handles the /connectivity/ranking endpoint listing the worst connected devices per municipality
+func ConnectivityRankingHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Optional period in hours and number of devices per municipality
+	period, ok := connectivityPeriod(w, r)
+	if !ok {
+		return
+	}
+	limit := service.DefaultRankingLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Rank the accessible devices
+	ranking, err := service.GetConnectivityRanking(meberID, period, limit, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error ranking device connectivity")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ranking)
+}
+
+// connectivityPeriod parses the optional hours parameter, writing a bad request when it is not a number
+func connectivityPeriod(w http.ResponseWriter, r *http.Request) (time.Duration, bool) {
+	hoursStr := r.URL.Query().Get("hours")
+	if hoursStr == "" {
+		return service.DefaultConnectivityPeriod, true
+	}
+	hours, err := strconv.Atoi(hoursStr)
+	if err != nil {
+		http.Error(w, "Invalid hours", http.StatusBadRequest)
+		return 0, false
+	}
+	return time.Duration(hours) * time.Hour, true
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DeviceAssetHandler handles the /assets endpoint returning the asset information of a device
+func DeviceAssetHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	asset, err := service.GetDeviceAsset(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device asset")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(asset)
+}
+
+// UpdateDeviceAssetHandler handles changing the serial number, vendor, firmware and dates of a device
+func UpdateDeviceAssetHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var update structs.DeviceAssetUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil || update.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.UpdateDeviceAsset(meberID, update); err != nil {
+		writeServiceError(w, err, "Error updating device asset")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// LifecycleTransitionHandler handles moving a device to another lifecycle stage
+func LifecycleTransitionHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var request structs.LifecycleTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.TransitionLifecycleStage(meberID, request); err != nil {
+		writeServiceError(w, err, "Error changing lifecycle stage")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AssetReportHandler handles the /assets/report endpoint listing devices nearing warranty end or stuck in a stage
+func AssetReportHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Optional horizons in days
+	warrantyDays, stuckDays := service.DefaultWarrantyDays, service.DefaultStuckDays
+	for _, param := range []struct {
+		name   string
+		target *int
+	}{{"warranty_days", &warrantyDays}, {"stuck_days", &stuckDays}} {
+		if value := r.URL.Query().Get(param.name); value != "" {
+			days, err := strconv.Atoi(value)
+			if err != nil {
+				http.Error(w, "Invalid "+param.name, http.StatusBadRequest)
+				return
+			}
+			*param.target = days
+		}
+	}
+
+	// Step 3: Build the report over the accessible devices
+	report, err := service.GetAssetReport(meberID, warrantyDays, stuckDays, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error building asset report")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// QueueDeviceCommandHandler handles queueing a command for devices or a device group
+func QueueDeviceCommandHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the command request
+	var request structs.DeviceCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Queue the command for every target device
+	commands, err := service.QueueDeviceCommand(meberID, request)
+	if err != nil {
+		writeServiceError(w, err, "Error queueing command")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(commands)
+}
+
+// DeviceCommandsHandler handles listing the commands and their status for a device or device group
+func DeviceCommandsHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	queryParams := r.URL.Query()
+	var deviceID, groupID int64
+	var err error
+	if deviceIDStr := queryParams.Get("device_id"); deviceIDStr != "" {
+		deviceID, err = strconv.ParseInt(deviceIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid device ID", http.StatusBadRequest)
+			return
+		}
+	}
+	if groupIDStr := queryParams.Get("group_id"); groupIDStr != "" {
+		groupID, err = strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid group ID", http.StatusBadRequest)
+			return
+		}
+	}
+	if deviceID == 0 && groupID == 0 {
+		http.Error(w, "Missing required query parameters: device_id or group_id", http.StatusBadRequest)
+		return
+	}
+
+	// Optional comma separated status filter, e.g. status=queued,sent
+	var statuses []string
+	if statusStr := queryParams.Get("status"); statusStr != "" {
+		statuses = strings.Split(statusStr, ",")
+	}
+
+	commands, err := service.GetDeviceCommands(meberID, deviceID, groupID, statuses)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving commands")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(commands)
+}
+
+// CancelDeviceCommandHandler handles cancelling a command that has not completed yet
+func CancelDeviceCommandHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var requestBody struct {
+		CommandID int64 `json:"command_id"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&requestBody)
+	if err != nil || requestBody.CommandID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.CancelDeviceCommand(meberID, requestBody.CommandID); err != nil {
+		writeServiceError(w, err, "Error cancelling command")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PollDeviceCommandsHandler handles the /device-api/commands endpoint where devices fetch their queued commands
+func PollDeviceCommandsHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	commands, err := service.PollDeviceCommands(deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving commands")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(commands)
+}
+
+// ReportDeviceCommandResultHandler handles a device acknowledging a command with its result
+func ReportDeviceCommandResultHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var result structs.DeviceCommandResult
+	err := json.NewDecoder(r.Body).Decode(&result)
+	if err != nil || result.CommandID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.ReportDeviceCommandResult(deviceID, result); err != nil {
+		writeServiceError(w, err, "Error storing command result")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuditLogHandler handles the /audit-log endpoint returning the audit trail of a device
+func AuditLogHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	entries, err := service.GetDeviceAuditLog(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving audit log")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// DeviceGroupsHandler handles the /groups endpoint to list the device groups and saved filters of the meber
+func DeviceGroupsHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	groups, err := service.GetDeviceGroups(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device groups")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(groups)
+}
+
+// CreateDeviceGroupHandler handles creating a static device group or saved filter
+func CreateDeviceGroupHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the group definition and optional initial members
+	var requestBody struct {
+		Name      string                `json:"name"`
+		Type      string                `json:"type"`
+		Filter    *structs.DeviceFilter `json:"filter"`
+		RoleID    *int64                `json:"role_id"`
+		DeviceIDs []int64               `json:"device_ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Create the group
+	group, err := service.CreateDeviceGroup(meberID, structs.DeviceGroup{
+		Name:   requestBody.Name,
+		Type:   requestBody.Type,
+		Filter: requestBody.Filter,
+		RoleID: requestBody.RoleID,
+	}, requestBody.DeviceIDs)
+	if err != nil {
+		writeServiceError(w, err, "Error creating device group")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(group)
+}
+
+// DeleteDeviceGroupHandler handles deleting a device group owned by the meber
+func DeleteDeviceGroupHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	groupID, err := strconv.ParseInt(r.URL.Query().Get("group_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid group ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.DeleteDeviceGroup(meberID, groupID); err != nil {
+		writeServiceError(w, err, "Error deleting device group")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeviceGroupMembersHandler handles the /groups/members endpoint to list the accessible members of a group
+func DeviceGroupMembersHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	groupID, err := strconv.ParseInt(r.URL.Query().Get("group_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid group ID", http.StatusBadRequest)
+		return
+	}
+
+	members, err := service.GetGroupDevicesForMap(meberID, groupID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving group members")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(members)
+}
+
+// UpdateDeviceGroupMembersHandler handles adding and removing members of a static group
+func UpdateDeviceGroupMembersHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var requestBody struct {
+		GroupID         int64   `json:"group_id"`
+		AddDeviceIDs    []int64 `json:"add_device_ids"`
+		RemoveDeviceIDs []int64 `json:"remove_device_ids"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&requestBody)
+	if err != nil || requestBody.GroupID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	err = service.UpdateDeviceGroupMembers(meberID, requestBody.GroupID, requestBody.AddDeviceIDs, requestBody.RemoveDeviceIDs)
+	if err != nil {
+		writeServiceError(w, err, "Error updating group members")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
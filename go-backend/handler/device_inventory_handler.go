@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"main/middleware"
+	"main/service"
+	"net/http"
+	"strconv"
+)
+
+// maxImportBytes limits the size of an uploaded inventory
+const maxImportBytes = 10 << 20
+
+// ExportDevicesHandler handles the /devices/export endpoint, exporting the accessible devices as CSV or NDJSON,
+// or for GIS tools as KML or GeoPackage styled by status
+func ExportDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the format and the optional device group
+	queryParams := r.URL.Query()
+	format := queryParams.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if _, gis := gisContentTypes[format]; !gis && format != "csv" && format != "ndjson" {
+		http.Error(w, "Invalid format, use csv, ndjson, kml or gpkg", http.StatusBadRequest)
+		return
+	}
+	var groupID int64
+	if groupIDStr := queryParams.Get("group_id"); groupIDStr != "" {
+		var err error
+		groupID, err = strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid group ID", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Fetch the inventory and write it in the requested format
+	if _, gis := gisContentTypes[format]; gis {
+		layer, err := service.ExportDeviceLayer(meberID, groupID)
+		if err != nil {
+			writeServiceError(w, err, "Error exporting devices")
+			return
+		}
+		writeGISExport(w, format, "devices", layer)
+		return
+	}
+
+	records, err := service.ExportDeviceInventory(meberID, groupID)
+	if err != nil {
+		writeServiceError(w, err, "Error exporting devices")
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="devices.csv"`)
+		err = service.WriteDeviceInventoryCSV(w, records)
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="devices.ndjson"`)
+		err = service.WriteDeviceInventoryNDJSON(w, records)
+	}
+	if err != nil {
+		// The headers are already sent, so the error can only be logged
+		log.Printf("Error writing device export: %v", err)
+	}
+}
+
+// ImportDevicesHandler handles the /devices/import endpoint, upserting devices from a CSV body.
+// With dry_run=true the report shows what would change without applying anything.
+func ImportDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	dryRun := false
+	if dryRunStr := r.URL.Query().Get("dry_run"); dryRunStr != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(dryRunStr)
+		if err != nil {
+			http.Error(w, "Invalid dry_run value", http.StatusBadRequest)
+			return
+		}
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBytes)
+	report, err := service.ImportDevicesCSV(meberID, body, dryRun)
+	if err != nil {
+		writeServiceError(w, err, "Error importing devices")
+		return
+	}
+
+	// Row errors are part of the report, nothing was applied in that case
+	status := http.StatusOK
+	if len(report.Errors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// ReplaceDeviceHandler handles replacing failed hardware with a new device at the same location
+func ReplaceDeviceHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the old device and the details of the new unit
+	var request structs.DeviceReplacementRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.OldDeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Create the new device and decommission the old one
+	replacement, err := service.ReplaceDevice(meberID, request)
+	if err != nil {
+		writeServiceError(w, err, "Error replacing device")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(replacement)
+}
+
+// DeviceReplacementsHandler handles the /devices/replacements endpoint returning the replacement history of a device
+func DeviceReplacementsHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	replacements, err := service.GetDeviceReplacements(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device replacements")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(replacements)
+}
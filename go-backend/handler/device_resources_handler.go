@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// ReportDeviceResourcesHandler handles a device reporting its CPU, memory and disk usage and capacity
+func ReportDeviceResourcesHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var report structs.DeviceResourceReport
+	if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.ReportDeviceResources(deviceID, report); err != nil {
+		writeServiceError(w, err, "Error storing device resources")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeviceResourcesHandler handles the /resources endpoint returning the resources and headroom of a device
+func DeviceResourcesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	resources, err := service.GetDeviceResources(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device resources")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resources)
+}
+
+// ApplicationRequirementsHandler handles setting the resources an application needs on a device
+func ApplicationRequirementsHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var requirements structs.ResourceRequirements
+	if err := json.NewDecoder(r.Body).Decode(&requirements); err != nil || requirements.AppID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.SetApplicationRequirements(meberID, requirements); err != nil {
+		writeServiceError(w, err, "Error setting application requirements")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// DeviceTwinHandler handles the /twin endpoint returning desired state, reported state and drift of a device
+func DeviceTwinHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	twin, err := service.GetDeviceTwin(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device twin")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(twin)
+}
+
+// OutOfSyncDevicesHandler handles the /twin/out-of-sync endpoint listing devices whose reported state drifted
+func OutOfSyncDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Optional group to limit the view to
+	var groupID int64
+	if groupIDStr := r.URL.Query().Get("group_id"); groupIDStr != "" {
+		var err error
+		groupID, err = strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid group ID", http.StatusBadRequest)
+			return
+		}
+	}
+
+	twins, err := service.GetOutOfSyncDevices(meberID, groupID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving out of sync devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(twins)
+}
+
+// SetDesiredApplicationHandler handles setting the desired version and config of an application
+func SetDesiredApplicationHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the desired state
+	var request structs.DesiredApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.AppID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Record the desired state for every target device
+	if err := service.SetDesiredApplication(meberID, request); err != nil {
+		writeServiceError(w, err, "Error setting desired application")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveDesiredApplicationHandler handles removing an application from the desired state of a device
+func RemoveDesiredApplicationHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	queryParams := r.URL.Query()
+	deviceID, err := strconv.ParseInt(queryParams.Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+	appID, err := strconv.ParseInt(queryParams.Get("application_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid application ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.RemoveDesiredApplication(meberID, deviceID, appID); err != nil {
+		writeServiceError(w, err, "Error removing desired application")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReportDeviceStateHandler handles the /device-api/state endpoint where devices report the applications they run
+func ReportDeviceStateHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// The report is the full state, applications left out are no longer running
+	var requestBody struct {
+		Applications []structs.ReportedApplication `json:"applications"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.ReportDeviceState(deviceID, requestBody.Applications); err != nil {
+		writeServiceError(w, err, "Error storing reported state")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// DeviceTypesHandler handles the /device-types endpoint returning the device type catalog
+func DeviceTypesHandler(w http.ResponseWriter, r *http.Request) {
+	types, err := service.GetDeviceTypes()
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving device types")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(types)
+}
+
+// CreateDeviceTypeHandler handles adding a hardware model to the device type catalog
+func CreateDeviceTypeHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the device type
+	var deviceType structs.DeviceType
+	if err := json.NewDecoder(r.Body).Decode(&deviceType); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Store it, only admins maintain the catalog
+	created, err := service.CreateDeviceType(meberID, deviceType)
+	if err != nil {
+		writeServiceError(w, err, "Error creating device type")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// AssignDeviceTypeHandler handles setting the type of existing devices
+func AssignDeviceTypeHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var assignment structs.DeviceTypeAssignment
+	if err := json.NewDecoder(r.Body).Decode(&assignment); err != nil || assignment.DeviceTypeID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.AssignDeviceType(meberID, assignment); err != nil {
+		writeServiceError(w, err, "Error assigning device type")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplicationCapabilitiesHandler handles setting the capabilities a device type needs to run an application
+func ApplicationCapabilitiesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var request structs.ApplicationCapabilities
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.AppID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.SetApplicationCapabilities(meberID, request); err != nil {
+		writeServiceError(w, err, "Error setting application capabilities")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ProvisioningTemplatesHandler handles the /provisioning-templates endpoint listing the provisioning templates
+func ProvisioningTemplatesHandler(w http.ResponseWriter, r *http.Request) {
+	templates, err := service.GetProvisioningTemplates()
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving provisioning templates")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(templates)
+}
+
+// CreateProvisioningTemplateHandler handles creating a provisioning template
+func CreateProvisioningTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var template structs.ProvisioningTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	created, err := service.CreateProvisioningTemplate(meberID, template)
+	if err != nil {
+		writeServiceError(w, err, "Error creating provisioning template")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// DeleteProvisioningTemplateHandler handles deleting a provisioning template
+func DeleteProvisioningTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	templateID, err := strconv.ParseInt(r.URL.Query().Get("template_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.DeleteProvisioningTemplate(meberID, templateID); err != nil {
+		writeServiceError(w, err, "Error deleting provisioning template")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegisterDeviceHandler handles registering a new device, which is provisioned from the matching templates
+func RegisterDeviceHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the new device
+	var request structs.DeviceRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Create and provision the device
+	registration, err := service.RegisterDevice(meberID, request)
+	if err != nil {
+		writeServiceError(w, err, "Error registering device")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(registration)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"errors"
+	"main/service"
+	"net/http"
+)
+
+// writeServiceError maps the known service errors to a status code and falls back to a 500 with the given message
+func writeServiceError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, service.ErrAccessDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, service.ErrInvalidRequest):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, service.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, fallbackMessage, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/service"
+	"net/http"
+)
+
+// MunicipalityBoundariesHandler handles the /municipalities/boundaries endpoint returning the municipality
+// boundaries as a GeoJSON FeatureCollection
+func MunicipalityBoundariesHandler(w http.ResponseWriter, r *http.Request) {
+	boundaries, err := service.GetMunicipalityBoundaries()
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving municipality boundaries")
+		return
+	}
+
+	// The boundaries only change with a new release
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(boundaries)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"bytes"
+	"main/geo"
+	"main/middleware"
+	"main/service"
+	"net/http"
+)
+
+// gisContentTypes are the content types of the GIS export formats
+var gisContentTypes = map[string]string{
+	service.ExportFormatKML:        "application/vnd.google-earth.kml+xml",
+	service.ExportFormatGeoPackage: "application/geopackage+sqlite3",
+}
+
+// MunicipalityExportHandler handles the /municipalities/export endpoint returning the status aggregated per
+// municipality joined to the municipality boundaries as KML or GeoPackage
+func MunicipalityExportHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the format
+	format := r.URL.Query().Get("format")
+	if _, ok := gisContentTypes[format]; !ok {
+		http.Error(w, "Invalid format, use kml or gpkg", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Aggregate the municipalities and write them in the requested format
+	layer, err := service.ExportMunicipalityLayer(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error exporting municipalities")
+		return
+	}
+	writeGISExport(w, format, "municipalities", layer)
+}
+
+// writeGISExport writes the layers as an attachment in the GIS format. The export is built in memory first, so a
+// failure can still be reported with a status code.
+func writeGISExport(w http.ResponseWriter, format, name string, layers ...geo.Layer) {
+	var export bytes.Buffer
+	if err := service.WriteGISExport(&export, format, name, layers...); err != nil {
+		writeServiceError(w, err, "Error writing "+format+" export")
+		return
+	}
+
+	w.Header().Set("Content-Type", gisContentTypes[format])
+	w.Header().Set("Content-Disposition", `attachment; filename="`+name+"."+format+`"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(export.Bytes())
+}
@@ -8,6 +8,7 @@ import (
 	"main/structs"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"main/service"
@@ -44,9 +45,22 @@ func GetAllDevicesHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
 		return
 	}
-	devices, err := service.GetAllDevicesWithApplications(meberID)
+
+	// Optionally narrow the list down to the members of a device group
+	var devices []structs.DeviceWithApplicationsDTO
+	var err error
+	if groupIDStr := r.URL.Query().Get("group_id"); groupIDStr != "" {
+		groupID, parseErr := strconv.ParseInt(groupIDStr, 10, 64)
+		if parseErr != nil {
+			http.Error(w, "Invalid group ID", http.StatusBadRequest)
+			return
+		}
+		devices, err = service.GetGroupDevicesWithApplications(meberID, groupID)
+	} else {
+		devices, err = service.GetAllDevicesWithApplications(meberID)
+	}
 	if err != nil {
-		http.Error(w, "Error retrieving devices", http.StatusInternalServerError)
+		writeServiceError(w, err, "Error retrieving devices")
 		return
 	}
 
@@ -55,6 +69,8 @@ func GetAllDevicesHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(devices)
 }
 
+// GetAllDevicesMapHandler handles the /map endpoint. With a bbox or zoom parameter the devices are clustered
+// server-side and a MapView is returned, otherwise every accessible device.
 func GetAllDevicesMapHandler(w http.ResponseWriter, r *http.Request) {
 	// Step 1: Extract meber ID from context
 	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
@@ -63,16 +79,82 @@ func GetAllDevicesMapHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	devices, err := service.GetAllEdgeDevicesForMap(meberID)
+	// Step 2: Parse the optional group, bounding box as min_lon,min_lat,max_lon,max_lat, zoom level and format
+	queryParams := r.URL.Query()
+	format := queryParams.Get("format")
+	if format != "" && format != "json" && format != "geojson" {
+		http.Error(w, "Invalid format, expected json or geojson", http.StatusBadRequest)
+		return
+	}
+	var groupID *int64
+	if groupIDStr := queryParams.Get("group_id"); groupIDStr != "" {
+		parsed, err := strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid group ID", http.StatusBadRequest)
+			return
+		}
+		groupID = &parsed
+	}
+	bboxStr, zoomStr := queryParams.Get("bbox"), queryParams.Get("zoom")
+	var bounds *structs.BoundingBox
+	if bboxStr != "" {
+		parsed, err := parseBoundingBox(bboxStr)
+		if err != nil {
+			http.Error(w, "Invalid bbox, expected min_lon,min_lat,max_lon,max_lat", http.StatusBadRequest)
+			return
+		}
+		bounds = &parsed
+	}
+	zoom := service.ClusterMaxZoom
+	if zoomStr != "" {
+		var err error
+		if zoom, err = strconv.Atoi(zoomStr); err != nil {
+			http.Error(w, "Invalid zoom", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Fetch the devices, as GeoJSON points or clustered when the client passed a viewport
+	var response interface{}
+	var err error
+	contentType := "application/json"
+	switch {
+	case format == "geojson":
+		response, err = service.GetMapGeoJSON(meberID, groupID, bounds)
+		contentType = "application/geo+json"
+	case bboxStr != "" || zoomStr != "":
+		response, err = service.GetMapView(meberID, groupID, bounds, zoom)
+	case groupID != nil:
+		response, err = service.GetGroupDevicesForMap(meberID, *groupID)
+	default:
+		response, err = service.GetAllEdgeDevicesForMap(meberID)
+	}
 	if err != nil {
-		http.Error(w, "Error retrieving devices", http.StatusInternalServerError)
+		writeServiceError(w, err, "Error retrieving devices")
 		return
 	}
 
-	// Step 3: Respond with JSON
-	w.Header().Set("Content-Type", "application/json")
+	// Step 4: Respond with JSON
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(devices)
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseBoundingBox parses a bounding box given as min_lon,min_lat,max_lon,max_lat
+func parseBoundingBox(value string) (structs.BoundingBox, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return structs.BoundingBox{}, fmt.Errorf("expected 4 values, got %d", len(parts))
+	}
+	var values [4]float64
+	for i, part := range parts {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return structs.BoundingBox{}, err
+		}
+		values[i] = parsed
+	}
+	return structs.BoundingBox{MinLongitude: values[0], MinLatitude: values[1], MaxLongitude: values[2], MaxLatitude: values[3]}, nil
 }
 
 func GetAllMebersHandler(w http.ResponseWriter, r *http.Request) {
@@ -126,6 +208,36 @@ func LoginHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(map[string]string{"token": tokenString})
 }
 
+// DeviceTokenHandler handles the /device-token endpoint to issue a token a device uses on the device-facing endpoints
+func DeviceTokenHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "Meber ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the request body to get the device ID
+	var requestBody struct {
+		DeviceID int64 `json:"device_id"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&requestBody)
+	if err != nil || requestBody.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Issue the token if the meber has access to the device
+	tokenString, err := service.IssueDeviceToken(meberID, requestBody.DeviceID)
+	if err != nil {
+		writeServiceError(w, err, "Failed to generate token")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"token": tokenString})
+}
+
 // AppStoreHandler handles the /appstore endpoint to return available applications and their associated sensor information
 func AppStoreHandler(w http.ResponseWriter, r *http.Request) {
 	applications, err := service.GetAppStoreData()
@@ -147,9 +259,10 @@ func EligibleDevicesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Step 2: Parse the request body to get the application ID
+	// Step 2: Parse the request body to get the application ID and optional device group
 	var requestBody struct {
 		ApplicationID int64 `json:"application_id"`
+		GroupID       int64 `json:"group_id"`
 	}
 	err := json.NewDecoder(r.Body).Decode(&requestBody)
 	if err != nil || requestBody.ApplicationID == 0 { // Check if ApplicationID is missing or invalid
@@ -158,9 +271,14 @@ func EligibleDevicesHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Step 3: Get eligible devices
-	eligibleDevices, err := service.GetEligibleDevices(meberID, requestBody.ApplicationID)
+	var eligibleDevices []structs.EligibleDevice
+	if requestBody.GroupID != 0 {
+		eligibleDevices, err = service.GetEligibleGroupDevices(meberID, requestBody.ApplicationID, requestBody.GroupID)
+	} else {
+		eligibleDevices, err = service.GetEligibleDevices(meberID, requestBody.ApplicationID)
+	}
 	if err != nil {
-		http.Error(w, "Error retrieving eligible devices", http.StatusInternalServerError)
+		writeServiceError(w, err, "Error retrieving eligible devices")
 		return
 	}
 
@@ -188,10 +306,11 @@ func AddApplicationsToDevicesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Step 2: Parse the request body to get the device IDs and application ID
+	// Step 2: Parse the request body to get the device IDs, optional device group and application ID
 	var requestBody struct {
 		AppID     int64   `json:"application_id"`
 		DeviceIDs []int64 `json:"device_ids"`
+		GroupID   int64   `json:"group_id"`
 	}
 	err := json.NewDecoder(r.Body).Decode(&requestBody)
 	if err != nil {
@@ -199,10 +318,20 @@ func AddApplicationsToDevicesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A device group can be used as install target, its members are added to the listed devices
+	if requestBody.GroupID != 0 {
+		memberIDs, err := service.GetDeviceGroupDeviceIDs(meberID, requestBody.GroupID)
+		if err != nil {
+			writeServiceError(w, err, "Error resolving device group")
+			return
+		}
+		requestBody.DeviceIDs = append(requestBody.DeviceIDs, memberIDs...)
+	}
+
 	// Step 3: Add application instances to devices
 	err = service.AddApplicationsToDevices(meberID, requestBody.AppID, requestBody.DeviceIDs)
 	if err != nil {
-		http.Error(w, "Error adding applications to devices", http.StatusInternalServerError)
+		writeServiceError(w, err, "Error adding applications to devices")
 		return
 	}
 
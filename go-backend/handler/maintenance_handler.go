@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// MaintenanceWindowsHandler handles listing the maintenance windows on targets the meber has access to
+func MaintenanceWindowsHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	windows, err := service.GetMaintenanceWindows(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving maintenance windows")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(windows)
+}
+
+// CreateMaintenanceWindowHandler handles creating a one-off or recurring maintenance window
+func CreateMaintenanceWindowHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the window, times are RFC3339
+	var window structs.MaintenanceWindow
+	if err := json.NewDecoder(r.Body).Decode(&window); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Validate and store the window
+	created, err := service.CreateMaintenanceWindow(meberID, window)
+	if err != nil {
+		writeServiceError(w, err, "Error creating maintenance window")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// DeleteMaintenanceWindowHandler handles deleting a maintenance window
+func DeleteMaintenanceWindowHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	windowID, err := strconv.ParseInt(r.URL.Query().Get("window_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid window ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.DeleteMaintenanceWindow(meberID, windowID); err != nil {
+		writeServiceError(w, err, "Error deleting maintenance window")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HeartbeatHandler handles the /device-api/heartbeat endpoint where devices report they are alive
+func HeartbeatHandler(w http.ResponseWriter, r *http.Request) {
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// The body is optional, without a status the device is reported online
+	var requestBody struct {
+		Status string `json:"status"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := service.RecordHeartbeat(deviceID, requestBody.Status); err != nil {
+		writeServiceError(w, err, "Error recording heartbeat")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"net/http"
+)
+
+// MunicipalityStatusHandler handles the /municipalities/status endpoint returning the device and application
+// statuses aggregated per municipality
+func MunicipalityStatusHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	statuses, err := service.GetMunicipalityStatus(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error aggregating municipality status")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(statuses)
+}
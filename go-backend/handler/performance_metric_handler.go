@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ReportPerformanceMetricsHandler handles the /device-api/metrics endpoint where devices push their metric samples
+func ReportPerformanceMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract device ID from the device token
+	deviceID, ok := r.Context().Value(middleware.DeviceIDKey).(int64)
+	if !ok {
+		http.Error(w, "Device ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the samples from the request body
+	var requestBody struct {
+		Samples []structs.PerformanceMetricSample `json:"samples"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Store the samples
+	if err := service.RecordPerformanceMetrics(deviceID, requestBody.Samples); err != nil {
+		writeServiceError(w, err, "Error storing performance metrics")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PerformanceMetricsHandler handles the /metrics endpoint returning the downsampled metric history of a device
+func PerformanceMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the query parameters, the range defaults to the last 24 hours
+	queryParams := r.URL.Query()
+	deviceID, err := strconv.ParseInt(queryParams.Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	end := time.Now()
+	if endStr := queryParams.Get("end"); endStr != "" {
+		end, err = time.Parse(time.RFC3339, endStr)
+		if err != nil {
+			http.Error(w, "Invalid end format, expected RFC3339", http.StatusBadRequest)
+			return
+		}
+	}
+
+	start := end.Add(-24 * time.Hour)
+	if startStr := queryParams.Get("start"); startStr != "" {
+		start, err = time.Parse(time.RFC3339, startStr)
+		if err != nil {
+			http.Error(w, "Invalid start format, expected RFC3339", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var points int64
+	if pointsStr := queryParams.Get("points"); pointsStr != "" {
+		points, err = strconv.ParseInt(pointsStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid points", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Fetch the downsampled series
+	series, err := service.GetPerformanceMetrics(meberID, deviceID, start, end, points)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving performance metrics")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(series)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+)
+
+// QuarantinedDevicesHandler handles the /quarantine endpoint listing the quarantined devices of the meber
+func QuarantinedDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	devices, err := service.GetQuarantinedDevices(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving quarantined devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(devices)
+}
+
+// QuarantineDeviceHandler handles putting a device in quarantine
+func QuarantineDeviceHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the device and reason
+	var request structs.QuarantineRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Quarantine the device, this revokes its credentials
+	if err := service.QuarantineDevice(meberID, request); err != nil {
+		writeServiceError(w, err, "Error quarantining device")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReleaseDeviceHandler handles an admin releasing a device from quarantine
+func ReleaseDeviceHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var request structs.QuarantineRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.ReleaseDevice(meberID, request); err != nil {
+		writeServiceError(w, err, "Error releasing device")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"main/middleware"
+	"main/service"
+	"net/http"
+	"strconv"
+)
+
+// maxAccessAreaBytes limits the size of an uploaded access area
+const maxAccessAreaBytes = 1 << 20
+
+// RoleAccessAreaHandler handles GET /roles/access-area returning the access area of a role as a GeoJSON feature
+func RoleAccessAreaHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	roleID, err := strconv.ParseInt(r.URL.Query().Get("role_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid role ID", http.StatusBadRequest)
+		return
+	}
+
+	area, err := service.GetRoleAccessArea(meberID, roleID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving access area")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(area)
+}
+
+// SetRoleAccessAreaHandler handles POST /roles/access-area replacing the access area of a role with the GeoJSON
+// Polygon or MultiPolygon in the body (admins only)
+func SetRoleAccessAreaHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the role and read the GeoJSON body
+	roleID, err := strconv.ParseInt(r.URL.Query().Get("role_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid role ID", http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAccessAreaBytes))
+	if err != nil {
+		http.Error(w, "Access area too large or unreadable", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Store the area
+	area, err := service.SetRoleAccessArea(meberID, roleID, body)
+	if err != nil {
+		writeServiceError(w, err, "Error storing access area")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(area)
+}
+
+// DeleteRoleAccessAreaHandler handles DELETE /roles/access-area removing the access area of a role (admins only)
+func DeleteRoleAccessAreaHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	roleID, err := strconv.ParseInt(r.URL.Query().Get("role_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid role ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := service.DeleteRoleAccessArea(meberID, roleID); err != nil {
+		writeServiceError(w, err, "Error removing access area")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
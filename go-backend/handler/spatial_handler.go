@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// DevicesWithinHandler handles the /devices/within endpoint returning the devices within a bounding box given as
+// min_lon,min_lat,max_lon,max_lat
+func DevicesWithinHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	bounds, err := parseBoundingBox(r.URL.Query().Get("bbox"))
+	if err != nil {
+		http.Error(w, "Invalid bbox, expected min_lon,min_lat,max_lon,max_lat", http.StatusBadRequest)
+		return
+	}
+
+	devices, err := service.GetDevicesInBoundingBox(meberID, bounds)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(devices)
+}
+
+// DevicesNearbyHandler handles the /devices/nearby endpoint returning the devices within a radius in meters of a point
+func DevicesNearbyHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the point and radius
+	center, ok := coordinateParams(w, r)
+	if !ok {
+		return
+	}
+	radius, err := strconv.ParseFloat(r.URL.Query().Get("radius"), 64)
+	if err != nil {
+		http.Error(w, "Invalid radius", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Fetch the devices, closest first
+	devices, err := service.GetDevicesWithinRadius(meberID, center, radius)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(devices)
+}
+
+// NearestDevicesHandler handles the /devices/nearest endpoint returning the devices closest to a point
+func NearestDevicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the point and the optional number of devices
+	center, ok := coordinateParams(w, r)
+	if !ok {
+		return
+	}
+	limit := service.DefaultNearestDevices
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Step 3: Fetch the nearest devices
+	devices, err := service.GetNearestDevices(meberID, center, limit)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(devices)
+}
+
+// coordinateParams parses the latitude and longitude query parameters, writing a bad request when either is missing
+func coordinateParams(w http.ResponseWriter, r *http.Request) (structs.Coordinate, bool) {
+	latitude, latErr := strconv.ParseFloat(r.URL.Query().Get("latitude"), 64)
+	longitude, lonErr := strconv.ParseFloat(r.URL.Query().Get("longitude"), 64)
+	if latErr != nil || lonErr != nil {
+		http.Error(w, "Invalid latitude or longitude", http.StatusBadRequest)
+		return structs.Coordinate{}, false
+	}
+	return structs.Coordinate{Latitude: latitude, Longitude: longitude}, true
+}
+
+// LocationMismatchesHandler handles the /devices/location-mismatches endpoint listing devices whose location tag
+// does not match the municipality at their coordinates
+func LocationMismatchesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	mismatches, err := service.GetLocationMismatches(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error checking device locations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(mismatches)
+}
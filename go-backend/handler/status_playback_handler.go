@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"net/http"
+	"time"
+)
+
+// FleetStatusAtHandler handles the /map/history endpoint returning the status of the accessible devices at the
+// moment given as at
+func FleetStatusAtHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the moment
+	at, err := time.Parse(time.RFC3339, r.URL.Query().Get("at"))
+	if err != nil {
+		http.Error(w, "Invalid at format, expected RFC3339", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Rebuild the fleet status at that moment
+	snapshot, err := service.GetFleetStatusAt(meberID, at, time.Now())
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving fleet status")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(snapshot)
+}
+
+// StatusChangesHandler handles the /map/history/changes endpoint returning the status changes of the accessible
+// devices between from and to, to be played back on top of the fleet status at from
+func StatusChangesHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the period
+	queryParams := r.URL.Query()
+	from, err := time.Parse(time.RFC3339, queryParams.Get("from"))
+	if err != nil {
+		http.Error(w, "Invalid from format, expected RFC3339", http.StatusBadRequest)
+		return
+	}
+	to, err := time.Parse(time.RFC3339, queryParams.Get("to"))
+	if err != nil {
+		http.Error(w, "Invalid to format, expected RFC3339", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Fetch the changes in the period
+	stream, err := service.GetStatusChanges(meberID, from, to)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving status changes")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(stream)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"main/middleware"
+	"main/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// DeviceTileHandler handles the /tiles/{z}/{x}/{y}.mvt endpoint returning the accessible devices in a tile as a
+// Mapbox Vector Tile
+func DeviceTileHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from context
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the tile coordinates from the path
+	vars := mux.Vars(r)
+	var coordinates [3]int
+	for i, name := range []string{"z", "x", "y"} {
+		value, err := strconv.Atoi(vars[name])
+		if err != nil {
+			http.Error(w, "Invalid tile coordinate "+name, http.StatusBadRequest)
+			return
+		}
+		coordinates[i] = value
+	}
+
+	// Step 3: Build or fetch the cached tile
+	tile, err := service.GetDeviceTile(meberID, coordinates[0], coordinates[1], coordinates[2])
+	if err != nil {
+		writeServiceError(w, err, "Error building vector tile")
+		return
+	}
+
+	// Tiles change with the device statuses, so clients have to revalidate
+	w.Header().Set("Content-Type", "application/vnd.mapbox-vector-tile")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Write(tile)
+}
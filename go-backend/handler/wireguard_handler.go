@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"main/middleware"
+	"main/service"
+	"main/structs"
+	"net/http"
+	"strconv"
+)
+
+// ProvisionWireGuardPeerHandler handles provisioning a WireGuard peer for a device, or rotating its key
+func ProvisionWireGuardPeerHandler(w http.ResponseWriter, r *http.Request) {
+	// Step 1: Extract meber ID from JWT
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	// Step 2: Parse the device
+	var request struct {
+		DeviceID int64 `json:"device_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.DeviceID == 0 {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Step 3: Provision the peer, the response holds the private key and is not cacheable
+	provisioning, err := service.ProvisionWireGuardPeer(meberID, request.DeviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error provisioning WireGuard peer")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(provisioning)
+}
+
+// WireGuardPeerHandler handles the /wireguard/peers endpoint returning the tunnel state of a device
+func WireGuardPeerHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	deviceID, err := strconv.ParseInt(r.URL.Query().Get("device_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid device ID", http.StatusBadRequest)
+		return
+	}
+
+	peer, err := service.GetWireGuardPeer(meberID, deviceID)
+	if err != nil {
+		writeServiceError(w, err, "Error retrieving WireGuard peer")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(peer)
+}
+
+// WireGuardHubConfigHandler handles the /wireguard/hub endpoint returning the wg-quick config of the hub
+func WireGuardHubConfigHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	config, err := service.GetWireGuardHubConfig(meberID)
+	if err != nil {
+		writeServiceError(w, err, "Error rendering WireGuard hub config")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(config))
+}
+
+// WireGuardHandshakesHandler handles the hub reporting the handshake state of its peers
+func WireGuardHandshakesHandler(w http.ResponseWriter, r *http.Request) {
+	meberID, ok := r.Context().Value(middleware.MeberIDKey).(int64)
+	if !ok {
+		http.Error(w, "User ID missing from context", http.StatusUnauthorized)
+		return
+	}
+
+	var handshakes []structs.WireGuardHandshake
+	if err := json.NewDecoder(r.Body).Decode(&handshakes); err != nil {
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := service.RecordWireGuardHandshakes(meberID, handshakes)
+	if err != nil {
+		writeServiceError(w, err, "Error recording WireGuard handshakes")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]int{"updated": updated})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"fmt"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -8,8 +9,10 @@ import (
 	"main/adder"
 	"main/presentation"
 	"main/repository"
+	"main/service"
 	"net/http"
 	"os"
+	"time"
 )
 
 func main() {
@@ -18,6 +21,18 @@ func main() {
 	// Initialize the database connection
 	repository.InitDB("")
 
+	// Roll old performance metric samples into aggregates in the background
+	go service.RunMetricRetention(time.Hour)
+
+	// Resend or time out device commands that were not acknowledged in time
+	go service.RunCommandTimeouts(30 * time.Second)
+
+	// Mark devices that stopped sending heartbeats as offline
+	go service.RunOfflineDetection(time.Minute)
+
+	// Cluster devices that went offline together into area outages
+	go service.RunOutageDetection(time.Minute)
+
 	router := mux.NewRouter()
 
 	// Register endpoints
@@ -33,6 +48,28 @@ func main() {
 
 	handler := c.Handler(router)
 
+	// Serve over TLS when a server certificate is configured, devices then authenticate with their client certificate
+	if certFile, keyFile := os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE"); certFile != "" && keyFile != "" {
+		clientCAs, err := service.CertificateAuthorityPool()
+		if err != nil {
+			log.Fatalf("Error loading device certificate authority: %v", err)
+		}
+		server := &http.Server{
+			Addr:    ":8443",
+			Handler: handler,
+			TLSConfig: &tls.Config{
+				ClientAuth: tls.VerifyClientCertIfGiven, // Mebers and enrolling devices have no client certificate
+				ClientCAs:  clientCAs,
+				MinVersion: tls.VersionTLS12,
+			},
+		}
+		log.Println("Starting TLS server on :8443")
+		if err := server.ListenAndServeTLS(certFile, keyFile); err != nil {
+			log.Fatalf("Error starting server: %v", err)
+		}
+		return
+	}
+
 	// Check if running in Docker (using an environment variable set in Dockerfile)
 	if os.Getenv("APP_ENV") == "docker" {
 		log.Println("Starting server on :8080")
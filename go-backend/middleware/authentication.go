@@ -5,6 +5,7 @@ import (
 	"main/service"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type key string
@@ -41,3 +42,46 @@ func AuthenticateMeber(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+const DeviceIDKey key = "deviceID"
+
+// AuthenticateDevice verifies the client certificate, or else the device token, and adds the device ID to the
+// request context
+func AuthenticateDevice(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Step 1: A client certificate presented over mutual TLS identifies the device
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			deviceID, err := service.VerifyDeviceCertificate(r.TLS.PeerCertificates[0], time.Now())
+			if err != nil {
+				http.Error(w, "Invalid client certificate", http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), DeviceIDKey, deviceID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		// Step 2: Otherwise fall back to a device token
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			http.Error(w, "Authorization header missing", http.StatusUnauthorized)
+			return
+		}
+
+		tokenParts := strings.Split(authHeader, " ")
+		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+			http.Error(w, "Invalid Authorization header format", http.StatusUnauthorized)
+			return
+		}
+
+		deviceID, err := service.AuthenticateDeviceToken(tokenParts[1])
+		if err != nil {
+			http.Error(w, "Invalid device token", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), DeviceIDKey, deviceID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -1,8 +1,16 @@
 package middleware_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"main/middleware"
+	"main/repository"
 	"main/service"
+	"main/structs"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -96,3 +104,84 @@ func TestAuthenticateMeber(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthenticateDeviceClientCertificate(t *testing.T) {
+	// Generate a CA and a device certificate for device 12
+	caPEM, caKeyPEM, err := service.NewCertificateAuthority(time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error generating CA: %v", err)
+	}
+	ca, err := service.ParseCertificateAuthority(caPEM, caKeyPEM)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing CA: %v", err)
+	}
+	deviceKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	csrDER, _ := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, deviceKey)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	stored, certificatePEM, err := ca.IssueDeviceCertificate(csr, 12, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error issuing certificate: %v", err)
+	}
+	block, _ := pem.Decode([]byte(certificatePEM))
+	certificate, _ := x509.ParseCertificate(block.Bytes)
+
+	// Backup the original repository functions and restore them after the test
+	originalGetCA := repository.GetCertificateAuthority
+	originalGetCertificate := repository.GetDeviceCertificate
+	originalDecommissioned := repository.GetDeviceDecommissionedAt
+	defer func() {
+		repository.GetCertificateAuthority = originalGetCA
+		repository.GetDeviceCertificate = originalGetCertificate
+		repository.GetDeviceDecommissionedAt = originalDecommissioned
+	}()
+	repository.GetCertificateAuthority = func() (string, string, error) { return caPEM, caKeyPEM, nil }
+
+	revoked := false
+	repository.GetDeviceCertificate = func(serial string) (*structs.DeviceCertificate, error) {
+		record := stored
+		if revoked {
+			revokedAt := time.Now()
+			record.RevokedAt = &revokedAt
+		}
+		return &record, nil
+	}
+	decommissioned := false
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) {
+		if decommissioned {
+			decommissionedAt := time.Now()
+			return true, &decommissionedAt, nil
+		}
+		return true, nil, nil
+	}
+
+	handler := middleware.AuthenticateDevice(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if deviceID, _ := r.Context().Value(middleware.DeviceIDKey).(int64); deviceID != 12 {
+			t.Errorf("Expected device ID 12 in context, got %d", deviceID)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tt := range []struct {
+		name           string
+		revoked        bool
+		decommissioned bool
+		expectedCode   int
+	}{
+		{"Valid Client Certificate", false, false, http.StatusOK},
+		{"Revoked Client Certificate", true, false, http.StatusUnauthorized},
+		{"Decommissioned Device", false, true, http.StatusUnauthorized},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			revoked, decommissioned = tt.revoked, tt.decommissioned
+			req := httptest.NewRequest("POST", "/device-api/heartbeat", nil)
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedCode {
+				t.Errorf("Expected status code %d, got %d", tt.expectedCode, rr.Code)
+			}
+		})
+	}
+}
@@ -58,6 +58,26 @@ func TestAllEndpoints(t *testing.T) {
 		{"Devices Request With Invalid Token", "GET", "/devices", nil, "Bearer " + invalidToken, http.StatusUnauthorized},
 		{"Devices Request With Expired Token", "GET", "/devices", nil, "Bearer " + expiredToken, http.StatusUnauthorized},
 
+		// Bulk device endpoint
+		{"Bulk Devices Without Operation", "POST", "/devices/bulk", []byte(`{"device_ids":[1,2]}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Bulk Devices With Invalid JSON", "POST", "/devices/bulk", []byte(`{"device_ids":}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Bulk Devices Without Authorization", "POST", "/devices/bulk", nil, "", http.StatusUnauthorized},
+
+		// Device group endpoints
+		{"Valid Groups Request", "GET", "/groups", nil, "Bearer " + validToken, http.StatusOK},
+		{"Create Group With Unknown Type", "POST", "/groups", []byte(`{"name":"test","type":"other"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Group Members Of Missing Group", "GET", "/groups/members?group_id=999999", nil, "Bearer " + validToken, http.StatusNotFound},
+		{"Groups Without Authorization", "GET", "/groups", nil, "", http.StatusUnauthorized},
+		{"Map Request With Invalid Group ID", "GET", "/map?group_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+
+		// Command endpoints
+		{"Valid Commands Request", "GET", "/commands?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Commands With Missing Parameters", "GET", "/commands", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Queue Command With Unknown Type", "POST", "/commands", []byte(`{"type":"format_disk","device_ids":[1]}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Commands Without Authorization", "GET", "/commands?device_id=1", nil, "", http.StatusUnauthorized},
+		{"Poll Commands With Meber Token", "GET", "/device-api/commands", nil, "Bearer " + validToken, http.StatusUnauthorized},
+		{"Valid Audit Log Request", "GET", "/audit-log?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+
 		// Login endpoints
 		{"Valid Login Request", "POST", "/api/login", []byte(`{"meber_id":1}`), "", http.StatusOK},
 		{"Login With Invalid JSON", "POST", "/api/login", []byte(`{"meber_id":}`), "", http.StatusBadRequest},
@@ -90,6 +110,147 @@ func TestAllEndpoints(t *testing.T) {
 		{"Logs Without Authorization", "GET", "/logs?device_id=1", nil, "", http.StatusUnauthorized},
 		{"Logs With Invalid Token", "GET", "/logs?device_id=1", nil, "Bearer " + invalidToken, http.StatusUnauthorized},
 		{"Logs With Expired Token", "GET", "/logs?device_id=1", nil, "Bearer " + expiredToken, http.StatusUnauthorized},
+
+		// Performance metrics endpoints
+		{"Valid Metrics Request", "GET", "/metrics?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Metrics With Invalid Device ID", "GET", "/metrics?device_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Metrics Without Authorization", "GET", "/metrics?device_id=1", nil, "", http.StatusUnauthorized},
+		{"Report Metrics With Meber Token", "POST", "/device-api/metrics", []byte(`{"samples":[{"value":50}]}`), "Bearer " + validToken, http.StatusUnauthorized},
+
+		// Maintenance window endpoints
+		{"Valid Maintenance Windows Request", "GET", "/maintenance-windows", nil, "Bearer " + validToken, http.StatusOK},
+		{"Maintenance Window With Invalid Target", "POST", "/maintenance-windows", []byte(`{"name":"Test","target_type":"street","target_id":1,"starts_at":"2030-01-01T08:00:00Z","ends_at":"2030-01-01T10:00:00Z"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Delete Maintenance Window With Invalid ID", "DELETE", "/maintenance-windows?window_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Heartbeat With Meber Token", "POST", "/device-api/heartbeat", nil, "Bearer " + validToken, http.StatusUnauthorized},
+
+		// Device twin endpoints
+		{"Valid Device Twin Request", "GET", "/twin?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Device Twin With Invalid Device ID", "GET", "/twin?device_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Valid Out Of Sync Request", "GET", "/twin/out-of-sync", nil, "Bearer " + validToken, http.StatusOK},
+		{"Desired Application Without Application", "POST", "/twin/desired", []byte(`{"device_ids":[1]}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Report State With Meber Token", "POST", "/device-api/state", []byte(`{"applications":[]}`), "Bearer " + validToken, http.StatusUnauthorized},
+
+		// Inventory import and export endpoints
+		{"Valid CSV Export Request", "GET", "/devices/export?format=csv", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid NDJSON Export Request", "GET", "/devices/export?format=ndjson", nil, "Bearer " + validToken, http.StatusOK},
+		{"Export With Invalid Format", "GET", "/devices/export?format=xml", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Import With Unknown Column", "POST", "/devices/import?dry_run=true", []byte("name,colour\nMSR-1,red\n"), "Bearer " + validToken, http.StatusBadRequest},
+		{"Import Without Authorization", "POST", "/devices/import?dry_run=true", []byte("name\nMSR-1\n"), "", http.StatusUnauthorized},
+
+		// WireGuard endpoints
+		{"Get WireGuard Peer With Invalid ID", "GET", "/wireguard/peers?device_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Provision WireGuard Peer Without Device", "POST", "/wireguard/peers", []byte(`{}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"WireGuard Hub Config Without Authorization", "GET", "/wireguard/hub", nil, "", http.StatusUnauthorized},
+		{"WireGuard Handshakes With Invalid Payload", "POST", "/wireguard/handshakes", []byte(`{"public_key":`), "Bearer " + validToken, http.StatusBadRequest},
+
+		// Certificate authority endpoints
+		{"Enrollment Token Without Device", "POST", "/certificates/enrollment-token", []byte(`{}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Certificates With Invalid Device ID", "GET", "/certificates?device_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Revoke Without Serial", "POST", "/certificates/revoke", []byte(`{}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Enroll With Invalid CSR", "POST", "/enroll", []byte(`{"token":"abc","csr":"not a csr"}`), "", http.StatusBadRequest},
+		{"Valid CRL Request", "GET", "/ca/crl", nil, "", http.StatusOK},
+		{"Renew Without Client Certificate", "POST", "/device-api/certificate/renew", []byte(`{"csr":""}`), "", http.StatusUnauthorized},
+
+		// Quarantine endpoints
+		{"Valid Quarantine List Request", "GET", "/quarantine", nil, "Bearer " + validToken, http.StatusOK},
+		{"Quarantine Without Reason", "POST", "/quarantine", []byte(`{"device_id":1}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Release Without Device", "POST", "/quarantine/release", []byte(`{"reason":"cleared"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Quarantine Without Authorization", "POST", "/quarantine", []byte(`{"device_id":1,"reason":"test"}`), "", http.StatusUnauthorized},
+
+		// Device replacement endpoints
+		{"Valid Replacement History Request", "GET", "/devices/replacements?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Replace Without Old Device", "POST", "/devices/replace", []byte(`{"name":"new unit"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Replace With Invalid Connection Type", "POST", "/devices/replace", []byte(`{"old_device_id":1,"connection_type":"carrier pigeon"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Replace Without Authorization", "POST", "/devices/replace", []byte(`{"old_device_id":1}`), "", http.StatusUnauthorized},
+
+		// Device types, provisioning templates and registration
+		{"Valid Device Types Request", "GET", "/device-types", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Provisioning Templates Request", "GET", "/provisioning-templates", nil, "Bearer " + validToken, http.StatusOK},
+		{"Device Type Without Model", "POST", "/device-types", []byte(`{"vendor":"Siemens"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Register Without Connection Type", "POST", "/devices/register", []byte(`{"name":"MSR_new"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Register Without Authorization", "POST", "/devices/register", []byte(`{"name":"MSR_new","connection_type":"wired"}`), "", http.StatusUnauthorized},
+
+		// Asset lifecycle endpoints
+		{"Valid Device Asset Request", "GET", "/assets?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Asset Report Request", "GET", "/assets/report?warranty_days=365", nil, "Bearer " + validToken, http.StatusOK},
+		{"Asset Report Invalid Days", "GET", "/assets/report?stuck_days=0", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Asset Invalid Warranty Date", "POST", "/assets", []byte(`{"device_id":1,"warranty_end":"31-12-2030"}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Lifecycle Unknown Stage", "POST", "/assets/lifecycle", []byte(`{"device_id":1,"stage":"broken"}`), "Bearer " + validToken, http.StatusBadRequest},
+
+		// Resource endpoints
+		{"Valid Device Resources Request", "GET", "/resources?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Device Resources Invalid Device", "GET", "/resources?device_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Negative Application Requirements", "POST", "/applications/requirements", []byte(`{"app_id":1,"memory_mb":-1}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Report Resources Without Device Token", "POST", "/device-api/resources", []byte(`{"cpu_cores":4}`), "", http.StatusUnauthorized},
+
+		// Connectivity endpoints
+		{"Valid Connectivity History Request", "GET", "/connectivity?device_id=1", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Flapping Devices Request", "GET", "/connectivity/flapping?hours=48", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Connectivity Ranking Request", "GET", "/connectivity/ranking?limit=5", nil, "Bearer " + validToken, http.StatusOK},
+		{"Connectivity Ranking Invalid Hours", "GET", "/connectivity/ranking?hours=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Connectivity Ranking Period Too Long", "GET", "/connectivity/ranking?hours=10000", nil, "Bearer " + validToken, http.StatusBadRequest},
+
+		// Clustered map endpoints
+		{"Valid Clustered Map Request", "GET", "/map?bbox=4.9,51.9,5.5,52.3&zoom=10", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Map Zoom Only Request", "GET", "/map?zoom=5", nil, "Bearer " + validToken, http.StatusOK},
+		{"Map Malformed Bounding Box", "GET", "/map?bbox=4.9,51.9,5.5", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Map Inverted Bounding Box", "GET", "/map?bbox=5.5,52.3,4.9,51.9&zoom=10", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Map Invalid Zoom", "GET", "/map?zoom=far", nil, "Bearer " + validToken, http.StatusBadRequest},
+
+		// Spatial query endpoints
+		{"Valid Devices Within Request", "GET", "/devices/within?bbox=5.0,52.0,5.2,52.2", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Devices Nearby Request", "GET", "/devices/nearby?latitude=52.09&longitude=5.12&radius=5000", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid Nearest Devices Request", "GET", "/devices/nearest?latitude=52.09&longitude=5.12&limit=3", nil, "Bearer " + validToken, http.StatusOK},
+		{"Nearby Radius Too Large", "GET", "/devices/nearby?latitude=52.09&longitude=5.12&radius=1000000", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Nearest Latitude Out Of Range", "GET", "/devices/nearest?latitude=95&longitude=5.12", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Register Without Coordinates", "POST", "/devices/register", []byte(`{"name":"MSR_new","connection_type":"wired"}`), "Bearer " + validToken, http.StatusBadRequest},
+
+		// GeoJSON endpoints
+		{"Valid Municipality Boundaries Request", "GET", "/municipalities/boundaries", nil, "Bearer " + validToken, http.StatusOK},
+		{"Municipality Boundaries Without Authorization", "GET", "/municipalities/boundaries", nil, "", http.StatusUnauthorized},
+		{"Valid GeoJSON Map Request", "GET", "/map?format=geojson", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid GeoJSON Map Request With Bounding Box", "GET", "/map?format=geojson&bbox=4.9,51.9,5.5,52.3", nil, "Bearer " + validToken, http.StatusOK},
+		{"Map Unknown Format", "GET", "/map?format=csv", nil, "Bearer " + validToken, http.StatusBadRequest},
+
+		// Municipality status endpoints
+		{"Valid Municipality Status Request", "GET", "/municipalities/status", nil, "Bearer " + validToken, http.StatusOK},
+		{"Municipality Status Without Authorization", "GET", "/municipalities/status", nil, "", http.StatusUnauthorized},
+
+		// Vector tile endpoints
+		{"Valid Vector Tile Request", "GET", "/tiles/10/526/337.mvt", nil, "Bearer " + validToken, http.StatusOK},
+		{"Vector Tile Outside The World", "GET", "/tiles/2/4/1.mvt", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Vector Tile Zoom Too High", "GET", "/tiles/23/0/0.mvt", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Vector Tile Without Authorization", "GET", "/tiles/10/526/337.mvt", nil, "", http.StatusUnauthorized},
+
+		// Municipality assignment endpoints
+		{"Valid Location Mismatches Request", "GET", "/devices/location-mismatches", nil, "Bearer " + validToken, http.StatusOK},
+		{"Register In Another Municipality", "POST", "/devices/register", []byte(`{"name":"MSR_new","connection_type":"wired","latitude":51.92,"longitude":4.47,"municipality_id":999999}`), "Bearer " + validToken, http.StatusBadRequest},
+
+		// Area outage endpoints
+		{"Valid Area Outages Request", "GET", "/outages", nil, "Bearer " + validToken, http.StatusOK},
+		{"Area Outages Without Authorization", "GET", "/outages", nil, "", http.StatusUnauthorized},
+
+		// Historical playback endpoints
+		{"Valid Fleet Status At Request", "GET", "/map/history?at=2024-06-01T12:00:00Z", nil, "Bearer " + validToken, http.StatusOK},
+		{"Fleet Status At Missing Moment", "GET", "/map/history", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Fleet Status At Without Authorization", "GET", "/map/history?at=2024-06-01T12:00:00Z", nil, "", http.StatusUnauthorized},
+		{"Valid Status Changes Request", "GET", "/map/history/changes?from=2024-06-01T10:00:00Z&to=2024-06-01T12:00:00Z", nil, "Bearer " + validToken, http.StatusOK},
+		{"Status Changes Reversed Period", "GET", "/map/history/changes?from=2024-06-01T12:00:00Z&to=2024-06-01T10:00:00Z", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Status Changes Period Too Long", "GET", "/map/history/changes?from=2024-05-01T00:00:00Z&to=2024-06-01T00:00:00Z", nil, "Bearer " + validToken, http.StatusBadRequest},
+
+		// GIS export endpoints
+		{"Valid KML Device Export Request", "GET", "/devices/export?format=kml", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid GeoPackage Device Export Request", "GET", "/devices/export?format=gpkg", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid KML Municipality Export Request", "GET", "/municipalities/export?format=kml", nil, "Bearer " + validToken, http.StatusOK},
+		{"Valid GeoPackage Municipality Export Request", "GET", "/municipalities/export?format=gpkg", nil, "Bearer " + validToken, http.StatusOK},
+		{"Municipality Export Invalid Format", "GET", "/municipalities/export?format=shp", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Municipality Export Without Authorization", "GET", "/municipalities/export?format=kml", nil, "", http.StatusUnauthorized},
+
+		// Role access area endpoints
+		{"Access Area Invalid Role ID", "GET", "/roles/access-area?role_id=abc", nil, "Bearer " + validToken, http.StatusBadRequest},
+		{"Access Area Unknown Role", "GET", "/roles/access-area?role_id=999999", nil, "Bearer " + validToken, http.StatusNotFound},
+		{"Set Access Area Invalid GeoJSON", "POST", "/roles/access-area?role_id=1", []byte(`{"type": "Point", "coordinates": [5.1, 52.08]}`), "Bearer " + validToken, http.StatusBadRequest},
+		{"Access Area Without Authorization", "GET", "/roles/access-area?role_id=1", nil, "", http.StatusUnauthorized},
 	}
 
 	// Iterate over the test cases
@@ -11,6 +11,68 @@ func RegisterDeviceHandlers(router *mux.Router) {
 	router.Handle("/map", middleware.AuthenticateMeber(http.HandlerFunc(handler.GetAllDevicesMapHandler))).Methods("GET")
 	// TODO rework: for /devices, return devices with appropriate attributes: device info, tags, application
 	router.Handle("/devices", middleware.AuthenticateMeber(http.HandlerFunc(handler.GetAllDevicesHandler))).Methods("GET")
+	router.Handle("/devices/bulk", middleware.AuthenticateMeber(http.HandlerFunc(handler.BulkDevicesHandler))).Methods("POST")
+	router.Handle("/devices/export", middleware.AuthenticateMeber(http.HandlerFunc(handler.ExportDevicesHandler))).Methods("GET")
+	router.Handle("/devices/import", middleware.AuthenticateMeber(http.HandlerFunc(handler.ImportDevicesHandler))).Methods("POST")
+	router.Handle("/devices/register", middleware.AuthenticateMeber(http.HandlerFunc(handler.RegisterDeviceHandler))).Methods("POST")
+	router.Handle("/devices/replace", middleware.AuthenticateMeber(http.HandlerFunc(handler.ReplaceDeviceHandler))).Methods("POST")
+	router.Handle("/devices/replacements", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceReplacementsHandler))).Methods("GET")
+	router.Handle("/devices/within", middleware.AuthenticateMeber(http.HandlerFunc(handler.DevicesWithinHandler))).Methods("GET")
+	router.Handle("/devices/nearby", middleware.AuthenticateMeber(http.HandlerFunc(handler.DevicesNearbyHandler))).Methods("GET")
+	router.Handle("/devices/nearest", middleware.AuthenticateMeber(http.HandlerFunc(handler.NearestDevicesHandler))).Methods("GET")
+	router.Handle("/devices/location-mismatches", middleware.AuthenticateMeber(http.HandlerFunc(handler.LocationMismatchesHandler))).Methods("GET")
+
+	// Municipality boundaries as GeoJSON, status aggregated per municipality and exported with the boundaries as KML
+	// or GeoPackage. /map?format=geojson returns the devices as GeoJSON
+	router.Handle("/municipalities/boundaries", middleware.AuthenticateMeber(http.HandlerFunc(handler.MunicipalityBoundariesHandler))).Methods("GET")
+	router.Handle("/municipalities/status", middleware.AuthenticateMeber(http.HandlerFunc(handler.MunicipalityStatusHandler))).Methods("GET")
+	router.Handle("/municipalities/export", middleware.AuthenticateMeber(http.HandlerFunc(handler.MunicipalityExportHandler))).Methods("GET")
+
+	// Area outages detected from neighbouring devices going offline together
+	router.Handle("/outages", middleware.AuthenticateMeber(http.HandlerFunc(handler.AreaOutagesHandler))).Methods("GET")
+
+	// Historical playback of the map: the fleet status at a moment and the status changes from there on
+	router.Handle("/map/history", middleware.AuthenticateMeber(http.HandlerFunc(handler.FleetStatusAtHandler))).Methods("GET")
+	router.Handle("/map/history/changes", middleware.AuthenticateMeber(http.HandlerFunc(handler.StatusChangesHandler))).Methods("GET")
+
+	// Vector tiles of the devices for rendering the map at national scale
+	router.Handle("/tiles/{z:[0-9]+}/{x:[0-9]+}/{y:[0-9]+}.mvt", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceTileHandler))).Methods("GET")
+
+	// Access areas of roles, restricted mebers see the devices inside the areas of their roles on top of their tags
+	router.Handle("/roles/access-area", middleware.AuthenticateMeber(http.HandlerFunc(handler.RoleAccessAreaHandler))).Methods("GET")
+	router.Handle("/roles/access-area", middleware.AuthenticateMeber(http.HandlerFunc(handler.SetRoleAccessAreaHandler))).Methods("POST")
+	router.Handle("/roles/access-area", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeleteRoleAccessAreaHandler))).Methods("DELETE")
+
+	// Device groups and saved filters, usable as group_id on /map, /devices and the install endpoints
+	router.Handle("/groups", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceGroupsHandler))).Methods("GET")
+	router.Handle("/groups", middleware.AuthenticateMeber(http.HandlerFunc(handler.CreateDeviceGroupHandler))).Methods("POST")
+	router.Handle("/groups", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeleteDeviceGroupHandler))).Methods("DELETE")
+	router.Handle("/groups/members", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceGroupMembersHandler))).Methods("GET")
+	router.Handle("/groups/members", middleware.AuthenticateMeber(http.HandlerFunc(handler.UpdateDeviceGroupMembersHandler))).Methods("POST")
+
+	// Device type catalog and the provisioning templates applied on registration, maintained by admins
+	router.Handle("/device-types", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceTypesHandler))).Methods("GET")
+	router.Handle("/device-types", middleware.AuthenticateMeber(http.HandlerFunc(handler.CreateDeviceTypeHandler))).Methods("POST")
+	router.Handle("/device-types/assign", middleware.AuthenticateMeber(http.HandlerFunc(handler.AssignDeviceTypeHandler))).Methods("POST")
+	router.Handle("/applications/capabilities", middleware.AuthenticateMeber(http.HandlerFunc(handler.ApplicationCapabilitiesHandler))).Methods("POST")
+	router.Handle("/provisioning-templates", middleware.AuthenticateMeber(http.HandlerFunc(handler.ProvisioningTemplatesHandler))).Methods("GET")
+	router.Handle("/provisioning-templates", middleware.AuthenticateMeber(http.HandlerFunc(handler.CreateProvisioningTemplateHandler))).Methods("POST")
+	router.Handle("/provisioning-templates", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeleteProvisioningTemplateHandler))).Methods("DELETE")
+
+	// Asset information and lifecycle of devices
+	router.Handle("/assets", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceAssetHandler))).Methods("GET")
+	router.Handle("/assets", middleware.AuthenticateMeber(http.HandlerFunc(handler.UpdateDeviceAssetHandler))).Methods("POST")
+	router.Handle("/assets/lifecycle", middleware.AuthenticateMeber(http.HandlerFunc(handler.LifecycleTransitionHandler))).Methods("POST")
+	router.Handle("/assets/report", middleware.AuthenticateMeber(http.HandlerFunc(handler.AssetReportHandler))).Methods("GET")
+
+	// Resources reported by devices and required by applications, installs need headroom on the device
+	router.Handle("/resources", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceResourcesHandler))).Methods("GET")
+	router.Handle("/applications/requirements", middleware.AuthenticateMeber(http.HandlerFunc(handler.ApplicationRequirementsHandler))).Methods("POST")
+
+	// Connection quality history, flapping connections and the worst connected devices per municipality
+	router.Handle("/connectivity", middleware.AuthenticateMeber(http.HandlerFunc(handler.ConnectivityHistoryHandler))).Methods("GET")
+	router.Handle("/connectivity/flapping", middleware.AuthenticateMeber(http.HandlerFunc(handler.FlappingDevicesHandler))).Methods("GET")
+	router.Handle("/connectivity/ranking", middleware.AuthenticateMeber(http.HandlerFunc(handler.ConnectivityRankingHandler))).Methods("GET")
 
 	// For handling meber functionality, AKA RBAC
 	router.HandleFunc("/mebers", handler.GetAllMebersHandler).Methods("GET")
@@ -21,4 +83,52 @@ func RegisterDeviceHandlers(router *mux.Router) {
 	router.Handle("/add-applications", middleware.AuthenticateMeber(http.HandlerFunc(handler.AddApplicationsToDevicesHandler))).Methods("POST")
 
 	router.Handle("/logs", middleware.AuthenticateMeber(http.HandlerFunc(handler.LogsHandler))).Methods("GET")
+	router.Handle("/metrics", middleware.AuthenticateMeber(http.HandlerFunc(handler.PerformanceMetricsHandler))).Methods("GET")
+	router.Handle("/audit-log", middleware.AuthenticateMeber(http.HandlerFunc(handler.AuditLogHandler))).Methods("GET")
+
+	// Remote commands for edge devices
+	router.Handle("/commands", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceCommandsHandler))).Methods("GET")
+	router.Handle("/commands", middleware.AuthenticateMeber(http.HandlerFunc(handler.QueueDeviceCommandHandler))).Methods("POST")
+	router.Handle("/commands/cancel", middleware.AuthenticateMeber(http.HandlerFunc(handler.CancelDeviceCommandHandler))).Methods("POST")
+
+	// Device twin: desired state set by mebers, reported state sent by the devices
+	router.Handle("/twin", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceTwinHandler))).Methods("GET")
+	router.Handle("/twin/out-of-sync", middleware.AuthenticateMeber(http.HandlerFunc(handler.OutOfSyncDevicesHandler))).Methods("GET")
+	router.Handle("/twin/desired", middleware.AuthenticateMeber(http.HandlerFunc(handler.SetDesiredApplicationHandler))).Methods("POST")
+	router.Handle("/twin/desired", middleware.AuthenticateMeber(http.HandlerFunc(handler.RemoveDesiredApplicationHandler))).Methods("DELETE")
+
+	// Maintenance windows, devices inside an active window are shown as in maintenance
+	router.Handle("/maintenance-windows", middleware.AuthenticateMeber(http.HandlerFunc(handler.MaintenanceWindowsHandler))).Methods("GET")
+	router.Handle("/maintenance-windows", middleware.AuthenticateMeber(http.HandlerFunc(handler.CreateMaintenanceWindowHandler))).Methods("POST")
+	router.Handle("/maintenance-windows", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeleteMaintenanceWindowHandler))).Methods("DELETE")
+
+	// WireGuard tunnel: peer provisioning per device, hub config and handshakes for the hub (admins only)
+	router.Handle("/wireguard/peers", middleware.AuthenticateMeber(http.HandlerFunc(handler.WireGuardPeerHandler))).Methods("GET")
+	router.Handle("/wireguard/peers", middleware.AuthenticateMeber(http.HandlerFunc(handler.ProvisionWireGuardPeerHandler))).Methods("POST")
+	router.Handle("/wireguard/hub", middleware.AuthenticateMeber(http.HandlerFunc(handler.WireGuardHubConfigHandler))).Methods("GET")
+	router.Handle("/wireguard/handshakes", middleware.AuthenticateMeber(http.HandlerFunc(handler.WireGuardHandshakesHandler))).Methods("POST")
+
+	// Quarantine isolates a possibly compromised device, releasing it is up to admins
+	router.Handle("/quarantine", middleware.AuthenticateMeber(http.HandlerFunc(handler.QuarantinedDevicesHandler))).Methods("GET")
+	router.Handle("/quarantine", middleware.AuthenticateMeber(http.HandlerFunc(handler.QuarantineDeviceHandler))).Methods("POST")
+	router.Handle("/quarantine/release", middleware.AuthenticateMeber(http.HandlerFunc(handler.ReleaseDeviceHandler))).Methods("POST")
+
+	// Local CA for device identity: enrollment tokens, certificates and the revocation list
+	router.Handle("/certificates", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceCertificatesHandler))).Methods("GET")
+	router.Handle("/certificates/enrollment-token", middleware.AuthenticateMeber(http.HandlerFunc(handler.EnrollmentTokenHandler))).Methods("POST")
+	router.Handle("/certificates/revoke", middleware.AuthenticateMeber(http.HandlerFunc(handler.RevokeDeviceCertificateHandler))).Methods("POST")
+	router.HandleFunc("/ca/certificate", handler.CACertificateHandler).Methods("GET")
+	router.HandleFunc("/ca/crl", handler.CertificateRevocationListHandler).Methods("GET")
+	router.HandleFunc("/enroll", handler.EnrollDeviceHandler).Methods("POST")
+
+	// Device-facing endpoints, authenticated with a client certificate or device token instead of a meber token
+	router.Handle("/device-token", middleware.AuthenticateMeber(http.HandlerFunc(handler.DeviceTokenHandler))).Methods("POST")
+	router.Handle("/device-api/heartbeat", middleware.AuthenticateDevice(http.HandlerFunc(handler.HeartbeatHandler))).Methods("POST")
+	router.Handle("/device-api/state", middleware.AuthenticateDevice(http.HandlerFunc(handler.ReportDeviceStateHandler))).Methods("POST")
+	router.Handle("/device-api/metrics", middleware.AuthenticateDevice(http.HandlerFunc(handler.ReportPerformanceMetricsHandler))).Methods("POST")
+	router.Handle("/device-api/resources", middleware.AuthenticateDevice(http.HandlerFunc(handler.ReportDeviceResourcesHandler))).Methods("POST")
+	router.Handle("/device-api/connectivity", middleware.AuthenticateDevice(http.HandlerFunc(handler.ReportConnectivityHandler))).Methods("POST")
+	router.Handle("/device-api/commands", middleware.AuthenticateDevice(http.HandlerFunc(handler.PollDeviceCommandsHandler))).Methods("GET")
+	router.Handle("/device-api/commands/result", middleware.AuthenticateDevice(http.HandlerFunc(handler.ReportDeviceCommandResultHandler))).Methods("POST")
+	router.Handle("/device-api/certificate/renew", middleware.AuthenticateDevice(http.HandlerFunc(handler.RenewDeviceCertificateHandler))).Methods("POST")
 }
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// GetRecentlyOfflineDevices retrieves the devices that are offline and last went offline outside maintenance
+// since the given moment, decommissioned devices are left out
+var GetRecentlyOfflineDevices = func(since time.Time) ([]structs.OfflineDevice, error) {
+	rows, err := DB.Query(`
+		SELECT ed.id, ed.name, ST_Y(ed.coordinates) AS latitude, ST_X(ed.coordinates) AS longitude, h.went_offline
+		FROM edge_devices ed
+		JOIN (
+			SELECT device_id, MAX(timestamp) AS went_offline
+			FROM device_status_history
+			WHERE status = 'offline' AND in_maintenance = FALSE AND timestamp >= ?
+			GROUP BY device_id
+		) h ON h.device_id = ed.id
+		WHERE ed.status = 'offline' AND ed.decommissioned_at IS NULL
+		ORDER BY ed.id
+	`, since.UTC())
+	if err != nil {
+		log.Printf("Error retrieving recently offline devices: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []structs.OfflineDevice
+	for rows.Next() {
+		var device structs.OfflineDevice
+		var wentOffline string
+		if err := rows.Scan(&device.DeviceID, &device.Name, &device.Latitude, &device.Longitude, &wentOffline); err != nil {
+			return nil, fmt.Errorf("error scanning offline device: %w", err)
+		}
+		if device.OfflineSince, err = parseTimestamp(wentOffline); err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
+
+// GetActiveAreaOutages retrieves the outages that are not resolved with all their devices, oldest first
+var GetActiveAreaOutages = func() ([]structs.AreaOutage, error) {
+	rows, err := DB.Query(`
+		SELECT id, started_at, detected_at, resolved_at, area
+		FROM area_outages
+		WHERE resolved_at IS NULL
+		ORDER BY id
+	`)
+	if err != nil {
+		log.Printf("Error retrieving area outages: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outages []structs.AreaOutage
+	byID := make(map[int64]int)
+	for rows.Next() {
+		var outage structs.AreaOutage
+		var startedAt, detectedAt string
+		var resolvedAt sql.NullString
+		var area []byte
+		if err := rows.Scan(&outage.ID, &startedAt, &detectedAt, &resolvedAt, &area); err != nil {
+			return nil, fmt.Errorf("error scanning area outage: %w", err)
+		}
+		if outage.StartedAt, err = parseTimestamp(startedAt); err != nil {
+			return nil, err
+		}
+		if outage.DetectedAt, err = parseTimestamp(detectedAt); err != nil {
+			return nil, err
+		}
+		if outage.ResolvedAt, err = parseNullTimestamp(resolvedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(area, &outage.Area); err != nil {
+			return nil, fmt.Errorf("error parsing area of outage %d: %w", outage.ID, err)
+		}
+		outage.Devices = []structs.AreaOutageDevice{}
+		byID[outage.ID] = len(outages)
+		outages = append(outages, outage)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(outages) == 0 {
+		return outages, nil
+	}
+
+	// Step 2: The devices of the outages with their current status
+	outageIDs := make([]int64, 0, len(outages))
+	for _, outage := range outages {
+		outageIDs = append(outageIDs, outage.ID)
+	}
+	placeholders, args := int64Placeholders(outageIDs)
+	deviceRows, err := DB.Query(`
+		SELECT aod.outage_id, ed.id, ed.name, ed.status, ST_Y(ed.coordinates) AS latitude, ST_X(ed.coordinates) AS longitude
+		FROM area_outage_devices aod
+		JOIN edge_devices ed ON ed.id = aod.device_id
+		WHERE aod.outage_id IN (`+placeholders+`)
+		ORDER BY aod.outage_id, ed.id
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving area outage devices: %v", err)
+		return nil, err
+	}
+	defer deviceRows.Close()
+
+	for deviceRows.Next() {
+		var outageID int64
+		var device structs.AreaOutageDevice
+		if err := deviceRows.Scan(&outageID, &device.DeviceID, &device.Name, &device.Status, &device.Latitude, &device.Longitude); err != nil {
+			return nil, fmt.Errorf("error scanning area outage device: %w", err)
+		}
+		outage := &outages[byID[outageID]]
+		outage.Devices = append(outage.Devices, device)
+		outage.DeviceCount++
+		if device.Status == "offline" {
+			outage.OfflineCount++
+		}
+	}
+
+	return outages, deviceRows.Err()
+}
+
+// InsertAreaOutage stores a new outage with its devices and records it in the audit log
+var InsertAreaOutage = func(outage structs.AreaOutage, deviceIDs []int64) (int64, error) {
+	area, err := json.Marshal(outage.Area)
+	if err != nil {
+		return 0, fmt.Errorf("error encoding outage area: %w", err)
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("INSERT INTO area_outages (started_at, detected_at, area) VALUES (?, ?, ?)",
+		outage.StartedAt.UTC(), outage.DetectedAt.UTC(), area)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting area outage: %w", err)
+	}
+	outageID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	if err := insertAreaOutageDevices(tx, outageID, deviceIDs); err != nil {
+		return 0, err
+	}
+	details := fmt.Sprintf("area outage %d detected with %d offline devices", outageID, len(deviceIDs))
+	if err := insertAuditLog(tx, nil, nil, "area_outage_detected", details); err != nil {
+		return 0, err
+	}
+
+	return outageID, tx.Commit()
+}
+
+// ExtendAreaOutage adds devices to an active outage and replaces its area
+var ExtendAreaOutage = func(outageID int64, area structs.Geometry, deviceIDs []int64) error {
+	encoded, err := json.Marshal(area)
+	if err != nil {
+		return fmt.Errorf("error encoding outage area: %w", err)
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE area_outages SET area = ? WHERE id = ?", encoded, outageID); err != nil {
+		return fmt.Errorf("error updating area of outage %d: %w", outageID, err)
+	}
+	if err := insertAreaOutageDevices(tx, outageID, deviceIDs); err != nil {
+		return err
+	}
+	details := fmt.Sprintf("area outage %d extended with %d offline devices", outageID, len(deviceIDs))
+	if err := insertAuditLog(tx, nil, nil, "area_outage_extended", details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ResolveAreaOutage marks an outage as resolved
+var ResolveAreaOutage = func(outageID int64, now time.Time) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE area_outages SET resolved_at = ? WHERE id = ? AND resolved_at IS NULL", now.UTC(), outageID); err != nil {
+		return fmt.Errorf("error resolving area outage %d: %w", outageID, err)
+	}
+	if err := insertAuditLog(tx, nil, nil, "area_outage_resolved", fmt.Sprintf("area outage %d resolved", outageID)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertAreaOutageDevices links devices to an outage, devices that are already linked are skipped
+func insertAreaOutageDevices(tx *sql.Tx, outageID int64, deviceIDs []int64) error {
+	for _, deviceID := range deviceIDs {
+		if _, err := tx.Exec("INSERT IGNORE INTO area_outage_devices (outage_id, device_id) VALUES (?, ?)", outageID, deviceID); err != nil {
+			return fmt.Errorf("error linking device %d to area outage %d: %w", deviceID, outageID, err)
+		}
+	}
+	return nil
+}
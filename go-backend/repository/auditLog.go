@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+)
+
+// execer is implemented by both *sql.DB and *sql.Tx, so audit entries can be written inside a transaction
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertAuditLog writes an audit entry using the given connection or transaction
+func insertAuditLog(db execer, meberID, deviceID *int64, action, details string) error {
+	_, err := db.Exec("INSERT INTO audit_log (meber_id, device_id, action, details) VALUES (?, ?, ?, ?)",
+		meberID, deviceID, action, details)
+	if err != nil {
+		return fmt.Errorf("error writing audit log entry %s: %w", action, err)
+	}
+	return nil
+}
+
+// InsertAuditLog writes an audit entry, meberID and deviceID are nil when not applicable
+var InsertAuditLog = func(meberID, deviceID *int64, action, details string) error {
+	return insertAuditLog(DB, meberID, deviceID, action, details)
+}
+
+// FetchAuditLogForDevice retrieves the audit trail of a device, newest first
+func FetchAuditLogForDevice(deviceID int64, limit int) ([]structs.AuditLogEntry, error) {
+	query := `
+		SELECT id, meber_id, device_id, action, details, timestamp
+		FROM audit_log
+		WHERE device_id = ?
+		ORDER BY timestamp DESC, id DESC
+		LIMIT ?
+	`
+
+	rows, err := DB.Query(query, deviceID, limit)
+	if err != nil {
+		log.Printf("Error retrieving audit log for device %d: %v", deviceID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []structs.AuditLogEntry
+	for rows.Next() {
+		var entry structs.AuditLogEntry
+		var meberID, entryDeviceID sql.NullInt64
+		var details sql.NullString
+		var timestampRaw string
+		if err := rows.Scan(&entry.ID, &meberID, &entryDeviceID, &entry.Action, &details, &timestampRaw); err != nil {
+			return nil, fmt.Errorf("error scanning audit log entry: %w", err)
+		}
+		if meberID.Valid {
+			entry.MeberID = &meberID.Int64
+		}
+		if entryDeviceID.Valid {
+			entry.DeviceID = &entryDeviceID.Int64
+		}
+		entry.Details = details.String
+		entry.Timestamp, err = parseTimestamp(timestampRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing audit log timestamp: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// GetTagsByNames retrieves the tags with the given names
+var GetTagsByNames = func(names []string) ([]structs.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	placeholders, args := stringPlaceholders(names)
+	query := fmt.Sprintf("SELECT id, name, type, is_editable, owner_id FROM tags WHERE name IN (%s)", placeholders)
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving tags by name: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []structs.Tag
+	for rows.Next() {
+		var tag structs.Tag
+		var ownerID sql.NullInt64
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.Type, &tag.IsEditable, &ownerID); err != nil {
+			return nil, fmt.Errorf("error scanning tag: %w", err)
+		}
+		if ownerID.Valid {
+			tag.OwnerID = &ownerID.Int64
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// ApplyBulkDeviceChunk applies tag, status and attribute changes to a chunk of devices in a single transaction. Status
+// changes are stored in the status history, flagged for the devices in maintenance.
+var ApplyBulkDeviceChunk = func(deviceIDs []int64, addTagIDs, removeTagIDs []int64, status string, attributes *structs.DeviceAttributes, inMaintenance map[int64]bool, now time.Time) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	devicePlaceholders, deviceArgs := int64Placeholders(deviceIDs)
+
+	if len(addTagIDs) > 0 {
+		// Skip the combinations that are already tagged so running the same operation twice is harmless
+		tagPlaceholders, tagArgs := int64Placeholders(addTagIDs)
+		query := fmt.Sprintf(`
+			INSERT INTO device_tags (tag_id, device_id)
+			SELECT tg.id, ed.id
+			FROM edge_devices ed
+			JOIN tags tg ON tg.id IN (%s)
+			WHERE ed.id IN (%s)
+			AND NOT EXISTS (
+				SELECT 1 FROM device_tags existing
+				WHERE existing.device_id = ed.id AND existing.tag_id = tg.id
+			)
+		`, tagPlaceholders, devicePlaceholders)
+		if _, err := tx.Exec(query, append(tagArgs, deviceArgs...)...); err != nil {
+			return fmt.Errorf("error adding tags: %w", err)
+		}
+	}
+
+	if len(removeTagIDs) > 0 {
+		tagPlaceholders, tagArgs := int64Placeholders(removeTagIDs)
+		query := fmt.Sprintf("DELETE FROM device_tags WHERE device_id IN (%s) AND tag_id IN (%s)", devicePlaceholders, tagPlaceholders)
+		if _, err := tx.Exec(query, append(append([]interface{}{}, deviceArgs...), tagArgs...)...); err != nil {
+			return fmt.Errorf("error removing tags: %w", err)
+		}
+	}
+
+	var assignments []string
+	var updateArgs []interface{}
+	if status != "" {
+		if err := insertStatusHistory(tx, deviceIDs, status, inMaintenance, now); err != nil {
+			return err
+		}
+		assignments = append(assignments, "status = ?")
+		updateArgs = append(updateArgs, status)
+	}
+	if attributes != nil && attributes.ConnectionType != nil {
+		assignments = append(assignments, "connection_type = ?")
+		updateArgs = append(updateArgs, *attributes.ConnectionType)
+	}
+	if attributes != nil && attributes.IPAddress != nil {
+		assignments = append(assignments, "ip_address = ?")
+		updateArgs = append(updateArgs, *attributes.IPAddress)
+	}
+	if len(assignments) > 0 {
+		query := fmt.Sprintf("UPDATE edge_devices SET %s WHERE id IN (%s)", strings.Join(assignments, ", "), devicePlaceholders)
+		if _, err := tx.Exec(query, append(updateArgs, deviceArgs...)...); err != nil {
+			return fmt.Errorf("error updating devices: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// InsertConnectivitySamples stores the connection quality samples reported by a device
+var InsertConnectivitySamples = func(deviceID int64, samples []structs.ConnectivitySample) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO connectivity_samples (device_id, signal_dbm, latency_ms, packet_loss_percent, timestamp)
+		VALUES (?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("error preparing connectivity sample insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, sample := range samples {
+		if _, err := stmt.Exec(deviceID, sample.SignalDBM, sample.LatencyMS, sample.PacketLossPercent, sample.Timestamp.UTC()); err != nil {
+			log.Printf("Error inserting connectivity sample for device %d: %v", deviceID, err)
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// FetchConnectivityBuckets returns the averaged connection quality per bucket for a device
+var FetchConnectivityBuckets = func(deviceID int64, start, end time.Time, bucketSeconds int64) ([]structs.ConnectivityBucket, error) {
+	// Buckets are counted from the start of the range, like the performance metric buckets
+	rows, err := DB.Query(`
+		SELECT
+			FLOOR(TIMESTAMPDIFF(SECOND, ?, timestamp) / ?) AS bucket,
+			AVG(signal_dbm),
+			AVG(latency_ms),
+			MAX(latency_ms),
+			AVG(packet_loss_percent),
+			COUNT(*)
+		FROM connectivity_samples
+		WHERE device_id = ? AND timestamp >= ? AND timestamp < ?
+		GROUP BY bucket
+		ORDER BY bucket
+	`, start.UTC(), bucketSeconds, deviceID, start.UTC(), end.UTC())
+	if err != nil {
+		log.Printf("Error retrieving connectivity history for device %d: %v", deviceID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []structs.ConnectivityBucket
+	for rows.Next() {
+		var bucketIndex int64
+		var signal sql.NullFloat64
+		var bucket structs.ConnectivityBucket
+		if err := rows.Scan(&bucketIndex, &signal, &bucket.AvgLatencyMS, &bucket.MaxLatencyMS, &bucket.AvgPacketLossPercent, &bucket.Count); err != nil {
+			return nil, fmt.Errorf("error scanning connectivity bucket: %w", err)
+		}
+		if signal.Valid {
+			bucket.AvgSignalDBM = &signal.Float64
+		}
+		bucket.BucketStart = start.Add(time.Duration(bucketIndex*bucketSeconds) * time.Second)
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+// GetConnectivitySummaries averages the connection quality of the devices between start and end, keyed by device
+// ID. Devices without samples are included with a zero sample count.
+var GetConnectivitySummaries = func(deviceIDs []int64, start, end time.Time) (map[int64]structs.DeviceConnectivity, error) {
+	summaries := make(map[int64]structs.DeviceConnectivity)
+	if len(deviceIDs) == 0 {
+		return summaries, nil
+	}
+
+	// A device has a single municipality, MIN only guards against duplicate location tags
+	placeholders, idArgs := int64Placeholders(deviceIDs)
+	args := append([]interface{}{}, idArgs...)
+	args = append(args, start.UTC(), end.UTC())
+	args = append(args, idArgs...)
+	rows, err := DB.Query(`
+		SELECT ed.id, ed.name, ed.connection_type, COALESCE(loc.name, ''),
+			COALESCE(cs.sample_count, 0), cs.avg_signal, COALESCE(cs.avg_latency, 0), COALESCE(cs.avg_loss, 0)
+		FROM edge_devices ed
+		LEFT JOIN (
+			SELECT dt.device_id, MIN(tg.name) AS name
+			FROM device_tags dt
+			JOIN tags tg ON dt.tag_id = tg.id
+			WHERE tg.type = 'location'
+			GROUP BY dt.device_id
+		) loc ON loc.device_id = ed.id
+		LEFT JOIN (
+			SELECT device_id, COUNT(*) AS sample_count, AVG(signal_dbm) AS avg_signal,
+				AVG(latency_ms) AS avg_latency, AVG(packet_loss_percent) AS avg_loss
+			FROM connectivity_samples
+			WHERE device_id IN (`+placeholders+`) AND timestamp >= ? AND timestamp < ?
+			GROUP BY device_id
+		) cs ON cs.device_id = ed.id
+		WHERE ed.id IN (`+placeholders+`)
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving connectivity summaries: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var summary structs.DeviceConnectivity
+		var signal sql.NullFloat64
+		if err := rows.Scan(&summary.DeviceID, &summary.Name, &summary.ConnectionType, &summary.Municipality,
+			&summary.SampleCount, &signal, &summary.AvgLatencyMS, &summary.AvgPacketLossPercent); err != nil {
+			return nil, fmt.Errorf("error scanning connectivity summary: %w", err)
+		}
+		if signal.Valid {
+			summary.AvgSignalDBM = &signal.Float64
+		}
+		summaries[summary.DeviceID] = summary
+	}
+
+	return summaries, rows.Err()
+}
+
+// CountStatusChanges counts per device how often it went offline or came back between start and end, changes
+// during maintenance are left out
+var CountStatusChanges = func(deviceIDs []int64, start, end time.Time) (map[int64]int, error) {
+	counts := make(map[int64]int)
+	if len(deviceIDs) == 0 {
+		return counts, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	args = append(args, start.UTC(), end.UTC())
+	rows, err := DB.Query(`
+		SELECT device_id, COUNT(*)
+		FROM device_status_history
+		WHERE device_id IN (`+placeholders+`) AND timestamp >= ? AND timestamp < ?
+			AND in_maintenance = FALSE AND (status = 'offline' OR previous_status = 'offline')
+		GROUP BY device_id
+	`, args...)
+	if err != nil {
+		log.Printf("Error counting status changes: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var count int
+		if err := rows.Scan(&deviceID, &count); err != nil {
+			return nil, fmt.Errorf("error scanning status change count: %w", err)
+		}
+		counts[deviceID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// DeleteConnectivitySamples removes connectivity samples older than cutoff
+func DeleteConnectivitySamples(cutoff time.Time) (int64, error) {
+	result, err := DB.Exec("DELETE FROM connectivity_samples WHERE timestamp < ?", cutoff.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("error deleting connectivity samples: %w", err)
+	}
+	return result.RowsAffected()
+}
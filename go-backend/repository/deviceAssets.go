@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// dateLayout is the format MariaDB returns DATE columns in
+const dateLayout = "2006-01-02"
+
+const deviceAssetColumns = `id, name, COALESCE(serial_number, ''), COALESCE(vendor, ''), COALESCE(firmware_version, ''),
+	installed_on, warranty_end, lifecycle_stage, lifecycle_stage_changed_at`
+
+// scanDeviceAsset scans the deviceAssetColumns of an edge_devices row
+func scanDeviceAsset(scanner interface{ Scan(...interface{}) error }) (structs.DeviceAsset, error) {
+	var asset structs.DeviceAsset
+	var installedOn, warrantyEnd sql.NullString
+	var stageChangedAt string
+	if err := scanner.Scan(&asset.DeviceID, &asset.Name, &asset.SerialNumber, &asset.Vendor, &asset.FirmwareVersion,
+		&installedOn, &warrantyEnd, &asset.LifecycleStage, &stageChangedAt); err != nil {
+		return structs.DeviceAsset{}, err
+	}
+
+	for _, date := range []struct {
+		raw    sql.NullString
+		target **time.Time
+	}{{installedOn, &asset.InstalledOn}, {warrantyEnd, &asset.WarrantyEnd}} {
+		if !date.raw.Valid {
+			continue
+		}
+		parsed, err := time.Parse(dateLayout, date.raw.String)
+		if err != nil {
+			return structs.DeviceAsset{}, err
+		}
+		*date.target = &parsed
+	}
+
+	var err error
+	asset.StageChangedAt, err = parseTimestamp(stageChangedAt)
+	return asset, err
+}
+
+// queryDeviceAssets retrieves the assets among deviceIDs that match the condition, ordered by ordering
+func queryDeviceAssets(deviceIDs []int64, condition string, ordering string, args ...interface{}) ([]structs.DeviceAsset, error) {
+	if len(deviceIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders, idArgs := int64Placeholders(deviceIDs)
+	query := "SELECT " + deviceAssetColumns + " FROM edge_devices WHERE id IN (" + placeholders + ")"
+	if condition != "" {
+		query += " AND " + condition
+	}
+	query += " ORDER BY " + ordering
+
+	rows, err := DB.Query(query, append(idArgs, args...)...)
+	if err != nil {
+		log.Printf("Error retrieving device assets: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assets []structs.DeviceAsset
+	for rows.Next() {
+		asset, err := scanDeviceAsset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning device asset: %w", err)
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets, rows.Err()
+}
+
+// GetDeviceAssets retrieves the asset information of the given devices
+var GetDeviceAssets = func(deviceIDs []int64) ([]structs.DeviceAsset, error) {
+	return queryDeviceAssets(deviceIDs, "", "id")
+}
+
+// GetAssetsWarrantyEndingBetween retrieves the devices among deviceIDs still in use whose warranty ends between
+// from and until, soonest first
+var GetAssetsWarrantyEndingBetween = func(deviceIDs []int64, from, until time.Time) ([]structs.DeviceAsset, error) {
+	return queryDeviceAssets(deviceIDs, "lifecycle_stage <> 'retired' AND warranty_end BETWEEN ? AND ?", "warranty_end, id",
+		from.Format(dateLayout), until.Format(dateLayout))
+}
+
+// GetAssetsInStageSince retrieves the devices among deviceIDs that entered one of the stages before the given
+// moment and are still in it, longest first
+var GetAssetsInStageSince = func(deviceIDs []int64, stages []string, before time.Time) ([]structs.DeviceAsset, error) {
+	if len(stages) == 0 {
+		return nil, nil
+	}
+	placeholders, args := stringPlaceholders(stages)
+	args = append(args, before.UTC())
+	return queryDeviceAssets(deviceIDs, "lifecycle_stage IN ("+placeholders+") AND lifecycle_stage_changed_at < ?",
+		"lifecycle_stage_changed_at, id", args...)
+}
+
+// GetDeviceIDBySerialNumber returns the device with the serial number, 0 when there is none
+var GetDeviceIDBySerialNumber = func(serialNumber string) (int64, error) {
+	var deviceID int64
+	err := DB.QueryRow("SELECT id FROM edge_devices WHERE serial_number = ?", serialNumber).Scan(&deviceID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error retrieving device by serial number: %w", err)
+	}
+	return deviceID, nil
+}
+
+// UpdateDeviceAsset writes the fields of the update that are set, empty values are stored as NULL
+var UpdateDeviceAsset = func(update structs.DeviceAssetUpdate, meberID int64) error {
+	fields := []struct {
+		column string
+		value  *string
+	}{
+		{"serial_number", update.SerialNumber},
+		{"vendor", update.Vendor},
+		{"firmware_version", update.FirmwareVersion},
+		{"installed_on", update.InstalledOn},
+		{"warranty_end", update.WarrantyEnd},
+	}
+
+	var assignments, changed []string
+	var args []interface{}
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		assignments = append(assignments, field.column+" = ?")
+		args = append(args, nullableString(*field.value))
+		changed = append(changed, fmt.Sprintf("%s=%q", field.column, *field.value))
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	args = append(args, update.DeviceID)
+	if _, err := tx.Exec("UPDATE edge_devices SET "+strings.Join(assignments, ", ")+" WHERE id = ?", args...); err != nil {
+		return fmt.Errorf("error updating asset information of device %d: %w", update.DeviceID, err)
+	}
+	deviceID := update.DeviceID
+	if err := insertAuditLog(tx, &meberID, &deviceID, "asset_updated", strings.Join(changed, ", ")); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// TransitionLifecycleStage moves a device from one lifecycle stage to the next. It returns false when the device
+// is no longer in the from stage, so concurrent transitions can't skip a validation.
+var TransitionLifecycleStage = func(deviceID int64, from, to string, meberID int64, reason string, now time.Time) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// A device that is installed without an installation date gets today
+	result, err := tx.Exec(`
+		UPDATE edge_devices
+		SET lifecycle_stage = ?, lifecycle_stage_changed_at = ?,
+			installed_on = IF(? = 'installed', COALESCE(installed_on, ?), installed_on)
+		WHERE id = ? AND lifecycle_stage = ?
+	`, to, now.UTC(), to, now.UTC().Format(dateLayout), deviceID, from)
+	if err != nil {
+		return false, fmt.Errorf("error changing lifecycle stage of device %d: %w", deviceID, err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+
+	details := fmt.Sprintf("%s -> %s", from, to)
+	if reason != "" {
+		details += ": " + reason
+	}
+	if err := insertAuditLog(tx, &meberID, &deviceID, "lifecycle_transition", details); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+const deviceCertificateColumns = `serial, device_id, fingerprint, not_before, not_after, issued_at, revoked_at,
+	COALESCE(revocation_reason, '')`
+
+// scanDeviceCertificate scans a device_certificates row selected with deviceCertificateColumns
+func scanDeviceCertificate(scanner interface{ Scan(...interface{}) error }) (structs.DeviceCertificate, error) {
+	var certificate structs.DeviceCertificate
+	var notBefore, notAfter, issuedAt string
+	var revokedAt sql.NullString
+
+	err := scanner.Scan(&certificate.Serial, &certificate.DeviceID, &certificate.Fingerprint, &notBefore, &notAfter,
+		&issuedAt, &revokedAt, &certificate.RevocationReason)
+	if err != nil {
+		return structs.DeviceCertificate{}, err
+	}
+	if certificate.NotBefore, err = parseTimestamp(notBefore); err != nil {
+		return structs.DeviceCertificate{}, err
+	}
+	if certificate.NotAfter, err = parseTimestamp(notAfter); err != nil {
+		return structs.DeviceCertificate{}, err
+	}
+	if certificate.IssuedAt, err = parseTimestamp(issuedAt); err != nil {
+		return structs.DeviceCertificate{}, err
+	}
+	if certificate.RevokedAt, err = parseNullTimestamp(revokedAt); err != nil {
+		return structs.DeviceCertificate{}, err
+	}
+	return certificate, nil
+}
+
+// GetCertificateAuthority retrieves the PEM encoded CA certificate and key, empty strings when none was generated yet
+var GetCertificateAuthority = func() (string, string, error) {
+	var certificatePEM, privateKeyPEM string
+	err := DB.QueryRow("SELECT certificate_pem, private_key_pem FROM certificate_authority WHERE id = 1").
+		Scan(&certificatePEM, &privateKeyPEM)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("error retrieving certificate authority: %w", err)
+	}
+	return certificatePEM, privateKeyPEM, nil
+}
+
+// InsertCertificateAuthority stores the CA unless another request stored one first
+var InsertCertificateAuthority = func(certificatePEM, privateKeyPEM string) error {
+	_, err := DB.Exec("INSERT IGNORE INTO certificate_authority (id, certificate_pem, private_key_pem) VALUES (1, ?, ?)",
+		certificatePEM, privateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("error storing certificate authority: %w", err)
+	}
+	return nil
+}
+
+// InsertEnrollmentToken stores the hash of a new enrollment token and audits who created it
+var InsertEnrollmentToken = func(tokenHash string, deviceID, meberID int64, expiresAt time.Time) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("INSERT INTO device_enrollment_tokens (token_hash, device_id, created_by, expires_at) VALUES (?, ?, ?, ?)",
+		tokenHash, deviceID, meberID, expiresAt.UTC())
+	if err != nil {
+		return fmt.Errorf("error storing enrollment token: %w", err)
+	}
+
+	details := fmt.Sprintf("enrollment token valid until %s", expiresAt.UTC().Format(time.RFC3339))
+	if err := insertAuditLog(tx, &meberID, &deviceID, "enrollment_token_created", details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// EnrollDeviceCertificate redeems an enrollment token and stores the certificate issue creates for the device the
+// token belongs to, in one transaction so a token can't be used twice. It returns nil when the token is unknown,
+// expired or already used, or when the device was decommissioned.
+var EnrollDeviceCertificate = func(tokenHash string, now time.Time, issue func(deviceID int64) (structs.DeviceCertificate, error)) (*structs.DeviceCertificate, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var deviceID int64
+	err = tx.QueryRow(`
+		SELECT et.device_id
+		FROM device_enrollment_tokens et
+		JOIN edge_devices ed ON et.device_id = ed.id
+		WHERE et.token_hash = ? AND et.used_at IS NULL AND et.expires_at > ? AND ed.decommissioned_at IS NULL
+		FOR UPDATE
+	`, tokenHash, now.UTC()).Scan(&deviceID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving enrollment token: %w", err)
+	}
+
+	if _, err := tx.Exec("UPDATE device_enrollment_tokens SET used_at = ? WHERE token_hash = ?", now.UTC(), tokenHash); err != nil {
+		return nil, fmt.Errorf("error redeeming enrollment token: %w", err)
+	}
+
+	certificate, err := issue(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := insertDeviceCertificate(tx, certificate); err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("enrolled with certificate %s", certificate.Serial)
+	if err := insertAuditLog(tx, nil, &deviceID, "device_enrolled", details); err != nil {
+		return nil, err
+	}
+
+	return &certificate, tx.Commit()
+}
+
+// RenewDeviceCertificate stores a new certificate for a device and revokes the one it replaces as superseded
+var RenewDeviceCertificate = func(certificate structs.DeviceCertificate, replacedSerial string, now time.Time) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertDeviceCertificate(tx, certificate); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+		UPDATE device_certificates SET revoked_at = ?, revocation_reason = 'superseded'
+		WHERE serial = ? AND device_id = ? AND revoked_at IS NULL
+	`, now.UTC(), replacedSerial, certificate.DeviceID)
+	if err != nil {
+		return fmt.Errorf("error revoking certificate %s: %w", replacedSerial, err)
+	}
+
+	details := fmt.Sprintf("renewed certificate %s as %s", replacedSerial, certificate.Serial)
+	if err := insertAuditLog(tx, nil, &certificate.DeviceID, "certificate_renewed", details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertDeviceCertificate stores an issued certificate
+func insertDeviceCertificate(tx *sql.Tx, certificate structs.DeviceCertificate) error {
+	_, err := tx.Exec(`
+		INSERT INTO device_certificates (serial, device_id, fingerprint, not_before, not_after, issued_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, certificate.Serial, certificate.DeviceID, certificate.Fingerprint, certificate.NotBefore.UTC(),
+		certificate.NotAfter.UTC(), certificate.IssuedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("error storing certificate %s: %w", certificate.Serial, err)
+	}
+	return nil
+}
+
+// GetDeviceCertificate retrieves a certificate by serial, nil when it was not issued by this backend
+var GetDeviceCertificate = func(serial string) (*structs.DeviceCertificate, error) {
+	row := DB.QueryRow("SELECT "+deviceCertificateColumns+" FROM device_certificates WHERE serial = ?", serial)
+	certificate, err := scanDeviceCertificate(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificate %s: %w", serial, err)
+	}
+	return &certificate, nil
+}
+
+// GetDeviceCertificates retrieves the certificates issued to a device, newest first
+var GetDeviceCertificates = func(deviceID int64) ([]structs.DeviceCertificate, error) {
+	return queryDeviceCertificates("SELECT "+deviceCertificateColumns+" FROM device_certificates WHERE device_id = ? ORDER BY issued_at DESC, serial", deviceID)
+}
+
+// GetRevokedCertificates retrieves the revoked certificates that have not expired yet, the contents of the CRL
+var GetRevokedCertificates = func(now time.Time) ([]structs.DeviceCertificate, error) {
+	return queryDeviceCertificates("SELECT "+deviceCertificateColumns+" FROM device_certificates WHERE revoked_at IS NOT NULL AND not_after > ? ORDER BY revoked_at", now.UTC())
+}
+
+// queryDeviceCertificates runs a query selecting deviceCertificateColumns
+func queryDeviceCertificates(query string, args ...interface{}) ([]structs.DeviceCertificate, error) {
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving device certificates: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var certificates []structs.DeviceCertificate
+	for rows.Next() {
+		certificate, err := scanDeviceCertificate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning device certificate: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, rows.Err()
+}
+
+// RevokeDeviceCertificates revokes the certificate with the given serial of a device, or all of its valid
+// certificates when serial is empty. It returns the serials that were revoked.
+var RevokeDeviceCertificates = func(deviceID int64, serial, reason string, meberID *int64, now time.Time) ([]string, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	serials, err := revokeDeviceCertificates(tx, deviceID, serial, reason, meberID, now)
+	if err != nil {
+		return nil, err
+	}
+	return serials, tx.Commit()
+}
+
+// revokeDeviceCertificates revokes and audits certificates of a device inside a transaction
+func revokeDeviceCertificates(tx *sql.Tx, deviceID int64, serial, reason string, meberID *int64, now time.Time) ([]string, error) {
+	query := "SELECT serial FROM device_certificates WHERE device_id = ? AND revoked_at IS NULL AND not_after > ?"
+	args := []interface{}{deviceID, now.UTC()}
+	if serial != "" {
+		query += " AND serial = ?"
+		args = append(args, serial)
+	}
+	rows, err := tx.Query(query+" FOR UPDATE", args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificates to revoke: %w", err)
+	}
+	var serials []string
+	for rows.Next() {
+		var revoked string
+		if err := rows.Scan(&revoked); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error scanning certificate serial: %w", err)
+		}
+		serials = append(serials, revoked)
+	}
+	rows.Close()
+
+	for _, revoked := range serials {
+		_, err := tx.Exec("UPDATE device_certificates SET revoked_at = ?, revocation_reason = ? WHERE serial = ?",
+			now.UTC(), reason, revoked)
+		if err != nil {
+			return nil, fmt.Errorf("error revoking certificate %s: %w", revoked, err)
+		}
+		details := fmt.Sprintf("revoked certificate %s: %s", revoked, reason)
+		if err := insertAuditLog(tx, meberID, &deviceID, "certificate_revoked", details); err != nil {
+			return nil, err
+		}
+	}
+
+	return serials, nil
+}
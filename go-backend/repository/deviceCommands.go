@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+const deviceCommandColumns = `id, device_id, type, app_instance_id, status, attempts, max_attempts, timeout_seconds,
+	result, created_by, created_at, sent_at, completed_at`
+
+// scanDeviceCommand scans a device_commands row selected with deviceCommandColumns
+func scanDeviceCommand(scanner interface{ Scan(...interface{}) error }) (structs.DeviceCommand, error) {
+	var command structs.DeviceCommand
+	var appInstanceID sql.NullInt64
+	var result, createdAt, sentAt, completedAt sql.NullString
+
+	err := scanner.Scan(&command.ID, &command.DeviceID, &command.Type, &appInstanceID, &command.Status,
+		&command.Attempts, &command.MaxAttempts, &command.TimeoutSeconds, &result, &command.CreatedBy,
+		&createdAt, &sentAt, &completedAt)
+	if err != nil {
+		return structs.DeviceCommand{}, err
+	}
+
+	if appInstanceID.Valid {
+		command.AppInstanceID = &appInstanceID.Int64
+	}
+	if result.Valid {
+		command.Result = &result.String
+	}
+	if created, err := parseNullTimestamp(createdAt); err != nil {
+		return structs.DeviceCommand{}, err
+	} else if created != nil {
+		command.CreatedAt = *created
+	}
+	if command.SentAt, err = parseNullTimestamp(sentAt); err != nil {
+		return structs.DeviceCommand{}, err
+	}
+	if command.CompletedAt, err = parseNullTimestamp(completedAt); err != nil {
+		return structs.DeviceCommand{}, err
+	}
+
+	return command, nil
+}
+
+// queryDeviceCommands runs a select on device_commands and scans all rows
+func queryDeviceCommands(queryer interface {
+	Query(string, ...interface{}) (*sql.Rows, error)
+}, query string, args ...interface{}) ([]structs.DeviceCommand, error) {
+	rows, err := queryer.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving device commands: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commands []structs.DeviceCommand
+	for rows.Next() {
+		command, err := scanDeviceCommand(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning device command: %w", err)
+		}
+		commands = append(commands, command)
+	}
+
+	return commands, rows.Err()
+}
+
+// InsertDeviceCommands queues the same command for every device and audits it, all in one transaction
+var InsertDeviceCommands = func(command structs.DeviceCommand, deviceIDs []int64) ([]int64, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO device_commands (device_id, type, app_instance_id, status, max_attempts, timeout_seconds, created_by, created_at)
+		VALUES (?, ?, ?, 'queued', ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing command insert: %w", err)
+	}
+	defer stmt.Close()
+
+	commandIDs := make([]int64, 0, len(deviceIDs))
+	for _, deviceID := range deviceIDs {
+		result, err := stmt.Exec(deviceID, command.Type, command.AppInstanceID, command.MaxAttempts,
+			command.TimeoutSeconds, command.CreatedBy, command.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error queueing command for device %d: %w", deviceID, err)
+		}
+		commandID, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		commandIDs = append(commandIDs, commandID)
+
+		device := deviceID
+		details := fmt.Sprintf("queued %s command %d", command.Type, commandID)
+		if err := insertAuditLog(tx, &command.CreatedBy, &device, "command_queued", details); err != nil {
+			return nil, err
+		}
+	}
+
+	return commandIDs, tx.Commit()
+}
+
+// GetDeviceCommandByID retrieves a single command
+var GetDeviceCommandByID = func(commandID int64) (*structs.DeviceCommand, error) {
+	row := DB.QueryRow("SELECT "+deviceCommandColumns+" FROM device_commands WHERE id = ?", commandID)
+	command, err := scanDeviceCommand(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving device command %d: %w", commandID, err)
+	}
+	return &command, nil
+}
+
+// GetDeviceCommands retrieves the commands of the given devices, newest first
+func GetDeviceCommands(deviceIDs []int64, statuses []string, limit int) ([]structs.DeviceCommand, error) {
+	if len(deviceIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	query := "SELECT " + deviceCommandColumns + " FROM device_commands WHERE device_id IN (" + placeholders + ")"
+	if len(statuses) > 0 {
+		statusPlaceholders, statusArgs := stringPlaceholders(statuses)
+		query += " AND status IN (" + statusPlaceholders + ")"
+		args = append(args, statusArgs...)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	return queryDeviceCommands(DB, query, args...)
+}
+
+// ClaimQueuedDeviceCommands hands the queued commands of a device out and marks them as sent
+func ClaimQueuedDeviceCommands(deviceID int64, now time.Time) ([]structs.DeviceCommand, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the rows so two concurrent polls of the same device can't both receive a command
+	commands, err := queryDeviceCommands(tx,
+		"SELECT "+deviceCommandColumns+" FROM device_commands WHERE device_id = ? AND status = 'queued' ORDER BY id FOR UPDATE",
+		deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(commands) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, len(commands))
+	for i := range commands {
+		ids[i] = commands[i].ID
+		commands[i].Status = "sent"
+		commands[i].Attempts++
+		commands[i].SentAt = &now
+	}
+
+	placeholders, idArgs := int64Placeholders(ids)
+	query := fmt.Sprintf("UPDATE device_commands SET status = 'sent', attempts = attempts + 1, sent_at = ? WHERE id IN (%s)", placeholders)
+	if _, err := tx.Exec(query, append([]interface{}{now}, idArgs...)...); err != nil {
+		return nil, fmt.Errorf("error marking commands as sent: %w", err)
+	}
+
+	return commands, tx.Commit()
+}
+
+// CompleteDeviceCommand stores the result a device reported and audits it. Only a command that is still sent is
+// completed, it returns false when the command was cancelled or expired in the meantime.
+var CompleteDeviceCommand = func(commandID, deviceID int64, status, result string, now time.Time) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Exec(`
+		UPDATE device_commands SET status = ?, result = ?, completed_at = ?
+		WHERE id = ? AND device_id = ? AND status = 'sent'
+	`, status, result, now, commandID, deviceID)
+	if err != nil {
+		return false, fmt.Errorf("error completing command %d: %w", commandID, err)
+	}
+	affected, err := updated.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+
+	details := fmt.Sprintf("command %d reported %s", commandID, status)
+	if err := insertAuditLog(tx, nil, &deviceID, "command_"+status, details); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// CancelDeviceCommand cancels a command that has not completed yet and audits it
+func CancelDeviceCommand(commandID, deviceID, meberID int64, now time.Time) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE device_commands SET status = 'cancelled', completed_at = ?
+		WHERE id = ? AND status IN ('queued', 'sent')
+	`, now, commandID)
+	if err != nil {
+		return false, fmt.Errorf("error cancelling command %d: %w", commandID, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+
+	details := fmt.Sprintf("cancelled command %d", commandID)
+	if err := insertAuditLog(tx, &meberID, &deviceID, "command_cancelled", details); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// ExpireDeviceCommands requeues sent commands whose timeout passed, or marks them timed out after the last attempt
+func ExpireDeviceCommands(now time.Time) (requeued int64, timedOut int64, err error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	expired := "status = 'sent' AND TIMESTAMPADD(SECOND, timeout_seconds, sent_at) < ?"
+
+	// Audit the commands that run out of attempts before changing them, they won't be retried
+	rows, err := tx.Query("SELECT id, device_id FROM device_commands WHERE "+expired+" AND attempts >= max_attempts", now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error retrieving expired commands: %w", err)
+	}
+	var auditLines []string
+	var auditDevices []int64
+	for rows.Next() {
+		var commandID, deviceID int64
+		if err := rows.Scan(&commandID, &deviceID); err != nil {
+			rows.Close()
+			return 0, 0, fmt.Errorf("error scanning expired command: %w", err)
+		}
+		auditLines = append(auditLines, fmt.Sprintf("command %d timed out", commandID))
+		auditDevices = append(auditDevices, deviceID)
+	}
+	rows.Close()
+
+	for i := range auditLines {
+		if err := insertAuditLog(tx, nil, &auditDevices[i], "command_timed_out", auditLines[i]); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	result, err := tx.Exec("UPDATE device_commands SET status = 'timed_out', completed_at = ? WHERE "+expired+" AND attempts >= max_attempts", now, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error timing out commands: %w", err)
+	}
+	if timedOut, err = result.RowsAffected(); err != nil {
+		return 0, 0, err
+	}
+
+	result, err = tx.Exec("UPDATE device_commands SET status = 'queued' WHERE "+expired+" AND attempts < max_attempts", now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error requeueing commands: %w", err)
+	}
+	if requeued, err = result.RowsAffected(); err != nil {
+		return 0, 0, err
+	}
+
+	return requeued, timedOut, tx.Commit()
+}
+
+// GetApplicationInstanceDeviceID returns the device an application instance runs on
+var GetApplicationInstanceDeviceID = func(appInstanceID int64) (int64, error) {
+	var deviceID int64
+	err := DB.QueryRow("SELECT device_id FROM application_instances WHERE id = ?", appInstanceID).Scan(&deviceID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return deviceID, err
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+)
+
+// int64Placeholders builds the "?,?,?" list and matching arguments for an IN clause
+func int64Placeholders(values []int64) (string, []interface{}) {
+	placeholders := make([]string, len(values))
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		placeholders[i] = "?"
+		args[i] = value
+	}
+	return strings.Join(placeholders, ","), args
+}
+
+// stringPlaceholders builds the "?,?,?" list and matching arguments for an IN clause
+func stringPlaceholders(values []string) (string, []interface{}) {
+	placeholders := make([]string, len(values))
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		placeholders[i] = "?"
+		args[i] = value
+	}
+	return strings.Join(placeholders, ","), args
+}
+
+// buildDeviceFilterConditions turns a device filter into SQL conditions on the edge_devices alias "ed".
+// Tag, sensor and application conditions use subqueries so they don't interfere with the tg join used for RBAC.
+func buildDeviceFilterConditions(filter structs.DeviceFilter) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if len(filter.DeviceIDs) > 0 {
+		placeholders, idArgs := int64Placeholders(filter.DeviceIDs)
+		conditions = append(conditions, fmt.Sprintf("ed.id IN (%s)", placeholders))
+		args = append(args, idArgs...)
+	}
+	if len(filter.Statuses) > 0 {
+		placeholders, statusArgs := stringPlaceholders(filter.Statuses)
+		conditions = append(conditions, fmt.Sprintf("ed.status IN (%s)", placeholders))
+		args = append(args, statusArgs...)
+	}
+	if len(filter.ConnectionTypes) > 0 {
+		placeholders, typeArgs := stringPlaceholders(filter.ConnectionTypes)
+		conditions = append(conditions, fmt.Sprintf("ed.connection_type IN (%s)", placeholders))
+		args = append(args, typeArgs...)
+	}
+	if len(filter.Municipalities) > 0 {
+		placeholders, municipalityArgs := stringPlaceholders(filter.Municipalities)
+		conditions = append(conditions, fmt.Sprintf(`ed.id IN (
+			SELECT fdt.device_id FROM device_tags fdt
+			JOIN tags ftg ON fdt.tag_id = ftg.id
+			WHERE ftg.type = 'location' AND ftg.name IN (%s))`, placeholders))
+		args = append(args, municipalityArgs...)
+	}
+	if len(filter.Tags) > 0 {
+		placeholders, tagArgs := stringPlaceholders(filter.Tags)
+		conditions = append(conditions, fmt.Sprintf(`ed.id IN (
+			SELECT fdt.device_id FROM device_tags fdt
+			JOIN tags ftg ON fdt.tag_id = ftg.id
+			WHERE ftg.name IN (%s))`, placeholders))
+		args = append(args, tagArgs...)
+	}
+	if len(filter.Sensors) > 0 {
+		// The device needs every sensor, so count the distinct matches
+		placeholders, sensorArgs := stringPlaceholders(filter.Sensors)
+		conditions = append(conditions, fmt.Sprintf(`ed.id IN (
+			SELECT fds.device_id FROM device_sensors fds
+			JOIN sensors fs ON fds.sensor_id = fs.id
+			WHERE fs.name IN (%s)
+			GROUP BY fds.device_id
+			HAVING COUNT(DISTINCT fs.id) = ?)`, placeholders))
+		args = append(args, sensorArgs...)
+		args = append(args, len(filter.Sensors))
+	}
+	if len(filter.Applications) > 0 {
+		placeholders, appArgs := stringPlaceholders(filter.Applications)
+		conditions = append(conditions, fmt.Sprintf(`ed.id IN (
+			SELECT fai.device_id FROM application_instances fai
+			JOIN applications fa ON fai.app_id = fa.id
+			WHERE fa.name IN (%s))`, placeholders))
+		args = append(args, appArgs...)
+	}
+	if filter.NameContains != "" {
+		conditions = append(conditions, "ed.name LIKE ?")
+		args = append(args, "%"+filter.NameContains+"%")
+	}
+
+	return conditions, args
+}
+
+// GetDeviceIDsByFilter returns the IDs of the devices in use matching the filter that the meber has access to
+var GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+	baseQuery := `
+		SELECT DISTINCT ed.id
+		FROM edge_devices ed
+		LEFT JOIN device_tags dt ON ed.id = dt.device_id
+		LEFT JOIN tags tg ON dt.tag_id = tg.id
+	`
+
+	// Decommissioned devices are only kept for their history, they are no longer part of the fleet
+	conditions, args := buildDeviceFilterConditions(filter)
+	conditions = append([]string{"ed.decommissioned_at IS NULL"}, conditions...)
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY ed.id"
+
+	query, err := applyRoleBasedAccess(meberID, baseQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error applying role-based access: %w", err)
+	}
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving filtered devices for meber %d: %v", meberID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deviceIDs []int64
+	for rows.Next() {
+		var deviceID int64
+		if err := rows.Scan(&deviceID); err != nil {
+			return nil, fmt.Errorf("error scanning device ID: %w", err)
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+
+	return deviceIDs, rows.Err()
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/structs"
+)
+
+// scanDeviceGroup scans a device_groups row and decodes the saved filter
+func scanDeviceGroup(scanner interface{ Scan(...interface{}) error }) (structs.DeviceGroup, error) {
+	var group structs.DeviceGroup
+	var filterRaw sql.NullString
+	var roleID sql.NullInt64
+
+	if err := scanner.Scan(&group.ID, &group.Name, &group.Type, &filterRaw, &group.OwnerID, &roleID); err != nil {
+		return structs.DeviceGroup{}, err
+	}
+	if roleID.Valid {
+		group.RoleID = &roleID.Int64
+	}
+	if filterRaw.Valid && filterRaw.String != "" {
+		var filter structs.DeviceFilter
+		if err := json.Unmarshal([]byte(filterRaw.String), &filter); err != nil {
+			return structs.DeviceGroup{}, fmt.Errorf("error decoding filter of group %d: %w", group.ID, err)
+		}
+		group.Filter = &filter
+	}
+
+	return group, nil
+}
+
+// GetDeviceGroupsForMeber retrieves the groups owned by the meber or shared with one of the meber's roles
+var GetDeviceGroupsForMeber = func(meberID int64) ([]structs.DeviceGroup, error) {
+	query := `
+		SELECT DISTINCT g.id, g.name, g.type, g.filter, g.owner_id, g.role_id
+		FROM device_groups g
+		LEFT JOIN meber_roles mr ON g.role_id = mr.role_id AND mr.meber_id = ?
+		WHERE g.owner_id = ? OR mr.id IS NOT NULL
+		ORDER BY g.name
+	`
+
+	rows, err := DB.Query(query, meberID, meberID)
+	if err != nil {
+		log.Printf("Error retrieving device groups for meber %d: %v", meberID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []structs.DeviceGroup
+	for rows.Next() {
+		group, err := scanDeviceGroup(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning device group: %w", err)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+// GetDeviceGroupByID retrieves a single device group
+var GetDeviceGroupByID = func(groupID int64) (*structs.DeviceGroup, error) {
+	row := DB.QueryRow("SELECT id, name, type, filter, owner_id, role_id FROM device_groups WHERE id = ?", groupID)
+
+	group, err := scanDeviceGroup(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		log.Printf("Error retrieving device group %d: %v", groupID, err)
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// CreateDeviceGroup stores a group together with its initial static members
+func CreateDeviceGroup(group structs.DeviceGroup, deviceIDs []int64) (int64, error) {
+	var filterJSON interface{}
+	if group.Filter != nil {
+		encoded, err := json.Marshal(group.Filter)
+		if err != nil {
+			return 0, fmt.Errorf("error encoding group filter: %w", err)
+		}
+		filterJSON = string(encoded)
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("INSERT INTO device_groups (name, type, filter, owner_id, role_id) VALUES (?, ?, ?, ?, ?)",
+		group.Name, group.Type, filterJSON, group.OwnerID, group.RoleID)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting device group: %w", err)
+	}
+	groupID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertDeviceGroupMembers(tx, groupID, deviceIDs); err != nil {
+		return 0, err
+	}
+
+	return groupID, tx.Commit()
+}
+
+// insertDeviceGroupMembers adds devices to a static group, devices that are already a member are skipped
+func insertDeviceGroupMembers(tx *sql.Tx, groupID int64, deviceIDs []int64) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	stmt, err := tx.Prepare("INSERT IGNORE INTO device_group_members (group_id, device_id) VALUES (?, ?)")
+	if err != nil {
+		return fmt.Errorf("error preparing group member insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, deviceID := range deviceIDs {
+		if _, err := stmt.Exec(groupID, deviceID); err != nil {
+			return fmt.Errorf("error adding device %d to group %d: %w", deviceID, groupID, err)
+		}
+	}
+	return nil
+}
+
+// AddDeviceGroupMembers adds devices to a static group
+func AddDeviceGroupMembers(groupID int64, deviceIDs []int64) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertDeviceGroupMembers(tx, groupID, deviceIDs); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemoveDeviceGroupMembers removes devices from a static group
+func RemoveDeviceGroupMembers(groupID int64, deviceIDs []int64) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	query := fmt.Sprintf("DELETE FROM device_group_members WHERE group_id = ? AND device_id IN (%s)", placeholders)
+	if _, err := DB.Exec(query, append([]interface{}{groupID}, args...)...); err != nil {
+		return fmt.Errorf("error removing members from group %d: %w", groupID, err)
+	}
+	return nil
+}
+
+// GetStaticGroupMemberIDs retrieves the explicit members of a static group
+var GetStaticGroupMemberIDs = func(groupID int64) ([]int64, error) {
+	rows, err := DB.Query("SELECT device_id FROM device_group_members WHERE group_id = ? ORDER BY device_id", groupID)
+	if err != nil {
+		log.Printf("Error retrieving members of group %d: %v", groupID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deviceIDs []int64
+	for rows.Next() {
+		var deviceID int64
+		if err := rows.Scan(&deviceID); err != nil {
+			return nil, fmt.Errorf("error scanning group member: %w", err)
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+
+	return deviceIDs, rows.Err()
+}
+
+// DeleteDeviceGroup removes a group, its memberships and the maintenance windows on it
+var DeleteDeviceGroup = func(groupID int64) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM device_group_members WHERE group_id = ?", groupID); err != nil {
+		return fmt.Errorf("error deleting members of group %d: %w", groupID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM maintenance_windows WHERE target_type = 'group' AND target_id = ?", groupID); err != nil {
+		return fmt.Errorf("error deleting maintenance windows of group %d: %w", groupID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM device_groups WHERE id = ?", groupID); err != nil {
+		return fmt.Errorf("error deleting group %d: %w", groupID, err)
+	}
+
+	return tx.Commit()
+}
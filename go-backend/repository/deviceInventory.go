@@ -0,0 +1,303 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// GetDeviceInventory retrieves the inventory records of the given devices with their tags, sensors and applications.
+// Access has to be checked by the caller, typically by resolving the IDs through GetDeviceIDsByFilter.
+var GetDeviceInventory = func(deviceIDs []int64) ([]structs.DeviceInventoryRecord, error) {
+	if len(deviceIDs) == 0 {
+		return nil, nil
+	}
+	placeholders, args := int64Placeholders(deviceIDs)
+
+	// Step 1: The devices themselves, coordinates are stored as POINT(longitude, latitude)
+	rows, err := DB.Query(`
+		SELECT id, name, status, connection_type, COALESCE(ip_address, ''),
+			ST_Y(coordinates) AS latitude, ST_X(coordinates) AS longitude
+		FROM edge_devices
+		WHERE id IN (`+placeholders+`)
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving device inventory: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []structs.DeviceInventoryRecord
+	index := make(map[int64]int, len(deviceIDs))
+	for rows.Next() {
+		var record structs.DeviceInventoryRecord
+		if err := rows.Scan(&record.ID, &record.Name, &record.Status, &record.ConnectionType, &record.IPAddress,
+			&record.Latitude, &record.Longitude); err != nil {
+			return nil, fmt.Errorf("error scanning device inventory: %w", err)
+		}
+		record.Tags = []string{}
+		record.Sensors = []string{}
+		record.Applications = []string{}
+		index[record.ID] = len(records)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Tags, the location tag is the municipality
+	err = scanInventoryNames(`
+		SELECT dt.device_id, tg.name, tg.type
+		FROM device_tags dt JOIN tags tg ON dt.tag_id = tg.id
+		WHERE dt.device_id IN (`+placeholders+`)
+		ORDER BY dt.device_id, tg.name
+	`, args, func(deviceID int64, name, tagType string) {
+		record := &records[index[deviceID]]
+		if tagType == "location" {
+			record.Municipality = name
+		} else {
+			record.Tags = append(record.Tags, name)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Sensors
+	err = scanInventoryNames(`
+		SELECT DISTINCT ds.device_id, s.name, ''
+		FROM device_sensors ds JOIN sensors s ON ds.sensor_id = s.id
+		WHERE ds.device_id IN (`+placeholders+`)
+		ORDER BY ds.device_id, s.name
+	`, args, func(deviceID int64, name, _ string) {
+		record := &records[index[deviceID]]
+		record.Sensors = append(record.Sensors, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 4: Applications running on the device
+	err = scanInventoryNames(`
+		SELECT DISTINCT ai.device_id, a.name, ''
+		FROM application_instances ai JOIN applications a ON ai.app_id = a.id
+		WHERE ai.device_id IN (`+placeholders+`)
+		ORDER BY ai.device_id, a.name
+	`, args, func(deviceID int64, name, _ string) {
+		record := &records[index[deviceID]]
+		record.Applications = append(record.Applications, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// scanInventoryNames runs a query returning (device_id, name, type) rows and hands every row to add
+func scanInventoryNames(query string, args []interface{}, add func(deviceID int64, name, kind string)) error {
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving device inventory details: %v", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var name, kind string
+		if err := rows.Scan(&deviceID, &name, &kind); err != nil {
+			return fmt.Errorf("error scanning device inventory details: %w", err)
+		}
+		add(deviceID, name, kind)
+	}
+	return rows.Err()
+}
+
+// GetSensorsByNames retrieves the sensors with the given names
+var GetSensorsByNames = func(names []string) ([]structs.Sensor, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	placeholders, args := stringPlaceholders(names)
+	rows, err := DB.Query("SELECT id, name FROM sensors WHERE name IN ("+placeholders+")", args...)
+	if err != nil {
+		log.Printf("Error retrieving sensors by name: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sensors []structs.Sensor
+	for rows.Next() {
+		var sensor structs.Sensor
+		if err := rows.Scan(&sensor.ID, &sensor.Name); err != nil {
+			return nil, fmt.Errorf("error scanning sensor: %w", err)
+		}
+		sensors = append(sensors, sensor)
+	}
+
+	return sensors, rows.Err()
+}
+
+// GetDeviceIDsByNames retrieves the IDs of the devices in use with the given names, keyed by name. A replacement
+// usually takes over the name of the decommissioned unit, which must not make the name ambiguous.
+var GetDeviceIDsByNames = func(names []string) (map[string][]int64, error) {
+	devices := make(map[string][]int64)
+	if len(names) == 0 {
+		return devices, nil
+	}
+
+	placeholders, args := stringPlaceholders(names)
+	rows, err := DB.Query("SELECT id, name FROM edge_devices WHERE name IN ("+placeholders+") AND decommissioned_at IS NULL ORDER BY id", args...)
+	if err != nil {
+		log.Printf("Error retrieving devices by name: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var name string
+		if err := rows.Scan(&deviceID, &name); err != nil {
+			return nil, fmt.Errorf("error scanning device: %w", err)
+		}
+		devices[name] = append(devices[name], deviceID)
+	}
+
+	return devices, rows.Err()
+}
+
+// ApplyDeviceImport creates and updates the devices of an import in a single transaction, so a failing row
+// leaves the inventory untouched. Only the columns listed in a row's Fields are written on update, status changes
+// are stored in the status history flagged for the devices in maintenance.
+var ApplyDeviceImport = func(rows []structs.DeviceImportRow, meberID int64, inMaintenance map[int64]bool) error {
+	now := time.Now().UTC()
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, row := range rows {
+		record := row.Record
+		set := row.Fields
+
+		deviceID := record.ID
+		if row.Action == "create" {
+			result, err := tx.Exec(`
+				INSERT INTO edge_devices (name, status, connection_type, coordinates, ip_address, created_at)
+				VALUES (?, ?, ?, POINT(?, ?), ?, ?)
+			`, record.Name, record.Status, record.ConnectionType, record.Longitude, record.Latitude, nullableString(record.IPAddress), now)
+			if err != nil {
+				return fmt.Errorf("error creating device on row %d: %w", row.Row, err)
+			}
+			if deviceID, err = result.LastInsertId(); err != nil {
+				return err
+			}
+		} else {
+			if err := updateImportedDevice(tx, deviceID, record, set, inMaintenance, now); err != nil {
+				return fmt.Errorf("error updating device on row %d: %w", row.Row, err)
+			}
+		}
+
+		// A device has a single municipality, so the location tag is replaced
+		if row.MunicipalityID != nil {
+			_, err := tx.Exec(`
+				DELETE dt FROM device_tags dt JOIN tags tg ON dt.tag_id = tg.id
+				WHERE dt.device_id = ? AND tg.type = 'location'
+			`, deviceID)
+			if err != nil {
+				return fmt.Errorf("error replacing municipality on row %d: %w", row.Row, err)
+			}
+			if _, err := tx.Exec("INSERT INTO device_tags (tag_id, device_id) VALUES (?, ?)", *row.MunicipalityID, deviceID); err != nil {
+				return fmt.Errorf("error setting municipality on row %d: %w", row.Row, err)
+			}
+		}
+
+		// Tags and sensors are added, existing ones are kept
+		for _, tagID := range row.TagIDs {
+			_, err := tx.Exec(`
+				INSERT INTO device_tags (tag_id, device_id)
+				SELECT ?, ? FROM DUAL
+				WHERE NOT EXISTS (SELECT 1 FROM device_tags WHERE tag_id = ? AND device_id = ?)
+			`, tagID, deviceID, tagID, deviceID)
+			if err != nil {
+				return fmt.Errorf("error adding tag on row %d: %w", row.Row, err)
+			}
+		}
+		for _, sensorID := range row.SensorIDs {
+			_, err := tx.Exec(`
+				INSERT INTO device_sensors (sensor_id, device_id)
+				SELECT ?, ? FROM DUAL
+				WHERE NOT EXISTS (SELECT 1 FROM device_sensors WHERE sensor_id = ? AND device_id = ?)
+			`, sensorID, deviceID, sensorID, deviceID)
+			if err != nil {
+				return fmt.Errorf("error adding sensor on row %d: %w", row.Row, err)
+			}
+		}
+
+		// New devices are provisioned like any other registration, imports don't carry a device type
+		if row.Action == "create" {
+			if _, err := applyProvisioningTemplates(tx, deviceID, nil, row.MunicipalityID, meberID, now); err != nil {
+				return fmt.Errorf("error provisioning device on row %d: %w", row.Row, err)
+			}
+		}
+
+		device := deviceID
+		details := fmt.Sprintf("%sd device %q from import row %d", row.Action, record.Name, row.Row)
+		if err := insertAuditLog(tx, &meberID, &device, "device_imported", details); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// updateImportedDevice writes the imported fields of an existing device
+func updateImportedDevice(tx *sql.Tx, deviceID int64, record structs.DeviceInventoryRecord, set map[string]bool, inMaintenance map[int64]bool, now time.Time) error {
+	var assignments []string
+	var args []interface{}
+	if set["name"] {
+		assignments = append(assignments, "name = ?")
+		args = append(args, record.Name)
+	}
+	if set["status"] {
+		if err := insertStatusHistory(tx, []int64{deviceID}, record.Status, inMaintenance, now); err != nil {
+			return err
+		}
+		assignments = append(assignments, "status = ?")
+		args = append(args, record.Status)
+	}
+	if set["connection_type"] {
+		assignments = append(assignments, "connection_type = ?")
+		args = append(args, record.ConnectionType)
+	}
+	if set["ip_address"] {
+		assignments = append(assignments, "ip_address = ?")
+		args = append(args, record.IPAddress)
+	}
+	if set["latitude"] {
+		assignments = append(assignments, "coordinates = POINT(?, ?)")
+		args = append(args, record.Longitude, record.Latitude)
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	query := "UPDATE edge_devices SET " + strings.Join(assignments, ", ") + " WHERE id = ?"
+	_, err := tx.Exec(query, append(args, deviceID)...)
+	return err
+}
+
+// nullableString turns an empty string into a NULL column value
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
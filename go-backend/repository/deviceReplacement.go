@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// GetDeviceDecommissionedAt reports whether a device exists and when it was decommissioned, nil if it is in service
+var GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) {
+	var decommissionedAt sql.NullString
+	err := DB.QueryRow("SELECT decommissioned_at FROM edge_devices WHERE id = ?", deviceID).Scan(&decommissionedAt)
+	if err == sql.ErrNoRows {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("error retrieving device %d: %w", deviceID, err)
+	}
+	decommissioned, err := parseNullTimestamp(decommissionedAt)
+	return true, decommissioned, err
+}
+
+// ReplaceDevice creates the replacement of a device and decommissions the old unit in one transaction. The new
+// device takes over the coordinates, tags, sensors and group memberships, and the applications of the old device
+// become its desired state. It returns nil when the old device does not exist or was already decommissioned.
+var ReplaceDevice = func(request structs.DeviceReplacementRequest, meberID int64, now time.Time) (*structs.DeviceReplacement, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now = now.UTC().Truncate(time.Second)
+	oldID := request.OldDeviceID
+
+	// Step 1: Lock the old device
+	var oldStatus string
+	var decommissionedAt sql.NullString
+	err = tx.QueryRow("SELECT status, decommissioned_at FROM edge_devices WHERE id = ? FOR UPDATE", oldID).Scan(&oldStatus, &decommissionedAt)
+	if err == sql.ErrNoRows || (err == nil && decommissionedAt.Valid) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving device %d: %w", oldID, err)
+	}
+
+	// Step 2: Create the new device at the same location, it stays offline until it reports in
+	result, err := tx.Exec(`
+		INSERT INTO edge_devices (name, status, last_contact, connection_type, coordinates, ip_address, device_type_id, lifecycle_stage, created_at)
+		SELECT COALESCE(?, name), 'offline', ?, COALESCE(?, connection_type), coordinates, ?, COALESCE(?, device_type_id), 'installed', ?
+		FROM edge_devices WHERE id = ?
+	`, nullableString(request.Name), now, nullableString(request.ConnectionType), nullableString(request.IPAddress),
+		request.DeviceTypeID, now, oldID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating replacement device: %w", err)
+	}
+	newID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Move tags, sensors and group memberships to the new device
+	for _, table := range []string{"device_tags", "device_sensors", "device_group_members"} {
+		if _, err := tx.Exec("UPDATE "+table+" SET device_id = ? WHERE device_id = ?", newID, oldID); err != nil {
+			return nil, fmt.Errorf("error migrating %s to device %d: %w", table, newID, err)
+		}
+	}
+
+	// Step 4: The desired state of the old device and whatever it ran becomes the desired state of the new one
+	_, err = tx.Exec(`
+		INSERT INTO device_desired_applications (device_id, app_id, version, config, updated_by, updated_at)
+		SELECT ?, app_id, version, config, ?, ? FROM device_desired_applications WHERE device_id = ?
+	`, newID, meberID, now, oldID)
+	if err != nil {
+		return nil, fmt.Errorf("error migrating desired state to device %d: %w", newID, err)
+	}
+	_, err = tx.Exec(`
+		INSERT INTO device_desired_applications (device_id, app_id, version, config, updated_by, updated_at)
+		SELECT DISTINCT ?, ai.app_id, a.version, NULL, ?, ?
+		FROM application_instances ai JOIN applications a ON ai.app_id = a.id
+		WHERE ai.device_id = ?
+			AND NOT EXISTS (SELECT 1 FROM device_desired_applications dda WHERE dda.device_id = ? AND dda.app_id = ai.app_id)
+	`, newID, meberID, now, oldID, newID)
+	if err != nil {
+		return nil, fmt.Errorf("error migrating applications to device %d: %w", newID, err)
+	}
+
+	// Step 5: Decommission the old unit, it keeps its history but no longer runs, reports or receives anything
+	if err := decommissionDevice(tx, oldID, oldStatus, meberID, now); err != nil {
+		return nil, err
+	}
+
+	// Step 6: Link both devices and audit the replacement on each of them
+	result, err = tx.Exec(`
+		INSERT INTO device_replacements (old_device_id, new_device_id, replaced_by, reason, replaced_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, oldID, newID, meberID, nullableString(request.Reason), now)
+	if err != nil {
+		return nil, fmt.Errorf("error recording replacement of device %d: %w", oldID, err)
+	}
+	replacementID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("replaced by device %d", newID)
+	if request.Reason != "" {
+		details += ": " + request.Reason
+	}
+	if err := insertAuditLog(tx, &meberID, &oldID, "device_decommissioned", details); err != nil {
+		return nil, err
+	}
+	if err := insertAuditLog(tx, &meberID, &newID, "device_created", fmt.Sprintf("replacement for device %d", oldID)); err != nil {
+		return nil, err
+	}
+
+	replacement := &structs.DeviceReplacement{
+		ID:          replacementID,
+		OldDeviceID: oldID,
+		NewDeviceID: newID,
+		ReplacedBy:  &meberID,
+		Reason:      request.Reason,
+		ReplacedAt:  now,
+	}
+	return replacement, tx.Commit()
+}
+
+// decommissionDevice takes a device out of service: its desired state, pending commands and credentials are removed
+// and it is marked offline so it is no longer checked for heartbeats
+func decommissionDevice(tx *sql.Tx, deviceID int64, previousStatus string, meberID int64, now time.Time) error {
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"DELETE FROM device_desired_applications WHERE device_id = ?", []interface{}{deviceID}},
+		{"UPDATE application_instances SET status = 'offline' WHERE device_id = ?", []interface{}{deviceID}},
+		{"UPDATE device_commands SET status = 'cancelled', completed_at = ? WHERE device_id = ? AND status IN ('queued', 'sent')", []interface{}{now, deviceID}},
+		{"DELETE FROM wireguard_peers WHERE device_id = ?", []interface{}{deviceID}},
+		{`UPDATE edge_devices
+			SET status = 'offline', decommissioned_at = ?, credentials_revoked_at = ?, last_heartbeat = NULL, ip_address = NULL,
+				lifecycle_stage = 'retired', lifecycle_stage_changed_at = ?
+			WHERE id = ?`, []interface{}{now, now, now, deviceID}},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement.query, statement.args...); err != nil {
+			return fmt.Errorf("error decommissioning device %d: %w", deviceID, err)
+		}
+	}
+
+	if previousStatus != "offline" {
+		_, err := tx.Exec(`
+			INSERT INTO device_status_history (device_id, previous_status, status, in_maintenance, timestamp)
+			VALUES (?, ?, 'offline', FALSE, ?)
+		`, deviceID, previousStatus, now)
+		if err != nil {
+			return fmt.Errorf("error recording status history of device %d: %w", deviceID, err)
+		}
+	}
+
+	_, err := revokeDeviceCertificates(tx, deviceID, "", "cessation_of_operation", &meberID, now)
+	return err
+}
+
+// GetDeviceReplacements retrieves the replacements the device took part in, as old or as new unit, oldest first
+var GetDeviceReplacements = func(deviceID int64) ([]structs.DeviceReplacement, error) {
+	rows, err := DB.Query(`
+		SELECT id, old_device_id, new_device_id, replaced_by, COALESCE(reason, ''), replaced_at
+		FROM device_replacements
+		WHERE old_device_id = ? OR new_device_id = ?
+		ORDER BY replaced_at, id
+	`, deviceID, deviceID)
+	if err != nil {
+		log.Printf("Error retrieving replacements of device %d: %v", deviceID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var replacements []structs.DeviceReplacement
+	for rows.Next() {
+		var replacement structs.DeviceReplacement
+		var replacedBy sql.NullInt64
+		var replacedAt string
+		if err := rows.Scan(&replacement.ID, &replacement.OldDeviceID, &replacement.NewDeviceID, &replacedBy,
+			&replacement.Reason, &replacedAt); err != nil {
+			return nil, fmt.Errorf("error scanning device replacement: %w", err)
+		}
+		if replacedBy.Valid {
+			replacement.ReplacedBy = &replacedBy.Int64
+		}
+		if replacement.ReplacedAt, err = parseTimestamp(replacedAt); err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, replacement)
+	}
+
+	return replacements, rows.Err()
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// UpsertDeviceResources stores the resources a device reports, replacing its previous report
+var UpsertDeviceResources = func(deviceID int64, report structs.DeviceResourceReport, now time.Time) error {
+	_, err := DB.Exec(`
+		INSERT INTO device_resources
+			(device_id, cpu_cores, cpu_usage_percent, memory_total_mb, memory_used_mb, disk_total_mb, disk_used_mb, reported_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			cpu_cores = VALUES(cpu_cores), cpu_usage_percent = VALUES(cpu_usage_percent),
+			memory_total_mb = VALUES(memory_total_mb), memory_used_mb = VALUES(memory_used_mb),
+			disk_total_mb = VALUES(disk_total_mb), disk_used_mb = VALUES(disk_used_mb),
+			reported_at = VALUES(reported_at)
+	`, deviceID, report.CPUCores, report.CPUUsagePercent, report.MemoryTotalMB, report.MemoryUsedMB,
+		report.DiskTotalMB, report.DiskUsedMB, now.UTC())
+	if err != nil {
+		return fmt.Errorf("error storing resources of device %d: %w", deviceID, err)
+	}
+	return nil
+}
+
+// GetDeviceResources retrieves the last reported resources of the devices, keyed by device ID. Devices that never
+// reported get the capacity of their device type, devices without either are left out.
+var GetDeviceResources = func(deviceIDs []int64) (map[int64]structs.DeviceResources, error) {
+	resources := make(map[int64]structs.DeviceResources)
+	if len(deviceIDs) == 0 {
+		return resources, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT ed.id,
+			COALESCE(dr.cpu_cores, dt.cpu_cores), COALESCE(dr.cpu_usage_percent, 0),
+			COALESCE(dr.memory_total_mb, dt.memory_mb), COALESCE(dr.memory_used_mb, 0),
+			COALESCE(dr.disk_total_mb, dt.storage_mb), COALESCE(dr.disk_used_mb, 0),
+			dr.reported_at
+		FROM edge_devices ed
+		LEFT JOIN device_resources dr ON ed.id = dr.device_id
+		LEFT JOIN device_types dt ON ed.device_type_id = dt.id
+		WHERE ed.id IN (`+placeholders+`) AND (dr.device_id IS NOT NULL OR dt.id IS NOT NULL)
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving device resources: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var device structs.DeviceResources
+		var reportedAt sql.NullString
+		if err := rows.Scan(&device.DeviceID, &device.CPUCores, &device.CPUUsagePercent, &device.MemoryTotalMB,
+			&device.MemoryUsedMB, &device.DiskTotalMB, &device.DiskUsedMB, &reportedAt); err != nil {
+			return nil, fmt.Errorf("error scanning device resources: %w", err)
+		}
+		if device.ReportedAt, err = parseNullTimestamp(reportedAt); err != nil {
+			return nil, err
+		}
+		resources[device.DeviceID] = device
+	}
+
+	return resources, rows.Err()
+}
+
+// GetApplicationRequirements retrieves the resource requirements of every application that declares them, keyed
+// by application ID
+var GetApplicationRequirements = func() (map[int64]structs.ResourceRequirements, error) {
+	rows, err := DB.Query("SELECT app_id, cpu_millicores, memory_mb, disk_mb FROM application_resource_requirements")
+	if err != nil {
+		log.Printf("Error retrieving application resource requirements: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	requirements := make(map[int64]structs.ResourceRequirements)
+	for rows.Next() {
+		var requirement structs.ResourceRequirements
+		if err := rows.Scan(&requirement.AppID, &requirement.CPUMillicores, &requirement.MemoryMB, &requirement.DiskMB); err != nil {
+			return nil, fmt.Errorf("error scanning application resource requirements: %w", err)
+		}
+		requirements[requirement.AppID] = requirement
+	}
+
+	return requirements, rows.Err()
+}
+
+// SetApplicationRequirements replaces the resource requirements of an application
+var SetApplicationRequirements = func(requirements structs.ResourceRequirements, meberID int64) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		INSERT INTO application_resource_requirements (app_id, cpu_millicores, memory_mb, disk_mb)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE cpu_millicores = VALUES(cpu_millicores), memory_mb = VALUES(memory_mb), disk_mb = VALUES(disk_mb)
+	`, requirements.AppID, requirements.CPUMillicores, requirements.MemoryMB, requirements.DiskMB)
+	if err != nil {
+		return fmt.Errorf("error setting resource requirements of application %d: %w", requirements.AppID, err)
+	}
+
+	details := fmt.Sprintf("application %d requires %d millicores, %d MB memory, %d MB disk",
+		requirements.AppID, requirements.CPUMillicores, requirements.MemoryMB, requirements.DiskMB)
+	if err := insertAuditLog(tx, &meberID, nil, "application_requirements_set", details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// StaleDevice is a device that stopped sending heartbeats while not marked offline
+type StaleDevice struct {
+	ID     int64
+	Status string
+}
+
+// GetDeviceStatus retrieves the stored status of a device
+var GetDeviceStatus = func(deviceID int64) (string, error) {
+	var status string
+	err := DB.QueryRow("SELECT status FROM edge_devices WHERE id = ?", deviceID).Scan(&status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("error retrieving status of device %d: %w", deviceID, err)
+	}
+	return status, nil
+}
+
+// TouchDeviceHeartbeat marks the device as seen at the given time
+var TouchDeviceHeartbeat = func(deviceID int64, now time.Time) error {
+	_, err := DB.Exec("UPDATE edge_devices SET last_contact = ?, last_heartbeat = ? WHERE id = ?", now, now, deviceID)
+	if err != nil {
+		log.Printf("Error updating heartbeat of device %d: %v", deviceID, err)
+		return err
+	}
+	return nil
+}
+
+// RecordDeviceStatusTransition changes the status of a device, stores the transition in the history and writes a log entry.
+// The status only changes while it is still previousStatus and, when heartbeatBefore is set, the device hasn't sent a
+// heartbeat since. It returns false when a concurrent heartbeat or status change got there first.
+var RecordDeviceStatusTransition = func(deviceID int64, previousStatus, status string, heartbeatBefore *time.Time, inMaintenance bool, now time.Time, logLevel, logDescription string) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := "UPDATE edge_devices SET status = ? WHERE id = ? AND status = ?"
+	args := []interface{}{status, deviceID, previousStatus}
+	if heartbeatBefore != nil {
+		query += " AND last_heartbeat < ?"
+		args = append(args, *heartbeatBefore)
+	}
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return false, fmt.Errorf("error updating status of device %d: %w", deviceID, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error updating status of device %d: %w", deviceID, err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	var previous interface{}
+	if previousStatus != "" {
+		previous = previousStatus
+	}
+	_, err = tx.Exec(`
+		INSERT INTO device_status_history (device_id, previous_status, status, in_maintenance, timestamp)
+		VALUES (?, ?, ?, ?, ?)
+	`, deviceID, previous, status, inMaintenance, now)
+	if err != nil {
+		return false, fmt.Errorf("error recording status history of device %d: %w", deviceID, err)
+	}
+
+	_, err = tx.Exec("INSERT INTO logs (device_id, description, warning_level, timestamp) VALUES (?, ?, ?, ?)",
+		deviceID, logDescription, logLevel, now)
+	if err != nil {
+		return false, fmt.Errorf("error writing status log of device %d: %w", deviceID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// insertStatusHistory stores a status history row for each of the devices whose status changes to status. It runs
+// before the new status is written, as the devices that change are found by their stored status.
+func insertStatusHistory(tx *sql.Tx, deviceIDs []int64, status string, inMaintenance map[int64]bool, now time.Time) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+	placeholders, args := int64Placeholders(deviceIDs)
+	query := fmt.Sprintf("SELECT id, status FROM edge_devices WHERE id IN (%s) AND status <> ? FOR UPDATE", placeholders)
+	rows, err := tx.Query(query, append(args, status)...)
+	if err != nil {
+		return fmt.Errorf("error retrieving status of devices: %w", err)
+	}
+	var changes []interface{}
+	var values []string
+	for rows.Next() {
+		var deviceID int64
+		var previousStatus string
+		if err := rows.Scan(&deviceID, &previousStatus); err != nil {
+			rows.Close()
+			return fmt.Errorf("error scanning device status: %w", err)
+		}
+		values = append(values, "(?, ?, ?, ?, ?)")
+		changes = append(changes, deviceID, previousStatus, status, inMaintenance[deviceID], now)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil || len(values) == 0 {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO device_status_history (device_id, previous_status, status, in_maintenance, timestamp)
+		VALUES `+strings.Join(values, ", "), changes...)
+	if err != nil {
+		return fmt.Errorf("error recording status history: %w", err)
+	}
+	return nil
+}
+
+// GetStaleDevices retrieves devices that sent heartbeats before but not since cutoff and are not offline yet
+var GetStaleDevices = func(cutoff time.Time) ([]StaleDevice, error) {
+	rows, err := DB.Query(`
+		SELECT id, status FROM edge_devices
+		WHERE last_heartbeat IS NOT NULL AND last_heartbeat < ? AND status <> 'offline'
+	`, cutoff)
+	if err != nil {
+		log.Printf("Error retrieving stale devices: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []StaleDevice
+	for rows.Next() {
+		var device StaleDevice
+		if err := rows.Scan(&device.ID, &device.Status); err != nil {
+			return nil, fmt.Errorf("error scanning stale device: %w", err)
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
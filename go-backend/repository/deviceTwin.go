@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// nullableJSON turns an empty or null JSON document into a NULL column value
+func nullableJSON(raw json.RawMessage) interface{} {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return string(raw)
+}
+
+// GetApplicationByID retrieves a single application from the catalog
+var GetApplicationByID = func(appID int64) (*structs.Application, error) {
+	var app structs.Application
+	var description, repoURL sql.NullString
+	err := DB.QueryRow("SELECT id, name, version, description, repo_url FROM applications WHERE id = ?", appID).
+		Scan(&app.ID, &app.Name, &app.Version, &description, &repoURL)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving application %d: %w", appID, err)
+	}
+	app.Description = description.String
+	app.RepoUrl = repoURL.String
+	return &app, nil
+}
+
+// GetKnownApplicationIDs returns which of the application IDs exist in the catalog
+var GetKnownApplicationIDs = func(appIDs []int64) (map[int64]bool, error) {
+	known := make(map[int64]bool, len(appIDs))
+	if len(appIDs) == 0 {
+		return known, nil
+	}
+
+	placeholders, args := int64Placeholders(appIDs)
+	rows, err := DB.Query(fmt.Sprintf("SELECT id FROM applications WHERE id IN (%s)", placeholders), args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving applications: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var appID int64
+		if err := rows.Scan(&appID); err != nil {
+			return nil, fmt.Errorf("error scanning application: %w", err)
+		}
+		known[appID] = true
+	}
+	return known, rows.Err()
+}
+
+// SetDesiredApplications records the desired version and config of an application on every device and audits it
+var SetDesiredApplications = func(deviceIDs []int64, appID int64, version string, config json.RawMessage, meberID int64) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO device_desired_applications (device_id, app_id, version, config, updated_by, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE version = VALUES(version), config = VALUES(config),
+			updated_by = VALUES(updated_by), updated_at = VALUES(updated_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("error preparing desired application insert: %w", err)
+	}
+	defer stmt.Close()
+
+	now := time.Now().UTC()
+	for _, deviceID := range deviceIDs {
+		if _, err := stmt.Exec(deviceID, appID, version, nullableJSON(config), meberID, now); err != nil {
+			return fmt.Errorf("error setting desired application %d on device %d: %w", appID, deviceID, err)
+		}
+
+		device := deviceID
+		details := fmt.Sprintf("desired application %d version %s", appID, version)
+		if err := insertAuditLog(tx, &meberID, &device, "desired_application_set", details); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteDesiredApplication removes an application from the desired state of a device and audits it
+var DeleteDesiredApplication = func(deviceID, appID, meberID int64) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("DELETE FROM device_desired_applications WHERE device_id = ? AND app_id = ?", deviceID, appID)
+	if err != nil {
+		return false, fmt.Errorf("error removing desired application %d from device %d: %w", appID, deviceID, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+
+	details := fmt.Sprintf("removed desired application %d", appID)
+	if err := insertAuditLog(tx, &meberID, &deviceID, "desired_application_removed", details); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// GetDeviceTwins retrieves the name and last state report of the given devices, keyed by device ID
+var GetDeviceTwins = func(deviceIDs []int64) (map[int64]structs.DeviceTwin, error) {
+	twins := make(map[int64]structs.DeviceTwin, len(deviceIDs))
+	if len(deviceIDs) == 0 {
+		return twins, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query("SELECT id, name, state_reported_at FROM edge_devices WHERE id IN ("+placeholders+")", args...)
+	if err != nil {
+		log.Printf("Error retrieving device twins: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var twin structs.DeviceTwin
+		var reportedAt sql.NullString
+		if err := rows.Scan(&twin.DeviceID, &twin.DeviceName, &reportedAt); err != nil {
+			return nil, fmt.Errorf("error scanning device twin: %w", err)
+		}
+		if twin.ReportedAt, err = parseNullTimestamp(reportedAt); err != nil {
+			return nil, err
+		}
+		twins[twin.DeviceID] = twin
+	}
+
+	return twins, rows.Err()
+}
+
+// GetDesiredApplications retrieves the desired applications of the given devices, keyed by device ID
+var GetDesiredApplications = func(deviceIDs []int64) (map[int64][]structs.DesiredApplication, error) {
+	desired := make(map[int64][]structs.DesiredApplication)
+	if len(deviceIDs) == 0 {
+		return desired, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT dda.device_id, dda.app_id, a.name, dda.version, dda.config
+		FROM device_desired_applications dda
+		JOIN applications a ON dda.app_id = a.id
+		WHERE dda.device_id IN (`+placeholders+`)
+		ORDER BY dda.device_id, dda.app_id
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving desired applications: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var app structs.DesiredApplication
+		var config sql.NullString
+		if err := rows.Scan(&deviceID, &app.AppID, &app.Name, &app.Version, &config); err != nil {
+			return nil, fmt.Errorf("error scanning desired application: %w", err)
+		}
+		if config.Valid {
+			app.Config = json.RawMessage(config.String)
+		}
+		desired[deviceID] = append(desired[deviceID], app)
+	}
+
+	return desired, rows.Err()
+}
+
+// GetReportedApplications retrieves the applications the given devices last reported, keyed by device ID
+var GetReportedApplications = func(deviceIDs []int64) (map[int64][]structs.ReportedApplication, error) {
+	reported := make(map[int64][]structs.ReportedApplication)
+	if len(deviceIDs) == 0 {
+		return reported, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT dra.device_id, dra.app_id, a.name, dra.version, dra.config, dra.status
+		FROM device_reported_applications dra
+		JOIN applications a ON dra.app_id = a.id
+		WHERE dra.device_id IN (`+placeholders+`)
+		ORDER BY dra.device_id, dra.app_id
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving reported applications: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var app structs.ReportedApplication
+		var config sql.NullString
+		if err := rows.Scan(&deviceID, &app.AppID, &app.Name, &app.Version, &config, &app.Status); err != nil {
+			return nil, fmt.Errorf("error scanning reported application: %w", err)
+		}
+		if config.Valid {
+			app.Config = json.RawMessage(config.String)
+		}
+		reported[deviceID] = append(reported[deviceID], app)
+	}
+
+	return reported, rows.Err()
+}
+
+// ReplaceReportedApplications stores the full application state a device reported and brings its
+// application instances in line with it, all in one transaction
+var ReplaceReportedApplications = func(deviceID int64, apps []structs.ReportedApplication, now time.Time) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Step 1: Replace the previous report
+	if _, err := tx.Exec("DELETE FROM device_reported_applications WHERE device_id = ?", deviceID); err != nil {
+		return fmt.Errorf("error clearing reported applications of device %d: %w", deviceID, err)
+	}
+	for _, app := range apps {
+		_, err := tx.Exec(`
+			INSERT INTO device_reported_applications (device_id, app_id, version, config, status, reported_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, deviceID, app.AppID, app.Version, nullableJSON(app.Config), app.Status, now)
+		if err != nil {
+			return fmt.Errorf("error storing reported application %d of device %d: %w", app.AppID, deviceID, err)
+		}
+	}
+	if _, err := tx.Exec("UPDATE edge_devices SET state_reported_at = ? WHERE id = ?", now, deviceID); err != nil {
+		return fmt.Errorf("error updating state report time of device %d: %w", deviceID, err)
+	}
+
+	// Step 2: Application instances follow what actually runs on the device
+	for _, app := range apps {
+		var count int
+		err := tx.QueryRow("SELECT COUNT(*) FROM application_instances WHERE device_id = ? AND app_id = ?", deviceID, app.AppID).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("error checking application instance of device %d: %w", deviceID, err)
+		}
+		if count > 0 {
+			_, err = tx.Exec("UPDATE application_instances SET status = ? WHERE device_id = ? AND app_id = ?", app.Status, deviceID, app.AppID)
+		} else {
+			_, err = tx.Exec(`
+				INSERT INTO application_instances (app_id, device_id, status, path)
+				SELECT id, ?, ?, CONCAT('/path/to/', name) FROM applications WHERE id = ?
+			`, deviceID, app.Status, app.AppID)
+		}
+		if err != nil {
+			return fmt.Errorf("error syncing application instance %d of device %d: %w", app.AppID, deviceID, err)
+		}
+	}
+
+	// Instances the device no longer reports are kept for their logs, but shown as offline
+	query := "UPDATE application_instances SET status = 'offline' WHERE device_id = ?"
+	args := []interface{}{deviceID}
+	if len(apps) > 0 {
+		appIDs := make([]int64, len(apps))
+		for i, app := range apps {
+			appIDs[i] = app.AppID
+		}
+		placeholders, appArgs := int64Placeholders(appIDs)
+		query += " AND app_id NOT IN (" + placeholders + ")"
+		args = append(args, appArgs...)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("error marking unreported applications of device %d offline: %w", deviceID, err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,499 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// GetDeviceTypes retrieves the device type catalog with the capabilities and supported sensors of every type
+var GetDeviceTypes = func() ([]structs.DeviceType, error) {
+	return queryDeviceTypes("")
+}
+
+// GetDeviceTypeByID retrieves a single device type, nil when it does not exist
+var GetDeviceTypeByID = func(deviceTypeID int64) (*structs.DeviceType, error) {
+	types, err := queryDeviceTypes("WHERE id = ?", deviceTypeID)
+	if err != nil || len(types) == 0 {
+		return nil, err
+	}
+	return &types[0], nil
+}
+
+// queryDeviceTypes retrieves the device types matching the where clause and fills in their capabilities and sensors
+func queryDeviceTypes(where string, args ...interface{}) ([]structs.DeviceType, error) {
+	rows, err := DB.Query("SELECT id, vendor, model, cpu_cores, memory_mb, storage_mb FROM device_types "+where+" ORDER BY vendor, model", args...)
+	if err != nil {
+		log.Printf("Error retrieving device types: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var types []structs.DeviceType
+	index := make(map[int64]int)
+	for rows.Next() {
+		deviceType := structs.DeviceType{Capabilities: []string{}, SensorIDs: []int64{}}
+		if err := rows.Scan(&deviceType.ID, &deviceType.Vendor, &deviceType.Model, &deviceType.CPUCores,
+			&deviceType.MemoryMB, &deviceType.StorageMB); err != nil {
+			return nil, fmt.Errorf("error scanning device type: %w", err)
+		}
+		index[deviceType.ID] = len(types)
+		types = append(types, deviceType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	capabilities, err := DB.Query("SELECT device_type_id, capability FROM device_type_capabilities ORDER BY capability")
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving device type capabilities: %w", err)
+	}
+	defer capabilities.Close()
+	for capabilities.Next() {
+		var deviceTypeID int64
+		var capability string
+		if err := capabilities.Scan(&deviceTypeID, &capability); err != nil {
+			return nil, fmt.Errorf("error scanning device type capability: %w", err)
+		}
+		if i, ok := index[deviceTypeID]; ok {
+			types[i].Capabilities = append(types[i].Capabilities, capability)
+		}
+	}
+	if err := capabilities.Err(); err != nil {
+		return nil, err
+	}
+
+	sensors, err := DB.Query("SELECT device_type_id, sensor_id FROM device_type_sensors ORDER BY sensor_id")
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving device type sensors: %w", err)
+	}
+	defer sensors.Close()
+	for sensors.Next() {
+		var deviceTypeID, sensorID int64
+		if err := sensors.Scan(&deviceTypeID, &sensorID); err != nil {
+			return nil, fmt.Errorf("error scanning device type sensor: %w", err)
+		}
+		if i, ok := index[deviceTypeID]; ok {
+			types[i].SensorIDs = append(types[i].SensorIDs, sensorID)
+		}
+	}
+
+	return types, sensors.Err()
+}
+
+// CreateDeviceType adds a device type with its capabilities and supported sensors to the catalog
+var CreateDeviceType = func(deviceType structs.DeviceType) (int64, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("INSERT INTO device_types (vendor, model, cpu_cores, memory_mb, storage_mb) VALUES (?, ?, ?, ?, ?)",
+		deviceType.Vendor, deviceType.Model, deviceType.CPUCores, deviceType.MemoryMB, deviceType.StorageMB)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting device type: %w", err)
+	}
+	deviceTypeID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, capability := range deviceType.Capabilities {
+		if _, err := tx.Exec("INSERT INTO device_type_capabilities (device_type_id, capability) VALUES (?, ?)", deviceTypeID, capability); err != nil {
+			return 0, fmt.Errorf("error adding capability %q to device type: %w", capability, err)
+		}
+	}
+	for _, sensorID := range deviceType.SensorIDs {
+		if _, err := tx.Exec("INSERT INTO device_type_sensors (device_type_id, sensor_id) VALUES (?, ?)", deviceTypeID, sensorID); err != nil {
+			return 0, fmt.Errorf("error adding sensor %d to device type: %w", sensorID, err)
+		}
+	}
+
+	return deviceTypeID, tx.Commit()
+}
+
+// AssignDeviceType sets the type of existing devices
+var AssignDeviceType = func(deviceTypeID int64, deviceIDs []int64, meberID int64) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	_, err = tx.Exec("UPDATE edge_devices SET device_type_id = ? WHERE id IN ("+placeholders+")", append([]interface{}{deviceTypeID}, args...)...)
+	if err != nil {
+		return fmt.Errorf("error assigning device type %d: %w", deviceTypeID, err)
+	}
+	for _, deviceID := range deviceIDs {
+		device := deviceID
+		if err := insertAuditLog(tx, &meberID, &device, "device_type_assigned", fmt.Sprintf("device type %d", deviceTypeID)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetDeviceCapabilities retrieves the capabilities of the devices through their type, devices without a type
+// have no capabilities
+var GetDeviceCapabilities = func(deviceIDs []int64) (map[int64]map[string]bool, error) {
+	capabilities := make(map[int64]map[string]bool)
+	if len(deviceIDs) == 0 {
+		return capabilities, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT ed.id, dtc.capability
+		FROM edge_devices ed
+		JOIN device_type_capabilities dtc ON ed.device_type_id = dtc.device_type_id
+		WHERE ed.id IN (`+placeholders+`)
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving device capabilities: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var capability string
+		if err := rows.Scan(&deviceID, &capability); err != nil {
+			return nil, fmt.Errorf("error scanning device capability: %w", err)
+		}
+		if capabilities[deviceID] == nil {
+			capabilities[deviceID] = make(map[string]bool)
+		}
+		capabilities[deviceID][capability] = true
+	}
+
+	return capabilities, rows.Err()
+}
+
+// GetApplicationCapabilities retrieves the capabilities required by each application, keyed by application ID
+var GetApplicationCapabilities = func() (map[int64][]string, error) {
+	rows, err := DB.Query("SELECT app_id, capability FROM application_capabilities ORDER BY app_id, capability")
+	if err != nil {
+		log.Printf("Error retrieving application capabilities: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	capabilities := make(map[int64][]string)
+	for rows.Next() {
+		var appID int64
+		var capability string
+		if err := rows.Scan(&appID, &capability); err != nil {
+			return nil, fmt.Errorf("error scanning application capability: %w", err)
+		}
+		capabilities[appID] = append(capabilities[appID], capability)
+	}
+
+	return capabilities, rows.Err()
+}
+
+// SetApplicationCapabilities replaces the capabilities an application requires
+var SetApplicationCapabilities = func(appID int64, capabilities []string, meberID int64) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM application_capabilities WHERE app_id = ?", appID); err != nil {
+		return fmt.Errorf("error clearing capabilities of application %d: %w", appID, err)
+	}
+	for _, capability := range capabilities {
+		if _, err := tx.Exec("INSERT INTO application_capabilities (app_id, capability) VALUES (?, ?)", appID, capability); err != nil {
+			return fmt.Errorf("error adding capability %q to application %d: %w", capability, appID, err)
+		}
+	}
+
+	details := fmt.Sprintf("application %d requires capabilities [%s]", appID, strings.Join(capabilities, ", "))
+	if err := insertAuditLog(tx, &meberID, nil, "application_capabilities_set", details); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetSensors retrieves all sensors
+var GetSensors = func() ([]structs.Sensor, error) {
+	rows, err := DB.Query("SELECT id, name FROM sensors ORDER BY id")
+	if err != nil {
+		log.Printf("Error retrieving sensors: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sensors []structs.Sensor
+	for rows.Next() {
+		var sensor structs.Sensor
+		if err := rows.Scan(&sensor.ID, &sensor.Name); err != nil {
+			return nil, fmt.Errorf("error scanning sensor: %w", err)
+		}
+		sensors = append(sensors, sensor)
+	}
+
+	return sensors, rows.Err()
+}
+
+// GetProvisioningTemplates retrieves all provisioning templates with their tags, sensors and applications
+var GetProvisioningTemplates = func() ([]structs.ProvisioningTemplate, error) {
+	rows, err := DB.Query("SELECT id, name, device_type_id, municipality_id, created_by FROM provisioning_templates ORDER BY name, id")
+	if err != nil {
+		log.Printf("Error retrieving provisioning templates: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []structs.ProvisioningTemplate
+	index := make(map[int64]int)
+	for rows.Next() {
+		template := structs.ProvisioningTemplate{TagIDs: []int64{}, SensorIDs: []int64{}, AppIDs: []int64{}}
+		var deviceTypeID, municipalityID sql.NullInt64
+		if err := rows.Scan(&template.ID, &template.Name, &deviceTypeID, &municipalityID, &template.CreatedBy); err != nil {
+			return nil, fmt.Errorf("error scanning provisioning template: %w", err)
+		}
+		if deviceTypeID.Valid {
+			template.DeviceTypeID = &deviceTypeID.Int64
+		}
+		if municipalityID.Valid {
+			template.MunicipalityID = &municipalityID.Int64
+		}
+		index[template.ID] = len(templates)
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Each template holds three lists, stored in a table each
+	lists := []struct {
+		query string
+		list  func(template *structs.ProvisioningTemplate) *[]int64
+	}{
+		{"SELECT template_id, tag_id FROM provisioning_template_tags ORDER BY tag_id",
+			func(template *structs.ProvisioningTemplate) *[]int64 { return &template.TagIDs }},
+		{"SELECT template_id, sensor_id FROM provisioning_template_sensors ORDER BY sensor_id",
+			func(template *structs.ProvisioningTemplate) *[]int64 { return &template.SensorIDs }},
+		{"SELECT template_id, app_id FROM provisioning_template_applications ORDER BY app_id",
+			func(template *structs.ProvisioningTemplate) *[]int64 { return &template.AppIDs }},
+	}
+	for _, list := range lists {
+		if err := scanTemplateList(list.query, func(templateID, id int64) {
+			if i, ok := index[templateID]; ok {
+				ids := list.list(&templates[i])
+				*ids = append(*ids, id)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+// scanTemplateList runs a query returning (template_id, id) pairs and passes every pair to add
+func scanTemplateList(query string, add func(templateID, id int64)) error {
+	rows, err := DB.Query(query)
+	if err != nil {
+		return fmt.Errorf("error retrieving provisioning template contents: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var templateID, id int64
+		if err := rows.Scan(&templateID, &id); err != nil {
+			return fmt.Errorf("error scanning provisioning template contents: %w", err)
+		}
+		add(templateID, id)
+	}
+	return rows.Err()
+}
+
+// CreateProvisioningTemplate stores a provisioning template with its tags, sensors and applications
+var CreateProvisioningTemplate = func(template structs.ProvisioningTemplate) (int64, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("INSERT INTO provisioning_templates (name, device_type_id, municipality_id, created_by) VALUES (?, ?, ?, ?)",
+		template.Name, template.DeviceTypeID, template.MunicipalityID, template.CreatedBy)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting provisioning template: %w", err)
+	}
+	templateID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	lists := []struct {
+		table, column string
+		ids           []int64
+	}{
+		{"provisioning_template_tags", "tag_id", template.TagIDs},
+		{"provisioning_template_sensors", "sensor_id", template.SensorIDs},
+		{"provisioning_template_applications", "app_id", template.AppIDs},
+	}
+	for _, list := range lists {
+		for _, id := range list.ids {
+			if _, err := tx.Exec("INSERT IGNORE INTO "+list.table+" (template_id, "+list.column+") VALUES (?, ?)", templateID, id); err != nil {
+				return 0, fmt.Errorf("error adding %s %d to provisioning template: %w", list.column, id, err)
+			}
+		}
+	}
+
+	return templateID, tx.Commit()
+}
+
+// DeleteProvisioningTemplate removes a provisioning template, devices provisioned with it keep their settings
+var DeleteProvisioningTemplate = func(templateID int64) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"provisioning_template_tags", "provisioning_template_sensors", "provisioning_template_applications"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE template_id = ?", templateID); err != nil {
+			return false, fmt.Errorf("error deleting provisioning template %d: %w", templateID, err)
+		}
+	}
+	result, err := tx.Exec("DELETE FROM provisioning_templates WHERE id = ?", templateID)
+	if err != nil {
+		return false, fmt.Errorf("error deleting provisioning template %d: %w", templateID, err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// RegisterDevice creates a device in its municipality and applies the matching provisioning templates, in one
+// transaction
+var RegisterDevice = func(request structs.DeviceRegistrationRequest, meberID int64, now time.Time) (structs.DeviceRegistration, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return structs.DeviceRegistration{}, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now = now.UTC().Truncate(time.Second)
+
+	// Step 1: Create the device as installed, it stays offline until it reports in
+	result, err := tx.Exec(`
+		INSERT INTO edge_devices (name, status, last_contact, connection_type, coordinates, ip_address, device_type_id, lifecycle_stage, created_at)
+		VALUES (?, 'offline', ?, ?, POINT(?, ?), ?, ?, 'installed', ?)
+	`, request.Name, now, request.ConnectionType, *request.Longitude, *request.Latitude, nullableString(request.IPAddress), request.DeviceTypeID, now)
+	if err != nil {
+		return structs.DeviceRegistration{}, fmt.Errorf("error registering device: %w", err)
+	}
+	deviceID, err := result.LastInsertId()
+	if err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+
+	if request.MunicipalityID != nil {
+		if _, err := tx.Exec("INSERT INTO device_tags (tag_id, device_id) VALUES (?, ?)", *request.MunicipalityID, deviceID); err != nil {
+			return structs.DeviceRegistration{}, fmt.Errorf("error setting municipality of device %d: %w", deviceID, err)
+		}
+	}
+	if err := insertAuditLog(tx, &meberID, &deviceID, "device_registered", fmt.Sprintf("registered device %q", request.Name)); err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+
+	// Step 2: Provision the device from the templates for its type and municipality
+	applied, err := applyProvisioningTemplates(tx, deviceID, request.DeviceTypeID, request.MunicipalityID, meberID, now)
+	if err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+
+	return structs.DeviceRegistration{DeviceID: deviceID, AppliedTemplates: applied}, tx.Commit()
+}
+
+// applyProvisioningTemplates adds the tags, sensors and applications of every template matching the type and
+// municipality of a new device. Applications whose required capabilities the device type lacks are skipped.
+func applyProvisioningTemplates(tx *sql.Tx, deviceID int64, deviceTypeID, municipalityID *int64, meberID int64, now time.Time) ([]int64, error) {
+	rows, err := tx.Query(`
+		SELECT id FROM provisioning_templates
+		WHERE (device_type_id IS NULL OR device_type_id = ?) AND (municipality_id IS NULL OR municipality_id = ?)
+		ORDER BY id
+	`, deviceTypeID, municipalityID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving provisioning templates: %w", err)
+	}
+	templateIDs := []int64{}
+	for rows.Next() {
+		var templateID int64
+		if err := rows.Scan(&templateID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error scanning provisioning template: %w", err)
+		}
+		templateIDs = append(templateIDs, templateID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(templateIDs) == 0 {
+		return templateIDs, nil
+	}
+
+	placeholders, args := int64Placeholders(templateIDs)
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`INSERT INTO device_tags (tag_id, device_id)
+			SELECT DISTINCT ptt.tag_id, ? FROM provisioning_template_tags ptt
+			WHERE ptt.template_id IN (` + placeholders + `)
+				AND NOT EXISTS (SELECT 1 FROM device_tags dt WHERE dt.device_id = ? AND dt.tag_id = ptt.tag_id)`,
+			append(append([]interface{}{deviceID}, args...), deviceID)},
+		{`INSERT INTO device_sensors (sensor_id, device_id)
+			SELECT DISTINCT pts.sensor_id, ? FROM provisioning_template_sensors pts
+			WHERE pts.template_id IN (` + placeholders + `)
+				AND NOT EXISTS (SELECT 1 FROM device_sensors ds WHERE ds.device_id = ? AND ds.sensor_id = pts.sensor_id)`,
+			append(append([]interface{}{deviceID}, args...), deviceID)},
+		{`INSERT IGNORE INTO device_desired_applications (device_id, app_id, version, config, updated_by, updated_at)
+			SELECT DISTINCT ?, a.id, a.version, NULL, ?, ?
+			FROM provisioning_template_applications pta JOIN applications a ON pta.app_id = a.id
+			WHERE pta.template_id IN (` + placeholders + `)
+				AND NOT EXISTS (
+					SELECT 1 FROM application_capabilities ac
+					WHERE ac.app_id = a.id AND ac.capability NOT IN (
+						SELECT capability FROM device_type_capabilities WHERE device_type_id = ?))`,
+			append(append([]interface{}{deviceID, meberID, now}, args...), deviceTypeID)},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement.query, statement.args...); err != nil {
+			return nil, fmt.Errorf("error provisioning device %d: %w", deviceID, err)
+		}
+	}
+
+	ids := make([]string, len(templateIDs))
+	for i, templateID := range templateIDs {
+		ids[i] = fmt.Sprint(templateID)
+	}
+	details := fmt.Sprintf("applied provisioning templates %s", strings.Join(ids, ", "))
+	if err := insertAuditLog(tx, &meberID, &deviceID, "provisioning_template_applied", details); err != nil {
+		return nil, err
+	}
+
+	return templateIDs, nil
+}
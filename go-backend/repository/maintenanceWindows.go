@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+)
+
+const maintenanceWindowColumns = `id, name, target_type, target_id, starts_at, ends_at, recurrence, recurrence_until,
+	installs_only_in_window, created_by`
+
+// scanMaintenanceWindow scans a maintenance_windows row selected with maintenanceWindowColumns
+func scanMaintenanceWindow(scanner interface{ Scan(...interface{}) error }) (structs.MaintenanceWindow, error) {
+	var window structs.MaintenanceWindow
+	var startsAt, endsAt string
+	var recurrenceUntil sql.NullString
+
+	err := scanner.Scan(&window.ID, &window.Name, &window.TargetType, &window.TargetID, &startsAt, &endsAt,
+		&window.Recurrence, &recurrenceUntil, &window.InstallsOnlyInWindow, &window.CreatedBy)
+	if err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+
+	if window.StartsAt, err = parseTimestamp(startsAt); err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+	if window.EndsAt, err = parseTimestamp(endsAt); err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+	if window.RecurrenceUntil, err = parseNullTimestamp(recurrenceUntil); err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+
+	return window, nil
+}
+
+// GetMaintenanceWindows retrieves all maintenance windows
+var GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+	rows, err := DB.Query("SELECT " + maintenanceWindowColumns + " FROM maintenance_windows ORDER BY starts_at")
+	if err != nil {
+		log.Printf("Error retrieving maintenance windows: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var windows []structs.MaintenanceWindow
+	for rows.Next() {
+		window, err := scanMaintenanceWindow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning maintenance window: %w", err)
+		}
+		windows = append(windows, window)
+	}
+
+	return windows, rows.Err()
+}
+
+// GetMaintenanceWindowByID retrieves a single maintenance window
+var GetMaintenanceWindowByID = func(windowID int64) (*structs.MaintenanceWindow, error) {
+	row := DB.QueryRow("SELECT "+maintenanceWindowColumns+" FROM maintenance_windows WHERE id = ?", windowID)
+	window, err := scanMaintenanceWindow(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving maintenance window %d: %w", windowID, err)
+	}
+	return &window, nil
+}
+
+// InsertMaintenanceWindow stores a new maintenance window
+func InsertMaintenanceWindow(window structs.MaintenanceWindow) (int64, error) {
+	result, err := DB.Exec(`
+		INSERT INTO maintenance_windows
+			(name, target_type, target_id, starts_at, ends_at, recurrence, recurrence_until, installs_only_in_window, created_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, window.Name, window.TargetType, window.TargetID, window.StartsAt, window.EndsAt, window.Recurrence,
+		window.RecurrenceUntil, window.InstallsOnlyInWindow, window.CreatedBy)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting maintenance window: %w", err)
+	}
+	return result.LastInsertId()
+}
+
+// DeleteMaintenanceWindow removes a maintenance window
+var DeleteMaintenanceWindow = func(windowID int64) error {
+	if _, err := DB.Exec("DELETE FROM maintenance_windows WHERE id = ?", windowID); err != nil {
+		return fmt.Errorf("error deleting maintenance window %d: %w", windowID, err)
+	}
+	return nil
+}
+
+// GetTagByID retrieves a single tag
+var GetTagByID = func(tagID int64) (*structs.Tag, error) {
+	var tag structs.Tag
+	var ownerID sql.NullInt64
+	err := DB.QueryRow("SELECT id, name, type, is_editable, owner_id FROM tags WHERE id = ?", tagID).
+		Scan(&tag.ID, &tag.Name, &tag.Type, &tag.IsEditable, &ownerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving tag %d: %w", tagID, err)
+	}
+	if ownerID.Valid {
+		tag.OwnerID = &ownerID.Int64
+	}
+	return &tag, nil
+}
+
+// GetDeviceIDsByTag retrieves the IDs of all devices carrying a tag, without access checks
+var GetDeviceIDsByTag = func(tagID int64) ([]int64, error) {
+	rows, err := DB.Query("SELECT device_id FROM device_tags WHERE tag_id = ?", tagID)
+	if err != nil {
+		log.Printf("Error retrieving devices for tag %d: %v", tagID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deviceIDs []int64
+	for rows.Next() {
+		var deviceID int64
+		if err := rows.Scan(&deviceID); err != nil {
+			return nil, fmt.Errorf("error scanning device ID: %w", err)
+		}
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+
+	return deviceIDs, rows.Err()
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// GetApplicationStatusCounts counts the application instances per device and status
+var GetApplicationStatusCounts = func(deviceIDs []int64) (map[int64]map[string]int, error) {
+	counts := make(map[int64]map[string]int)
+	if len(deviceIDs) == 0 {
+		return counts, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT device_id, status, COUNT(*)
+		FROM application_instances
+		WHERE device_id IN (`+placeholders+`)
+		GROUP BY device_id, status
+	`, args...)
+	if err != nil {
+		log.Printf("Error counting application instances: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var status string
+		var count int
+		if err := rows.Scan(&deviceID, &status, &count); err != nil {
+			return nil, fmt.Errorf("error scanning application instance count: %w", err)
+		}
+		if counts[deviceID] == nil {
+			counts[deviceID] = make(map[string]int)
+		}
+		counts[deviceID][status] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetLastIncidents returns per device the last time it changed to a status other than online outside maintenance,
+// devices without an incident are left out
+var GetLastIncidents = func(deviceIDs []int64) (map[int64]time.Time, error) {
+	incidents := make(map[int64]time.Time)
+	if len(deviceIDs) == 0 {
+		return incidents, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT device_id, MAX(timestamp)
+		FROM device_status_history
+		WHERE device_id IN (`+placeholders+`) AND status <> 'online' AND in_maintenance = FALSE
+		GROUP BY device_id
+	`, args...)
+	if err != nil {
+		log.Printf("Error retrieving last incidents: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var timestamp string
+		if err := rows.Scan(&deviceID, &timestamp); err != nil {
+			return nil, fmt.Errorf("error scanning last incident: %w", err)
+		}
+		if incidents[deviceID], err = parseTimestamp(timestamp); err != nil {
+			return nil, err
+		}
+	}
+
+	return incidents, rows.Err()
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// InsertPerformanceMetricSamples stores reported samples for a device and updates the device's current metric
+func InsertPerformanceMetricSamples(deviceID int64, samples []structs.PerformanceMetricSample) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare("INSERT INTO performance_metric_samples (device_id, value, timestamp) VALUES (?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("error preparing sample insert: %w", err)
+	}
+	defer stmt.Close()
+
+	latest := samples[0]
+	for _, sample := range samples {
+		if _, err := stmt.Exec(deviceID, sample.Value, sample.Timestamp); err != nil {
+			log.Printf("Error inserting metric sample for device %d: %v", deviceID, err)
+			return err
+		}
+		if sample.Timestamp.After(latest.Timestamp) {
+			latest = sample
+		}
+	}
+
+	// Keep the current value on the device itself, the map and device list still read it from there
+	_, err = tx.Exec("UPDATE edge_devices SET performance_metric = ? WHERE id = ?", latest.Value, deviceID)
+	if err != nil {
+		return fmt.Errorf("error updating current performance metric: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// FetchPerformanceMetricBuckets returns min/max/avg per bucket for a device, combining raw samples and rolled up aggregates
+var FetchPerformanceMetricBuckets = func(deviceID int64, start, end time.Time, bucketSeconds int64) ([]structs.PerformanceMetricBucket, error) {
+	// Buckets are counted from the start of the range, so the result does not depend on the session time zone. The
+	// hourly aggregate the range starts in overlaps it and is counted in the first bucket.
+	query := `
+		SELECT
+			bucket,
+			MIN(min_value),
+			MAX(max_value),
+			SUM(value_sum) / SUM(sample_count),
+			SUM(sample_count)
+		FROM (
+			SELECT
+				FLOOR(TIMESTAMPDIFF(SECOND, ?, timestamp) / ?) AS bucket,
+				value AS min_value,
+				value AS max_value,
+				value AS value_sum,
+				1 AS sample_count
+			FROM performance_metric_samples
+			WHERE device_id = ? AND timestamp >= ? AND timestamp < ?
+			UNION ALL
+			SELECT
+				FLOOR(TIMESTAMPDIFF(SECOND, ?, GREATEST(bucket_start, ?)) / ?) AS bucket,
+				min_value,
+				max_value,
+				avg_value * sample_count AS value_sum,
+				sample_count
+			FROM performance_metric_aggregates
+			WHERE device_id = ? AND bucket_start > ? - INTERVAL 1 HOUR AND bucket_start < ?
+		) combined
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	rows, err := DB.Query(query,
+		start, bucketSeconds, deviceID, start, end,
+		start, start, bucketSeconds, deviceID, start, end,
+	)
+	if err != nil {
+		log.Printf("Error retrieving performance metrics for device %d: %v", deviceID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []structs.PerformanceMetricBucket
+	for rows.Next() {
+		var bucketIndex int64
+		var bucket structs.PerformanceMetricBucket
+		if err := rows.Scan(&bucketIndex, &bucket.Min, &bucket.Max, &bucket.Avg, &bucket.Count); err != nil {
+			return nil, fmt.Errorf("error scanning metric bucket: %w", err)
+		}
+		bucket.BucketStart = start.Add(time.Duration(bucketIndex*bucketSeconds) * time.Second)
+		buckets = append(buckets, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating metric buckets: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// RollupPerformanceMetricSamples rolls all raw samples older than cutoff into hourly aggregates and removes them
+func RollupPerformanceMetricSamples(cutoff time.Time) (int64, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Merge into an existing aggregate when part of the hour was already rolled up in an earlier run.
+	// avg_value is assigned first because MariaDB evaluates the assignments in order.
+	rollupQuery := `
+		INSERT INTO performance_metric_aggregates
+			(device_id, bucket_start, bucket_seconds, min_value, max_value, avg_value, sample_count)
+		SELECT
+			device_id,
+			DATE_FORMAT(timestamp, '%Y-%m-%d %H:00:00') AS hour_start,
+			3600,
+			MIN(value),
+			MAX(value),
+			AVG(value),
+			COUNT(*)
+		FROM performance_metric_samples
+		WHERE timestamp < ?
+		GROUP BY device_id, hour_start
+		ON DUPLICATE KEY UPDATE
+			avg_value = (avg_value * sample_count + VALUES(avg_value) * VALUES(sample_count)) / (sample_count + VALUES(sample_count)),
+			sample_count = sample_count + VALUES(sample_count),
+			min_value = LEAST(min_value, VALUES(min_value)),
+			max_value = GREATEST(max_value, VALUES(max_value))
+	`
+	if _, err := tx.Exec(rollupQuery, cutoff); err != nil {
+		return 0, fmt.Errorf("error rolling up metric samples: %w", err)
+	}
+
+	result, err := tx.Exec("DELETE FROM performance_metric_samples WHERE timestamp < ?", cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting rolled up metric samples: %w", err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return removed, tx.Commit()
+}
+
+// DeletePerformanceMetricAggregates removes aggregates that started before cutoff
+func DeletePerformanceMetricAggregates(cutoff time.Time) (int64, error) {
+	result, err := DB.Exec("DELETE FROM performance_metric_aggregates WHERE bucket_start < ?", cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting metric aggregates: %w", err)
+	}
+	return result.RowsAffected()
+}
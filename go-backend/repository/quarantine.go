@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// GetQuarantinedDeviceIDs returns which of the given devices are quarantined, or all quarantined devices when
+// deviceIDs is nil
+var GetQuarantinedDeviceIDs = func(deviceIDs []int64) ([]int64, error) {
+	devices, err := GetQuarantinedDevices(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(devices))
+	for i, device := range devices {
+		ids[i] = device.DeviceID
+	}
+	return ids, nil
+}
+
+// GetQuarantinedDevices retrieves the quarantined devices among the given devices, or all when deviceIDs is nil
+var GetQuarantinedDevices = func(deviceIDs []int64) ([]structs.QuarantinedDevice, error) {
+	query := `
+		SELECT id, name, quarantined_at, quarantined_by, COALESCE(quarantine_reason, '')
+		FROM edge_devices
+		WHERE quarantined_at IS NOT NULL
+	`
+	var args []interface{}
+	if deviceIDs != nil {
+		if len(deviceIDs) == 0 {
+			return nil, nil
+		}
+		var placeholders string
+		placeholders, args = int64Placeholders(deviceIDs)
+		query += " AND id IN (" + placeholders + ")"
+	}
+	query += " ORDER BY quarantined_at DESC, id"
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving quarantined devices: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []structs.QuarantinedDevice
+	for rows.Next() {
+		var device structs.QuarantinedDevice
+		var quarantinedAt string
+		var quarantinedBy sql.NullInt64
+		if err := rows.Scan(&device.DeviceID, &device.Name, &quarantinedAt, &quarantinedBy, &device.Reason); err != nil {
+			return nil, fmt.Errorf("error scanning quarantined device: %w", err)
+		}
+		if device.QuarantinedAt, err = parseTimestamp(quarantinedAt); err != nil {
+			return nil, err
+		}
+		if quarantinedBy.Valid {
+			device.QuarantinedBy = &quarantinedBy.Int64
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
+
+// QuarantineDevice isolates a device in one transaction: it is marked as quarantined, its device tokens and
+// certificates are revoked, its WireGuard peer is removed and pending commands other than allowedCommands are
+// cancelled. It returns false when the device was already quarantined.
+var QuarantineDevice = func(deviceID, meberID int64, reason string, allowedCommands []string, now time.Time) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Step 1: Mark the device, tokens issued before now are no longer accepted
+	result, err := tx.Exec(`
+		UPDATE edge_devices
+		SET quarantined_at = ?, quarantined_by = ?, quarantine_reason = ?, credentials_revoked_at = ?
+		WHERE id = ? AND quarantined_at IS NULL
+	`, now.UTC(), meberID, reason, now.UTC(), deviceID)
+	if err != nil {
+		return false, fmt.Errorf("error quarantining device %d: %w", deviceID, err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+	details := fmt.Sprintf("quarantined: %s", reason)
+	if err := insertAuditLog(tx, &meberID, &deviceID, "device_quarantined", details); err != nil {
+		return false, err
+	}
+
+	// Step 2: Revoke the certificates and take the device off the tunnel
+	if _, err := revokeDeviceCertificates(tx, deviceID, "", "key_compromise", &meberID, now); err != nil {
+		return false, err
+	}
+	result, err = tx.Exec("DELETE FROM wireguard_peers WHERE device_id = ?", deviceID)
+	if err != nil {
+		return false, fmt.Errorf("error removing WireGuard peer of device %d: %w", deviceID, err)
+	}
+	if removed, err := result.RowsAffected(); err == nil && removed > 0 {
+		if _, err := tx.Exec("UPDATE edge_devices SET ip_address = NULL WHERE id = ?", deviceID); err != nil {
+			return false, fmt.Errorf("error clearing tunnel address of device %d: %w", deviceID, err)
+		}
+		if err := insertAuditLog(tx, &meberID, &deviceID, "wireguard_peer_removed", "removed WireGuard peer on quarantine"); err != nil {
+			return false, err
+		}
+	}
+
+	// Step 3: Cancel pending commands that are not allowed in quarantine
+	query := "UPDATE device_commands SET status = 'cancelled', completed_at = ? WHERE device_id = ? AND status IN ('queued', 'sent')"
+	args := []interface{}{now.UTC(), deviceID}
+	if len(allowedCommands) > 0 {
+		placeholders, typeArgs := stringPlaceholders(allowedCommands)
+		query += " AND type NOT IN (" + placeholders + ")"
+		args = append(args, typeArgs...)
+	}
+	result, err = tx.Exec(query, args...)
+	if err != nil {
+		return false, fmt.Errorf("error cancelling commands of device %d: %w", deviceID, err)
+	}
+	if cancelled, err := result.RowsAffected(); err == nil && cancelled > 0 {
+		details := fmt.Sprintf("cancelled %d pending commands other than %s on quarantine", cancelled, strings.Join(allowedCommands, ", "))
+		if err := insertAuditLog(tx, &meberID, &deviceID, "command_cancelled", details); err != nil {
+			return false, err
+		}
+	}
+
+	return true, tx.Commit()
+}
+
+// ReleaseDeviceFromQuarantine lifts the quarantine of a device. Revoked credentials stay revoked, the device has
+// to enroll again. It returns false when the device was not quarantined.
+var ReleaseDeviceFromQuarantine = func(deviceID, meberID int64, reason string) (bool, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE edge_devices
+		SET quarantined_at = NULL, quarantined_by = NULL, quarantine_reason = NULL
+		WHERE id = ? AND quarantined_at IS NOT NULL
+	`, deviceID)
+	if err != nil {
+		return false, fmt.Errorf("error releasing device %d: %w", deviceID, err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+
+	details := fmt.Sprintf("released from quarantine: %s", reason)
+	if err := insertAuditLog(tx, &meberID, &deviceID, "device_released", details); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// GetDeviceCredentialsRevokedAt returns when the credentials of a device were last revoked, nil if never
+var GetDeviceCredentialsRevokedAt = func(deviceID int64) (*time.Time, error) {
+	var revokedAt sql.NullString
+	err := DB.QueryRow("SELECT credentials_revoked_at FROM edge_devices WHERE id = ?", deviceID).Scan(&revokedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credential revocation of device %d: %w", deviceID, err)
+	}
+	return parseNullTimestamp(revokedAt)
+}
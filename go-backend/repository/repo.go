@@ -17,8 +17,8 @@ func GetDeviceByID(deviceID int64) (structs.EdgeDevice, error) {
             status, 
             last_contact, 
             connection_type, 
-            ST_X(coordinates) AS latitude, 
-            ST_Y(coordinates) AS longitude, 
+            ST_Y(coordinates) AS latitude, 
+            ST_X(coordinates) AS longitude, 
             ip_address, 
             performance_metric 
         FROM edge_devices
@@ -32,8 +32,8 @@ func GetDeviceByID(deviceID int64) (structs.EdgeDevice, error) {
 		&device.Status,
 		&device.LastContact,
 		&device.ConnectionType,
-		&device.Longitude,
 		&device.Latitude,
+		&device.Longitude,
 		&device.IPAddress,
 		&device.PerformanceMetric,
 	)
@@ -53,8 +53,8 @@ func GetAllDevices() ([]structs.EdgeDevice, error) {
             status, 
             last_contact, 
             connection_type, 
-            ST_X(coordinates) AS latitude, 
-            ST_Y(coordinates) AS longitude, 
+            ST_Y(coordinates) AS latitude, 
+            ST_X(coordinates) AS longitude, 
             ip_address, 
             performance_metric 
         FROM edge_devices
@@ -78,8 +78,8 @@ func GetAllDevices() ([]structs.EdgeDevice, error) {
 			&device.Status,
 			&lastContactRaw,
 			&device.ConnectionType,
-			&device.Longitude,
 			&device.Latitude,
+			&device.Longitude,
 			&device.IPAddress,
 			&device.PerformanceMetric,
 		)
@@ -103,33 +103,50 @@ func GetAllDevices() ([]structs.EdgeDevice, error) {
 	return devices, nil
 }
 
-// TODO: Fix the lat long for the love of anything sane
-func GetAllDevicesForMap(meberID int64) ([]structs.EdgeDeviceMapResponse, error) {
-	// Define the base query (without the WHERE clause)
+// GetAllDevicesForMap retrieves the accessible devices that are in use, only those within the bounds when given
+var GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+	// The tag join is only there for the access rules, DISTINCT keeps it from returning a row per tag. The
+	// municipality comes from the location tag alone.
 	baseQuery := `
-		SELECT 
+		SELECT DISTINCT
 			ed.id, 
 			ed.name, 
 			ed.status, 
 			ed.last_contact, 
 			ed.connection_type, 
-			ST_X(ed.coordinates) AS latitude, 
-			ST_Y(ed.coordinates) AS longitude, 
+			ST_Y(ed.coordinates) AS latitude, 
+			ST_X(ed.coordinates) AS longitude, 
 			ed.ip_address, 
 			ed.performance_metric, 
-			tg.name AS municipality
+			loc.name AS municipality,
+			ed.quarantined_at IS NOT NULL AS quarantined
 		FROM edge_devices ed
 		LEFT JOIN device_tags dt ON ed.id = dt.device_id
 		LEFT JOIN tags tg ON dt.tag_id = tg.id
+		LEFT JOIN (
+			SELECT ldt.device_id, MIN(ltg.name) AS name
+			FROM device_tags ldt
+			JOIN tags ltg ON ldt.tag_id = ltg.id
+			WHERE ltg.type = 'location'
+			GROUP BY ldt.device_id
+		) loc ON ed.id = loc.device_id
+		WHERE ed.decommissioned_at IS NULL
 	`
 
+	// Only the devices inside the bounding box when one is given, using the spatial index
+	var args []interface{}
+	if bounds != nil {
+		baseQuery += " AND MBRContains(ST_GeomFromText(?), ed.coordinates)"
+		args = append(args, boundingBoxWKT(*bounds))
+	}
+
 	// Get the query with the necessary RBAC applied
 	query, err := applyRoleBasedAccess(meberID, baseQuery)
 	if err != nil {
 		return nil, fmt.Errorf("error applying role-based access: %w", err)
 	}
 
-	rows, err := DB.Query(query)
+	rows, err := DB.Query(query, args...)
 	if err != nil {
 		log.Printf("Error retrieving devices for map: %v", err)
 		return nil, err
@@ -141,6 +158,7 @@ func GetAllDevicesForMap(meberID int64) ([]structs.EdgeDeviceMapResponse, error)
 		var device structs.EdgeDevice
 		var lastContactRaw []uint8
 		var municipality sql.NullString
+		var quarantined bool
 
 		err := rows.Scan(
 			&device.ID,
@@ -148,11 +166,12 @@ func GetAllDevicesForMap(meberID int64) ([]structs.EdgeDeviceMapResponse, error)
 			&device.Status,
 			&lastContactRaw,
 			&device.ConnectionType,
-			&device.Longitude,
 			&device.Latitude,
+			&device.Longitude,
 			&device.IPAddress,
 			&device.PerformanceMetric,
 			&municipality,
+			&quarantined,
 		)
 		if err != nil {
 			log.Printf("Error scanning device: %v", err)
@@ -180,8 +199,8 @@ func GetAllDevicesForMap(meberID int64) ([]structs.EdgeDeviceMapResponse, error)
 			Name:         device.Name,
 			Status:       device.Status,
 			Municipality: municipalityName,
-			Latitude:     device.Latitude,
-			Longitude:    device.Longitude,
+			Coordinate:   device.Coordinate,
+			Quarantined:  quarantined,
 		}
 		devices = append(devices, mapResponse)
 	}
@@ -238,7 +257,7 @@ func GetApplicationInstancesByDeviceID(deviceID int64) ([]structs.ApplicationIns
 	return apps, nil
 }
 
-// Fetches all devices and their associated applications from the database
+// Fetches all devices in use and their associated applications from the database
 var GetAllDevicesWithApplications = func(meberID int64) ([]struct {
 	DeviceID             int64
 	DeviceName           string
@@ -262,14 +281,14 @@ var GetAllDevicesWithApplications = func(meberID int64) ([]struct {
 }, error) {
 	baseQuery := `
         SELECT 
-            d.id AS device_id, 
-            d.name AS device_name, 
-            d.status AS device_status, 
-            d.last_contact AS device_last_contact, 
-            d.connection_type AS device_connection_type, 
-            ST_X(d.coordinates) AS longitude, 
-            ST_Y(d.coordinates) AS latitude, 
-            d.ip_address AS device_ip_address,
+            ed.id AS device_id, 
+            ed.name AS device_name, 
+            ed.status AS device_status, 
+            ed.last_contact AS device_last_contact, 
+            ed.connection_type AS device_connection_type, 
+            ST_X(ed.coordinates) AS longitude, 
+            ST_Y(ed.coordinates) AS latitude, 
+            ed.ip_address AS device_ip_address,
             ai.id AS instance_id, 
             a.name AS app_name, 
             ai.status AS app_status, 
@@ -282,11 +301,11 @@ var GetAllDevicesWithApplications = func(meberID int64) ([]struct {
             tg.is_editable AS tag_is_editable,
             tg.owner_id AS tag_owner_id
         FROM 
-            edge_devices d
+            edge_devices ed
         LEFT JOIN 
             application_instances ai 
         ON 
-            d.id = ai.device_id
+            ed.id = ai.device_id
         LEFT JOIN 
             applications a 
         ON 
@@ -294,12 +313,14 @@ var GetAllDevicesWithApplications = func(meberID int64) ([]struct {
         LEFT JOIN 
             device_tags dt 
         ON 
-            d.id = dt.device_id
+            ed.id = dt.device_id
         LEFT JOIN 
             tags tg 
         ON 
             dt.tag_id = tg.id
-        ORDER BY d.id
+        WHERE
+            ed.decommissioned_at IS NULL
+        ORDER BY ed.id
     `
 
 	query, err := applyRoleBasedAccess(meberID, baseQuery)
@@ -376,7 +397,7 @@ var GetAllDevicesWithApplications = func(meberID int64) ([]struct {
 	return rawResults, nil
 }
 
-func GetMeberTags(meberID int64) ([]string, error) {
+var GetMeberTags = func(meberID int64) ([]string, error) {
 	query := `
 		SELECT DISTINCT tg.name
 		FROM meber_roles mr
@@ -454,9 +475,9 @@ var GetMeberByID = func(meberID int64) (*structs.Meber, error) {
 	return &meber, nil
 }
 
-func GetRolesForMeber(meberID int64) ([]structs.Role, error) {
+var GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
 	query := `
-		SELECT r.id, r.name, r.is_restricted
+		SELECT r.id, r.name, r.is_admin, r.is_restricted, r.access_area IS NOT NULL
 		FROM meber_roles mr
 		JOIN roles r ON mr.role_id = r.id
 		WHERE mr.meber_id = ?
@@ -471,7 +492,7 @@ func GetRolesForMeber(meberID int64) ([]structs.Role, error) {
 	var roles []structs.Role
 	for rows.Next() {
 		var role structs.Role
-		err := rows.Scan(&role.ID, &role.Name, &role.IsRestricted)
+		err := rows.Scan(&role.ID, &role.Name, &role.IsAdmin, &role.IsRestricted, &role.HasAccessArea)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning role: %w", err)
 		}
@@ -535,14 +556,15 @@ func GetAppStoreData() ([]structs.ApplicationWithSensors, error) {
 	return applications, nil
 }
 
-// GetDevicesByMeber retrieves devices that a meber has access to
-func GetDevicesByMeber(meberID int64) ([]structs.EdgeDevice, error) {
+// GetDevicesByMeber retrieves the devices in use that a meber has access to
+var GetDevicesByMeber = func(meberID int64) ([]structs.EdgeDevice, error) {
 	// Define the base query (without the WHERE clause)
 	baseQuery := `
-		SELECT ed.id, ed.name, ed.status, ed.last_contact, ed.connection_type, ST_X(ed.coordinates) AS latitude, ST_Y(ed.coordinates) AS longitude, ed.ip_address, ed.performance_metric, tg.name AS municipality
+		SELECT ed.id, ed.name, ed.status, ed.last_contact, ed.connection_type, ST_Y(ed.coordinates) AS latitude, ST_X(ed.coordinates) AS longitude, ed.ip_address, ed.performance_metric, tg.name AS municipality
 		FROM edge_devices ed
 		LEFT JOIN device_tags dt ON ed.id = dt.device_id
 		LEFT JOIN tags tg ON dt.tag_id = tg.id
+		WHERE ed.decommissioned_at IS NULL
 	`
 
 	// Apply role-based access control to the base query
@@ -618,14 +640,18 @@ func CheckDevicesEligibilityBulk(deviceIDs []int64, appID int64) ([]structs.Elig
 
 	inClause := strings.Join(placeholders, ",")
 
-	// Query for installed applications
+	// Query for installed applications, an application that is desired but not reported yet counts as installed
 	installedQuery := fmt.Sprintf(`
         SELECT device_id
         FROM application_instances
         WHERE app_id = ? AND device_id IN (%s)
-    `, inClause)
+        UNION
+        SELECT device_id
+        FROM device_desired_applications
+        WHERE app_id = ? AND device_id IN (%s)
+    `, inClause, inClause)
 
-	rows, err := DB.Query(installedQuery, args...)
+	rows, err := DB.Query(installedQuery, append(append([]interface{}{}, args...), args...)...)
 	if err != nil {
 		log.Printf("Error fetching installed applications for app %d: %v", appID, err)
 		return nil, err
@@ -730,16 +756,6 @@ func CheckDevicesEligibilityBulk(deviceIDs []int64, appID int64) ([]structs.Elig
 	return eligibleDevices, nil
 }
 
-func AddApplicationInstance(deviceID int64, appID int64) error {
-	query := "INSERT INTO application_instances (device_id, app_id, status, path) VALUES (?, ?, 'warning', ?)"
-	_, err := DB.Exec(query, deviceID, appID, "/path/to/newly created app")
-	if err != nil {
-		log.Printf("Error adding application instance for device %d and app %d: %v", deviceID, appID, err)
-		return err
-	}
-	return nil
-}
-
 // FetchLogs retrieves logs based on the given parameters
 func FetchLogs(deviceID, appInstanceID int64, startDate, endDate *time.Time) ([]structs.Log, error) {
 	// Base query
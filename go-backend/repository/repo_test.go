@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"main/structs"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubRows are the rows the stub driver returns, keyed by the selected column expression. Selecting a column that
+// isn't in a row fails the query, the same as an unknown column does in the database.
+var stubRows []map[string]driver.Value
+
+// stubQueries records the queries the stub driver ran
+var stubQueries []string
+
+type stubDriver struct{}
+type stubConn struct{}
+type stubStmt struct{ query string }
+type stubResult struct {
+	columns []string
+	next    int
+}
+
+func (stubDriver) Open(name string) (driver.Conn, error)   { return stubConn{}, nil }
+func (stubConn) Prepare(query string) (driver.Stmt, error) { return stubStmt{query: query}, nil }
+func (stubConn) Close() error                              { return nil }
+func (stubConn) Begin() (driver.Tx, error)                 { return nil, fmt.Errorf("transactions are not supported") }
+func (stubStmt) Close() error                              { return nil }
+func (stubStmt) NumInput() int                             { return -1 }
+func (stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("exec is not supported")
+}
+
+// Query returns the stub rows for the columns between SELECT and FROM
+func (s stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	query := strings.TrimSpace(s.query)
+	stubQueries = append(stubQueries, query)
+	from := strings.Index(query, "FROM")
+	if !strings.HasPrefix(query, "SELECT") || from < 0 {
+		return nil, fmt.Errorf("unsupported query: %s", query)
+	}
+	var columns []string
+	for _, column := range strings.Split(query[len("SELECT"):from], ",") {
+		column = strings.TrimSpace(column)
+		for _, row := range stubRows {
+			if _, ok := row[column]; !ok {
+				return nil, fmt.Errorf("unknown column %s", column)
+			}
+		}
+		columns = append(columns, column)
+	}
+	return &stubResult{columns: columns}, nil
+}
+
+func (r *stubResult) Columns() []string { return r.columns }
+func (r *stubResult) Close() error      { return nil }
+func (r *stubResult) Next(dest []driver.Value) error {
+	if r.next == len(stubRows) {
+		return io.EOF
+	}
+	for i, column := range r.columns {
+		dest[i] = stubRows[r.next][column]
+	}
+	r.next++
+	return nil
+}
+
+func init() {
+	sql.Register("stub", stubDriver{})
+}
+
+func TestGetRolesForMeber(t *testing.T) {
+	originalDB := DB
+	defer func() { DB = originalDB }()
+	var err error
+	if DB, err = sql.Open("stub", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stubRows = []map[string]driver.Value{
+		{"r.id": int64(1), "r.name": "admin", "r.is_admin": true, "r.is_restricted": false, "r.access_area IS NOT NULL": false},
+		{"r.id": int64(2), "r.name": "Delft", "r.is_admin": false, "r.is_restricted": true, "r.access_area IS NOT NULL": true},
+	}
+	roles, err := GetRolesForMeber(1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// requireAdmin relies on the admin flag being read
+	if len(roles) != 2 || !roles[0].IsAdmin || roles[0].IsRestricted {
+		t.Errorf("Expected the admin role to be read as admin, got %+v", roles)
+	}
+	if roles[1].IsAdmin || !roles[1].IsRestricted || !roles[1].HasAccessArea {
+		t.Errorf("Expected the municipality role to be read as restricted with an access area, got %+v", roles[1])
+	}
+}
+
+func TestGetDeviceIDsByFilterSkipsDecommissioned(t *testing.T) {
+	originalDB, originalGetRoles := DB, GetRolesForMeber
+	defer func() { DB, GetRolesForMeber = originalDB, originalGetRoles }()
+	var err error
+	if DB, err = sql.Open("stub", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 1, IsAdmin: true}}, nil
+	}
+
+	stubRows = []map[string]driver.Value{{"DISTINCT ed.id": int64(3)}}
+	for _, filter := range []structs.DeviceFilter{{}, {DeviceIDs: []int64{3}, Statuses: []string{"online"}}} {
+		stubQueries = nil
+		ids, err := GetDeviceIDsByFilter(1, filter)
+		if err != nil || len(ids) != 1 || ids[0] != 3 {
+			t.Fatalf("Expected device 3, got %v (%v)", ids, err)
+		}
+		// Every access check and export goes through this query, replaced units must never be part of it
+		if len(stubQueries) != 1 || !strings.Contains(stubQueries[0], "WHERE ed.decommissioned_at IS NULL") {
+			t.Errorf("Expected the query to leave out decommissioned devices, got %v", stubQueries)
+		}
+	}
+}
+
+func TestGetPlaybackDevicesExistingAtTheMoment(t *testing.T) {
+	originalDB, originalGetRoles := DB, GetRolesForMeber
+	defer func() { DB, GetRolesForMeber = originalDB, originalGetRoles }()
+	var err error
+	if DB, err = sql.Open("stub", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 1, IsAdmin: true}}, nil
+	}
+
+	stubRows = []map[string]driver.Value{
+		{"DISTINCT ed.id": int64(3), "ed.name": "MSR_3", "ST_Y(ed.coordinates)": 52.0116, "ST_X(ed.coordinates)": 4.3571, "loc.name": "Delft"},
+		{"DISTINCT ed.id": int64(4), "ed.name": "MSR_4", "ST_Y(ed.coordinates)": 52.1, "ST_X(ed.coordinates)": 5.1, "loc.name": nil},
+	}
+	stubQueries = nil
+	at := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	devices, err := GetPlaybackDevices(1, at, at)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(devices) != 2 || devices[0].Municipality != "Delft" || devices[0].Latitude != 52.0116 || devices[1].Municipality != "" {
+		t.Errorf("Expected both devices with their location, got %+v", devices)
+	}
+	// Devices created later or replaced before the moment weren't part of the fleet then
+	if len(stubQueries) != 1 || !strings.Contains(stubQueries[0], "ed.created_at <= ? AND (ed.decommissioned_at IS NULL OR ed.decommissioned_at > ?)") {
+		t.Errorf("Expected the query to only select the devices that existed, got %v", stubQueries)
+	}
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"main/geo"
+	"main/structs"
+)
+
+// GetRoleByID retrieves a single role, nil when it doesn't exist
+var GetRoleByID = func(roleID int64) (*structs.Role, error) {
+	var role structs.Role
+	var description sql.NullString
+	err := DB.QueryRow(`
+		SELECT id, name, description, is_admin, is_restricted, access_area IS NOT NULL
+		FROM roles WHERE id = ?
+	`, roleID).Scan(&role.ID, &role.Name, &description, &role.IsAdmin, &role.IsRestricted, &role.HasAccessArea)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving role %d: %w", roleID, err)
+	}
+	role.Description = description.String
+	return &role, nil
+}
+
+// GetRoleAccessArea retrieves the access area of a role as a GeoJSON geometry, empty when the role has none
+var GetRoleAccessArea = func(roleID int64) (string, error) {
+	var area sql.NullString
+	err := DB.QueryRow("SELECT ST_AsGeoJSON(access_area) FROM roles WHERE id = ?", roleID).Scan(&area)
+	if err != nil && err != sql.ErrNoRows {
+		return "", fmt.Errorf("error retrieving access area of role %d: %w", roleID, err)
+	}
+	return area.String, nil
+}
+
+// SetRoleAccessArea replaces the access area of a role, a nil area removes it
+var SetRoleAccessArea = func(roleID, meberID int64, area geo.MultiPolygon) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	action, details := "role_access_area_removed", fmt.Sprintf("access area of role %d removed", roleID)
+	if area == nil {
+		_, err = tx.Exec("UPDATE roles SET access_area = NULL WHERE id = ?", roleID)
+	} else {
+		_, err = tx.Exec("UPDATE roles SET access_area = ST_GeomFromText(?) WHERE id = ?", area.WKT(), roleID)
+		action, details = "role_access_area_updated", fmt.Sprintf("access area of role %d set to %d polygon(s)", roleID, len(area))
+	}
+	if err != nil {
+		return fmt.Errorf("error updating access area of role %d: %w", roleID, err)
+	}
+
+	if err := insertAuditLog(tx, &meberID, nil, action, details); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -2,10 +2,13 @@ package repository
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
-// applyRoleBasedAccess appends role-based access conditions to the provided base query.
+// applyRoleBasedAccess appends role-based access conditions to the provided base query. Restricted mebers see the
+// devices with one of their location tags and the devices inside the access area of one of their roles, the query
+// must name the devices ed and the tags tg.
 func applyRoleBasedAccess(meberID int64, baseQuery string) (string, error) {
 	// Fetch roles for the user
 	roles, err := GetRolesForMeber(meberID)
@@ -21,21 +24,38 @@ func applyRoleBasedAccess(meberID int64, baseQuery string) (string, error) {
 		}
 	}
 
+	// Roles with an access area grant the devices inside it
+	var areaRoleIDs []string
+	for _, role := range roles {
+		if role.HasAccessArea {
+			areaRoleIDs = append(areaRoleIDs, strconv.FormatInt(role.ID, 10))
+		}
+	}
+
 	// Fetch tags for the meber if all roles are restricted
 	meberTags, err := GetMeberTags(meberID)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch tags for meber: %w", err)
 	}
 
-	// If no tags are found, restrict access to nothing
-	if len(meberTags) == 0 {
+	// If no tags or areas are found, restrict access to nothing
+	if len(meberTags) == 0 && len(areaRoleIDs) == 0 {
 		// Append an always-false condition to the base query
 		return insertWhereClause(baseQuery, "1 = 0"), nil
 	}
 
-	// Generate the access condition based on the tags
-	queryTags := "'" + strings.Join(meberTags, "','") + "'"
-	accessClause := fmt.Sprintf("tg.name IN (%s) AND tg.type = 'location'", queryTags)
+	// Generate the access condition based on the tags, combined with the access areas
+	var accessConditions []string
+	if len(meberTags) > 0 {
+		queryTags := "'" + strings.Join(meberTags, "','") + "'"
+		accessConditions = append(accessConditions, fmt.Sprintf("(tg.name IN (%s) AND tg.type = 'location')", queryTags))
+	}
+	if len(areaRoleIDs) > 0 {
+		accessConditions = append(accessConditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM roles ar WHERE ar.id IN (%s) AND ST_Contains(ar.access_area, ed.coordinates))",
+			strings.Join(areaRoleIDs, ",")))
+	}
+	accessClause := "(" + strings.Join(accessConditions, " OR ") + ")"
 
 	// Append the RBAC access clause to the base query
 	return insertWhereClause(baseQuery, accessClause), nil
@@ -72,3 +92,26 @@ func insertWhereClause(query string, condition string) string {
 		return fmt.Sprintf("%s WHERE %s", query, condition)
 	}
 }
+
+// MeberHasDeviceAccess reports whether the meber is allowed to see the given device, decommissioned devices included
+var MeberHasDeviceAccess = func(meberID int64, deviceID int64) (bool, error) {
+	baseQuery := `
+		SELECT COUNT(*)
+		FROM edge_devices ed
+		LEFT JOIN device_tags dt ON ed.id = dt.device_id
+		LEFT JOIN tags tg ON dt.tag_id = tg.id
+		WHERE ed.id = ?
+	`
+
+	query, err := applyRoleBasedAccess(meberID, baseQuery)
+	if err != nil {
+		return false, fmt.Errorf("error applying role-based access: %w", err)
+	}
+
+	var count int
+	if err := DB.QueryRow(query, deviceID).Scan(&count); err != nil {
+		return false, fmt.Errorf("error checking device access: %w", err)
+	}
+
+	return count > 0, nil
+}
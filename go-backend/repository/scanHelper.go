@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+)
+
+// timestampLayout is the format MariaDB returns TIMESTAMP columns in, the DSN does not use parseTime
+const timestampLayout = "2006-01-02 15:04:05"
+
+// parseTimestamp converts a scanned TIMESTAMP column to time.Time
+func parseTimestamp(raw string) (time.Time, error) {
+	return time.Parse(timestampLayout, raw)
+}
+
+// parseNullTimestamp converts a nullable TIMESTAMP column, returning nil for NULL
+func parseNullTimestamp(raw sql.NullString) (*time.Time, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	parsed, err := parseTimestamp(raw.String)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"main/structs"
+	"math"
+)
+
+// metersPerDegreeLatitude is the length of one degree of latitude, close enough everywhere for an envelope
+const metersPerDegreeLatitude = 111320.0
+
+// boundingBoxWKT gives the bounding box as a WKT polygon in the (longitude latitude) axis order of the coordinates
+// column, for use with ST_GeomFromText
+func boundingBoxWKT(bounds structs.BoundingBox) string {
+	return fmt.Sprintf("POLYGON((%[1]f %[2]f, %[3]f %[2]f, %[3]f %[4]f, %[1]f %[4]f, %[1]f %[2]f))",
+		bounds.MinLongitude, bounds.MinLatitude, bounds.MaxLongitude, bounds.MaxLatitude)
+}
+
+// envelopeAround returns a bounding box that contains the circle of radiusMeters around center, so a radius query
+// can narrow down the candidates with the spatial index before computing exact distances
+func envelopeAround(center structs.Coordinate, radiusMeters float64) structs.BoundingBox {
+	latitudeDelta := radiusMeters / metersPerDegreeLatitude
+	longitudeDelta := 180.0
+	if cos := math.Cos(center.Latitude * math.Pi / 180); cos > 0.01 {
+		longitudeDelta = math.Min(180, latitudeDelta/cos)
+	}
+	return structs.BoundingBox{
+		MinLongitude: math.Max(-180, center.Longitude-longitudeDelta),
+		MinLatitude:  math.Max(-90, center.Latitude-latitudeDelta),
+		MaxLongitude: math.Min(180, center.Longitude+longitudeDelta),
+		MaxLatitude:  math.Min(90, center.Latitude+latitudeDelta),
+	}
+}
+
+// GetDevicesInBoundingBox retrieves the accessible devices in use within the bounding box
+var GetDevicesInBoundingBox = func(meberID int64, bounds structs.BoundingBox) ([]structs.NearbyDevice, error) {
+	return queryNearbyDevices(meberID, nil, "MBRContains(ST_GeomFromText(?), ed.coordinates)", "ed.id", boundingBoxWKT(bounds))
+}
+
+// GetDevicesWithinRadius retrieves the accessible devices in use within radiusMeters of center, closest first
+var GetDevicesWithinRadius = func(meberID int64, center structs.Coordinate, radiusMeters float64) ([]structs.NearbyDevice, error) {
+	return queryNearbyDevices(meberID, &center,
+		"MBRContains(ST_GeomFromText(?), ed.coordinates) AND ST_Distance_Sphere(ed.coordinates, POINT(?, ?)) <= ?",
+		"distance, ed.id",
+		boundingBoxWKT(envelopeAround(center, radiusMeters)), center.Longitude, center.Latitude, radiusMeters)
+}
+
+// GetNearestDevices retrieves the limit accessible devices in use closest to center that lie within radiusMeters,
+// closest first. A radius of 0 searches all devices, which can't use the spatial index.
+var GetNearestDevices = func(meberID int64, center structs.Coordinate, limit int, radiusMeters float64) ([]structs.NearbyDevice, error) {
+	condition, args := "1 = 1", []interface{}{}
+	if radiusMeters > 0 {
+		condition = "MBRContains(ST_GeomFromText(?), ed.coordinates) AND ST_Distance_Sphere(ed.coordinates, POINT(?, ?)) <= ?"
+		args = append(args, boundingBoxWKT(envelopeAround(center, radiusMeters)), center.Longitude, center.Latitude, radiusMeters)
+	}
+	return queryNearbyDevices(meberID, &center, condition, fmt.Sprintf("distance, ed.id LIMIT %d", limit), args...)
+}
+
+// queryNearbyDevices retrieves the accessible devices in use matching the condition. With a center the distance to
+// it in meters is selected as distance, which the ordering can use.
+func queryNearbyDevices(meberID int64, center *structs.Coordinate, condition string, ordering string, args ...interface{}) ([]structs.NearbyDevice, error) {
+	distance := "NULL"
+	var selectArgs []interface{}
+	if center != nil {
+		distance = "ST_Distance_Sphere(ed.coordinates, POINT(?, ?))"
+		selectArgs = []interface{}{center.Longitude, center.Latitude}
+	}
+
+	// DISTINCT because the tag join for the access rules gives a row per tag
+	baseQuery := `
+		SELECT DISTINCT ed.id, ed.name, ed.status, ST_Y(ed.coordinates) AS latitude, ST_X(ed.coordinates) AS longitude,
+			` + distance + ` AS distance
+		FROM edge_devices ed
+		LEFT JOIN device_tags dt ON ed.id = dt.device_id
+		LEFT JOIN tags tg ON dt.tag_id = tg.id
+		WHERE ed.decommissioned_at IS NULL AND ` + condition + `
+		ORDER BY ` + ordering
+
+	query, err := applyRoleBasedAccess(meberID, baseQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error applying role-based access: %w", err)
+	}
+
+	rows, err := DB.Query(query, append(selectArgs, args...)...)
+	if err != nil {
+		log.Printf("Error retrieving devices by location: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	devices := []structs.NearbyDevice{}
+	for rows.Next() {
+		var device structs.NearbyDevice
+		if err := rows.Scan(&device.ID, &device.Name, &device.Status, &device.Latitude, &device.Longitude, &device.DistanceMeters); err != nil {
+			return nil, fmt.Errorf("error scanning device location: %w", err)
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+// GetPlaybackDevices retrieves the accessible devices that existed at some moment between from and to, decommissioned
+// devices included. Only the location of the devices is filled in, their status comes from the status history.
+var GetPlaybackDevices = func(meberID int64, from, to time.Time) ([]structs.PlaybackDevice, error) {
+	// The tag join is only there for the access rules, the municipality comes from the location tag alone
+	baseQuery := `
+		SELECT DISTINCT ed.id, ed.name, ST_Y(ed.coordinates), ST_X(ed.coordinates), loc.name
+		FROM edge_devices ed
+		LEFT JOIN device_tags dt ON ed.id = dt.device_id
+		LEFT JOIN tags tg ON dt.tag_id = tg.id
+		LEFT JOIN (
+			SELECT ldt.device_id, MIN(ltg.name) AS name
+			FROM device_tags ldt
+			JOIN tags ltg ON ldt.tag_id = ltg.id
+			WHERE ltg.type = 'location'
+			GROUP BY ldt.device_id
+		) loc ON ed.id = loc.device_id
+		WHERE ed.created_at <= ? AND (ed.decommissioned_at IS NULL OR ed.decommissioned_at > ?)
+		ORDER BY ed.id
+	`
+
+	query, err := applyRoleBasedAccess(meberID, baseQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error applying role-based access: %w", err)
+	}
+
+	rows, err := DB.Query(query, to, from)
+	if err != nil {
+		log.Printf("Error retrieving devices between %s and %s: %v", from, to, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	devices := []structs.PlaybackDevice{}
+	for rows.Next() {
+		var device structs.PlaybackDevice
+		var municipality sql.NullString
+		if err := rows.Scan(&device.ID, &device.Name, &device.Latitude, &device.Longitude, &municipality); err != nil {
+			return nil, fmt.Errorf("error scanning device: %w", err)
+		}
+		device.Municipality = municipality.String
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
+
+// GetDeviceStatusesAt returns per device the status it had at the given moment according to the status history.
+// That is the status of the last change at or before the moment, or the previous status of the first change after
+// it. Devices without any recorded change around the moment are left out.
+var GetDeviceStatusesAt = func(deviceIDs []int64, at time.Time) (map[int64]string, error) {
+	statuses := make(map[int64]string)
+	if len(deviceIDs) == 0 {
+		return statuses, nil
+	}
+
+	// The history is appended in order, so the highest id before the moment is the latest change
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT h.device_id, h.status
+		FROM device_status_history h
+		JOIN (
+			SELECT MAX(id) AS id
+			FROM device_status_history
+			WHERE device_id IN (`+placeholders+`) AND timestamp <= ?
+			GROUP BY device_id
+		) latest ON latest.id = h.id
+	`, append(args, at)...)
+	if err != nil {
+		log.Printf("Error retrieving device statuses at %s: %v", at, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var status string
+		if err := rows.Scan(&deviceID, &status); err != nil {
+			return nil, fmt.Errorf("error scanning device status: %w", err)
+		}
+		statuses[deviceID] = status
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = DB.Query(`
+		SELECT h.device_id, h.previous_status
+		FROM device_status_history h
+		JOIN (
+			SELECT MIN(id) AS id
+			FROM device_status_history
+			WHERE device_id IN (`+placeholders+`) AND timestamp > ?
+			GROUP BY device_id
+		) earliest ON earliest.id = h.id
+		WHERE h.previous_status IS NOT NULL
+	`, append(args, at)...)
+	if err != nil {
+		log.Printf("Error retrieving device statuses after %s: %v", at, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceID int64
+		var previousStatus string
+		if err := rows.Scan(&deviceID, &previousStatus); err != nil {
+			return nil, fmt.Errorf("error scanning previous device status: %w", err)
+		}
+		if _, ok := statuses[deviceID]; !ok {
+			statuses[deviceID] = previousStatus
+		}
+	}
+
+	return statuses, rows.Err()
+}
+
+// GetStatusChanges returns the status changes of the devices in [from, to) in the order they happened, at most
+// limit changes
+var GetStatusChanges = func(deviceIDs []int64, from, to time.Time, limit int) ([]structs.StatusChange, error) {
+	changes := []structs.StatusChange{}
+	if len(deviceIDs) == 0 {
+		return changes, nil
+	}
+
+	placeholders, args := int64Placeholders(deviceIDs)
+	rows, err := DB.Query(`
+		SELECT device_id, previous_status, status, in_maintenance, timestamp
+		FROM device_status_history
+		WHERE device_id IN (`+placeholders+`) AND timestamp >= ? AND timestamp < ?
+		ORDER BY timestamp, id
+		LIMIT ?
+	`, append(args, from, to, limit)...)
+	if err != nil {
+		log.Printf("Error retrieving status changes: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change structs.StatusChange
+		var previousStatus sql.NullString
+		var timestamp string
+		if err := rows.Scan(&change.DeviceID, &previousStatus, &change.Status, &change.InMaintenance, &timestamp); err != nil {
+			return nil, fmt.Errorf("error scanning status change: %w", err)
+		}
+		if previousStatus.Valid {
+			change.PreviousStatus = &previousStatus.String
+		}
+		if change.Timestamp, err = parseTimestamp(timestamp); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"main/structs"
+	"time"
+)
+
+const wireGuardPeerColumns = `device_id, address, public_key, created_at, latest_handshake, COALESCE(endpoint, ''),
+	transfer_rx, transfer_tx`
+
+// scanWireGuardPeer scans a wireguard_peers row selected with wireGuardPeerColumns
+func scanWireGuardPeer(scanner interface{ Scan(...interface{}) error }) (structs.WireGuardPeer, error) {
+	var peer structs.WireGuardPeer
+	var createdAt string
+	var latestHandshake sql.NullString
+
+	err := scanner.Scan(&peer.DeviceID, &peer.Address, &peer.PublicKey, &createdAt, &latestHandshake, &peer.Endpoint,
+		&peer.TransferRx, &peer.TransferTx)
+	if err != nil {
+		return structs.WireGuardPeer{}, err
+	}
+	if peer.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return structs.WireGuardPeer{}, err
+	}
+	if peer.LatestHandshake, err = parseNullTimestamp(latestHandshake); err != nil {
+		return structs.WireGuardPeer{}, err
+	}
+	return peer, nil
+}
+
+// GetWireGuardHubKeys retrieves the key pair of the hub, empty strings when none was generated yet
+var GetWireGuardHubKeys = func() (string, string, error) {
+	var privateKey, publicKey string
+	err := DB.QueryRow("SELECT private_key, public_key FROM wireguard_hub WHERE id = 1").Scan(&privateKey, &publicKey)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("error retrieving WireGuard hub keys: %w", err)
+	}
+	return privateKey, publicKey, nil
+}
+
+// InsertWireGuardHubKeys stores the hub key pair unless another request stored one first
+var InsertWireGuardHubKeys = func(privateKey, publicKey string) error {
+	_, err := DB.Exec("INSERT IGNORE INTO wireguard_hub (id, private_key, public_key) VALUES (1, ?, ?)", privateKey, publicKey)
+	if err != nil {
+		return fmt.Errorf("error storing WireGuard hub keys: %w", err)
+	}
+	return nil
+}
+
+// UpsertWireGuardPeer stores a new public key for a device. A device that has no peer yet gets the address picked by
+// allocate from the addresses in use; the peers are locked meanwhile so two devices can't get the same address.
+// The tunnel address is mirrored into edge_devices.ip_address.
+var UpsertWireGuardPeer = func(deviceID int64, publicKey string, allocate func(used []string) (string, error)) (structs.WireGuardPeer, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return structs.WireGuardPeer{}, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query("SELECT device_id, address FROM wireguard_peers FOR UPDATE")
+	if err != nil {
+		return structs.WireGuardPeer{}, fmt.Errorf("error locking WireGuard peers: %w", err)
+	}
+	var used []string
+	address := ""
+	for rows.Next() {
+		var peerDeviceID int64
+		var peerAddress string
+		if err := rows.Scan(&peerDeviceID, &peerAddress); err != nil {
+			rows.Close()
+			return structs.WireGuardPeer{}, fmt.Errorf("error scanning WireGuard peer: %w", err)
+		}
+		if peerDeviceID == deviceID {
+			address = peerAddress
+		}
+		used = append(used, peerAddress)
+	}
+	rows.Close()
+
+	if address == "" {
+		// New peer: allocate an address
+		if address, err = allocate(used); err != nil {
+			return structs.WireGuardPeer{}, err
+		}
+		_, err = tx.Exec("INSERT INTO wireguard_peers (device_id, address, public_key, created_at) VALUES (?, ?, ?, ?)",
+			deviceID, address, publicKey, time.Now().UTC())
+	} else {
+		// Existing peer: rotate the key, the old handshake belongs to the old key
+		_, err = tx.Exec(`
+			UPDATE wireguard_peers
+			SET public_key = ?, latest_handshake = NULL, endpoint = NULL, transfer_rx = 0, transfer_tx = 0
+			WHERE device_id = ?
+		`, publicKey, deviceID)
+	}
+	if err != nil {
+		return structs.WireGuardPeer{}, fmt.Errorf("error storing WireGuard peer of device %d: %w", deviceID, err)
+	}
+
+	if _, err := tx.Exec("UPDATE edge_devices SET ip_address = ? WHERE id = ?", address, deviceID); err != nil {
+		return structs.WireGuardPeer{}, fmt.Errorf("error updating tunnel address of device %d: %w", deviceID, err)
+	}
+
+	peer, err := scanWireGuardPeer(tx.QueryRow("SELECT "+wireGuardPeerColumns+" FROM wireguard_peers WHERE device_id = ?", deviceID))
+	if err != nil {
+		return structs.WireGuardPeer{}, fmt.Errorf("error retrieving WireGuard peer of device %d: %w", deviceID, err)
+	}
+
+	return peer, tx.Commit()
+}
+
+// GetWireGuardPeers retrieves the peers of the given devices, or all peers when deviceIDs is nil
+var GetWireGuardPeers = func(deviceIDs []int64) ([]structs.WireGuardPeer, error) {
+	query := "SELECT " + wireGuardPeerColumns + " FROM wireguard_peers"
+	var args []interface{}
+	if deviceIDs != nil {
+		if len(deviceIDs) == 0 {
+			return nil, nil
+		}
+		var placeholders string
+		placeholders, args = int64Placeholders(deviceIDs)
+		query += " WHERE device_id IN (" + placeholders + ")"
+	}
+	query += " ORDER BY device_id"
+
+	rows, err := DB.Query(query, args...)
+	if err != nil {
+		log.Printf("Error retrieving WireGuard peers: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var peers []structs.WireGuardPeer
+	for rows.Next() {
+		peer, err := scanWireGuardPeer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning WireGuard peer: %w", err)
+		}
+		peers = append(peers, peer)
+	}
+
+	return peers, rows.Err()
+}
+
+// UpdateWireGuardHandshakes stores the handshake state reported by the hub and marks devices with a newer
+// handshake as contacted. It returns the number of peers whose state changed.
+var UpdateWireGuardHandshakes = func(handshakes []structs.WireGuardHandshake) (int, error) {
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated := 0
+	for _, handshake := range handshakes {
+		var latest interface{}
+		if handshake.LatestHandshake > 0 {
+			latest = time.Unix(handshake.LatestHandshake, 0).UTC()
+		}
+		result, err := tx.Exec(`
+			UPDATE wireguard_peers
+			SET latest_handshake = COALESCE(?, latest_handshake), endpoint = ?, transfer_rx = ?, transfer_tx = ?
+			WHERE public_key = ?
+		`, latest, nullableString(handshake.Endpoint), handshake.TransferRx, handshake.TransferTx, handshake.PublicKey)
+		if err != nil {
+			return 0, fmt.Errorf("error storing WireGuard handshake: %w", err)
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected > 0 {
+			updated++
+		}
+
+		if latest != nil {
+			_, err := tx.Exec(`
+				UPDATE edge_devices ed JOIN wireguard_peers wp ON ed.id = wp.device_id
+				SET ed.last_contact = ?
+				WHERE wp.public_key = ? AND (ed.last_contact IS NULL OR ed.last_contact < ?)
+			`, latest, handshake.PublicKey, latest)
+			if err != nil {
+				return 0, fmt.Errorf("error updating last contact from WireGuard handshake: %w", err)
+			}
+		}
+	}
+
+	return updated, tx.Commit()
+}
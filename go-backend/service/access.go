@@ -0,0 +1,20 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+)
+
+// requireAdmin verifies that the meber holds an admin role
+func requireAdmin(meberID int64) error {
+	roles, err := repository.GetRolesForMeber(meberID)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.IsAdmin {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: admin role required", ErrAccessDenied)
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"log"
+	"main/geo"
+	"main/repository"
+	"main/structs"
+	"sort"
+	"time"
+)
+
+const (
+	// OutageWindow is how far back devices that went offline are clustered into outages
+	OutageWindow = 15 * time.Minute
+	// OutageRadiusMeters is the distance within which offline devices are neighbours
+	OutageRadiusMeters = 2000
+	// OutageTimeSpread is the time within which neighbouring devices have to go offline to be part of one outage
+	OutageTimeSpread = 10 * time.Minute
+	// OutageMinDevices is the number of neighbouring offline devices, the device itself included, that make an
+	// outage. An outage is resolved once fewer of its devices are offline.
+	OutageMinDevices = 3
+
+	// outageBufferMeters widens the area of an outage around its outermost devices
+	outageBufferMeters = 250
+)
+
+// DetectAreaOutages clusters the devices that went offline recently with DBSCAN, over their distance and the time
+// between them going offline. A cluster that shares a device with an active outage extends it, other clusters
+// raise a new outage. Active outages with fewer than OutageMinDevices offline devices are resolved.
+func DetectAreaOutages(now time.Time) error {
+	now = now.UTC().Truncate(time.Second)
+
+	offline, err := repository.GetRecentlyOfflineDevices(now.Add(-OutageWindow))
+	if err != nil {
+		return err
+	}
+	active, err := repository.GetActiveAreaOutages()
+	if err != nil {
+		return err
+	}
+
+	// Step 1: Resolve outages whose devices came back
+	var ongoing []structs.AreaOutage
+	for _, outage := range active {
+		if outage.OfflineCount < OutageMinDevices {
+			if err := repository.ResolveAreaOutage(outage.ID, now); err != nil {
+				return err
+			}
+			continue
+		}
+		ongoing = append(ongoing, outage)
+	}
+	outageOf := make(map[int64]int)
+	for i, outage := range ongoing {
+		for _, device := range outage.Devices {
+			outageOf[device.DeviceID] = i
+		}
+	}
+
+	// Step 2: Extend an ongoing outage with the devices of an overlapping cluster, or raise a new one
+	for _, cluster := range clusterOfflineDevices(offline) {
+		existing := -1
+		for _, device := range cluster {
+			if i, ok := outageOf[device.DeviceID]; ok && (existing == -1 || i < existing) {
+				existing = i
+			}
+		}
+
+		if existing == -1 {
+			deviceIDs := make([]int64, 0, len(cluster))
+			coordinates := make([]structs.Coordinate, 0, len(cluster))
+			for _, device := range cluster {
+				deviceIDs = append(deviceIDs, device.DeviceID)
+				coordinates = append(coordinates, device.Coordinate)
+			}
+			// Clusters are ordered by when their devices went offline
+			outage := structs.AreaOutage{StartedAt: cluster[0].OfflineSince, DetectedAt: now, Area: outageArea(coordinates)}
+			if _, err := repository.InsertAreaOutage(outage, deviceIDs); err != nil {
+				return err
+			}
+			continue
+		}
+
+		outage := &ongoing[existing]
+		var added []int64
+		for _, device := range cluster {
+			if _, ok := outageOf[device.DeviceID]; !ok {
+				added = append(added, device.DeviceID)
+				outageOf[device.DeviceID] = existing
+				outage.Devices = append(outage.Devices, structs.AreaOutageDevice{DeviceID: device.DeviceID, Name: device.Name, Status: "offline", Coordinate: device.Coordinate})
+			}
+		}
+		if len(added) == 0 {
+			continue
+		}
+		coordinates := make([]structs.Coordinate, 0, len(outage.Devices))
+		for _, device := range outage.Devices {
+			coordinates = append(coordinates, device.Coordinate)
+		}
+		if err := repository.ExtendAreaOutage(outage.ID, outageArea(coordinates), added); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunOutageDetection looks for area outages on every tick of the given interval
+func RunOutageDetection(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := DetectAreaOutages(time.Now()); err != nil {
+			log.Printf("Error detecting area outages: %v", err)
+		}
+	}
+}
+
+// GetActiveAreaOutages returns the active outages affecting devices the meber has access to, listing only those
+// devices
+func GetActiveAreaOutages(meberID int64) ([]structs.AreaOutage, error) {
+	outages, err := repository.GetActiveAreaOutages()
+	if err != nil {
+		return nil, err
+	}
+
+	var deviceIDs []int64
+	for _, outage := range outages {
+		for _, device := range outage.Devices {
+			deviceIDs = append(deviceIDs, device.DeviceID)
+		}
+	}
+	visible := []structs.AreaOutage{}
+	if len(deviceIDs) == 0 {
+		return visible, nil
+	}
+	// An empty ID list would match every accessible device
+	accessibleIDs, err := repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{DeviceIDs: deviceIDs})
+	if err != nil {
+		return nil, err
+	}
+	accessible := idSet(accessibleIDs)
+
+	for _, outage := range outages {
+		devices := []structs.AreaOutageDevice{}
+		for _, device := range outage.Devices {
+			if accessible[device.DeviceID] {
+				devices = append(devices, device)
+			}
+		}
+		if len(devices) == 0 {
+			continue
+		}
+		outage.Devices = devices
+		visible = append(visible, outage)
+	}
+	return visible, nil
+}
+
+// clusterOfflineDevices groups the devices with DBSCAN. Two devices are neighbours when they are within
+// OutageRadiusMeters of each other and went offline within OutageTimeSpread of each other, a device with at least
+// OutageMinDevices - 1 neighbours starts or grows a cluster. Devices in no cluster are left out.
+func clusterOfflineDevices(devices []structs.OfflineDevice) [][]structs.OfflineDevice {
+	neighbours := func(i int) []int {
+		var found []int
+		for j := range devices {
+			if i == j {
+				continue
+			}
+			spread := devices[i].OfflineSince.Sub(devices[j].OfflineSince)
+			if spread < 0 {
+				spread = -spread
+			}
+			if spread <= OutageTimeSpread && geo.Distance(devices[i].Coordinate, devices[j].Coordinate) <= OutageRadiusMeters {
+				found = append(found, j)
+			}
+		}
+		return found
+	}
+
+	const noise = -1
+	labels := make([]int, len(devices)) // 0 until visited, then noise or the cluster number
+	clusters := 0
+	for i := range devices {
+		if labels[i] != 0 {
+			continue
+		}
+		queue := neighbours(i)
+		if len(queue)+1 < OutageMinDevices {
+			labels[i] = noise
+			continue
+		}
+
+		clusters++
+		labels[i] = clusters
+		for k := 0; k < len(queue); k++ {
+			j := queue[k]
+			if labels[j] == noise {
+				// A border device joins the cluster but does not grow it
+				labels[j] = clusters
+			}
+			if labels[j] != 0 {
+				continue
+			}
+			labels[j] = clusters
+			if next := neighbours(j); len(next)+1 >= OutageMinDevices {
+				queue = append(queue, next...)
+			}
+		}
+	}
+
+	grouped := make([][]structs.OfflineDevice, clusters)
+	for i, label := range labels {
+		if label > 0 {
+			grouped[label-1] = append(grouped[label-1], devices[i])
+		}
+	}
+	for _, cluster := range grouped {
+		sort.Slice(cluster, func(i, j int) bool { return cluster[i].OfflineSince.Before(cluster[j].OfflineSince) })
+	}
+	return grouped
+}
+
+// outageArea returns the GeoJSON Polygon around the devices of an outage
+func outageArea(coordinates []structs.Coordinate) structs.Geometry {
+	return structs.Geometry{Type: "Polygon", Coordinates: [][][2]float64{geo.BufferedHull(coordinates, outageBufferMeters)}}
+}
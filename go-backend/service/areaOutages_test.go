@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestDetectAreaOutages(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalOffline := repository.GetRecentlyOfflineDevices
+	originalActive := repository.GetActiveAreaOutages
+	originalInsert := repository.InsertAreaOutage
+	originalExtend := repository.ExtendAreaOutage
+	originalResolve := repository.ResolveAreaOutage
+	defer func() {
+		repository.GetRecentlyOfflineDevices = originalOffline
+		repository.GetActiveAreaOutages = originalActive
+		repository.InsertAreaOutage = originalInsert
+		repository.ExtendAreaOutage = originalExtend
+		repository.ResolveAreaOutage = originalResolve
+	}()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(id int64, latitude, longitude float64, minutesAgo int) structs.OfflineDevice {
+		return structs.OfflineDevice{
+			DeviceID:     id,
+			Coordinate:   structs.Coordinate{Latitude: latitude, Longitude: longitude},
+			OfflineSince: now.Add(-time.Duration(minutesAgo) * time.Minute),
+		}
+	}
+	// Four devices in the centre of Utrecht going offline within minutes, a device in Utrecht that went offline
+	// long before them, a single device in Delft and two in Rotterdam
+	offline := []structs.OfflineDevice{
+		at(1, 52.0907, 5.1214, 6), at(2, 52.0930, 5.1180, 5), at(3, 52.0880, 5.1250, 5), at(4, 52.0950, 5.1300, 4),
+		at(5, 52.0910, 5.1220, 30),
+		at(6, 52.0116, 4.3571, 3),
+		at(7, 51.9225, 4.4792, 2), at(8, 51.9230, 4.4800, 2),
+	}
+	repository.GetRecentlyOfflineDevices = func(since time.Time) ([]structs.OfflineDevice, error) {
+		return offline, nil
+	}
+	var active []structs.AreaOutage
+	repository.GetActiveAreaOutages = func() ([]structs.AreaOutage, error) {
+		return active, nil
+	}
+	var inserted []structs.AreaOutage
+	var insertedDevices [][]int64
+	repository.InsertAreaOutage = func(outage structs.AreaOutage, deviceIDs []int64) (int64, error) {
+		inserted = append(inserted, outage)
+		insertedDevices = append(insertedDevices, deviceIDs)
+		return int64(len(inserted)), nil
+	}
+	var extended []int64
+	repository.ExtendAreaOutage = func(outageID int64, area structs.Geometry, deviceIDs []int64) error {
+		extended = append(extended, deviceIDs...)
+		return nil
+	}
+	var resolved []int64
+	repository.ResolveAreaOutage = func(outageID int64, now time.Time) error {
+		resolved = append(resolved, outageID)
+		return nil
+	}
+
+	if err := service.DetectAreaOutages(now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inserted) != 1 || len(insertedDevices[0]) != 4 {
+		t.Fatalf("Expected a single outage of the four devices in Utrecht, got %v", insertedDevices)
+	}
+	if !inserted[0].StartedAt.Equal(now.Add(-6*time.Minute)) || inserted[0].Area.Type != "Polygon" {
+		t.Errorf("Expected the outage to start with its first device and have a polygon, got %+v", inserted[0])
+	}
+	ring := inserted[0].Area.Coordinates.([][][2]float64)[0]
+	if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+		t.Errorf("Expected a closed ring around the devices, got %v", ring)
+	}
+
+	// A neighbour going offline later extends the active outage, an outage whose devices came back is resolved
+	active = []structs.AreaOutage{
+		{ID: 1, OfflineCount: 4, Devices: []structs.AreaOutageDevice{{DeviceID: 1}, {DeviceID: 2}, {DeviceID: 3}, {DeviceID: 4}}},
+		{ID: 2, OfflineCount: 1, Devices: []structs.AreaOutageDevice{{DeviceID: 20}, {DeviceID: 21}, {DeviceID: 22}}},
+	}
+	offline = append(offline, at(9, 52.0920, 5.1240, 1))
+	inserted = nil
+	if err := service.DetectAreaOutages(now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inserted) != 0 || len(extended) != 1 || extended[0] != 9 {
+		t.Errorf("Expected device 9 to extend the outage, got inserted %v and extended %v", inserted, extended)
+	}
+	if len(resolved) != 1 || resolved[0] != 2 {
+		t.Errorf("Expected outage 2 to be resolved, got %v", resolved)
+	}
+}
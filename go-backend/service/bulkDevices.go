@@ -0,0 +1,193 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"main/repository"
+	"main/structs"
+	"net"
+	"time"
+)
+
+// BulkChunkSize is the number of devices changed per transaction in a bulk operation
+const BulkChunkSize = 250
+
+var validDeviceStatuses = map[string]bool{"online": true, "offline": true, "error": true, "app_issue": true}
+
+var validConnectionTypes = map[string]bool{"wireless": true, "wired": true}
+
+// ApplyBulkDeviceOperation applies tag, status and attribute changes to all accessible devices selected by the request
+func ApplyBulkDeviceOperation(meberID int64, request structs.BulkDeviceRequest) (structs.BulkDeviceReport, error) {
+	// Step 1: Validate the requested changes before touching any device
+	if err := validateBulkDeviceRequest(request); err != nil {
+		return structs.BulkDeviceReport{}, err
+	}
+
+	// Access to a device only allows viewing it, overriding its status or attributes is up to admins
+	if request.Status != "" || request.Attributes != nil {
+		if err := requireAdmin(meberID); err != nil {
+			return structs.BulkDeviceReport{}, err
+		}
+	}
+
+	addTagIDs, err := resolveEditableTags(meberID, request.AddTags)
+	if err != nil {
+		return structs.BulkDeviceReport{}, err
+	}
+	removeTagIDs, err := resolveEditableTags(meberID, request.RemoveTags)
+	if err != nil {
+		return structs.BulkDeviceReport{}, err
+	}
+
+	// Step 2: Resolve the target devices, the repository only returns devices the meber has access to
+	filter := structs.DeviceFilter{}
+	if request.Filter != nil {
+		filter = *request.Filter
+	}
+	if len(request.DeviceIDs) > 0 {
+		filter.DeviceIDs = request.DeviceIDs
+	}
+	if filter.IsEmpty() {
+		return structs.BulkDeviceReport{}, fmt.Errorf("%w: a device ID list or filter is required", ErrInvalidRequest)
+	}
+
+	accessibleIDs, err := repository.GetDeviceIDsByFilter(meberID, filter)
+	if err != nil {
+		return structs.BulkDeviceReport{}, err
+	}
+
+	report := structs.BulkDeviceReport{Results: []structs.BulkDeviceResult{}}
+
+	// Explicitly requested devices that were not returned are either missing or outside the meber's access
+	accessible := idSet(accessibleIDs)
+	for _, deviceID := range request.DeviceIDs {
+		if !accessible[deviceID] {
+			report.Results = append(report.Results, structs.BulkDeviceResult{
+				DeviceID: deviceID,
+				Error:    "device not found or access denied",
+			})
+		}
+	}
+
+	// Status changes are recorded in the history, which needs to know the devices in maintenance
+	now := time.Now().UTC()
+	var inMaintenance map[int64]bool
+	if request.Status != "" {
+		state, err := getMaintenanceState(now)
+		if err != nil {
+			return structs.BulkDeviceReport{}, err
+		}
+		inMaintenance = state.inMaintenance
+	}
+
+	// Step 3: Apply the changes chunk by chunk, a failing chunk is rolled back without affecting the others
+	for start := 0; start < len(accessibleIDs); start += BulkChunkSize {
+		end := start + BulkChunkSize
+		if end > len(accessibleIDs) {
+			end = len(accessibleIDs)
+		}
+		chunk := accessibleIDs[start:end]
+
+		chunkErr := repository.ApplyBulkDeviceChunk(chunk, addTagIDs, removeTagIDs, request.Status, request.Attributes, inMaintenance, now)
+		if chunkErr != nil {
+			log.Printf("Error applying bulk operation to devices %d-%d: %v", chunk[0], chunk[len(chunk)-1], chunkErr)
+		} else if request.Status != "" {
+			invalidateDeviceTiles(chunk...)
+		} else {
+			// Tags and attributes aren't shown on tiles, but can move the devices in or out of groups
+			invalidateMaintenanceTargets()
+		}
+		for _, deviceID := range chunk {
+			result := structs.BulkDeviceResult{DeviceID: deviceID, Success: chunkErr == nil}
+			if chunkErr != nil {
+				result.Error = "chunk rolled back: " + chunkErr.Error()
+			}
+			report.Results = append(report.Results, result)
+		}
+	}
+
+	// Step 4: Summarise
+	report.Total = len(report.Results)
+	for _, result := range report.Results {
+		if result.Success {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// validateBulkDeviceRequest checks that the request changes something and that all new values are valid
+func validateBulkDeviceRequest(request structs.BulkDeviceRequest) error {
+	hasAttributes := request.Attributes != nil &&
+		(request.Attributes.ConnectionType != nil || request.Attributes.IPAddress != nil)
+	if len(request.AddTags) == 0 && len(request.RemoveTags) == 0 && request.Status == "" && !hasAttributes {
+		return fmt.Errorf("%w: no operation requested", ErrInvalidRequest)
+	}
+
+	if request.Status != "" && !validDeviceStatuses[request.Status] {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidRequest, request.Status)
+	}
+	if request.Attributes != nil {
+		if request.Attributes.ConnectionType != nil && !validConnectionTypes[*request.Attributes.ConnectionType] {
+			return fmt.Errorf("%w: unknown connection type %q", ErrInvalidRequest, *request.Attributes.ConnectionType)
+		}
+		if request.Attributes.IPAddress != nil && net.ParseIP(*request.Attributes.IPAddress) == nil {
+			return fmt.Errorf("%w: invalid IP address %q", ErrInvalidRequest, *request.Attributes.IPAddress)
+		}
+	}
+
+	for _, tagName := range request.AddTags {
+		for _, removed := range request.RemoveTags {
+			if tagName == removed {
+				return fmt.Errorf("%w: tag %q is both added and removed", ErrInvalidRequest, tagName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveEditableTags looks up the tag IDs for the names, all of which have to pass checkEditableTag
+func resolveEditableTags(meberID int64, names []string) ([]int64, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags, err := repository.GetTagsByNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsByName := make(map[string]structs.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByName[tag.Name] = tag
+	}
+
+	var tagIDs []int64
+	for _, name := range names {
+		tag, exists := tagsByName[name]
+		if err := checkEditableTag(meberID, name, tag, exists); err != nil {
+			return nil, err
+		}
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, nil
+}
+
+// checkEditableTag checks that the meber can add the tag to or remove it from devices. Location and other fixed tags
+// can't be changed that way and tags owned by another meber are only changed by their owner.
+func checkEditableTag(meberID int64, name string, tag structs.Tag, exists bool) error {
+	switch {
+	case !exists:
+		return fmt.Errorf("%w: unknown tag %q", ErrInvalidRequest, name)
+	case !tag.IsEditable || tag.Type == "location":
+		return fmt.Errorf("%w: tag %q is not editable", ErrInvalidRequest, name)
+	case tag.OwnerID != nil && *tag.OwnerID != meberID:
+		return fmt.Errorf("%w: tag %q is owned by another meber", ErrAccessDenied, name)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestApplyBulkDeviceOperation(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalTags := repository.GetTagsByNames
+	originalApply := repository.ApplyBulkDeviceChunk
+	originalGetRoles := repository.GetRolesForMeber
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetTagsByNames = originalTags
+		repository.ApplyBulkDeviceChunk = originalApply
+		repository.GetRolesForMeber = originalGetRoles
+	}()
+
+	// Meber 1 is an admin, meber 2 an operator
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 1 {
+			return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+		}
+		return []structs.Role{{ID: 2, Name: "operator"}}, nil
+	}
+
+	// Meber has access to every device except 9999
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		if len(filter.DeviceIDs) == 0 {
+			ids := make([]int64, 0, 600)
+			for i := int64(1); i <= 600; i++ {
+				ids = append(ids, i)
+			}
+			return ids, nil
+		}
+		var ids []int64
+		for _, id := range filter.DeviceIDs {
+			if id != 9999 {
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+	owner := int64(2)
+	repository.GetTagsByNames = func(names []string) ([]structs.Tag, error) {
+		return []structs.Tag{
+			{ID: 3, Name: "maintenance", Type: "custom", IsEditable: true},
+			{ID: 50, Name: "Utrecht", Type: "location", IsEditable: false},
+			{ID: 7, Name: "my devices", Type: "custom", IsEditable: true, OwnerID: &owner},
+		}, nil
+	}
+
+	// Device 1 is in maintenance, its status changes are flagged as such in the history
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		now := time.Now()
+		return []structs.MaintenanceWindow{{ID: 1, TargetType: "device", TargetID: 1, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Recurrence: "none"}}, nil
+	}
+
+	var chunkSizes []int
+	var chunkMaintenance map[int64]bool
+	repository.ApplyBulkDeviceChunk = func(deviceIDs []int64, addTagIDs, removeTagIDs []int64, status string, attributes *structs.DeviceAttributes, inMaintenance map[int64]bool, now time.Time) error {
+		chunkSizes = append(chunkSizes, len(deviceIDs))
+		chunkMaintenance = inMaintenance
+		// Fail the chunk containing device 501 to check that only that chunk is reported as failed
+		for _, id := range deviceIDs {
+			if id == 501 {
+				return errors.New("deadlock")
+			}
+		}
+		return nil
+	}
+
+	t.Run("Filter Is Applied In Chunks", func(t *testing.T) {
+		chunkSizes = nil
+		report, err := service.ApplyBulkDeviceOperation(1, structs.BulkDeviceRequest{
+			Filter:  &structs.DeviceFilter{ConnectionTypes: []string{"wireless"}},
+			AddTags: []string{"maintenance"},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(chunkSizes) != 3 || chunkSizes[0] != service.BulkChunkSize || chunkSizes[2] != 100 {
+			t.Errorf("Expected chunks of %d, %d and 100, got %v", service.BulkChunkSize, service.BulkChunkSize, chunkSizes)
+		}
+		if report.Total != 600 || report.Succeeded != 500 || report.Failed != 100 {
+			t.Errorf("Expected 600 total, 500 succeeded and 100 failed, got %d/%d/%d", report.Total, report.Succeeded, report.Failed)
+		}
+	})
+
+	t.Run("Inaccessible Devices Are Reported", func(t *testing.T) {
+		report, err := service.ApplyBulkDeviceOperation(1, structs.BulkDeviceRequest{
+			DeviceIDs: []int64{1, 9999},
+			Status:    "offline",
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Total != 2 || report.Failed != 1 || report.Results[0].DeviceID != 9999 {
+			t.Errorf("Expected device 9999 to be reported as failed, got %+v", report.Results)
+		}
+		if !chunkMaintenance[1] {
+			t.Errorf("Expected device 1 to be passed as in maintenance, got %v", chunkMaintenance)
+		}
+	})
+
+	t.Run("Invalid Requests Are Rejected", func(t *testing.T) {
+		invalidRequests := map[string]structs.BulkDeviceRequest{
+			"No Operation":      {DeviceIDs: []int64{1}},
+			"No Targets":        {Status: "offline"},
+			"Unknown Status":    {DeviceIDs: []int64{1}, Status: "broken"},
+			"Location Tag":      {DeviceIDs: []int64{1}, AddTags: []string{"Utrecht"}},
+			"Unknown Tag":       {DeviceIDs: []int64{1}, RemoveTags: []string{"does not exist"}},
+			"Add And Remove":    {DeviceIDs: []int64{1}, AddTags: []string{"maintenance"}, RemoveTags: []string{"maintenance"}},
+			"Invalid IPAddress": {DeviceIDs: []int64{1}, Attributes: &structs.DeviceAttributes{IPAddress: new(string)}},
+		}
+		for name, request := range invalidRequests {
+			if _, err := service.ApplyBulkDeviceOperation(1, request); !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("%s: expected invalid request error, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Overrides Require Edit Rights", func(t *testing.T) {
+		connectionType := "wired"
+		deniedRequests := map[int64]map[string]structs.BulkDeviceRequest{
+			2: {
+				"Status":     {DeviceIDs: []int64{1}, Status: "offline"},
+				"Attributes": {DeviceIDs: []int64{1}, Attributes: &structs.DeviceAttributes{ConnectionType: &connectionType}},
+			},
+			1: {
+				"Tag Of Another Meber": {DeviceIDs: []int64{1}, RemoveTags: []string{"my devices"}},
+			},
+		}
+		for meberID, requests := range deniedRequests {
+			for name, request := range requests {
+				if _, err := service.ApplyBulkDeviceOperation(meberID, request); !errors.Is(err, service.ErrAccessDenied) {
+					t.Errorf("%s: expected access denied error, got %v", name, err)
+				}
+			}
+		}
+
+		// Shared and own tags can be changed without admin rights
+		report, err := service.ApplyBulkDeviceOperation(2, structs.BulkDeviceRequest{DeviceIDs: []int64{1}, AddTags: []string{"maintenance", "my devices"}})
+		if err != nil || report.Succeeded != 1 {
+			t.Errorf("Expected the owner to tag the device, got %+v, %v", report, err)
+		}
+	})
+}
@@ -0,0 +1,472 @@
+package service
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DeviceCertificateLifetime is how long a device certificate is valid, devices renew before it runs out
+	DeviceCertificateLifetime = 90 * 24 * time.Hour
+	// EnrollmentTokenLifetime is how long a device has to redeem its enrollment token
+	EnrollmentTokenLifetime = 24 * time.Hour
+	// CRLLifetime is the interval after which clients should fetch a new revocation list
+	CRLLifetime = 24 * time.Hour
+
+	certificateAuthorityLifetime = 10 * 365 * 24 * time.Hour
+	deviceCommonNamePrefix       = "edge-device-"
+)
+
+// ErrInvalidCertificate is returned when a client certificate is not a valid certificate of this CA
+var ErrInvalidCertificate = errors.New("invalid device certificate")
+
+// revocationReasons maps the accepted revocation reasons to their RFC 5280 reason codes
+var revocationReasons = map[string]int{
+	"unspecified":            0,
+	"key_compromise":         1,
+	"superseded":             4,
+	"cessation_of_operation": 5,
+}
+
+// caMutex keeps concurrent requests from generating two certificate authorities
+var caMutex sync.Mutex
+
+// CertificateAuthority is the local CA that issues device client certificates
+type CertificateAuthority struct {
+	Certificate    *x509.Certificate
+	CertificatePEM string
+	key            crypto.Signer
+}
+
+// NewCertificateAuthority generates a self-signed ECDSA P-256 CA and returns the PEM encoded certificate and key
+func NewCertificateAuthority(now time.Time) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating CA key: %w", err)
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "Edge device CA"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(certificateAuthorityLifetime),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating CA certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("error encoding CA key: %w", err)
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return string(certificatePEM), string(keyPEM), nil
+}
+
+// ParseCertificateAuthority loads a CA from its PEM encoded certificate and PKCS#8 key
+func ParseCertificateAuthority(certificatePEM, keyPEM string) (*CertificateAuthority, error) {
+	certificateBlock, _ := pem.Decode([]byte(certificatePEM))
+	if certificateBlock == nil || certificateBlock.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid CA certificate PEM")
+	}
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA certificate: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode([]byte(keyPEM))
+	if keyBlock == nil {
+		return nil, errors.New("invalid CA key PEM")
+	}
+	parsedKey, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA key: %w", err)
+	}
+	key, ok := parsedKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key can't sign")
+	}
+
+	return &CertificateAuthority{Certificate: certificate, CertificatePEM: certificatePEM, key: key}, nil
+}
+
+// loadCertificateAuthority returns the CA, generating and storing it on first use
+func loadCertificateAuthority() (*CertificateAuthority, error) {
+	caMutex.Lock()
+	defer caMutex.Unlock()
+
+	certificatePEM, keyPEM, err := repository.GetCertificateAuthority()
+	if err != nil {
+		return nil, err
+	}
+	if certificatePEM == "" {
+		if certificatePEM, keyPEM, err = NewCertificateAuthority(time.Now()); err != nil {
+			return nil, err
+		}
+		if err := repository.InsertCertificateAuthority(certificatePEM, keyPEM); err != nil {
+			return nil, err
+		}
+		// Another backend instance may have stored its CA first, that one wins
+		if certificatePEM, keyPEM, err = repository.GetCertificateAuthority(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ParseCertificateAuthority(certificatePEM, keyPEM)
+}
+
+// CertificateAuthorityPool returns a pool with the CA certificate, for verifying client certificates on the TLS listener
+func CertificateAuthorityPool() (*x509.CertPool, error) {
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Certificate)
+	return pool, nil
+}
+
+// GetCACertificatePEM returns the PEM encoded CA certificate devices use to trust the backend's client CA
+func GetCACertificatePEM() (string, error) {
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return "", err
+	}
+	return ca.CertificatePEM, nil
+}
+
+// ParseCertificateRequest decodes a PEM encoded CSR and checks that it is signed by the key it contains
+func ParseCertificateRequest(csrPEM string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("%w: csr must be a PEM encoded certificate request", ErrInvalidRequest)
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid certificate request: %v", ErrInvalidRequest, err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: invalid certificate request signature: %v", ErrInvalidRequest, err)
+	}
+	return csr, nil
+}
+
+// IssueDeviceCertificate signs a client certificate for the key in the CSR. The subject of the CSR is ignored,
+// the certificate is always bound to the given device through its common name.
+func (ca *CertificateAuthority) IssueDeviceCertificate(csr *x509.CertificateRequest, deviceID int64, now time.Time) (structs.DeviceCertificate, string, error) {
+	serial, err := randomSerial()
+	if err != nil {
+		return structs.DeviceCertificate{}, "", err
+	}
+
+	notAfter := now.Add(DeviceCertificateLifetime)
+	if notAfter.After(ca.Certificate.NotAfter) {
+		notAfter = ca.Certificate.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: deviceCommonNamePrefix + strconv.FormatInt(deviceID, 10)},
+		NotBefore:    now.Add(-time.Minute), // Allow for clock skew on the device
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, csr.PublicKey, ca.key)
+	if err != nil {
+		return structs.DeviceCertificate{}, "", fmt.Errorf("error signing device certificate: %w", err)
+	}
+
+	fingerprint := sha256.Sum256(der)
+	certificate := structs.DeviceCertificate{
+		Serial:      serialString(serial),
+		DeviceID:    deviceID,
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		NotBefore:   template.NotBefore.UTC().Truncate(time.Second),
+		NotAfter:    template.NotAfter.UTC().Truncate(time.Second),
+		IssuedAt:    now.UTC().Truncate(time.Second),
+	}
+	return certificate, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// CreateRevocationList signs a CRL listing the given revoked certificates
+func (ca *CertificateAuthority) CreateRevocationList(revoked []structs.DeviceCertificate, now time.Time) ([]byte, error) {
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, certificate := range revoked {
+		serial, ok := new(big.Int).SetString(certificate.Serial, 16)
+		if !ok || certificate.RevokedAt == nil {
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: *certificate.RevokedAt,
+			ReasonCode:     revocationReasons[certificate.RevocationReason],
+		})
+	}
+
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(now.UnixNano()), // Increases with every list that is signed
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(CRLLifetime),
+	}
+	crl, err := x509.CreateRevocationList(rand.Reader, template, ca.Certificate, ca.key)
+	if err != nil {
+		return nil, fmt.Errorf("error signing revocation list: %w", err)
+	}
+	return crl, nil
+}
+
+// DeviceIDFromCertificate extracts the device a certificate issued by IssueDeviceCertificate is bound to
+func DeviceIDFromCertificate(certificate *x509.Certificate) (int64, error) {
+	name := certificate.Subject.CommonName
+	if !strings.HasPrefix(name, deviceCommonNamePrefix) {
+		return 0, fmt.Errorf("%w: %q is not a device certificate", ErrInvalidCertificate, name)
+	}
+	deviceID, err := strconv.ParseInt(strings.TrimPrefix(name, deviceCommonNamePrefix), 10, 64)
+	if err != nil || deviceID <= 0 {
+		return 0, fmt.Errorf("%w: %q is not a device certificate", ErrInvalidCertificate, name)
+	}
+	return deviceID, nil
+}
+
+// VerifyDeviceCertificate checks that a client certificate was issued by the CA, has not expired and was not
+// revoked, and returns the device it belongs to as long as it is in service
+func VerifyDeviceCertificate(certificate *x509.Certificate, now time.Time) (int64, error) {
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return 0, err
+	}
+
+	// Step 1: The chain has to lead to our CA, also when the TLS listener did not verify it
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+	_, err = certificate.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+	deviceID, err := DeviceIDFromCertificate(certificate)
+	if err != nil {
+		return 0, err
+	}
+
+	// Step 2: The certificate has to be one we issued to that device and still valid
+	stored, err := repository.GetDeviceCertificate(serialString(certificate.SerialNumber))
+	if err != nil {
+		return 0, err
+	}
+	if stored == nil || stored.DeviceID != deviceID {
+		return 0, fmt.Errorf("%w: unknown certificate", ErrInvalidCertificate)
+	}
+	if stored.RevokedAt != nil {
+		return 0, fmt.Errorf("%w: certificate was revoked", ErrInvalidCertificate)
+	}
+	_, decommissionedAt, err := repository.GetDeviceDecommissionedAt(deviceID)
+	if err != nil {
+		return 0, err
+	}
+	if decommissionedAt != nil {
+		return 0, fmt.Errorf("%w: device %d was decommissioned", ErrInvalidCertificate, deviceID)
+	}
+
+	return deviceID, nil
+}
+
+// CreateEnrollmentToken creates a one-time token a device uses to enroll, for a device the meber has access to
+func CreateEnrollmentToken(meberID, deviceID int64) (structs.EnrollmentToken, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.EnrollmentToken{}, err
+	}
+	if err := CheckQuarantine([]int64{deviceID}); err != nil {
+		return structs.EnrollmentToken{}, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return structs.EnrollmentToken{}, fmt.Errorf("error generating enrollment token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	expiresAt := time.Now().Add(EnrollmentTokenLifetime).UTC().Truncate(time.Second)
+
+	// Only the hash is stored, so a database leak does not leak usable tokens
+	if err := repository.InsertEnrollmentToken(hashEnrollmentToken(token), deviceID, meberID, expiresAt); err != nil {
+		return structs.EnrollmentToken{}, err
+	}
+
+	return structs.EnrollmentToken{DeviceID: deviceID, Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// EnrollDevice exchanges an enrollment token and CSR for the first client certificate of a device
+func EnrollDevice(request structs.EnrollmentRequest, now time.Time) (structs.DeviceCertificateBundle, error) {
+	// Step 1: Validate the CSR before the token is used up
+	if request.Token == "" {
+		return structs.DeviceCertificateBundle{}, fmt.Errorf("%w: token is required", ErrInvalidRequest)
+	}
+	csr, err := ParseCertificateRequest(request.CSR)
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+
+	// Step 2: Redeem the token and issue the certificate for the device it belongs to
+	var certificatePEM string
+	certificate, err := repository.EnrollDeviceCertificate(hashEnrollmentToken(request.Token), now, func(deviceID int64) (structs.DeviceCertificate, error) {
+		issued, issuedPEM, err := ca.IssueDeviceCertificate(csr, deviceID, now)
+		certificatePEM = issuedPEM
+		return issued, err
+	})
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+	if certificate == nil {
+		return structs.DeviceCertificateBundle{}, fmt.Errorf("%w: enrollment token is invalid, expired or already used", ErrAccessDenied)
+	}
+
+	return structs.DeviceCertificateBundle{
+		Certificate:      *certificate,
+		CertificatePEM:   certificatePEM,
+		CACertificatePEM: ca.CertificatePEM,
+	}, nil
+}
+
+// RenewDeviceCertificate issues a new certificate to a device authenticated with its current certificate.
+// The current certificate is revoked as superseded.
+func RenewDeviceCertificate(deviceID int64, current *x509.Certificate, csrPEM string, now time.Time) (structs.DeviceCertificateBundle, error) {
+	if current == nil {
+		return structs.DeviceCertificateBundle{}, fmt.Errorf("%w: renewal requires the current client certificate", ErrAccessDenied)
+	}
+	if certificateDeviceID, err := DeviceIDFromCertificate(current); err != nil || certificateDeviceID != deviceID {
+		return structs.DeviceCertificateBundle{}, fmt.Errorf("%w: client certificate does not belong to device %d", ErrAccessDenied, deviceID)
+	}
+	csr, err := ParseCertificateRequest(csrPEM)
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+
+	certificate, certificatePEM, err := ca.IssueDeviceCertificate(csr, deviceID, now)
+	if err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+	if err := repository.RenewDeviceCertificate(certificate, serialString(current.SerialNumber), now); err != nil {
+		return structs.DeviceCertificateBundle{}, err
+	}
+
+	return structs.DeviceCertificateBundle{
+		Certificate:      certificate,
+		CertificatePEM:   certificatePEM,
+		CACertificatePEM: ca.CertificatePEM,
+	}, nil
+}
+
+// GetDeviceCertificates lists the certificates issued to a device the meber has access to
+func GetDeviceCertificates(meberID, deviceID int64) ([]structs.DeviceCertificate, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return nil, err
+	}
+	certificates, err := repository.GetDeviceCertificates(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if certificates == nil {
+		certificates = []structs.DeviceCertificate{}
+	}
+	return certificates, nil
+}
+
+// RevokeDeviceCertificate revokes a certificate of a device the meber has access to
+func RevokeDeviceCertificate(meberID int64, serial, reason string) error {
+	// Step 1: Validate the reason and find the device the certificate belongs to
+	if reason == "" {
+		reason = "unspecified"
+	}
+	if _, ok := revocationReasons[reason]; !ok {
+		return fmt.Errorf("%w: unknown revocation reason %q", ErrInvalidRequest, reason)
+	}
+	certificate, err := repository.GetDeviceCertificate(strings.ToLower(serial))
+	if err != nil {
+		return err
+	}
+	if certificate == nil {
+		return fmt.Errorf("%w: certificate %s", ErrNotFound, serial)
+	}
+
+	// Step 2: Revoke it if the meber has access to the device
+	if err := checkDeviceAccess(meberID, []int64{certificate.DeviceID}); err != nil {
+		return err
+	}
+	if certificate.RevokedAt != nil {
+		return fmt.Errorf("%w: certificate %s is already revoked", ErrInvalidRequest, serial)
+	}
+	_, err = repository.RevokeDeviceCertificates(certificate.DeviceID, certificate.Serial, reason, &meberID, time.Now())
+	return err
+}
+
+// GetCertificateRevocationList returns the DER encoded CRL of the CA
+func GetCertificateRevocationList(now time.Time) ([]byte, error) {
+	ca, err := loadCertificateAuthority()
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := repository.GetRevokedCertificates(now)
+	if err != nil {
+		return nil, err
+	}
+	return ca.CreateRevocationList(revoked, now)
+}
+
+// hashEnrollmentToken returns the hex encoded SHA-256 of a token, as stored in the database
+func hashEnrollmentToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// randomSerial returns a random positive 128 bit certificate serial number
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("error generating serial number: %w", err)
+	}
+	return serial.Add(serial, big.NewInt(1)), nil
+}
+
+// serialString formats a serial number the way it is stored in device_certificates
+func serialString(serial *big.Int) string {
+	return serial.Text(16)
+}
@@ -0,0 +1,277 @@
+package service_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+// useTestCertificateAuthority generates a CA and makes the repository return it, the returned function restores the original
+func useTestCertificateAuthority(t *testing.T) (*service.CertificateAuthority, func()) {
+	t.Helper()
+	certificatePEM, keyPEM, err := service.NewCertificateAuthority(time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error generating CA: %v", err)
+	}
+	ca, err := service.ParseCertificateAuthority(certificatePEM, keyPEM)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing CA: %v", err)
+	}
+
+	originalGetCA := repository.GetCertificateAuthority
+	repository.GetCertificateAuthority = func() (string, string, error) {
+		return certificatePEM, keyPEM, nil
+	}
+	return ca, func() { repository.GetCertificateAuthority = originalGetCA }
+}
+
+// generateTestCSR creates a PEM encoded CSR for a fresh device key
+func generateTestCSR(t *testing.T, commonName string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unexpected error generating device key: %v", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	if err != nil {
+		t.Fatalf("Unexpected error creating CSR: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+// parseTestCertificate decodes a PEM encoded certificate
+func parseTestCertificate(t *testing.T, certificatePEM string) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil {
+		t.Fatalf("Expected a PEM encoded certificate, got %q", certificatePEM)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing certificate: %v", err)
+	}
+	return certificate
+}
+
+// issueTestCertificate issues a device certificate directly from the CA
+func issueTestCertificate(t *testing.T, ca *service.CertificateAuthority, deviceID int64) (structs.DeviceCertificate, *x509.Certificate) {
+	t.Helper()
+	csr, err := service.ParseCertificateRequest(generateTestCSR(t, "device"))
+	if err != nil {
+		t.Fatalf("Unexpected error parsing CSR: %v", err)
+	}
+	stored, certificatePEM, err := ca.IssueDeviceCertificate(csr, deviceID, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error issuing certificate: %v", err)
+	}
+	return stored, parseTestCertificate(t, certificatePEM)
+}
+
+func TestEnrollDevice(t *testing.T) {
+	ca, restoreCA := useTestCertificateAuthority(t)
+	defer restoreCA()
+
+	// Backup the original repository function and restore it after the test
+	originalEnroll := repository.EnrollDeviceCertificate
+	defer func() { repository.EnrollDeviceCertificate = originalEnroll }()
+
+	var redeemed string
+	repository.EnrollDeviceCertificate = func(tokenHash string, now time.Time, issue func(deviceID int64) (structs.DeviceCertificate, error)) (*structs.DeviceCertificate, error) {
+		redeemed = tokenHash
+		certificate, err := issue(42)
+		return &certificate, err
+	}
+
+	// The subject in the CSR must not decide which device the certificate is for
+	bundle, err := service.EnrollDevice(structs.EnrollmentRequest{Token: "one-time-token", CSR: generateTestCSR(t, "edge-device-1")}, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if redeemed == "" || redeemed == "one-time-token" {
+		t.Errorf("Expected the token to be redeemed by its hash, got %q", redeemed)
+	}
+
+	certificate := parseTestCertificate(t, bundle.CertificatePEM)
+	if deviceID, err := service.DeviceIDFromCertificate(certificate); err != nil || deviceID != 42 {
+		t.Errorf("Expected certificate bound to device 42, got %d (%v)", deviceID, err)
+	}
+	if bundle.Certificate.DeviceID != 42 || bundle.Certificate.Serial != certificate.SerialNumber.Text(16) {
+		t.Errorf("Stored certificate %+v does not match the issued certificate", bundle.Certificate)
+	}
+	if bundle.CACertificatePEM != ca.CertificatePEM {
+		t.Error("Expected the CA certificate in the bundle")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+	if _, err := certificate.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+		t.Errorf("Expected a client certificate signed by the CA, got %v", err)
+	}
+}
+
+func TestEnrollDeviceRejected(t *testing.T) {
+	_, restoreCA := useTestCertificateAuthority(t)
+	defer restoreCA()
+
+	originalEnroll := repository.EnrollDeviceCertificate
+	defer func() { repository.EnrollDeviceCertificate = originalEnroll }()
+
+	calls := 0
+	repository.EnrollDeviceCertificate = func(tokenHash string, now time.Time, issue func(deviceID int64) (structs.DeviceCertificate, error)) (*structs.DeviceCertificate, error) {
+		calls++
+		return nil, nil // Unknown, expired or used token
+	}
+
+	// An invalid CSR is rejected before the token is used up
+	_, err := service.EnrollDevice(structs.EnrollmentRequest{Token: "token", CSR: "not a csr"}, time.Now())
+	if !errors.Is(err, service.ErrInvalidRequest) || calls != 0 {
+		t.Errorf("Expected ErrInvalidRequest without redeeming the token, got %v after %d calls", err, calls)
+	}
+
+	_, err = service.EnrollDevice(structs.EnrollmentRequest{Token: "used-token", CSR: generateTestCSR(t, "device")}, time.Now())
+	if !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied for an unusable token, got %v", err)
+	}
+}
+
+func TestVerifyDeviceCertificate(t *testing.T) {
+	ca, restoreCA := useTestCertificateAuthority(t)
+	defer restoreCA()
+
+	originalGetCertificate := repository.GetDeviceCertificate
+	originalDecommissioned := repository.GetDeviceDecommissionedAt
+	defer func() {
+		repository.GetDeviceCertificate = originalGetCertificate
+		repository.GetDeviceDecommissionedAt = originalDecommissioned
+	}()
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) { return true, nil, nil }
+
+	stored, certificate := issueTestCertificate(t, ca, 7)
+	revokedAt := time.Now()
+	revoked := stored
+	revoked.RevokedAt = &revokedAt
+
+	// A certificate of another CA with the same device name
+	otherCA, otherRestore := useTestCertificateAuthority(t)
+	otherRestore()
+	_, foreign := issueTestCertificate(t, otherCA, 7)
+
+	tests := []struct {
+		name        string
+		certificate *x509.Certificate
+		stored      *structs.DeviceCertificate
+		wantErr     bool
+	}{
+		{"Valid Certificate", certificate, &stored, false},
+		{"Revoked Certificate", certificate, &revoked, true},
+		{"Unknown Certificate", certificate, nil, true},
+		{"Certificate Of Another CA", foreign, &stored, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repository.GetDeviceCertificate = func(serial string) (*structs.DeviceCertificate, error) {
+				return tc.stored, nil
+			}
+
+			deviceID, err := service.VerifyDeviceCertificate(tc.certificate, time.Now())
+			if tc.wantErr {
+				if !errors.Is(err, service.ErrInvalidCertificate) {
+					t.Errorf("Expected ErrInvalidCertificate, got %v", err)
+				}
+				return
+			}
+			if err != nil || deviceID != 7 {
+				t.Errorf("Expected device 7, got %d (%v)", deviceID, err)
+			}
+		})
+	}
+
+	// Expired certificates are rejected as well
+	repository.GetDeviceCertificate = func(serial string) (*structs.DeviceCertificate, error) { return &stored, nil }
+	if _, err := service.VerifyDeviceCertificate(certificate, time.Now().Add(service.DeviceCertificateLifetime+time.Hour)); err == nil {
+		t.Error("Expected an expired certificate to be rejected")
+	}
+
+	// A decommissioned device can't authenticate with a certificate that somehow escaped revocation
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) { return true, &revokedAt, nil }
+	if _, err := service.VerifyDeviceCertificate(certificate, time.Now()); !errors.Is(err, service.ErrInvalidCertificate) {
+		t.Errorf("Expected ErrInvalidCertificate for a decommissioned device, got %v", err)
+	}
+}
+
+func TestRenewDeviceCertificate(t *testing.T) {
+	ca, restoreCA := useTestCertificateAuthority(t)
+	defer restoreCA()
+
+	originalRenew := repository.RenewDeviceCertificate
+	defer func() { repository.RenewDeviceCertificate = originalRenew }()
+
+	var replaced string
+	repository.RenewDeviceCertificate = func(certificate structs.DeviceCertificate, replacedSerial string, now time.Time) error {
+		replaced = replacedSerial
+		return nil
+	}
+
+	_, current := issueTestCertificate(t, ca, 3)
+
+	// A device can't renew with the certificate of another device
+	if _, err := service.RenewDeviceCertificate(4, current, generateTestCSR(t, "device"), time.Now()); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied, got %v", err)
+	}
+
+	bundle, err := service.RenewDeviceCertificate(3, current, generateTestCSR(t, "device"), time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if replaced != current.SerialNumber.Text(16) {
+		t.Errorf("Expected certificate %s to be superseded, got %q", current.SerialNumber.Text(16), replaced)
+	}
+	if renewed := parseTestCertificate(t, bundle.CertificatePEM); renewed.SerialNumber.Cmp(current.SerialNumber) == 0 {
+		t.Error("Expected a new serial number")
+	}
+}
+
+func TestCertificateRevocationList(t *testing.T) {
+	ca, restoreCA := useTestCertificateAuthority(t)
+	defer restoreCA()
+
+	originalGetRevoked := repository.GetRevokedCertificates
+	defer func() { repository.GetRevokedCertificates = originalGetRevoked }()
+
+	stored, certificate := issueTestCertificate(t, ca, 9)
+	revokedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	stored.RevokedAt = &revokedAt
+	stored.RevocationReason = "key_compromise"
+	repository.GetRevokedCertificates = func(now time.Time) ([]structs.DeviceCertificate, error) {
+		return []structs.DeviceCertificate{stored}, nil
+	}
+
+	der, err := service.GetCertificateRevocationList(time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	crl, err := x509.ParseRevocationList(der)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing CRL: %v", err)
+	}
+	if err := crl.CheckSignatureFrom(ca.Certificate); err != nil {
+		t.Errorf("Expected the CRL to be signed by the CA, got %v", err)
+	}
+	if len(crl.RevokedCertificateEntries) != 1 {
+		t.Fatalf("Expected 1 revoked certificate, got %d", len(crl.RevokedCertificateEntries))
+	}
+	entry := crl.RevokedCertificateEntries[0]
+	if entry.SerialNumber.Cmp(certificate.SerialNumber) != 0 || entry.ReasonCode != 1 || !entry.RevocationTime.Equal(revokedAt) {
+		t.Errorf("Unexpected CRL entry %+v", entry)
+	}
+}
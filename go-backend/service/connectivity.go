@@ -0,0 +1,226 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// ConnectivityRetention is how long connectivity samples are kept
+	ConnectivityRetention = 90 * 24 * time.Hour
+	// DefaultConnectivityPeriod is the period the flapping and ranking endpoints look back over by default
+	DefaultConnectivityPeriod = 24 * time.Hour
+	// MaxConnectivityPeriod caps how far back the flapping and ranking endpoints look
+	MaxConnectivityPeriod = 30 * 24 * time.Hour
+	// DefaultRankingLimit is the number of devices listed per municipality when none is given
+	DefaultRankingLimit = 10
+	maxRankingLimit     = 100
+
+	// flappingChangesPerDay is the number of changes from and to offline per day from which a connection flaps,
+	// three short outages a day. Periods shorter than a day need at least minFlappingChanges.
+	flappingChangesPerDay = 6
+	minFlappingChanges    = 4
+
+	minSignalDBM       = -150
+	maxLatencyMS       = 60000
+	weakSignalDBM      = -70 // Signals below this start counting towards the score
+	scoreLatencyMS     = 50  // Latency that weighs as much as one percent packet loss
+	scorePerStatusFlip = 2.5 // Five points for every outage, which goes offline and comes back
+)
+
+// RecordConnectivitySamples validates and stores connection quality samples reported by a device
+func RecordConnectivitySamples(deviceID int64, samples []structs.ConnectivitySample) error {
+	if len(samples) == 0 {
+		return fmt.Errorf("%w: no samples provided", ErrInvalidRequest)
+	}
+
+	now := time.Now()
+	for i := range samples {
+		if samples[i].Timestamp.IsZero() {
+			samples[i].Timestamp = now
+		}
+		if samples[i].Timestamp.After(now.Add(maxMetricClockSkew)) {
+			return fmt.Errorf("%w: sample timestamp %s is in the future", ErrInvalidRequest, samples[i].Timestamp.Format(time.RFC3339))
+		}
+		if signal := samples[i].SignalDBM; signal != nil && (*signal < minSignalDBM || *signal > 0) {
+			return fmt.Errorf("%w: signal strength must be between %d and 0 dBm", ErrInvalidRequest, minSignalDBM)
+		}
+		if samples[i].LatencyMS < 0 || samples[i].LatencyMS > maxLatencyMS {
+			return fmt.Errorf("%w: latency must be between 0 and %d ms", ErrInvalidRequest, maxLatencyMS)
+		}
+		if samples[i].PacketLossPercent < 0 || samples[i].PacketLossPercent > 100 {
+			return fmt.Errorf("%w: packet loss must be between 0 and 100 percent", ErrInvalidRequest)
+		}
+	}
+
+	return repository.InsertConnectivitySamples(deviceID, samples)
+}
+
+// GetConnectivityHistory returns the averaged connection quality of a device the meber has access to and whether
+// its connection flapped in the range
+func GetConnectivityHistory(meberID, deviceID int64, start, end time.Time, points int64) (structs.ConnectivityHistory, error) {
+	if !end.After(start) {
+		return structs.ConnectivityHistory{}, fmt.Errorf("%w: end must be after start", ErrInvalidRequest)
+	}
+	if points <= 0 {
+		points = DefaultMetricPoints
+	}
+	if points > MaxMetricPoints {
+		points = MaxMetricPoints
+	}
+	if err := checkDeviceRecordAccess(meberID, deviceID); err != nil {
+		return structs.ConnectivityHistory{}, err
+	}
+
+	bucketSeconds := (int64(end.Sub(start).Seconds()) + points - 1) / points
+	if bucketSeconds < 1 {
+		bucketSeconds = 1
+	}
+	buckets, err := repository.FetchConnectivityBuckets(deviceID, start, end, bucketSeconds)
+	if err != nil {
+		return structs.ConnectivityHistory{}, fmt.Errorf("error fetching connectivity history: %w", err)
+	}
+	if buckets == nil {
+		buckets = []structs.ConnectivityBucket{}
+	}
+
+	changes, err := repository.CountStatusChanges([]int64{deviceID}, start, end)
+	if err != nil {
+		return structs.ConnectivityHistory{}, err
+	}
+
+	return structs.ConnectivityHistory{
+		DeviceID:      deviceID,
+		Start:         start,
+		End:           end,
+		BucketSeconds: bucketSeconds,
+		Buckets:       buckets,
+		StatusChanges: changes[deviceID],
+		Flapping:      changes[deviceID] >= flappingThreshold(end.Sub(start)),
+	}, nil
+}
+
+// GetFlappingDevices lists the accessible devices whose connection flapped during the period before now, most
+// status changes first
+func GetFlappingDevices(meberID int64, period time.Duration, now time.Time) ([]structs.DeviceConnectivity, error) {
+	devices, err := getDeviceConnectivity(meberID, period, now)
+	if err != nil {
+		return nil, err
+	}
+
+	flapping := []structs.DeviceConnectivity{}
+	for _, device := range devices {
+		if device.Flapping {
+			flapping = append(flapping, device)
+		}
+	}
+	sort.Slice(flapping, func(i, j int) bool {
+		if flapping[i].StatusChanges != flapping[j].StatusChanges {
+			return flapping[i].StatusChanges > flapping[j].StatusChanges
+		}
+		return flapping[i].DeviceID < flapping[j].DeviceID
+	})
+	return flapping, nil
+}
+
+// GetConnectivityRanking ranks the accessible devices of every municipality by how badly they were connected
+// during the period before now, listing at most limit devices per municipality. Devices without samples or status
+// changes in the period are left out.
+func GetConnectivityRanking(meberID int64, period time.Duration, limit int, now time.Time) (structs.ConnectivityRanking, error) {
+	if limit <= 0 || limit > maxRankingLimit {
+		return structs.ConnectivityRanking{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidRequest, maxRankingLimit)
+	}
+	devices, err := getDeviceConnectivity(meberID, period, now)
+	if err != nil {
+		return structs.ConnectivityRanking{}, err
+	}
+
+	byMunicipality := make(map[string][]structs.DeviceConnectivity)
+	for _, device := range devices {
+		if device.SampleCount == 0 && device.StatusChanges == 0 {
+			continue
+		}
+		byMunicipality[device.Municipality] = append(byMunicipality[device.Municipality], device)
+	}
+
+	ranking := structs.ConnectivityRanking{Start: now.Add(-period), End: now, Municipalities: []structs.MunicipalityConnectivity{}}
+	for municipality, ranked := range byMunicipality {
+		sort.Slice(ranked, func(i, j int) bool {
+			if ranked[i].Score != ranked[j].Score {
+				return ranked[i].Score > ranked[j].Score
+			}
+			return ranked[i].DeviceID < ranked[j].DeviceID
+		})
+		if len(ranked) > limit {
+			ranked = ranked[:limit]
+		}
+		ranking.Municipalities = append(ranking.Municipalities, structs.MunicipalityConnectivity{Municipality: municipality, Devices: ranked})
+	}
+	// Devices without a municipality go last
+	sort.Slice(ranking.Municipalities, func(i, j int) bool {
+		a, b := ranking.Municipalities[i].Municipality, ranking.Municipalities[j].Municipality
+		if a == "" || b == "" {
+			return b == ""
+		}
+		return a < b
+	})
+	return ranking, nil
+}
+
+// getDeviceConnectivity summarizes the connection quality of every accessible device during the period before now
+func getDeviceConnectivity(meberID int64, period time.Duration, now time.Time) ([]structs.DeviceConnectivity, error) {
+	if period <= 0 || period > MaxConnectivityPeriod {
+		return nil, fmt.Errorf("%w: period must be between 1 hour and %d days", ErrInvalidRequest, int(MaxConnectivityPeriod.Hours()/24))
+	}
+
+	deviceIDs, err := repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{})
+	if err != nil {
+		return nil, err
+	}
+	start := now.Add(-period)
+	summaries, err := repository.GetConnectivitySummaries(deviceIDs, start, now)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := repository.CountStatusChanges(deviceIDs, start, now)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := flappingThreshold(period)
+	devices := make([]structs.DeviceConnectivity, 0, len(summaries))
+	for _, deviceID := range deviceIDs {
+		device, ok := summaries[deviceID]
+		if !ok {
+			continue
+		}
+		device.StatusChanges = changes[deviceID]
+		device.Flapping = device.StatusChanges >= threshold
+		device.Score = connectivityScore(device)
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+// flappingThreshold is the number of changes from and to offline within the period from which a connection flaps
+func flappingThreshold(period time.Duration) int {
+	threshold := int(math.Ceil(flappingChangesPerDay * period.Hours() / 24))
+	if threshold < minFlappingChanges {
+		return minFlappingChanges
+	}
+	return threshold
+}
+
+// connectivityScore weighs how badly a device is connected: a point per percent of packet loss, a point per
+// scoreLatencyMS of latency, a point per dBm the signal is below weakSignalDBM and points for every status change
+func connectivityScore(device structs.DeviceConnectivity) float64 {
+	score := device.AvgPacketLossPercent + device.AvgLatencyMS/scoreLatencyMS + float64(device.StatusChanges)*scorePerStatusFlip
+	if device.AvgSignalDBM != nil && *device.AvgSignalDBM < weakSignalDBM {
+		score += weakSignalDBM - *device.AvgSignalDBM
+	}
+	return math.Round(score*100) / 100
+}
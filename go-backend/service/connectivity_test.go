@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetConnectivityRanking(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalSummaries := repository.GetConnectivitySummaries
+	originalChanges := repository.CountStatusChanges
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetConnectivitySummaries = originalSummaries
+		repository.CountStatusChanges = originalChanges
+	}()
+
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return []int64{1, 2, 3, 4, 5}, nil
+	}
+	weak, strong := -90.0, -55.0
+	repository.GetConnectivitySummaries = func(deviceIDs []int64, start, end time.Time) (map[int64]structs.DeviceConnectivity, error) {
+		return map[int64]structs.DeviceConnectivity{
+			1: {DeviceID: 1, Municipality: "Utrecht", SampleCount: 10, AvgSignalDBM: &strong, AvgLatencyMS: 50},
+			2: {DeviceID: 2, Municipality: "Utrecht", SampleCount: 10, AvgSignalDBM: &weak, AvgLatencyMS: 200, AvgPacketLossPercent: 4},
+			3: {DeviceID: 3, Municipality: "Utrecht", SampleCount: 10, AvgLatencyMS: 5},
+			4: {DeviceID: 4, Municipality: "Amersfoort"},
+			5: {DeviceID: 5, Municipality: "Amersfoort"},
+		}, nil
+	}
+	// Device 4 went offline and came back four times, device 5 never reported anything
+	repository.CountStatusChanges = func(deviceIDs []int64, start, end time.Time) (map[int64]int, error) {
+		return map[int64]int{4: 8}, nil
+	}
+
+	ranking, err := service.GetConnectivityRanking(5, 24*time.Hour, 2, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ranking.Municipalities) != 2 || ranking.Municipalities[0].Municipality != "Amersfoort" {
+		t.Fatalf("Expected Amersfoort and Utrecht, got %+v", ranking.Municipalities)
+	}
+
+	amersfoort := ranking.Municipalities[0].Devices
+	if len(amersfoort) != 1 || amersfoort[0].DeviceID != 4 || !amersfoort[0].Flapping || amersfoort[0].Score != 20 {
+		t.Errorf("Expected only flapping device 4 with score 20, got %+v", amersfoort)
+	}
+
+	// Device 2 scores 4 for packet loss, 4 for latency and 20 for its weak signal
+	utrecht := ranking.Municipalities[1].Devices
+	if len(utrecht) != 2 || utrecht[0].DeviceID != 2 || utrecht[0].Score != 28 || utrecht[1].DeviceID != 1 {
+		t.Errorf("Expected devices 2 and 1 with device 2 scoring 28, got %+v", utrecht)
+	}
+
+	if _, err := service.GetConnectivityRanking(5, 24*time.Hour, 0, time.Now()); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for limit 0, got %v", err)
+	}
+	if _, err := service.GetConnectivityRanking(5, 365*24*time.Hour, 10, time.Now()); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for a year, got %v", err)
+	}
+}
+
+func TestRecordConnectivitySamples(t *testing.T) {
+	originalInsert := repository.InsertConnectivitySamples
+	defer func() { repository.InsertConnectivitySamples = originalInsert }()
+
+	var stored []structs.ConnectivitySample
+	repository.InsertConnectivitySamples = func(deviceID int64, samples []structs.ConnectivitySample) error {
+		stored = samples
+		return nil
+	}
+
+	signal, positive := -67, 5
+	if err := service.RecordConnectivitySamples(1, []structs.ConnectivitySample{{SignalDBM: &signal, LatencyMS: 35, PacketLossPercent: 0.5}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stored) != 1 || stored[0].Timestamp.IsZero() {
+		t.Errorf("Expected the sample to be stored with the current time, got %+v", stored)
+	}
+
+	tests := []struct {
+		name    string
+		samples []structs.ConnectivitySample
+	}{
+		{"No Samples", nil},
+		{"Positive Signal", []structs.ConnectivitySample{{SignalDBM: &positive}}},
+		{"Negative Latency", []structs.ConnectivitySample{{LatencyMS: -1}}},
+		{"Packet Loss Above 100", []structs.ConnectivitySample{{PacketLossPercent: 101}}},
+		{"Future Timestamp", []structs.ConnectivitySample{{Timestamp: time.Now().Add(time.Hour)}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := service.RecordConnectivitySamples(1, tc.samples); !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// lifecycleTransitions are the stages a device can move to from each stage, retired is final
+var lifecycleTransitions = map[string][]string{
+	"ordered":   {"installed", "retired"},
+	"installed": {"active", "retired"},
+	"active":    {"retired"},
+	"retired":   {},
+}
+
+// stuckLifecycleStages are the stages a device is only meant to pass through
+var stuckLifecycleStages = []string{"ordered", "installed"}
+
+// Horizons of the asset report when none are given
+const (
+	DefaultWarrantyDays = 90
+	DefaultStuckDays    = 30
+	maxReportDays       = 3650
+)
+
+// GetDeviceAsset retrieves the asset information of a device the meber has access to
+func GetDeviceAsset(meberID, deviceID int64) (structs.DeviceAsset, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.DeviceAsset{}, err
+	}
+	return getDeviceAsset(deviceID)
+}
+
+// UpdateDeviceAsset changes the asset information of a device the meber has access to
+func UpdateDeviceAsset(meberID int64, update structs.DeviceAssetUpdate) error {
+	// Step 1: Validate and normalize the fields that are set
+	for _, field := range []struct {
+		name      string
+		value     *string
+		maxLength int
+	}{
+		{"serial number", update.SerialNumber, 100},
+		{"vendor", update.Vendor, 100},
+		{"firmware version", update.FirmwareVersion, 50},
+	} {
+		if field.value == nil {
+			continue
+		}
+		*field.value = strings.TrimSpace(*field.value)
+		if len(*field.value) > field.maxLength {
+			return fmt.Errorf("%w: %s can be at most %d characters", ErrInvalidRequest, field.name, field.maxLength)
+		}
+	}
+	installedOn, err := parseAssetDate("installed_on", update.InstalledOn)
+	if err != nil {
+		return err
+	}
+	warrantyEnd, err := parseAssetDate("warranty_end", update.WarrantyEnd)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Check access and compare the dates with the ones that are kept
+	if err := checkDeviceAccess(meberID, []int64{update.DeviceID}); err != nil {
+		return err
+	}
+	current, err := getDeviceAsset(update.DeviceID)
+	if err != nil {
+		return err
+	}
+	if update.InstalledOn == nil {
+		installedOn = current.InstalledOn
+	}
+	if update.WarrantyEnd == nil {
+		warrantyEnd = current.WarrantyEnd
+	}
+	if installedOn != nil && warrantyEnd != nil && warrantyEnd.Before(*installedOn) {
+		return fmt.Errorf("%w: warranty can't end before the installation date", ErrInvalidRequest)
+	}
+
+	// Step 3: A serial number identifies a single unit
+	if update.SerialNumber != nil && *update.SerialNumber != "" {
+		owner, err := repository.GetDeviceIDBySerialNumber(*update.SerialNumber)
+		if err != nil {
+			return err
+		}
+		if owner != 0 && owner != update.DeviceID {
+			return fmt.Errorf("%w: serial number %q already belongs to device %d", ErrInvalidRequest, *update.SerialNumber, owner)
+		}
+	}
+
+	return repository.UpdateDeviceAsset(update, meberID)
+}
+
+// TransitionLifecycleStage moves a device the meber has access to to another lifecycle stage, only the transitions
+// in lifecycleTransitions are allowed
+func TransitionLifecycleStage(meberID int64, request structs.LifecycleTransitionRequest) error {
+	if _, known := lifecycleTransitions[request.Stage]; !known {
+		return fmt.Errorf("%w: unknown lifecycle stage %q", ErrInvalidRequest, request.Stage)
+	}
+	request.Reason = strings.TrimSpace(request.Reason)
+	if len(request.Reason) > maxQuarantineReasonLength {
+		return fmt.Errorf("%w: reason can be at most %d characters", ErrInvalidRequest, maxQuarantineReasonLength)
+	}
+	if request.Stage == "retired" && request.Reason == "" {
+		return fmt.Errorf("%w: a reason is required to retire a device", ErrInvalidRequest)
+	}
+
+	if err := checkDeviceAccess(meberID, []int64{request.DeviceID}); err != nil {
+		return err
+	}
+	current, err := getDeviceAsset(request.DeviceID)
+	if err != nil {
+		return err
+	}
+	if !canTransition(current.LifecycleStage, request.Stage) {
+		return fmt.Errorf("%w: a device can't go from %s to %s", ErrInvalidRequest, current.LifecycleStage, request.Stage)
+	}
+
+	moved, err := repository.TransitionLifecycleStage(request.DeviceID, current.LifecycleStage, request.Stage, meberID, request.Reason, time.Now())
+	if err != nil {
+		return err
+	}
+	if !moved {
+		return fmt.Errorf("%w: the lifecycle stage of device %d changed concurrently", ErrInvalidRequest, request.DeviceID)
+	}
+	return nil
+}
+
+// GetAssetReport lists the accessible devices whose warranty ends within warrantyDays and the devices that have
+// been ordered or installed for longer than stuckDays
+func GetAssetReport(meberID int64, warrantyDays, stuckDays int, now time.Time) (structs.AssetReport, error) {
+	if warrantyDays <= 0 || warrantyDays > maxReportDays || stuckDays <= 0 || stuckDays > maxReportDays {
+		return structs.AssetReport{}, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidRequest, maxReportDays)
+	}
+
+	deviceIDs, err := repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{})
+	if err != nil {
+		return structs.AssetReport{}, err
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	nearing, err := repository.GetAssetsWarrantyEndingBetween(deviceIDs, today, today.AddDate(0, 0, warrantyDays))
+	if err != nil {
+		return structs.AssetReport{}, err
+	}
+	stuck, err := repository.GetAssetsInStageSince(deviceIDs, stuckLifecycleStages, now.AddDate(0, 0, -stuckDays))
+	if err != nil {
+		return structs.AssetReport{}, err
+	}
+
+	report := structs.AssetReport{
+		GeneratedAt:        now,
+		WarrantyDays:       warrantyDays,
+		StuckDays:          stuckDays,
+		NearingWarrantyEnd: nearing,
+		StuckInStage:       stuck,
+	}
+	if report.NearingWarrantyEnd == nil {
+		report.NearingWarrantyEnd = []structs.DeviceAsset{}
+	}
+	if report.StuckInStage == nil {
+		report.StuckInStage = []structs.DeviceAsset{}
+	}
+	return report, nil
+}
+
+// canTransition reports whether a device may move from one lifecycle stage to another
+func canTransition(from, to string) bool {
+	for _, stage := range lifecycleTransitions[from] {
+		if stage == to {
+			return true
+		}
+	}
+	return false
+}
+
+// getDeviceAsset retrieves the asset information of a device, returning ErrNotFound when it does not exist
+func getDeviceAsset(deviceID int64) (structs.DeviceAsset, error) {
+	assets, err := repository.GetDeviceAssets([]int64{deviceID})
+	if err != nil {
+		return structs.DeviceAsset{}, err
+	}
+	if len(assets) == 0 {
+		return structs.DeviceAsset{}, fmt.Errorf("%w: device %d", ErrNotFound, deviceID)
+	}
+	return assets[0], nil
+}
+
+// parseAssetDate parses an optional YYYY-MM-DD date, an empty string clears the date and gives nil
+func parseAssetDate(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	*value = strings.TrimSpace(*value)
+	if *value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a date as YYYY-MM-DD", ErrInvalidRequest, field)
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestTransitionLifecycleStage(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalAssets := repository.GetDeviceAssets
+	originalTransition := repository.TransitionLifecycleStage
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetDeviceAssets = originalAssets
+		repository.TransitionLifecycleStage = originalTransition
+	}()
+
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return filter.DeviceIDs, nil
+	}
+	// Device 1 is ordered, device 2 active and device 3 retired
+	stages := map[int64]string{1: "ordered", 2: "active", 3: "retired"}
+	repository.GetDeviceAssets = func(deviceIDs []int64) ([]structs.DeviceAsset, error) {
+		var assets []structs.DeviceAsset
+		for _, deviceID := range deviceIDs {
+			if stage, ok := stages[deviceID]; ok {
+				assets = append(assets, structs.DeviceAsset{DeviceID: deviceID, LifecycleStage: stage})
+			}
+		}
+		return assets, nil
+	}
+	var transitions []string
+	repository.TransitionLifecycleStage = func(deviceID int64, from, to string, meberID int64, reason string, now time.Time) (bool, error) {
+		transitions = append(transitions, from+"->"+to)
+		return true, nil
+	}
+
+	tests := []struct {
+		name    string
+		request structs.LifecycleTransitionRequest
+		wantErr error
+	}{
+		{"Ordered To Installed", structs.LifecycleTransitionRequest{DeviceID: 1, Stage: "installed"}, nil},
+		{"Ordered Can't Skip To Active", structs.LifecycleTransitionRequest{DeviceID: 1, Stage: "active"}, service.ErrInvalidRequest},
+		{"Active To Retired", structs.LifecycleTransitionRequest{DeviceID: 2, Stage: "retired", Reason: "end of life"}, nil},
+		{"Retire Without Reason", structs.LifecycleTransitionRequest{DeviceID: 2, Stage: "retired"}, service.ErrInvalidRequest},
+		{"Retired Is Final", structs.LifecycleTransitionRequest{DeviceID: 3, Stage: "active"}, service.ErrInvalidRequest},
+		{"Unknown Stage", structs.LifecycleTransitionRequest{DeviceID: 2, Stage: "broken"}, service.ErrInvalidRequest},
+		{"Unknown Device", structs.LifecycleTransitionRequest{DeviceID: 4, Stage: "installed"}, service.ErrNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := service.TransitionLifecycleStage(5, tc.request)
+			if tc.wantErr == nil && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("Expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	if len(transitions) != 2 || transitions[0] != "ordered->installed" || transitions[1] != "active->retired" {
+		t.Errorf("Expected only the valid transitions to be stored, got %v", transitions)
+	}
+}
+
+func TestUpdateDeviceAsset(t *testing.T) {
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalAssets := repository.GetDeviceAssets
+	originalSerial := repository.GetDeviceIDBySerialNumber
+	originalUpdate := repository.UpdateDeviceAsset
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetDeviceAssets = originalAssets
+		repository.GetDeviceIDBySerialNumber = originalSerial
+		repository.UpdateDeviceAsset = originalUpdate
+	}()
+
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return filter.DeviceIDs, nil
+	}
+	installedOn := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	repository.GetDeviceAssets = func(deviceIDs []int64) ([]structs.DeviceAsset, error) {
+		return []structs.DeviceAsset{{DeviceID: deviceIDs[0], LifecycleStage: "active", InstalledOn: &installedOn}}, nil
+	}
+	repository.GetDeviceIDBySerialNumber = func(serialNumber string) (int64, error) {
+		if serialNumber == "SN-TAKEN" {
+			return 9, nil
+		}
+		return 0, nil
+	}
+	var stored structs.DeviceAssetUpdate
+	repository.UpdateDeviceAsset = func(update structs.DeviceAssetUpdate, meberID int64) error {
+		stored = update
+		return nil
+	}
+
+	serial, warranty := " SN-001 ", "2027-03-01"
+	if err := service.UpdateDeviceAsset(5, structs.DeviceAssetUpdate{DeviceID: 1, SerialNumber: &serial, WarrantyEnd: &warranty}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *stored.SerialNumber != "SN-001" || stored.Vendor != nil {
+		t.Errorf("Expected the trimmed serial number and no vendor change, got %+v", stored)
+	}
+
+	taken, beforeInstall, invalid := "SN-TAKEN", "2021-12-31", "tomorrow"
+	tests := []struct {
+		name   string
+		update structs.DeviceAssetUpdate
+	}{
+		{"Serial Number Of Another Device", structs.DeviceAssetUpdate{DeviceID: 1, SerialNumber: &taken}},
+		{"Warranty Ends Before Installation", structs.DeviceAssetUpdate{DeviceID: 1, WarrantyEnd: &beforeInstall}},
+		{"Invalid Date", structs.DeviceAssetUpdate{DeviceID: 1, InstalledOn: &invalid}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := service.UpdateDeviceAsset(5, tc.update); !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
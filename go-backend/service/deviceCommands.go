@@ -0,0 +1,251 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"main/repository"
+	"main/structs"
+	"time"
+)
+
+const (
+	// DefaultCommandTimeout is the time a device gets to report a result before a command is resent
+	DefaultCommandTimeout = 300
+	// DefaultCommandAttempts is the number of times a command is sent before it is marked as timed out
+	DefaultCommandAttempts = 3
+
+	maxCommandTimeout  = 24 * 60 * 60
+	minCommandTimeout  = 10
+	maxCommandAttempts = 10
+	commandListLimit   = 500
+)
+
+var validCommandTypes = map[string]bool{
+	"reboot":              true,
+	"restart_application": true,
+	"collect_diagnostics": true,
+	"resync":              true,
+}
+
+// QueueDeviceCommand validates a command request and queues the command for every targeted device
+func QueueDeviceCommand(meberID int64, request structs.DeviceCommandRequest) ([]structs.DeviceCommand, error) {
+	// Step 1: Validate the command itself
+	if !validCommandTypes[request.Type] {
+		return nil, fmt.Errorf("%w: unknown command type %q", ErrInvalidRequest, request.Type)
+	}
+	if request.TimeoutSeconds == 0 {
+		request.TimeoutSeconds = DefaultCommandTimeout
+	}
+	if request.TimeoutSeconds < minCommandTimeout || request.TimeoutSeconds > maxCommandTimeout {
+		return nil, fmt.Errorf("%w: timeout must be between %d and %d seconds", ErrInvalidRequest, minCommandTimeout, maxCommandTimeout)
+	}
+	if request.MaxAttempts == 0 {
+		request.MaxAttempts = DefaultCommandAttempts
+	}
+	if request.MaxAttempts < 1 || request.MaxAttempts > maxCommandAttempts {
+		return nil, fmt.Errorf("%w: max attempts must be between 1 and %d", ErrInvalidRequest, maxCommandAttempts)
+	}
+
+	// Step 2: Resolve the target devices and check access to each of them
+	deviceIDs, err := resolveTargetDevices(meberID, request.DeviceIDs, request.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkQuarantineCommand(request.Type, deviceIDs); err != nil {
+		return nil, err
+	}
+
+	// Step 3: A restart targets one application instance, which has to run on the single target device
+	if request.Type == "restart_application" {
+		if request.AppInstanceID == nil || len(deviceIDs) != 1 {
+			return nil, fmt.Errorf("%w: restart_application needs one device and an application instance", ErrInvalidRequest)
+		}
+		instanceDeviceID, err := repository.GetApplicationInstanceDeviceID(*request.AppInstanceID)
+		if err != nil {
+			return nil, err
+		}
+		if instanceDeviceID != deviceIDs[0] {
+			return nil, fmt.Errorf("%w: application instance %d does not run on device %d", ErrInvalidRequest, *request.AppInstanceID, deviceIDs[0])
+		}
+	} else if request.AppInstanceID != nil {
+		return nil, fmt.Errorf("%w: only restart_application takes an application instance", ErrInvalidRequest)
+	}
+
+	// Step 4: Queue the command, the repository audits every queued command
+	command := structs.DeviceCommand{
+		Type:           request.Type,
+		AppInstanceID:  request.AppInstanceID,
+		Status:         "queued",
+		MaxAttempts:    request.MaxAttempts,
+		TimeoutSeconds: request.TimeoutSeconds,
+		CreatedBy:      meberID,
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+	commandIDs, err := repository.InsertDeviceCommands(command, deviceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error queueing commands: %w", err)
+	}
+
+	commands := make([]structs.DeviceCommand, len(commandIDs))
+	for i, commandID := range commandIDs {
+		commands[i] = command
+		commands[i].ID = commandID
+		commands[i].DeviceID = deviceIDs[i]
+	}
+	return commands, nil
+}
+
+// GetDeviceCommands lists the commands of a device or device group the meber has access to
+func GetDeviceCommands(meberID, deviceID, groupID int64, statuses []string) ([]structs.DeviceCommand, error) {
+	var deviceIDs []int64
+	if deviceID != 0 {
+		deviceIDs = append(deviceIDs, deviceID)
+	}
+	deviceIDs, err := resolveTargetDevices(meberID, deviceIDs, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	commands, err := repository.GetDeviceCommands(deviceIDs, statuses, commandListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching commands: %w", err)
+	}
+	if commands == nil {
+		commands = []structs.DeviceCommand{}
+	}
+	return commands, nil
+}
+
+// CancelDeviceCommand cancels a queued or sent command on a device the meber has access to
+func CancelDeviceCommand(meberID, commandID int64) error {
+	command, err := repository.GetDeviceCommandByID(commandID)
+	if err != nil {
+		return err
+	}
+	if command == nil {
+		return fmt.Errorf("%w: command %d", ErrNotFound, commandID)
+	}
+	if err := checkDeviceAccess(meberID, []int64{command.DeviceID}); err != nil {
+		return err
+	}
+
+	cancelled, err := repository.CancelDeviceCommand(commandID, command.DeviceID, meberID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return fmt.Errorf("%w: command %d already completed", ErrInvalidRequest, commandID)
+	}
+	return nil
+}
+
+// PollDeviceCommands hands the queued commands of a device to that device
+func PollDeviceCommands(deviceID int64) ([]structs.DeviceCommand, error) {
+	commands, err := repository.ClaimQueuedDeviceCommands(deviceID, time.Now().UTC().Truncate(time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("error claiming commands: %w", err)
+	}
+	if commands == nil {
+		commands = []structs.DeviceCommand{}
+	}
+	return commands, nil
+}
+
+// ReportDeviceCommandResult stores the outcome of a command reported by the device it was sent to
+func ReportDeviceCommandResult(deviceID int64, result structs.DeviceCommandResult) error {
+	command, err := repository.GetDeviceCommandByID(result.CommandID)
+	if err != nil {
+		return err
+	}
+	// A device can only report on its own commands, anything else is reported as missing
+	if command == nil || command.DeviceID != deviceID {
+		return fmt.Errorf("%w: command %d", ErrNotFound, result.CommandID)
+	}
+	if command.Status != "sent" {
+		return fmt.Errorf("%w: command %d is %s", ErrInvalidRequest, result.CommandID, command.Status)
+	}
+
+	status := "failed"
+	if result.Success {
+		status = "succeeded"
+	}
+	// The command may have been cancelled or expired since it was read
+	completed, err := repository.CompleteDeviceCommand(command.ID, deviceID, status, result.Output, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if !completed {
+		return fmt.Errorf("%w: command %d is no longer sent", ErrInvalidRequest, result.CommandID)
+	}
+	return nil
+}
+
+// ExpireDeviceCommands requeues commands the device did not answer in time, or times them out after the last attempt
+func ExpireDeviceCommands(now time.Time) error {
+	requeued, timedOut, err := repository.ExpireDeviceCommands(now.UTC())
+	if err != nil {
+		return err
+	}
+	if requeued > 0 || timedOut > 0 {
+		log.Printf("Device commands: requeued %d, timed out %d", requeued, timedOut)
+	}
+	return nil
+}
+
+// RunCommandTimeouts checks for expired commands on every tick of the given interval
+func RunCommandTimeouts(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := ExpireDeviceCommands(time.Now()); err != nil {
+			log.Printf("Error expiring device commands: %v", err)
+		}
+	}
+}
+
+// GetDeviceAuditLog retrieves the audit trail of a device the meber has access to
+func GetDeviceAuditLog(meberID, deviceID int64) ([]structs.AuditLogEntry, error) {
+	if err := checkDeviceRecordAccess(meberID, deviceID); err != nil {
+		return nil, err
+	}
+
+	entries, err := repository.FetchAuditLogForDevice(deviceID, commandListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching audit log: %w", err)
+	}
+	if entries == nil {
+		entries = []structs.AuditLogEntry{}
+	}
+	return entries, nil
+}
+
+// resolveTargetDevices combines explicit device IDs with the members of a group and checks access to all of them
+func resolveTargetDevices(meberID int64, deviceIDs []int64, groupID int64) ([]int64, error) {
+	targets := append([]int64{}, deviceIDs...)
+	if groupID != 0 {
+		memberIDs, err := GetDeviceGroupDeviceIDs(meberID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, memberIDs...)
+	}
+
+	// Drop duplicates while keeping the order
+	seen := make(map[int64]bool, len(targets))
+	unique := targets[:0]
+	for _, deviceID := range targets {
+		if !seen[deviceID] {
+			seen[deviceID] = true
+			unique = append(unique, deviceID)
+		}
+	}
+
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("%w: no target devices", ErrInvalidRequest)
+	}
+	if err := checkDeviceAccess(meberID, unique); err != nil {
+		return nil, err
+	}
+	return unique, nil
+}
@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestQueueDeviceCommand(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalInsert := repository.InsertDeviceCommands
+	originalInstance := repository.GetApplicationInstanceDeviceID
+	originalQuarantined := repository.GetQuarantinedDeviceIDs
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.InsertDeviceCommands = originalInsert
+		repository.GetApplicationInstanceDeviceID = originalInstance
+		repository.GetQuarantinedDeviceIDs = originalQuarantined
+	}()
+
+	// Meber has access to devices 1 and 2
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		var ids []int64
+		for _, id := range filter.DeviceIDs {
+			if id == 1 || id == 2 {
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+	repository.GetApplicationInstanceDeviceID = func(appInstanceID int64) (int64, error) {
+		return 1, nil
+	}
+
+	// No device is quarantined unless a subtest says so
+	var quarantined []int64
+	repository.GetQuarantinedDeviceIDs = func(deviceIDs []int64) ([]int64, error) {
+		return quarantined, nil
+	}
+
+	var queuedFor []int64
+	repository.InsertDeviceCommands = func(command structs.DeviceCommand, deviceIDs []int64) ([]int64, error) {
+		queuedFor = deviceIDs
+		ids := make([]int64, len(deviceIDs))
+		for i := range deviceIDs {
+			ids[i] = int64(100 + i)
+		}
+		return ids, nil
+	}
+
+	t.Run("Defaults Are Applied", func(t *testing.T) {
+		commands, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "reboot", DeviceIDs: []int64{1, 2, 1}})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(queuedFor) != 2 {
+			t.Errorf("Expected duplicates to be removed, queued for %v", queuedFor)
+		}
+		if commands[1].DeviceID != 2 || commands[1].ID != 101 || commands[1].CreatedBy != 5 {
+			t.Errorf("Unexpected command %+v", commands[1])
+		}
+		if commands[0].TimeoutSeconds != service.DefaultCommandTimeout || commands[0].MaxAttempts != service.DefaultCommandAttempts {
+			t.Errorf("Expected default timeout and attempts, got %+v", commands[0])
+		}
+	})
+
+	t.Run("Device Without Access", func(t *testing.T) {
+		_, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "resync", DeviceIDs: []int64{1, 3}})
+		if !errors.Is(err, service.ErrAccessDenied) {
+			t.Errorf("Expected access denied error, got %v", err)
+		}
+	})
+
+	t.Run("Restart Needs Instance On Device", func(t *testing.T) {
+		instanceID := int64(9)
+		if _, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "restart_application", DeviceIDs: []int64{1}, AppInstanceID: &instanceID}); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if _, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "restart_application", DeviceIDs: []int64{2}, AppInstanceID: &instanceID}); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected invalid request for instance on other device, got %v", err)
+		}
+		if _, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "restart_application", DeviceIDs: []int64{1}}); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected invalid request without instance, got %v", err)
+		}
+	})
+
+	t.Run("Quarantined Device Only Gets Diagnostics", func(t *testing.T) {
+		quarantined = []int64{2}
+		defer func() { quarantined = nil }()
+
+		if _, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "reboot", DeviceIDs: []int64{1, 2}}); !errors.Is(err, service.ErrAccessDenied) {
+			t.Errorf("Expected access denied for a quarantined device, got %v", err)
+		}
+		if _, err := service.QueueDeviceCommand(5, structs.DeviceCommandRequest{Type: "collect_diagnostics", DeviceIDs: []int64{2}}); err != nil {
+			t.Errorf("Expected diagnostics to be allowed, got %v", err)
+		}
+	})
+
+	t.Run("Invalid Requests", func(t *testing.T) {
+		invalidRequests := map[string]structs.DeviceCommandRequest{
+			"Unknown Type":     {Type: "format", DeviceIDs: []int64{1}},
+			"No Targets":       {Type: "reboot"},
+			"Timeout Too Low":  {Type: "reboot", DeviceIDs: []int64{1}, TimeoutSeconds: 1},
+			"Too Many Retries": {Type: "reboot", DeviceIDs: []int64{1}, MaxAttempts: 50},
+		}
+		for name, request := range invalidRequests {
+			if _, err := service.QueueDeviceCommand(5, request); !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("%s: expected invalid request error, got %v", name, err)
+			}
+		}
+	})
+}
+
+func TestReportDeviceCommandResult(t *testing.T) {
+	originalGet := repository.GetDeviceCommandByID
+	originalComplete := repository.CompleteDeviceCommand
+	defer func() {
+		repository.GetDeviceCommandByID = originalGet
+		repository.CompleteDeviceCommand = originalComplete
+	}()
+
+	repository.GetDeviceCommandByID = func(commandID int64) (*structs.DeviceCommand, error) {
+		switch commandID {
+		case 1:
+			return &structs.DeviceCommand{ID: 1, DeviceID: 10, Status: "sent"}, nil
+		case 2:
+			return &structs.DeviceCommand{ID: 2, DeviceID: 10, Status: "timed_out"}, nil
+		case 3:
+			return &structs.DeviceCommand{ID: 3, DeviceID: 10, Status: "sent"}, nil
+		}
+		return nil, nil
+	}
+
+	var storedStatus string
+	repository.CompleteDeviceCommand = func(commandID, deviceID int64, status, result string, now time.Time) (bool, error) {
+		// Command 3 is cancelled between reading and completing it
+		if commandID == 3 {
+			return false, nil
+		}
+		storedStatus = status
+		return true, nil
+	}
+
+	if err := service.ReportDeviceCommandResult(10, structs.DeviceCommandResult{CommandID: 1, Success: false, Output: "disk full"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if storedStatus != "failed" {
+		t.Errorf("Expected status failed, got %s", storedStatus)
+	}
+
+	// Another device can't report on this command
+	if err := service.ReportDeviceCommandResult(11, structs.DeviceCommandResult{CommandID: 1, Success: true}); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+
+	// A command that already timed out can't be completed anymore
+	if err := service.ReportDeviceCommandResult(10, structs.DeviceCommandResult{CommandID: 2, Success: true}); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected invalid request error, got %v", err)
+	}
+	// A late report doesn't overwrite a command that was cancelled after it was read
+	if err := service.ReportDeviceCommandResult(10, structs.DeviceCommandResult{CommandID: 3, Success: true}); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected invalid request error, got %v", err)
+	}
+}
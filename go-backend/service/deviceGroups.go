@@ -0,0 +1,292 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// GetDeviceGroups retrieves the groups the meber owns or that are shared with one of the meber's roles
+func GetDeviceGroups(meberID int64) ([]structs.DeviceGroup, error) {
+	groups, err := repository.GetDeviceGroupsForMeber(meberID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching device groups: %w", err)
+	}
+	if groups == nil {
+		groups = []structs.DeviceGroup{}
+	}
+	return groups, nil
+}
+
+// CreateDeviceGroup validates and stores a new static group or saved filter owned by the meber
+func CreateDeviceGroup(meberID int64, group structs.DeviceGroup, deviceIDs []int64) (structs.DeviceGroup, error) {
+	group.Name = strings.TrimSpace(group.Name)
+	if group.Name == "" {
+		return structs.DeviceGroup{}, fmt.Errorf("%w: group name is required", ErrInvalidRequest)
+	}
+
+	switch group.Type {
+	case "filter":
+		if group.Filter == nil || group.Filter.IsEmpty() {
+			return structs.DeviceGroup{}, fmt.Errorf("%w: a filter group needs a filter", ErrInvalidRequest)
+		}
+		if err := validateDeviceFilter(*group.Filter); err != nil {
+			return structs.DeviceGroup{}, err
+		}
+		if len(deviceIDs) > 0 {
+			return structs.DeviceGroup{}, fmt.Errorf("%w: a filter group can't have explicit members", ErrInvalidRequest)
+		}
+	case "static":
+		if group.Filter != nil {
+			return structs.DeviceGroup{}, fmt.Errorf("%w: a static group can't have a filter", ErrInvalidRequest)
+		}
+		if err := checkDeviceAccess(meberID, deviceIDs); err != nil {
+			return structs.DeviceGroup{}, err
+		}
+	default:
+		return structs.DeviceGroup{}, fmt.Errorf("%w: group type must be static or filter", ErrInvalidRequest)
+	}
+
+	// A group can only be shared with a role the meber holds
+	if group.RoleID != nil {
+		roles, err := repository.GetRolesForMeber(meberID)
+		if err != nil {
+			return structs.DeviceGroup{}, err
+		}
+		hasRole := false
+		for _, role := range roles {
+			if role.ID == *group.RoleID {
+				hasRole = true
+				break
+			}
+		}
+		if !hasRole {
+			return structs.DeviceGroup{}, fmt.Errorf("%w: meber does not hold role %d", ErrAccessDenied, *group.RoleID)
+		}
+	}
+
+	group.OwnerID = meberID
+	groupID, err := repository.CreateDeviceGroup(group, deviceIDs)
+	if err != nil {
+		return structs.DeviceGroup{}, fmt.Errorf("error creating device group: %w", err)
+	}
+	group.ID = groupID
+
+	return group, nil
+}
+
+// DeleteDeviceGroup removes a group owned by the meber
+func DeleteDeviceGroup(meberID, groupID int64) error {
+	if _, err := ownedDeviceGroup(meberID, groupID); err != nil {
+		return err
+	}
+
+	// Maintenance that is running or still to come would silently be dropped, finished windows go with the group
+	windows, err := repository.GetMaintenanceWindows()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, window := range windows {
+		if window.TargetType == "group" && window.TargetID == groupID && !maintenanceWindowFinished(window, now) {
+			return fmt.Errorf("%w: group %d is the target of maintenance window %q", ErrInvalidRequest, groupID, window.Name)
+		}
+	}
+
+	if err := repository.DeleteDeviceGroup(groupID); err != nil {
+		return err
+	}
+	invalidateMaintenanceTargets()
+	return nil
+}
+
+// UpdateDeviceGroupMembers adds and removes explicit members of a static group owned by the meber
+func UpdateDeviceGroupMembers(meberID, groupID int64, addDeviceIDs, removeDeviceIDs []int64) error {
+	group, err := ownedDeviceGroup(meberID, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Type != "static" {
+		return fmt.Errorf("%w: members can only be changed on a static group", ErrInvalidRequest)
+	}
+	if err := checkDeviceAccess(meberID, addDeviceIDs); err != nil {
+		return err
+	}
+
+	if err := repository.AddDeviceGroupMembers(groupID, addDeviceIDs); err != nil {
+		return err
+	}
+	if err := repository.RemoveDeviceGroupMembers(groupID, removeDeviceIDs); err != nil {
+		return err
+	}
+	invalidateMaintenanceTargets()
+	return nil
+}
+
+// GetDeviceGroupDeviceIDs resolves a group to the IDs of its members the meber currently has access to
+func GetDeviceGroupDeviceIDs(meberID, groupID int64) ([]int64, error) {
+	group, err := visibleDeviceGroup(meberID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Saved filters are evaluated on every use, so they follow the devices' current attributes
+	if group.Type == "filter" {
+		return repository.GetDeviceIDsByFilter(meberID, *group.Filter)
+	}
+
+	memberIDs, err := repository.GetStaticGroupMemberIDs(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) == 0 {
+		return nil, nil
+	}
+
+	// A shared group can contain devices outside this meber's access, those are left out
+	return repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{DeviceIDs: memberIDs})
+}
+
+// GetGroupDevicesForMap returns the map entries of the accessible members of a group
+func GetGroupDevicesForMap(meberID, groupID int64) ([]structs.EdgeDeviceMapResponse, error) {
+	memberIDs, err := GetDeviceGroupDeviceIDs(meberID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := idSet(memberIDs)
+
+	devices, err := GetAllEdgeDevicesForMap(meberID)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []structs.EdgeDeviceMapResponse{}
+	for _, device := range devices {
+		if members[device.ID] {
+			filtered = append(filtered, device)
+		}
+	}
+	return filtered, nil
+}
+
+// GetGroupDevicesWithApplications returns the devices with applications of the accessible members of a group
+func GetGroupDevicesWithApplications(meberID, groupID int64) ([]structs.DeviceWithApplicationsDTO, error) {
+	memberIDs, err := GetDeviceGroupDeviceIDs(meberID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := idSet(memberIDs)
+
+	devices, err := GetAllDevicesWithApplications(meberID)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []structs.DeviceWithApplicationsDTO{}
+	for _, device := range devices {
+		if members[device.DeviceID] {
+			filtered = append(filtered, device)
+		}
+	}
+	return filtered, nil
+}
+
+// visibleDeviceGroup retrieves a group the meber owns or that is shared with the meber
+func visibleDeviceGroup(meberID, groupID int64) (*structs.DeviceGroup, error) {
+	group, err := repository.GetDeviceGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("%w: device group %d", ErrNotFound, groupID)
+	}
+	if group.OwnerID == meberID {
+		return group, nil
+	}
+
+	if group.RoleID != nil {
+		roles, err := repository.GetRolesForMeber(meberID)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			if role.ID == *group.RoleID {
+				return group, nil
+			}
+		}
+	}
+
+	// Groups of other mebers are reported as missing rather than forbidden
+	return nil, fmt.Errorf("%w: device group %d", ErrNotFound, groupID)
+}
+
+// ownedDeviceGroup retrieves a group the meber is allowed to change, only the owner can change a group
+func ownedDeviceGroup(meberID, groupID int64) (*structs.DeviceGroup, error) {
+	group, err := visibleDeviceGroup(meberID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.OwnerID != meberID {
+		return nil, fmt.Errorf("%w: only the owner can change device group %d", ErrAccessDenied, groupID)
+	}
+	return group, nil
+}
+
+// checkDeviceAccess verifies that the meber has access to every listed device
+func checkDeviceAccess(meberID int64, deviceIDs []int64) error {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	accessibleIDs, err := repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{DeviceIDs: deviceIDs})
+	if err != nil {
+		return err
+	}
+
+	accessible := idSet(accessibleIDs)
+	for _, deviceID := range deviceIDs {
+		if !accessible[deviceID] {
+			return fmt.Errorf("%w: no access to device %d", ErrAccessDenied, deviceID)
+		}
+	}
+	return nil
+}
+
+// checkDeviceRecordAccess checks that the meber has access to a device, also when it was decommissioned, so the
+// history that is kept of replaced units stays readable
+func checkDeviceRecordAccess(meberID, deviceID int64) error {
+	hasAccess, err := repository.MeberHasDeviceAccess(meberID, deviceID)
+	if err != nil {
+		return err
+	}
+	if !hasAccess {
+		return fmt.Errorf("%w: no access to device %d", ErrAccessDenied, deviceID)
+	}
+	return nil
+}
+
+// validateDeviceFilter checks the enum values in a filter so a saved filter can't silently match nothing
+func validateDeviceFilter(filter structs.DeviceFilter) error {
+	for _, status := range filter.Statuses {
+		if !validDeviceStatuses[status] {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidRequest, status)
+		}
+	}
+	for _, connectionType := range filter.ConnectionTypes {
+		if !validConnectionTypes[connectionType] {
+			return fmt.Errorf("%w: unknown connection type %q", ErrInvalidRequest, connectionType)
+		}
+	}
+	return nil
+}
+
+// idSet turns a list of IDs into a lookup set
+func idSet(ids []int64) map[int64]bool {
+	set := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
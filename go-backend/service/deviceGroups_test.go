@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetDeviceGroupDeviceIDs(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalGroup := repository.GetDeviceGroupByID
+	originalMembers := repository.GetStaticGroupMemberIDs
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalRoles := repository.GetRolesForMeber
+	defer func() {
+		repository.GetDeviceGroupByID = originalGroup
+		repository.GetStaticGroupMemberIDs = originalMembers
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetRolesForMeber = originalRoles
+	}()
+
+	sharedRoleID := int64(7)
+	groups := map[int64]*structs.DeviceGroup{
+		1: {ID: 1, Name: "Static", Type: "static", OwnerID: 1, RoleID: &sharedRoleID},
+		2: {ID: 2, Name: "Wireless audio", Type: "filter", OwnerID: 1, Filter: &structs.DeviceFilter{
+			ConnectionTypes: []string{"wireless"},
+			Sensors:         []string{"audio sensor"},
+		}},
+	}
+	repository.GetDeviceGroupByID = func(groupID int64) (*structs.DeviceGroup, error) {
+		return groups[groupID], nil
+	}
+	repository.GetStaticGroupMemberIDs = func(groupID int64) ([]int64, error) {
+		return []int64{10, 11, 12}, nil
+	}
+	// Meber 2 only has access to device 11
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		if len(filter.DeviceIDs) > 0 {
+			if meberID == 2 {
+				return []int64{11}, nil
+			}
+			return filter.DeviceIDs, nil
+		}
+		if len(filter.Sensors) == 1 && filter.Sensors[0] == "audio sensor" {
+			return []int64{20, 21}, nil
+		}
+		return nil, nil
+	}
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 2 {
+			return []structs.Role{{ID: sharedRoleID}}, nil
+		}
+		return nil, nil
+	}
+
+	t.Run("Owner Gets All Static Members", func(t *testing.T) {
+		ids, err := service.GetDeviceGroupDeviceIDs(1, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(ids) != 3 {
+			t.Errorf("Expected 3 members, got %v", ids)
+		}
+	})
+
+	t.Run("Shared Group Is Limited To Accessible Devices", func(t *testing.T) {
+		ids, err := service.GetDeviceGroupDeviceIDs(2, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(ids) != 1 || ids[0] != 11 {
+			t.Errorf("Expected only device 11, got %v", ids)
+		}
+	})
+
+	t.Run("Saved Filter Is Evaluated", func(t *testing.T) {
+		ids, err := service.GetDeviceGroupDeviceIDs(1, 2)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(ids) != 2 || ids[0] != 20 {
+			t.Errorf("Expected devices 20 and 21, got %v", ids)
+		}
+	})
+
+	t.Run("Unshared Group Is Not Found", func(t *testing.T) {
+		_, err := service.GetDeviceGroupDeviceIDs(3, 2)
+		if !errors.Is(err, service.ErrNotFound) {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("Only Owner Can Change Members", func(t *testing.T) {
+		err := service.UpdateDeviceGroupMembers(2, 1, []int64{11}, nil)
+		if !errors.Is(err, service.ErrAccessDenied) {
+			t.Errorf("Expected access denied error, got %v", err)
+		}
+	})
+
+	t.Run("Cannot Share With Role Not Held", func(t *testing.T) {
+		otherRoleID := int64(99)
+		_, err := service.CreateDeviceGroup(2, structs.DeviceGroup{Name: "Mine", Type: "static", RoleID: &otherRoleID}, []int64{11})
+		if !errors.Is(err, service.ErrAccessDenied) {
+			t.Errorf("Expected access denied error, got %v", err)
+		}
+	})
+
+	t.Run("Filter Group Needs Valid Filter", func(t *testing.T) {
+		_, err := service.CreateDeviceGroup(1, structs.DeviceGroup{Name: "Bad", Type: "filter", Filter: &structs.DeviceFilter{Statuses: []string{"sleeping"}}}, nil)
+		if !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected invalid request error, got %v", err)
+		}
+	})
+}
+
+func TestDeleteDeviceGroup(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalGroup := repository.GetDeviceGroupByID
+	originalWindows := repository.GetMaintenanceWindows
+	originalDelete := repository.DeleteDeviceGroup
+	defer func() {
+		repository.GetDeviceGroupByID = originalGroup
+		repository.GetMaintenanceWindows = originalWindows
+		repository.DeleteDeviceGroup = originalDelete
+	}()
+
+	// Group 1 had maintenance last year, group 2 has a window coming up and group 3 one that repeats daily
+	now := time.Now()
+	repository.GetDeviceGroupByID = func(groupID int64) (*structs.DeviceGroup, error) {
+		return &structs.DeviceGroup{ID: groupID, Name: "Group", Type: "static", OwnerID: 1}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return []structs.MaintenanceWindow{
+			{ID: 1, Name: "Past", TargetType: "group", TargetID: 1, StartsAt: now.AddDate(-1, 0, 0), EndsAt: now.AddDate(-1, 0, 0).Add(time.Hour), Recurrence: "none"},
+			{ID: 2, Name: "Upcoming", TargetType: "group", TargetID: 2, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Recurrence: "none"},
+			{ID: 3, Name: "Nightly", TargetType: "group", TargetID: 3, StartsAt: now.AddDate(0, 0, -7), EndsAt: now.AddDate(0, 0, -7).Add(time.Hour), Recurrence: "daily"},
+			{ID: 4, Name: "Device", TargetType: "device", TargetID: 1, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Recurrence: "none"},
+		}, nil
+	}
+	var deleted []int64
+	repository.DeleteDeviceGroup = func(groupID int64) error {
+		deleted = append(deleted, groupID)
+		return nil
+	}
+
+	if err := service.DeleteDeviceGroup(1, 1); err != nil {
+		t.Errorf("Expected a group with only finished maintenance to be deleted, got %v", err)
+	}
+	for _, groupID := range []int64{2, 3} {
+		if err := service.DeleteDeviceGroup(1, groupID); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected group %d with maintenance to come to be kept, got %v", groupID, err)
+		}
+	}
+	if err := service.DeleteDeviceGroup(2, 1); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected the group of another meber to be reported as missing, got %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != 1 {
+		t.Errorf("Expected only group 1 to be deleted, got %v", deleted)
+	}
+}
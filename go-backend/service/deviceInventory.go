@@ -0,0 +1,476 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"main/geo"
+	"main/repository"
+	"main/structs"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxImportRows is the maximum number of devices in a single import
+const MaxImportRows = 5000
+
+// InventoryColumns are the CSV columns of the device inventory, in export order
+var InventoryColumns = []string{
+	"id", "name", "status", "connection_type", "ip_address", "latitude", "longitude",
+	"municipality", "tags", "sensors", "applications",
+}
+
+// inventoryListSeparator separates the values of list columns such as tags and sensors
+const inventoryListSeparator = ";"
+
+// ExportDeviceInventory retrieves the inventory of the accessible devices, limited to a group when groupID is set
+func ExportDeviceInventory(meberID, groupID int64) ([]structs.DeviceInventoryRecord, error) {
+	var deviceIDs []int64
+	var err error
+	if groupID != 0 {
+		deviceIDs, err = GetDeviceGroupDeviceIDs(meberID, groupID)
+	} else {
+		deviceIDs, err = repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := repository.GetDeviceInventory(deviceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching device inventory: %w", err)
+	}
+	if records == nil {
+		records = []structs.DeviceInventoryRecord{}
+	}
+	return records, nil
+}
+
+// WriteDeviceInventoryCSV writes inventory records as CSV with a header row
+func WriteDeviceInventoryCSV(w io.Writer, records []structs.DeviceInventoryRecord) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(InventoryColumns); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		row := []string{
+			strconv.FormatInt(record.ID, 10),
+			record.Name,
+			record.Status,
+			record.ConnectionType,
+			record.IPAddress,
+			strconv.FormatFloat(record.Latitude, 'f', -1, 64),
+			strconv.FormatFloat(record.Longitude, 'f', -1, 64),
+			record.Municipality,
+			strings.Join(record.Tags, inventoryListSeparator),
+			strings.Join(record.Sensors, inventoryListSeparator),
+			strings.Join(record.Applications, inventoryListSeparator),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteDeviceInventoryNDJSON writes inventory records as newline delimited JSON, one device per line
+func WriteDeviceInventoryNDJSON(w io.Writer, records []structs.DeviceInventoryRecord) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportDevicesCSV validates a CSV inventory and, unless it is a dry run, creates and updates the devices in one
+// transaction. Rows are matched on id, or on name when id is empty. Nothing is applied when any row is invalid.
+func ImportDevicesCSV(meberID int64, r io.Reader, dryRun bool) (structs.DeviceImportReport, error) {
+	report := structs.DeviceImportReport{DryRun: dryRun, Rows: []structs.DeviceImportRow{}, Errors: []structs.DeviceImportError{}}
+
+	// Step 1: Parse the CSV into rows, collecting errors per row
+	rows, err := parseDeviceImport(r, &report)
+	if err != nil {
+		return report, err
+	}
+
+	// Step 2: Resolve names and access against the database
+	if err := resolveDeviceImport(meberID, rows, &report); err != nil {
+		return report, err
+	}
+
+	for _, row := range rows {
+		if row.Action == "create" {
+			report.Created++
+		} else {
+			report.Updated++
+		}
+	}
+	report.Rows = rows
+	sort.SliceStable(report.Errors, func(i, j int) bool { return report.Errors[i].Row < report.Errors[j].Row })
+
+	// Step 3: Apply everything at once, or nothing when a row is invalid
+	if len(report.Errors) > 0 || dryRun {
+		return report, nil
+	}
+	state, err := getMaintenanceState(time.Now())
+	if err != nil {
+		return report, err
+	}
+	if err := repository.ApplyDeviceImport(rows, meberID, state.inMaintenance); err != nil {
+		return report, fmt.Errorf("error applying device import: %w", err)
+	}
+	report.Applied = true
+	// Imports can create devices and change their status
+	invalidateAllTiles()
+
+	return report, nil
+}
+
+// parseDeviceImport reads the header and rows of an import and validates the values of every row
+func parseDeviceImport(r io.Reader, report *structs.DeviceImportReport) ([]structs.DeviceImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: the import is empty", ErrInvalidRequest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid CSV header: %v", ErrInvalidRequest, err)
+	}
+
+	known := make(map[string]bool, len(InventoryColumns))
+	for _, column := range InventoryColumns {
+		known[column] = true
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !known[column] {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidRequest, column)
+		}
+		if _, duplicate := columns[column]; duplicate {
+			return nil, fmt.Errorf("%w: column %q appears twice", ErrInvalidRequest, column)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("%w: the name column is required", ErrInvalidRequest)
+	}
+	_, hasLatitude := columns["latitude"]
+	_, hasLongitude := columns["longitude"]
+	if hasLatitude != hasLongitude {
+		return nil, fmt.Errorf("%w: latitude and longitude columns go together", ErrInvalidRequest)
+	}
+
+	var rows []structs.DeviceImportRow
+	for line := 2; ; line++ {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid CSV on line %d: %v", ErrInvalidRequest, line, err)
+		}
+		if len(rows) == MaxImportRows {
+			return nil, fmt.Errorf("%w: an import can contain at most %d devices", ErrInvalidRequest, MaxImportRows)
+		}
+		if len(values) != len(header) {
+			report.Errors = append(report.Errors, structs.DeviceImportError{Row: line,
+				Message: fmt.Sprintf("expected %d values, got %d", len(header), len(values))})
+			continue
+		}
+
+		row, rowErrors := parseDeviceImportRow(line, columns, values)
+		report.Errors = append(report.Errors, rowErrors...)
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 && len(report.Errors) == 0 {
+		return nil, fmt.Errorf("%w: the import contains no devices", ErrInvalidRequest)
+	}
+	return rows, nil
+}
+
+// parseDeviceImportRow converts the values of a single row, only non-empty values are taken over
+func parseDeviceImportRow(line int, columns map[string]int, values []string) (structs.DeviceImportRow, []structs.DeviceImportError) {
+	row := structs.DeviceImportRow{Row: line, Fields: map[string]bool{}}
+	var rowErrors []structs.DeviceImportError
+	fail := func(column, message string) {
+		rowErrors = append(rowErrors, structs.DeviceImportError{Row: line, Column: column, Message: message})
+	}
+
+	value := func(column string) string {
+		index, ok := columns[column]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(values[index])
+	}
+	for column := range columns {
+		if value(column) != "" {
+			row.Fields[column] = true
+		}
+	}
+
+	record := &row.Record
+	if id := value("id"); id != "" {
+		parsed, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || parsed <= 0 {
+			fail("id", "id must be a positive number")
+		}
+		record.ID = parsed
+	}
+
+	record.Name = value("name")
+	if record.Name == "" && record.ID == 0 {
+		fail("name", "name is required when there is no id")
+	}
+	if len(record.Name) > 100 {
+		fail("name", "name can be at most 100 characters")
+	}
+
+	record.Status = value("status")
+	if record.Status != "" && !validDeviceStatuses[record.Status] {
+		fail("status", fmt.Sprintf("unknown status %q", record.Status))
+	}
+	record.ConnectionType = value("connection_type")
+	if record.ConnectionType != "" && !validConnectionTypes[record.ConnectionType] {
+		fail("connection_type", fmt.Sprintf("unknown connection type %q", record.ConnectionType))
+	}
+	record.IPAddress = value("ip_address")
+	if record.IPAddress != "" && net.ParseIP(record.IPAddress) == nil {
+		fail("ip_address", fmt.Sprintf("invalid IP address %q", record.IPAddress))
+	}
+
+	latitude, longitude := value("latitude"), value("longitude")
+	if (latitude == "") != (longitude == "") {
+		fail("latitude", "latitude and longitude go together")
+	} else if latitude != "" {
+		var err error
+		if record.Latitude, err = strconv.ParseFloat(latitude, 64); err != nil || record.Latitude < -90 || record.Latitude > 90 {
+			fail("latitude", "latitude must be a number between -90 and 90")
+		}
+		if record.Longitude, err = strconv.ParseFloat(longitude, 64); err != nil || record.Longitude < -180 || record.Longitude > 180 {
+			fail("longitude", "longitude must be a number between -180 and 180")
+		}
+	}
+
+	record.Municipality = value("municipality")
+	record.Tags = splitInventoryList(value("tags"))
+	record.Sensors = splitInventoryList(value("sensors"))
+
+	// Installing applications checks their resources and capabilities, that is left to the application endpoints
+	if value("applications") != "" {
+		fail("applications", "applications can't be imported, install them on the devices instead")
+	}
+
+	return row, rowErrors
+}
+
+// splitInventoryList splits a list column into its trimmed, non-empty values
+func splitInventoryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, inventoryListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// resolveDeviceImport decides per row whether it creates or updates a device and resolves tag and sensor names
+func resolveDeviceImport(meberID int64, rows []structs.DeviceImportRow, report *structs.DeviceImportReport) error {
+	fail := func(row int, column, message string) {
+		report.Errors = append(report.Errors, structs.DeviceImportError{Row: row, Column: column, Message: message})
+	}
+
+	// Step 1: Look up everything the rows refer to in bulk
+	var ids []int64
+	var names, tagNames, sensorNames []string
+	located := make(map[int]string) // Municipality at the coordinates of a row, by row number
+	for _, row := range rows {
+		if row.Fields["latitude"] {
+			municipality, found, err := geo.MunicipalityAt(row.Record.Coordinate)
+			if err != nil {
+				return err
+			}
+			if found {
+				located[row.Row] = municipality.Name
+				tagNames = append(tagNames, municipality.Name)
+			}
+		}
+		if row.Record.ID != 0 {
+			ids = append(ids, row.Record.ID)
+		} else if row.Record.Name != "" {
+			names = append(names, row.Record.Name)
+		}
+		tagNames = append(tagNames, row.Record.Tags...)
+		if row.Record.Municipality != "" {
+			tagNames = append(tagNames, row.Record.Municipality)
+		}
+		sensorNames = append(sensorNames, row.Record.Sensors...)
+	}
+
+	devicesByName, err := repository.GetDeviceIDsByNames(names)
+	if err != nil {
+		return err
+	}
+	for _, matches := range devicesByName {
+		ids = append(ids, matches...)
+	}
+	var accessibleIDs []int64
+	if len(ids) > 0 {
+		// An empty ID list would match every accessible device
+		if accessibleIDs, err = repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{DeviceIDs: ids}); err != nil {
+			return err
+		}
+	}
+	accessible := idSet(accessibleIDs)
+
+	tags, err := repository.GetTagsByNames(tagNames)
+	if err != nil {
+		return err
+	}
+	tagsByName := make(map[string]structs.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByName[tag.Name] = tag
+	}
+
+	sensors, err := repository.GetSensorsByNames(sensorNames)
+	if err != nil {
+		return err
+	}
+	sensorsByName := make(map[string]int64, len(sensors))
+	for _, sensor := range sensors {
+		sensorsByName[sensor.Name] = sensor.ID
+	}
+
+	unrestricted, municipalities, err := accessibleMunicipalities(meberID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Resolve every row
+	targets := make(map[string]int)
+	overrides := false
+	for i := range rows {
+		row := &rows[i]
+		record := &row.Record
+
+		switch {
+		case record.ID != 0:
+			if !accessible[record.ID] {
+				fail(row.Row, "id", fmt.Sprintf("device %d does not exist or is not accessible", record.ID))
+			}
+			row.Action = "update"
+		case len(devicesByName[record.Name]) > 1:
+			fail(row.Row, "name", fmt.Sprintf("name %q matches several devices, use the id column", record.Name))
+		case len(devicesByName[record.Name]) == 1:
+			record.ID = devicesByName[record.Name][0]
+			if !accessible[record.ID] {
+				fail(row.Row, "name", fmt.Sprintf("device %q is not accessible", record.Name))
+			}
+			row.Action = "update"
+		default:
+			row.Action = "create"
+		}
+
+		// Two rows changing the same device would depend on their order
+		target := record.Name
+		if record.ID != 0 {
+			target = strconv.FormatInt(record.ID, 10)
+		}
+		if previous, duplicate := targets[target]; duplicate {
+			fail(row.Row, "", fmt.Sprintf("device already imported on row %d", previous))
+		}
+		targets[target] = row.Row
+
+		// New coordinates assign the municipality they lie in, a municipality in the file has to agree with them
+		if tag, ok := tagsByName[located[row.Row]]; ok && tag.Type == "location" {
+			given, known := tagsByName[record.Municipality]
+			switch {
+			case record.Municipality == "":
+				record.Municipality = tag.Name
+			case known && given.Type == "location" && given.ID != tag.ID:
+				fail(row.Row, "municipality", fmt.Sprintf("the coordinates lie in municipality %q", tag.Name))
+			}
+		}
+
+		// A restricted meber can't move a device out of their municipalities, new coordinates have to lie in one
+		if row.Action == "update" && !unrestricted && row.Fields["latitude"] && located[row.Row] == "" {
+			fail(row.Row, "latitude", "the coordinates lie outside the municipalities you have access to")
+		}
+
+		if row.Action == "create" {
+			if record.ConnectionType == "" {
+				fail(row.Row, "connection_type", "connection_type is required for a new device")
+			}
+			if !row.Fields["latitude"] {
+				fail(row.Row, "latitude", "latitude and longitude are required for a new device")
+			}
+			if record.Status == "" {
+				record.Status = "offline"
+			}
+			// A restricted meber could not see a new device without one of their municipalities
+			if !unrestricted && record.Municipality == "" {
+				fail(row.Row, "municipality", "a new device needs a municipality you have access to")
+			}
+		}
+
+		if record.Municipality != "" {
+			tag, ok := tagsByName[record.Municipality]
+			switch {
+			case !ok || tag.Type != "location":
+				fail(row.Row, "municipality", fmt.Sprintf("unknown municipality %q", record.Municipality))
+			case !unrestricted && !municipalities[record.Municipality]:
+				fail(row.Row, "municipality", fmt.Sprintf("no access to municipality %q", record.Municipality))
+			default:
+				row.MunicipalityID = &tag.ID
+			}
+		}
+
+		// The same rules as for tags changed in bulk
+		for _, name := range record.Tags {
+			tag, ok := tagsByName[name]
+			if err := checkEditableTag(meberID, name, tag, ok); err != nil {
+				fail(row.Row, "tags", err.Error())
+				continue
+			}
+			row.TagIDs = append(row.TagIDs, tag.ID)
+		}
+
+		for _, name := range record.Sensors {
+			sensorID, ok := sensorsByName[name]
+			if !ok {
+				fail(row.Row, "sensors", fmt.Sprintf("unknown sensor %q", name))
+				continue
+			}
+			row.SensorIDs = append(row.SensorIDs, sensorID)
+		}
+
+		// A new device needs a connection type, changing it on an existing device is an override
+		if row.Fields["status"] || row.Fields["ip_address"] || (row.Action == "update" && row.Fields["connection_type"]) {
+			overrides = true
+		}
+	}
+
+	// Access to a device only allows viewing it, overriding its status or attributes is up to admins
+	if overrides {
+		if err := requireAdmin(meberID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package service_test
+
+import (
+	"bytes"
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mockDeviceImportRepository replaces the repository lookups used by an import and records applied rows
+func mockDeviceImportRepository(t *testing.T, applied *[]structs.DeviceImportRow) {
+	originalNames := repository.GetDeviceIDsByNames
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalTags := repository.GetTagsByNames
+	originalSensors := repository.GetSensorsByNames
+	originalRoles := repository.GetRolesForMeber
+	originalApply := repository.ApplyDeviceImport
+	originalWindows := repository.GetMaintenanceWindows
+	t.Cleanup(func() {
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetDeviceIDsByNames = originalNames
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetTagsByNames = originalTags
+		repository.GetSensorsByNames = originalSensors
+		repository.GetRolesForMeber = originalRoles
+		repository.ApplyDeviceImport = originalApply
+	})
+
+	repository.GetDeviceIDsByNames = func(names []string) (map[string][]int64, error) {
+		return map[string][]int64{"MSR-Existing": {5}, "MSR-Twice": {6, 7}}, nil
+	}
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return []int64{1, 5, 6, 7}, nil
+	}
+	repository.GetTagsByNames = func(names []string) ([]structs.Tag, error) {
+		return []structs.Tag{
+			{ID: 10, Name: "Rotterdam", Type: "location"},
+			{ID: 11, Name: "pilot", Type: "custom", IsEditable: true},
+			{ID: 12, Name: "fraude", Type: "team"},
+		}, nil
+	}
+	repository.GetSensorsByNames = func(names []string) ([]structs.Sensor, error) {
+		return []structs.Sensor{{ID: 20, Name: "Temperature Sensor"}}, nil
+	}
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+	}
+	// Device 5 is in maintenance
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		now := time.Now()
+		return []structs.MaintenanceWindow{{ID: 1, TargetType: "device", TargetID: 5, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Recurrence: "none"}}, nil
+	}
+	repository.ApplyDeviceImport = func(rows []structs.DeviceImportRow, meberID int64, inMaintenance map[int64]bool) error {
+		if !inMaintenance[5] {
+			t.Errorf("Expected device 5 to be passed as in maintenance, got %v", inMaintenance)
+		}
+		*applied = rows
+		return nil
+	}
+}
+
+func TestImportDevicesCSV(t *testing.T) {
+	var applied []structs.DeviceImportRow
+	mockDeviceImportRepository(t, &applied)
+
+	csv := "id,name,status,connection_type,latitude,longitude,municipality,tags,sensors\n" +
+		",MSR-New,online,wired,51.92,4.47,Rotterdam,pilot,Temperature Sensor\n" +
+		"1,MSR-Renamed,,,,,,,\n" +
+		",MSR-Existing,error,,,,,,\n"
+
+	// A dry run reports the planned changes without applying them
+	report, err := service.ImportDevicesCSV(1, strings.NewReader(csv), true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(report.Errors) != 0 {
+		t.Fatalf("Expected no row errors, got %+v", report.Errors)
+	}
+	if report.Created != 1 || report.Updated != 2 {
+		t.Errorf("Expected 1 created and 2 updated, got %d and %d", report.Created, report.Updated)
+	}
+	if report.Applied || applied != nil {
+		t.Errorf("Expected a dry run not to apply anything")
+	}
+	if report.Rows[2].Record.ID != 5 {
+		t.Errorf("Expected the third row to match device 5 by name, got %d", report.Rows[2].Record.ID)
+	}
+
+	// The same import applies all rows at once
+	report, err = service.ImportDevicesCSV(1, strings.NewReader(csv), false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !report.Applied || len(applied) != 3 {
+		t.Fatalf("Expected 3 applied rows, got %d", len(applied))
+	}
+	created := applied[0]
+	if created.MunicipalityID == nil || *created.MunicipalityID != 10 || len(created.TagIDs) != 1 || len(created.SensorIDs) != 1 {
+		t.Errorf("Expected municipality, tag and sensor to be resolved, got %+v", created)
+	}
+	if applied[1].Fields["status"] {
+		t.Errorf("Expected empty cells not to be written")
+	}
+}
+
+func TestImportDevicesCSVRowErrors(t *testing.T) {
+	var applied []structs.DeviceImportRow
+	mockDeviceImportRepository(t, &applied)
+
+	csv := "id,name,status,connection_type,ip_address,municipality,tags,latitude,longitude,applications\n" +
+		",MSR-New,broken,wired,,,,52.1,5.1,\n" + // unknown status
+		",MSR-New2,,,,,,52.1,5.1,\n" + // connection type missing for a new device
+		"99,MSR-Hidden,,,,,,,,\n" + // not accessible
+		",MSR-Twice,,,,,,,,\n" + // ambiguous name
+		",MSR-Tagged,,wired,not-an-ip,Atlantis,fraude,52.1,5.1,\n" + // invalid ip, unknown municipality, non-editable tag
+		",MSR-Nowhere,,wired,,,,,,\n" + // coordinates missing for a new device
+		",MSR-Apps,,wired,,,,52.1,5.1,Audio Analysis\n" // applications aren't imported
+
+	report, err := service.ImportDevicesCSV(1, strings.NewReader(csv), false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.Applied || applied != nil {
+		t.Fatalf("Expected nothing to be applied when rows are invalid")
+	}
+
+	rowsWithErrors := map[int]int{}
+	for _, rowError := range report.Errors {
+		rowsWithErrors[rowError.Row]++
+	}
+	for row, expected := range map[int]int{2: 1, 3: 1, 4: 1, 5: 1, 6: 3, 7: 1, 8: 1} {
+		if rowsWithErrors[row] != expected {
+			t.Errorf("Expected %d errors on row %d, got %d", expected, row, rowsWithErrors[row])
+		}
+	}
+}
+
+func TestImportDevicesCSVRestrictedMunicipalities(t *testing.T) {
+	var applied []structs.DeviceImportRow
+	mockDeviceImportRepository(t, &applied)
+	originalMeberTags := repository.GetMeberTags
+	t.Cleanup(func() { repository.GetMeberTags = originalMeberTags })
+
+	// The meber only has access to Rotterdam
+	owner, other := int64(2), int64(3)
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 2, Name: "Rotterdam", IsRestricted: true}}, nil
+	}
+	repository.GetMeberTags = func(meberID int64) ([]string, error) {
+		return []string{"Rotterdam"}, nil
+	}
+	repository.GetTagsByNames = func(names []string) ([]structs.Tag, error) {
+		return []structs.Tag{
+			{ID: 10, Name: "Rotterdam", Type: "location"},
+			{ID: 13, Name: "Delft", Type: "location"},
+			{ID: 14, Name: "mine", Type: "custom", IsEditable: true, OwnerID: &owner},
+			{ID: 15, Name: "theirs", Type: "custom", IsEditable: true, OwnerID: &other},
+		}, nil
+	}
+
+	csv := "id,name,status,connection_type,ip_address,municipality,tags,latitude,longitude\n" +
+		"5,,,,,Delft,,,\n" + // moved to a municipality without access
+		"1,,,,,,,52.0116,4.3571\n" + // coordinates in Delft
+		"7,,,,,,,53.5,3.0\n" + // coordinates outside every municipality
+		"6,,,,,Rotterdam,mine,51.92,4.47\n" + // stays in Rotterdam
+		",MSR-Other,,wired,,Rotterdam,theirs,51.92,4.47\n" // new device with a tag owned by another meber
+
+	report, err := service.ImportDevicesCSV(2, strings.NewReader(csv), false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.Applied {
+		t.Fatalf("Expected nothing to be applied when rows are invalid")
+	}
+
+	rowsWithErrors := map[int]int{}
+	for _, rowError := range report.Errors {
+		rowsWithErrors[rowError.Row]++
+	}
+	for row, expected := range map[int]int{2: 1, 3: 1, 4: 1, 5: 0, 6: 1} {
+		if rowsWithErrors[row] != expected {
+			t.Errorf("Expected %d errors on row %d, got %d", expected, row, rowsWithErrors[row])
+		}
+	}
+}
+
+func TestImportDevicesCSVRequiresAdminForOverrides(t *testing.T) {
+	var applied []structs.DeviceImportRow
+	mockDeviceImportRepository(t, &applied)
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 2, Name: "viewer"}}, nil
+	}
+
+	// A new device needs a connection type, so that alone is no override
+	csv := "name,connection_type,latitude,longitude\nMSR-New,wired,51.92,4.47\n"
+	if _, err := service.ImportDevicesCSV(2, strings.NewReader(csv), true); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, csv := range []string{
+		"id,name,status\n5,,online\n",
+		"id,name,connection_type\n5,,lte\n",
+		"id,name,ip_address\n5,,10.0.0.5\n",
+		"name,status,connection_type,latitude,longitude\nMSR-New,online,wired,51.92,4.47\n",
+	} {
+		if _, err := service.ImportDevicesCSV(2, strings.NewReader(csv), true); !errors.Is(err, service.ErrAccessDenied) {
+			t.Errorf("Expected access denied for %q, got %v", csv, err)
+		}
+	}
+	if len(applied) != 0 {
+		t.Errorf("Expected nothing to be applied, got %+v", applied)
+	}
+}
+
+func TestWriteDeviceInventoryCSV(t *testing.T) {
+	records := []structs.DeviceInventoryRecord{{
+		ID: 1, Name: "MSR, Centrum", Status: "online", ConnectionType: "wired", Coordinate: structs.Coordinate{Latitude: 51.92, Longitude: 4.47},
+		Municipality: "Rotterdam", Tags: []string{"pilot", "fraude"}, Sensors: []string{}, Applications: []string{"Audio Analysis"},
+	}}
+
+	var buffer bytes.Buffer
+	if err := service.WriteDeviceInventoryCSV(&buffer, records); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "id,name,status,connection_type,ip_address,latitude,longitude,municipality,tags,sensors,applications\n" +
+		"1,\"MSR, Centrum\",online,wired,,51.92,4.47,Rotterdam,pilot;fraude,,Audio Analysis\n"
+	if buffer.String() != expected {
+		t.Errorf("Unexpected CSV:\n%s", buffer.String())
+	}
+}
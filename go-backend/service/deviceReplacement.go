@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"net"
+	"strings"
+	"time"
+)
+
+const maxDeviceNameLength = 100
+
+// ReplaceDevice replaces a failed device the meber has access to with a new unit at the same location. The new
+// device takes over the tags, sensors, groups and applications, the old one is decommissioned.
+func ReplaceDevice(meberID int64, request structs.DeviceReplacementRequest) (*structs.DeviceReplacement, error) {
+	// Step 1: Validate the new unit
+	request.Name = strings.TrimSpace(request.Name)
+	request.Reason = strings.TrimSpace(request.Reason)
+	if len(request.Name) > maxDeviceNameLength {
+		return nil, fmt.Errorf("%w: name can be at most %d characters", ErrInvalidRequest, maxDeviceNameLength)
+	}
+	if request.ConnectionType != "" && !validConnectionTypes[request.ConnectionType] {
+		return nil, fmt.Errorf("%w: invalid connection type %q", ErrInvalidRequest, request.ConnectionType)
+	}
+	if request.IPAddress != "" && net.ParseIP(request.IPAddress) == nil {
+		return nil, fmt.Errorf("%w: invalid IP address %q", ErrInvalidRequest, request.IPAddress)
+	}
+	if len(request.Reason) > maxQuarantineReasonLength {
+		return nil, fmt.Errorf("%w: reason can be at most %d characters", ErrInvalidRequest, maxQuarantineReasonLength)
+	}
+
+	// Step 2: Check access to the old device and that it is still in service
+	if err := checkDeviceRecordAccess(meberID, request.OldDeviceID); err != nil {
+		return nil, err
+	}
+	exists, decommissionedAt, err := repository.GetDeviceDecommissionedAt(request.OldDeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: device %d", ErrNotFound, request.OldDeviceID)
+	}
+	if decommissionedAt != nil {
+		return nil, fmt.Errorf("%w: device %d was already decommissioned", ErrInvalidRequest, request.OldDeviceID)
+	}
+
+	// Step 3: Migrate and decommission in one transaction
+	replacement, err := repository.ReplaceDevice(request, meberID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if replacement == nil {
+		// Replaced concurrently between the check and the transaction
+		return nil, fmt.Errorf("%w: device %d was already decommissioned", ErrInvalidRequest, request.OldDeviceID)
+	}
+	invalidateDeviceTiles(request.OldDeviceID)
+	return replacement, nil
+}
+
+// GetDeviceReplacements returns the replacement history of a device the meber has access to, the device may be
+// the old or the new unit
+func GetDeviceReplacements(meberID, deviceID int64) ([]structs.DeviceReplacement, error) {
+	if err := checkDeviceRecordAccess(meberID, deviceID); err != nil {
+		return nil, err
+	}
+
+	replacements, err := repository.GetDeviceReplacements(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if replacements == nil {
+		replacements = []structs.DeviceReplacement{}
+	}
+	return replacements, nil
+}
+
+// checkInService rejects a device that was decommissioned, a replaced unit can no longer take part in the fleet
+func checkInService(deviceID int64) error {
+	_, decommissionedAt, err := repository.GetDeviceDecommissionedAt(deviceID)
+	if err != nil {
+		return err
+	}
+	if decommissionedAt != nil {
+		return fmt.Errorf("%w: device %d was decommissioned", ErrInvalidRequest, deviceID)
+	}
+	return nil
+}
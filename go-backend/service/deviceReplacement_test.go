@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestReplaceDevice(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalAccess := repository.MeberHasDeviceAccess
+	originalDecommissioned := repository.GetDeviceDecommissionedAt
+	originalReplace := repository.ReplaceDevice
+	defer func() {
+		repository.MeberHasDeviceAccess = originalAccess
+		repository.GetDeviceDecommissionedAt = originalDecommissioned
+		repository.ReplaceDevice = originalReplace
+	}()
+
+	// Meber has access to devices 1, 2 and 3, device 2 was already replaced and device 3 no longer exists
+	repository.MeberHasDeviceAccess = func(meberID int64, deviceID int64) (bool, error) {
+		return deviceID <= 3, nil
+	}
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) {
+		switch deviceID {
+		case 2:
+			decommissionedAt := time.Now().Add(-time.Hour)
+			return true, &decommissionedAt, nil
+		case 3:
+			return false, nil, nil
+		}
+		return true, nil, nil
+	}
+
+	var stored structs.DeviceReplacementRequest
+	repository.ReplaceDevice = func(request structs.DeviceReplacementRequest, meberID int64, now time.Time) (*structs.DeviceReplacement, error) {
+		stored = request
+		return &structs.DeviceReplacement{ID: 1, OldDeviceID: request.OldDeviceID, NewDeviceID: 10, ReplacedBy: &meberID, Reason: request.Reason}, nil
+	}
+
+	replacement, err := service.ReplaceDevice(5, structs.DeviceReplacementRequest{OldDeviceID: 1, Name: " Substation 1 ", Reason: " water damage "})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if replacement.OldDeviceID != 1 || replacement.NewDeviceID != 10 {
+		t.Errorf("Expected device 1 to be replaced by device 10, got %+v", replacement)
+	}
+	if stored.Name != "Substation 1" || stored.Reason != "water damage" {
+		t.Errorf("Expected the trimmed name and reason to be stored, got %q and %q", stored.Name, stored.Reason)
+	}
+
+	tests := []struct {
+		name    string
+		request structs.DeviceReplacementRequest
+		wantErr error
+	}{
+		{"Invalid Connection Type", structs.DeviceReplacementRequest{OldDeviceID: 1, ConnectionType: "satellite"}, service.ErrInvalidRequest},
+		{"Invalid IP Address", structs.DeviceReplacementRequest{OldDeviceID: 1, IPAddress: "10.0.0.300"}, service.ErrInvalidRequest},
+		{"No Access", structs.DeviceReplacementRequest{OldDeviceID: 4}, service.ErrAccessDenied},
+		{"Already Decommissioned", structs.DeviceReplacementRequest{OldDeviceID: 2}, service.ErrInvalidRequest},
+		{"Device Not Found", structs.DeviceReplacementRequest{OldDeviceID: 3}, service.ErrNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := service.ReplaceDevice(5, tc.request); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
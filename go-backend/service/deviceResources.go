@@ -0,0 +1,202 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"math"
+	"strings"
+	"time"
+)
+
+// ReportDeviceResources stores the resource usage and capacity a device reports about itself
+func ReportDeviceResources(deviceID int64, report structs.DeviceResourceReport) error {
+	if report.CPUCores <= 0 || report.MemoryTotalMB <= 0 || report.DiskTotalMB <= 0 {
+		return fmt.Errorf("%w: cpu cores, memory and disk capacity must be positive", ErrInvalidRequest)
+	}
+	if report.CPUUsagePercent < 0 || report.CPUUsagePercent > 100 {
+		return fmt.Errorf("%w: cpu usage must be between 0 and 100 percent", ErrInvalidRequest)
+	}
+	if report.MemoryUsedMB < 0 || report.MemoryUsedMB > report.MemoryTotalMB {
+		return fmt.Errorf("%w: memory usage must be between 0 and the memory capacity", ErrInvalidRequest)
+	}
+	if report.DiskUsedMB < 0 || report.DiskUsedMB > report.DiskTotalMB {
+		return fmt.Errorf("%w: disk usage must be between 0 and the disk capacity", ErrInvalidRequest)
+	}
+
+	return repository.UpsertDeviceResources(deviceID, report, time.Now())
+}
+
+// GetDeviceResources retrieves the resources of a device the meber has access to, with the headroom left after
+// the applications it still has to install
+func GetDeviceResources(meberID, deviceID int64) (structs.DeviceResources, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.DeviceResources{}, err
+	}
+
+	requirements, err := repository.GetApplicationRequirements()
+	if err != nil {
+		return structs.DeviceResources{}, err
+	}
+	state, err := getResourceState([]int64{deviceID}, requirements)
+	if err != nil {
+		return structs.DeviceResources{}, err
+	}
+	resources, ok := state.resources[deviceID]
+	if !ok {
+		return structs.DeviceResources{}, fmt.Errorf("%w: no resources known for device %d", ErrNotFound, deviceID)
+	}
+	headroom := state.headroom(deviceID)
+	resources.Headroom = &headroom
+	return resources, nil
+}
+
+// SetApplicationRequirements declares the resources an application needs on a device, admins only
+func SetApplicationRequirements(meberID int64, requirements structs.ResourceRequirements) error {
+	if err := requireAdmin(meberID); err != nil {
+		return err
+	}
+	if requirements.CPUMillicores < 0 || requirements.MemoryMB < 0 || requirements.DiskMB < 0 {
+		return fmt.Errorf("%w: resource requirements can't be negative", ErrInvalidRequest)
+	}
+
+	app, err := repository.GetApplicationByID(requirements.AppID)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		return fmt.Errorf("%w: application %d", ErrNotFound, requirements.AppID)
+	}
+
+	return repository.SetApplicationRequirements(requirements, meberID)
+}
+
+// CheckResourceHeadroom verifies that every device has room for the application on top of what it already runs
+func CheckResourceHeadroom(deviceIDs []int64, appID int64) error {
+	shortfalls, err := resourceShortfalls(deviceIDs, appID)
+	if err != nil {
+		return err
+	}
+	for _, deviceID := range deviceIDs {
+		if reason, ok := shortfalls[deviceID]; ok {
+			return fmt.Errorf("%w: device %d can't run application %d: %s", ErrInvalidRequest, deviceID, appID, reason)
+		}
+	}
+	return nil
+}
+
+// resourceShortfalls explains for each device that lacks the resources for the application why it does, devices
+// with enough headroom and devices that already have the application are left out
+func resourceShortfalls(deviceIDs []int64, appID int64) (map[int64]string, error) {
+	shortfalls := make(map[int64]string)
+	if len(deviceIDs) == 0 {
+		return shortfalls, nil
+	}
+
+	requirements, err := repository.GetApplicationRequirements()
+	if err != nil {
+		return nil, err
+	}
+	required, ok := requirements[appID]
+	if !ok {
+		return shortfalls, nil
+	}
+
+	state, err := getResourceState(deviceIDs, requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, deviceID := range deviceIDs {
+		if state.desires(deviceID, appID) {
+			continue
+		}
+		resources, ok := state.resources[deviceID]
+		if !ok || (resources.ReportedAt == nil && (resources.CPUCores == 0 || resources.MemoryTotalMB == 0 || resources.DiskTotalMB == 0)) {
+			shortfalls[deviceID] = "Device has not reported its resources"
+			continue
+		}
+
+		headroom := state.headroom(deviceID)
+		var lacking []string
+		for _, resource := range []struct {
+			name            string
+			unit            string
+			free, requested int64
+		}{
+			{"CPU", "millicores", headroom.CPUMillicores, required.CPUMillicores},
+			{"memory", "MB", headroom.MemoryMB, required.MemoryMB},
+			{"disk", "MB", headroom.DiskMB, required.DiskMB},
+		} {
+			if resource.requested > resource.free {
+				lacking = append(lacking, fmt.Sprintf("Not enough %s: %d %s free, %d %s required",
+					resource.name, max(resource.free, 0), resource.unit, resource.requested, resource.unit))
+			}
+		}
+		if len(lacking) > 0 {
+			shortfalls[deviceID] = strings.Join(lacking, "; ")
+		}
+	}
+	return shortfalls, nil
+}
+
+// resourceState holds what is known about the resources of a set of devices and the applications they run
+type resourceState struct {
+	resources    map[int64]structs.DeviceResources
+	desired      map[int64][]structs.DesiredApplication
+	reported     map[int64][]structs.ReportedApplication
+	requirements map[int64]structs.ResourceRequirements
+}
+
+// getResourceState fetches the resources and the desired and reported applications of the devices
+func getResourceState(deviceIDs []int64, requirements map[int64]structs.ResourceRequirements) (resourceState, error) {
+	state := resourceState{requirements: requirements}
+	var err error
+	if state.resources, err = repository.GetDeviceResources(deviceIDs); err != nil {
+		return state, err
+	}
+	if state.desired, err = repository.GetDesiredApplications(deviceIDs); err != nil {
+		return state, err
+	}
+	state.reported, err = repository.GetReportedApplications(deviceIDs)
+	return state, err
+}
+
+// desires reports whether the application is already in the desired state of the device
+func (state resourceState) desires(deviceID, appID int64) bool {
+	for _, app := range state.desired[deviceID] {
+		if app.AppID == appID {
+			return true
+		}
+	}
+	return false
+}
+
+// headroom is the capacity of a device minus its reported usage and the requirements of the desired applications
+// it does not report running yet. Without a report every desired application counts, as nothing was measured.
+func (state resourceState) headroom(deviceID int64) structs.ResourceHeadroom {
+	resources := state.resources[deviceID]
+	cpuMillicores := int64(resources.CPUCores) * 1000
+	headroom := structs.ResourceHeadroom{
+		CPUMillicores: cpuMillicores - int64(math.Round(float64(cpuMillicores)*resources.CPUUsagePercent/100)),
+		MemoryMB:      resources.MemoryTotalMB - resources.MemoryUsedMB,
+		DiskMB:        resources.DiskTotalMB - resources.DiskUsedMB,
+	}
+
+	running := make(map[int64]bool)
+	if resources.ReportedAt != nil {
+		for _, app := range state.reported[deviceID] {
+			running[app.AppID] = true
+		}
+	}
+	for _, app := range state.desired[deviceID] {
+		if running[app.AppID] {
+			continue
+		}
+		required := state.requirements[app.AppID]
+		headroom.CPUMillicores -= required.CPUMillicores
+		headroom.MemoryMB -= required.MemoryMB
+		headroom.DiskMB -= required.DiskMB
+	}
+	return headroom
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckResourceHeadroom(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalRequirements := repository.GetApplicationRequirements
+	originalResources := repository.GetDeviceResources
+	originalDesired := repository.GetDesiredApplications
+	originalReported := repository.GetReportedApplications
+	defer func() {
+		repository.GetApplicationRequirements = originalRequirements
+		repository.GetDeviceResources = originalResources
+		repository.GetDesiredApplications = originalDesired
+		repository.GetReportedApplications = originalReported
+	}()
+
+	repository.GetApplicationRequirements = func() (map[int64]structs.ResourceRequirements, error) {
+		return map[int64]structs.ResourceRequirements{
+			1: {AppID: 1, CPUMillicores: 500, MemoryMB: 512, DiskMB: 1024},
+			2: {AppID: 2, CPUMillicores: 100, MemoryMB: 1024, DiskMB: 100},
+		}, nil
+	}
+	reportedAt := time.Now()
+	// Device 1 has plenty of room, device 2 has 1280 MB memory free but app 2 is still to be installed on it,
+	// device 3 runs at full CPU, device 4 only has the capacity of its type and device 5 is unknown
+	repository.GetDeviceResources = func(deviceIDs []int64) (map[int64]structs.DeviceResources, error) {
+		report := structs.DeviceResourceReport{CPUCores: 4, CPUUsagePercent: 25, MemoryTotalMB: 4096, MemoryUsedMB: 1024, DiskTotalMB: 32768, DiskUsedMB: 1024}
+		busy := report
+		busy.CPUUsagePercent = 100
+		tight := report
+		tight.MemoryUsedMB = 2816
+		return map[int64]structs.DeviceResources{
+			1: {DeviceID: 1, DeviceResourceReport: report, ReportedAt: &reportedAt},
+			2: {DeviceID: 2, DeviceResourceReport: tight, ReportedAt: &reportedAt},
+			3: {DeviceID: 3, DeviceResourceReport: busy, ReportedAt: &reportedAt},
+			4: {DeviceID: 4, DeviceResourceReport: structs.DeviceResourceReport{CPUCores: 2, MemoryTotalMB: 2048, DiskTotalMB: 8192}},
+		}, nil
+	}
+	repository.GetDesiredApplications = func(deviceIDs []int64) (map[int64][]structs.DesiredApplication, error) {
+		return map[int64][]structs.DesiredApplication{2: {{AppID: 2}}, 4: {{AppID: 1}}}, nil
+	}
+	repository.GetReportedApplications = func(deviceIDs []int64) (map[int64][]structs.ReportedApplication, error) {
+		return map[int64][]structs.ReportedApplication{}, nil
+	}
+
+	tests := []struct {
+		name       string
+		deviceID   int64
+		appID      int64
+		wantReason string // Empty when the install fits
+	}{
+		{"Enough Headroom", 1, 1, ""},
+		{"Pending Install Reserves Memory", 2, 1, "Not enough memory: 256 MB free, 512 MB required"},
+		{"Full CPU", 3, 1, "Not enough CPU: 0 millicores free, 500 millicores required"},
+		{"Already Desired", 4, 1, ""},
+		{"Type Capacity Without Report", 4, 2, ""},
+		{"No Resources Known", 5, 1, "Device has not reported its resources"},
+		{"Application Without Requirements", 5, 3, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := service.CheckResourceHeadroom([]int64{tc.deviceID}, tc.appID)
+			if tc.wantReason == "" && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if tc.wantReason != "" && (!errors.Is(err, service.ErrInvalidRequest) || !strings.Contains(err.Error(), tc.wantReason)) {
+				t.Errorf("Expected ErrInvalidRequest with %q, got %v", tc.wantReason, err)
+			}
+		})
+	}
+}
+
+func TestReportDeviceResources(t *testing.T) {
+	originalUpsert := repository.UpsertDeviceResources
+	defer func() { repository.UpsertDeviceResources = originalUpsert }()
+
+	stored := 0
+	repository.UpsertDeviceResources = func(deviceID int64, report structs.DeviceResourceReport, now time.Time) error {
+		stored++
+		return nil
+	}
+
+	valid := structs.DeviceResourceReport{CPUCores: 4, CPUUsagePercent: 12.5, MemoryTotalMB: 4096, MemoryUsedMB: 900, DiskTotalMB: 32768, DiskUsedMB: 4000}
+	if err := service.ReportDeviceResources(1, valid); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	overused := valid
+	overused.MemoryUsedMB = 5000
+	overloaded := valid
+	overloaded.CPUUsagePercent = 140
+	for _, report := range []structs.DeviceResourceReport{{}, overused, overloaded} {
+		if err := service.ReportDeviceResources(1, report); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest for %+v, got %v", report, err)
+		}
+	}
+	if stored != 1 {
+		t.Errorf("Expected only the valid report to be stored, got %d", stored)
+	}
+}
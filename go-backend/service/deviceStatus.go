@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"main/repository"
+	"time"
+)
+
+// OfflineThreshold is the time without heartbeat after which a device is marked offline
+const OfflineThreshold = 5 * time.Minute
+
+// reportableStatuses are the statuses a device can report about itself, offline is only ever detected
+var reportableStatuses = map[string]bool{
+	"online":    true,
+	"error":     true,
+	"app_issue": true,
+}
+
+// RecordHeartbeat stores a heartbeat of a device and the status it reports, online when none is given
+func RecordHeartbeat(deviceID int64, status string) error {
+	if status == "" {
+		status = "online"
+	}
+	if !reportableStatuses[status] {
+		return fmt.Errorf("%w: a device can't report status %q", ErrInvalidRequest, status)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if err := repository.TouchDeviceHeartbeat(deviceID, now); err != nil {
+		return err
+	}
+
+	previous, err := repository.GetDeviceStatus(deviceID)
+	if err != nil {
+		return err
+	}
+	if previous == status {
+		return nil
+	}
+
+	state, err := getMaintenanceState(now)
+	if err != nil {
+		return err
+	}
+	return transitionDeviceStatus(deviceID, previous, status, nil, state.inMaintenance[deviceID], now)
+}
+
+// DetectOfflineDevices marks devices whose last heartbeat is older than OfflineThreshold as offline
+func DetectOfflineDevices(now time.Time) error {
+	now = now.UTC().Truncate(time.Second)
+	cutoff := now.Add(-OfflineThreshold)
+	devices, err := repository.GetStaleDevices(cutoff)
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return nil
+	}
+
+	state, err := getMaintenanceState(now)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range devices {
+		// A heartbeat arriving in the meantime keeps the device online
+		if err := transitionDeviceStatus(device.ID, device.Status, "offline", &cutoff, state.inMaintenance[device.ID], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunOfflineDetection checks for devices that stopped sending heartbeats on every tick of the given interval
+func RunOfflineDetection(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := DetectOfflineDevices(time.Now()); err != nil {
+			log.Printf("Error detecting offline devices: %v", err)
+		}
+	}
+}
+
+// transitionDeviceStatus records a status change, during maintenance the change is only logged as a warning. Nothing
+// is recorded when the stored status no longer matches previous, see RecordDeviceStatusTransition.
+func transitionDeviceStatus(deviceID int64, previous, status string, heartbeatBefore *time.Time, inMaintenance bool, now time.Time) error {
+	logLevel := status
+	description := fmt.Sprintf("Device status changed from %s to %s", previous, status)
+	if inMaintenance {
+		logLevel = "warning"
+		description += " during maintenance"
+	}
+	changed, err := repository.RecordDeviceStatusTransition(deviceID, previous, status, heartbeatBefore, inMaintenance, now, logLevel, description)
+	if err != nil || !changed {
+		return err
+	}
+	invalidateDeviceTiles(deviceID)
+	return nil
+}
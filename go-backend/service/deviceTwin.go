@@ -0,0 +1,258 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var validReportedStatuses = map[string]bool{
+	"online":  true,
+	"offline": true,
+	"error":   true,
+	"warning": true,
+}
+
+// ComputeDeviceDrift lists the differences between the desired and reported applications of a device
+func ComputeDeviceDrift(desired []structs.DesiredApplication, reported []structs.ReportedApplication) []structs.DeviceTwinDrift {
+	reportedByApp := make(map[int64]structs.ReportedApplication, len(reported))
+	for _, app := range reported {
+		reportedByApp[app.AppID] = app
+	}
+
+	drift := []structs.DeviceTwinDrift{}
+	desiredApps := make(map[int64]bool, len(desired))
+	for _, want := range desired {
+		desiredApps[want.AppID] = true
+
+		have, ok := reportedByApp[want.AppID]
+		switch {
+		case !ok:
+			drift = append(drift, structs.DeviceTwinDrift{AppID: want.AppID, Name: want.Name, Type: "missing", DesiredVersion: want.Version})
+		case have.Version != want.Version:
+			drift = append(drift, structs.DeviceTwinDrift{AppID: want.AppID, Name: want.Name, Type: "version_mismatch",
+				DesiredVersion: want.Version, ReportedVersion: have.Version})
+		case !configMatches(want.Config, have.Config):
+			drift = append(drift, structs.DeviceTwinDrift{AppID: want.AppID, Name: want.Name, Type: "config_mismatch",
+				DesiredVersion: want.Version, ReportedVersion: have.Version})
+		}
+	}
+
+	for _, have := range reported {
+		if !desiredApps[have.AppID] {
+			drift = append(drift, structs.DeviceTwinDrift{AppID: have.AppID, Name: have.Name, Type: "unexpected", ReportedVersion: have.Version})
+		}
+	}
+
+	return drift
+}
+
+// configMatches compares two JSON configs by content, an empty desired config accepts whatever the device runs
+func configMatches(desired, reported json.RawMessage) bool {
+	if isEmptyJSON(desired) {
+		return true
+	}
+	if isEmptyJSON(reported) {
+		return false
+	}
+
+	var want, have interface{}
+	if json.Unmarshal(desired, &want) != nil || json.Unmarshal(reported, &have) != nil {
+		return bytes.Equal(desired, reported)
+	}
+	return reflect.DeepEqual(want, have)
+}
+
+// isEmptyJSON reports whether a JSON document is missing or null
+func isEmptyJSON(raw json.RawMessage) bool {
+	trimmed := strings.TrimSpace(string(raw))
+	return trimmed == "" || trimmed == "null"
+}
+
+// GetDeviceTwin retrieves the desired and reported state of a device the meber has access to
+func GetDeviceTwin(meberID, deviceID int64) (structs.DeviceTwin, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.DeviceTwin{}, err
+	}
+
+	twins, err := buildDeviceTwins([]int64{deviceID})
+	if err != nil {
+		return structs.DeviceTwin{}, err
+	}
+	if len(twins) == 0 {
+		return structs.DeviceTwin{}, fmt.Errorf("%w: device %d", ErrNotFound, deviceID)
+	}
+	return twins[0], nil
+}
+
+// GetOutOfSyncDevices lists the twins of accessible devices, optionally within a group, whose reported state drifted
+func GetOutOfSyncDevices(meberID, groupID int64) ([]structs.DeviceTwin, error) {
+	var deviceIDs []int64
+	var err error
+	if groupID != 0 {
+		deviceIDs, err = GetDeviceGroupDeviceIDs(meberID, groupID)
+	} else {
+		deviceIDs, err = repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	twins, err := buildDeviceTwins(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	outOfSync := []structs.DeviceTwin{}
+	for _, twin := range twins {
+		if !twin.InSync {
+			outOfSync = append(outOfSync, twin)
+		}
+	}
+	return outOfSync, nil
+}
+
+// SetDesiredApplication sets the desired version and config of an application on devices or a device group
+func SetDesiredApplication(meberID int64, request structs.DesiredApplicationRequest) error {
+	// Step 1: Resolve the target devices and check access to each of them
+	deviceIDs, err := resolveTargetDevices(meberID, request.DeviceIDs, request.GroupID)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Validate the application, version and config
+	app, err := repository.GetApplicationByID(request.AppID)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		return fmt.Errorf("%w: unknown application %d", ErrInvalidRequest, request.AppID)
+	}
+	version := strings.TrimSpace(request.Version)
+	if version == "" {
+		version = app.Version
+	}
+	if len(version) > 10 {
+		return fmt.Errorf("%w: version can be at most 10 characters", ErrInvalidRequest)
+	}
+	if !isEmptyJSON(request.Config) && !json.Valid(request.Config) {
+		return fmt.Errorf("%w: config must be valid JSON", ErrInvalidRequest)
+	}
+
+	// Step 3: Changing what runs on a device counts as an install
+	if err := CheckQuarantine(deviceIDs); err != nil {
+		return err
+	}
+	if err := CheckInstallWindow(deviceIDs, time.Now()); err != nil {
+		return err
+	}
+	if err := CheckDeviceCapabilities(deviceIDs, app.ID); err != nil {
+		return err
+	}
+	if err := CheckResourceHeadroom(deviceIDs, app.ID); err != nil {
+		return err
+	}
+
+	return repository.SetDesiredApplications(deviceIDs, app.ID, version, request.Config, meberID)
+}
+
+// RemoveDesiredApplication removes an application from the desired state of a device the meber has access to
+func RemoveDesiredApplication(meberID, deviceID, appID int64) error {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return err
+	}
+
+	removed, err := repository.DeleteDesiredApplication(deviceID, appID, meberID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("%w: application %d is not desired on device %d", ErrNotFound, appID, deviceID)
+	}
+	return nil
+}
+
+// ReportDeviceState stores the applications a device reports as actually running
+func ReportDeviceState(deviceID int64, apps []structs.ReportedApplication) error {
+	seen := make(map[int64]bool, len(apps))
+	for i, app := range apps {
+		if app.AppID == 0 {
+			return fmt.Errorf("%w: reported application without app_id", ErrInvalidRequest)
+		}
+		if seen[app.AppID] {
+			return fmt.Errorf("%w: application %d reported twice", ErrInvalidRequest, app.AppID)
+		}
+		seen[app.AppID] = true
+
+		if apps[i].Status == "" {
+			apps[i].Status = "online"
+		}
+		if !validReportedStatuses[apps[i].Status] {
+			return fmt.Errorf("%w: unknown application status %q", ErrInvalidRequest, apps[i].Status)
+		}
+		if app.Version == "" || len(app.Version) > 10 {
+			return fmt.Errorf("%w: application %d needs a version of at most 10 characters", ErrInvalidRequest, app.AppID)
+		}
+		if !isEmptyJSON(app.Config) && !json.Valid(app.Config) {
+			return fmt.Errorf("%w: config of application %d must be valid JSON", ErrInvalidRequest, app.AppID)
+		}
+	}
+
+	// Applications outside the catalog can't be stored, the device is told instead of failing on the foreign key
+	appIDs := make([]int64, 0, len(apps))
+	for _, app := range apps {
+		appIDs = append(appIDs, app.AppID)
+	}
+	known, err := repository.GetKnownApplicationIDs(appIDs)
+	if err != nil {
+		return err
+	}
+	for _, appID := range appIDs {
+		if !known[appID] {
+			return fmt.Errorf("%w: unknown application %d", ErrInvalidRequest, appID)
+		}
+	}
+
+	return repository.ReplaceReportedApplications(deviceID, apps, time.Now().UTC().Truncate(time.Second))
+}
+
+// buildDeviceTwins combines desired and reported state of the devices and computes their drift
+func buildDeviceTwins(deviceIDs []int64) ([]structs.DeviceTwin, error) {
+	devices, err := repository.GetDeviceTwins(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := repository.GetDesiredApplications(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	reported, err := repository.GetReportedApplications(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	twins := make([]structs.DeviceTwin, 0, len(devices))
+	for _, deviceID := range deviceIDs {
+		twin, ok := devices[deviceID]
+		if !ok {
+			continue
+		}
+		twin.Desired = desired[deviceID]
+		if twin.Desired == nil {
+			twin.Desired = []structs.DesiredApplication{}
+		}
+		twin.Reported = reported[deviceID]
+		if twin.Reported == nil {
+			twin.Reported = []structs.ReportedApplication{}
+		}
+		twin.Drift = ComputeDeviceDrift(twin.Desired, twin.Reported)
+		twin.InSync = len(twin.Drift) == 0
+		twins = append(twins, twin)
+	}
+	return twins, nil
+}
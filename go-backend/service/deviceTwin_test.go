@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"encoding/json"
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestComputeDeviceDrift(t *testing.T) {
+	desired := []structs.DesiredApplication{
+		{AppID: 1, Name: "In Sync", Version: "1.0"},
+		{AppID: 2, Name: "Not Installed", Version: "1.0"},
+		{AppID: 3, Name: "Old Version", Version: "2.0"},
+		{AppID: 4, Name: "Wrong Config", Version: "1.0", Config: json.RawMessage(`{"interval": 10}`)},
+		{AppID: 5, Name: "Same Config", Version: "1.0", Config: json.RawMessage(`{"a": 1, "b": 2}`)},
+	}
+	reported := []structs.ReportedApplication{
+		{AppID: 1, Name: "In Sync", Version: "1.0", Status: "online"},
+		{AppID: 3, Name: "Old Version", Version: "1.0", Status: "online"},
+		{AppID: 4, Name: "Wrong Config", Version: "1.0", Config: json.RawMessage(`{"interval": 30}`), Status: "online"},
+		{AppID: 5, Name: "Same Config", Version: "1.0", Config: json.RawMessage(`{"b":2,"a":1}`), Status: "online"},
+		{AppID: 6, Name: "Left Behind", Version: "0.9", Status: "error"},
+	}
+
+	drift := service.ComputeDeviceDrift(desired, reported)
+
+	expected := map[int64]string{2: "missing", 3: "version_mismatch", 4: "config_mismatch", 6: "unexpected"}
+	if len(drift) != len(expected) {
+		t.Fatalf("Expected %d drift entries, got %d: %+v", len(expected), len(drift), drift)
+	}
+	for _, entry := range drift {
+		if expected[entry.AppID] != entry.Type {
+			t.Errorf("Expected drift %q for app %d, got %q", expected[entry.AppID], entry.AppID, entry.Type)
+		}
+	}
+}
+
+func TestComputeDeviceDriftInSync(t *testing.T) {
+	desired := []structs.DesiredApplication{{AppID: 1, Name: "App", Version: "1.0"}}
+	reported := []structs.ReportedApplication{{AppID: 1, Name: "App", Version: "1.0", Config: json.RawMessage(`{"x": 1}`)}}
+
+	// Without a desired config any reported config is accepted
+	if drift := service.ComputeDeviceDrift(desired, reported); len(drift) != 0 {
+		t.Errorf("Expected no drift, got %+v", drift)
+	}
+}
+
+func TestReportDeviceState(t *testing.T) {
+	// Backup the original repository function and restore it after the test
+	originalReplace := repository.ReplaceReportedApplications
+	originalKnown := repository.GetKnownApplicationIDs
+	defer func() {
+		repository.ReplaceReportedApplications = originalReplace
+		repository.GetKnownApplicationIDs = originalKnown
+	}()
+
+	// Only application 1 is in the catalog
+	repository.GetKnownApplicationIDs = func(appIDs []int64) (map[int64]bool, error) {
+		return map[int64]bool{1: true}, nil
+	}
+
+	var stored []structs.ReportedApplication
+	repository.ReplaceReportedApplications = func(deviceID int64, apps []structs.ReportedApplication, now time.Time) error {
+		stored = apps
+		return nil
+	}
+
+	err := service.ReportDeviceState(1, []structs.ReportedApplication{{AppID: 1, Version: "1.0"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(stored) != 1 || stored[0].Status != "online" {
+		t.Errorf("Expected the application to be stored as online, got %+v", stored)
+	}
+
+	invalid := [][]structs.ReportedApplication{
+		{{AppID: 0, Version: "1.0"}},
+		{{AppID: 1, Version: "1.0"}, {AppID: 1, Version: "1.0"}},
+		{{AppID: 1, Version: "1.0", Status: "exploded"}},
+		{{AppID: 1}},
+		{{AppID: 1, Version: "1.0", Config: json.RawMessage(`{broken`)}},
+		{{AppID: 1, Version: "1.0"}, {AppID: 404, Version: "1.0"}},
+	}
+	for _, apps := range invalid {
+		if err := service.ReportDeviceState(1, apps); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected ErrInvalidRequest for %+v, got %v", apps, err)
+		}
+	}
+}
+
+func TestSetDesiredApplicationUnknownApplication(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalGetApp := repository.GetApplicationByID
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetApplicationByID = originalGetApp
+	}()
+
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return filter.DeviceIDs, nil
+	}
+	repository.GetApplicationByID = func(appID int64) (*structs.Application, error) {
+		return nil, nil
+	}
+
+	err := service.SetDesiredApplication(1, structs.DesiredApplicationRequest{DeviceIDs: []int64{1}, AppID: 404, Version: "1.0"})
+	if !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for an unknown application, got %v", err)
+	}
+}
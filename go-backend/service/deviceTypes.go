@@ -0,0 +1,372 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// capabilityPattern limits capabilities to short identifiers such as "audio_processing"
+var capabilityPattern = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+// GetDeviceTypes retrieves the device type catalog
+func GetDeviceTypes() ([]structs.DeviceType, error) {
+	types, err := repository.GetDeviceTypes()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching device types: %w", err)
+	}
+	if types == nil {
+		types = []structs.DeviceType{}
+	}
+	return types, nil
+}
+
+// CreateDeviceType adds a hardware model to the catalog, only admins maintain the catalog
+func CreateDeviceType(meberID int64, deviceType structs.DeviceType) (structs.DeviceType, error) {
+	if err := requireAdmin(meberID); err != nil {
+		return structs.DeviceType{}, err
+	}
+
+	deviceType.Vendor = strings.TrimSpace(deviceType.Vendor)
+	deviceType.Model = strings.TrimSpace(deviceType.Model)
+	if deviceType.Vendor == "" || deviceType.Model == "" {
+		return structs.DeviceType{}, fmt.Errorf("%w: vendor and model are required", ErrInvalidRequest)
+	}
+	if len(deviceType.Vendor) > maxDeviceNameLength || len(deviceType.Model) > maxDeviceNameLength {
+		return structs.DeviceType{}, fmt.Errorf("%w: vendor and model can be at most %d characters", ErrInvalidRequest, maxDeviceNameLength)
+	}
+	if deviceType.CPUCores < 0 || deviceType.MemoryMB < 0 || deviceType.StorageMB < 0 {
+		return structs.DeviceType{}, fmt.Errorf("%w: resource capacity can't be negative", ErrInvalidRequest)
+	}
+
+	capabilities, err := normalizeCapabilities(deviceType.Capabilities)
+	if err != nil {
+		return structs.DeviceType{}, err
+	}
+	deviceType.Capabilities = capabilities
+	if deviceType.SensorIDs, err = checkSensors(deviceType.SensorIDs, nil); err != nil {
+		return structs.DeviceType{}, err
+	}
+
+	deviceTypeID, err := repository.CreateDeviceType(deviceType)
+	if err != nil {
+		return structs.DeviceType{}, fmt.Errorf("error creating device type: %w", err)
+	}
+	deviceType.ID = deviceTypeID
+
+	return deviceType, nil
+}
+
+// AssignDeviceType sets the type of existing devices the meber has access to
+func AssignDeviceType(meberID int64, assignment structs.DeviceTypeAssignment) error {
+	if len(assignment.DeviceIDs) == 0 {
+		return fmt.Errorf("%w: no devices given", ErrInvalidRequest)
+	}
+	if _, err := getDeviceType(assignment.DeviceTypeID); err != nil {
+		return err
+	}
+	if err := checkDeviceAccess(meberID, assignment.DeviceIDs); err != nil {
+		return err
+	}
+
+	return repository.AssignDeviceType(assignment.DeviceTypeID, assignment.DeviceIDs, meberID)
+}
+
+// SetApplicationCapabilities replaces the capabilities a device type needs to run an application, admins only
+func SetApplicationCapabilities(meberID int64, request structs.ApplicationCapabilities) error {
+	if err := requireAdmin(meberID); err != nil {
+		return err
+	}
+
+	capabilities, err := normalizeCapabilities(request.Capabilities)
+	if err != nil {
+		return err
+	}
+	app, err := repository.GetApplicationByID(request.AppID)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		return fmt.Errorf("%w: application %d", ErrNotFound, request.AppID)
+	}
+
+	return repository.SetApplicationCapabilities(request.AppID, capabilities, meberID)
+}
+
+// GetProvisioningTemplates retrieves all provisioning templates
+func GetProvisioningTemplates() ([]structs.ProvisioningTemplate, error) {
+	templates, err := repository.GetProvisioningTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching provisioning templates: %w", err)
+	}
+	if templates == nil {
+		templates = []structs.ProvisioningTemplate{}
+	}
+	return templates, nil
+}
+
+// CreateProvisioningTemplate validates and stores a provisioning template, admins only
+func CreateProvisioningTemplate(meberID int64, template structs.ProvisioningTemplate) (structs.ProvisioningTemplate, error) {
+	if err := requireAdmin(meberID); err != nil {
+		return structs.ProvisioningTemplate{}, err
+	}
+
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return structs.ProvisioningTemplate{}, fmt.Errorf("%w: template name is required", ErrInvalidRequest)
+	}
+	if len(template.Name) > maxDeviceNameLength {
+		return structs.ProvisioningTemplate{}, fmt.Errorf("%w: name can be at most %d characters", ErrInvalidRequest, maxDeviceNameLength)
+	}
+
+	// Step 1: The scope of the template, a type and a municipality
+	var supportedSensors []int64
+	if template.DeviceTypeID != nil {
+		deviceType, err := getDeviceType(*template.DeviceTypeID)
+		if err != nil {
+			return structs.ProvisioningTemplate{}, err
+		}
+		supportedSensors = deviceType.SensorIDs
+	}
+	if template.MunicipalityID != nil {
+		if _, err := getMunicipality(*template.MunicipalityID); err != nil {
+			return structs.ProvisioningTemplate{}, err
+		}
+	}
+
+	// Step 2: What the template provisions, sensors have to be supported by the type of the template
+	for _, tagID := range template.TagIDs {
+		tag, err := repository.GetTagByID(tagID)
+		if err != nil {
+			return structs.ProvisioningTemplate{}, err
+		}
+		if tag == nil {
+			return structs.ProvisioningTemplate{}, fmt.Errorf("%w: tag %d", ErrNotFound, tagID)
+		}
+		if !tag.IsEditable || tag.Type == "location" {
+			return structs.ProvisioningTemplate{}, fmt.Errorf("%w: tag %q can't be provisioned", ErrInvalidRequest, tag.Name)
+		}
+	}
+	var err error
+	if template.SensorIDs, err = checkSensors(template.SensorIDs, supportedSensors); err != nil {
+		return structs.ProvisioningTemplate{}, err
+	}
+	for _, appID := range template.AppIDs {
+		app, err := repository.GetApplicationByID(appID)
+		if err != nil {
+			return structs.ProvisioningTemplate{}, err
+		}
+		if app == nil {
+			return structs.ProvisioningTemplate{}, fmt.Errorf("%w: application %d", ErrNotFound, appID)
+		}
+	}
+
+	template.CreatedBy = meberID
+	templateID, err := repository.CreateProvisioningTemplate(template)
+	if err != nil {
+		return structs.ProvisioningTemplate{}, fmt.Errorf("error creating provisioning template: %w", err)
+	}
+	template.ID = templateID
+
+	return template, nil
+}
+
+// DeleteProvisioningTemplate removes a provisioning template, admins only
+func DeleteProvisioningTemplate(meberID, templateID int64) error {
+	if err := requireAdmin(meberID); err != nil {
+		return err
+	}
+
+	deleted, err := repository.DeleteProvisioningTemplate(templateID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("%w: provisioning template %d", ErrNotFound, templateID)
+	}
+	return nil
+}
+
+// RegisterDevice creates a new device and provisions it with the templates for its type and municipality. The
+// municipality is assigned from the coordinates when they lie in a known municipality.
+func RegisterDevice(meberID int64, request structs.DeviceRegistrationRequest) (structs.DeviceRegistration, error) {
+	// Step 1: Validate the device
+	request.Name = strings.TrimSpace(request.Name)
+	if request.Name == "" {
+		return structs.DeviceRegistration{}, fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if len(request.Name) > maxDeviceNameLength {
+		return structs.DeviceRegistration{}, fmt.Errorf("%w: name can be at most %d characters", ErrInvalidRequest, maxDeviceNameLength)
+	}
+	if !validConnectionTypes[request.ConnectionType] {
+		return structs.DeviceRegistration{}, fmt.Errorf("%w: connection type must be wireless or wired", ErrInvalidRequest)
+	}
+	if request.IPAddress != "" && net.ParseIP(request.IPAddress) == nil {
+		return structs.DeviceRegistration{}, fmt.Errorf("%w: invalid IP address %q", ErrInvalidRequest, request.IPAddress)
+	}
+	if request.Latitude == nil || request.Longitude == nil {
+		return structs.DeviceRegistration{}, fmt.Errorf("%w: latitude and longitude are required", ErrInvalidRequest)
+	}
+	coordinate := structs.Coordinate{Latitude: *request.Latitude, Longitude: *request.Longitude}
+	if err := validateCoordinate(coordinate); err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+	if request.DeviceTypeID != nil {
+		if _, err := getDeviceType(*request.DeviceTypeID); err != nil {
+			return structs.DeviceRegistration{}, err
+		}
+	}
+
+	// Step 2: The municipality follows from the coordinates, a municipality that is given has to agree with them
+	located, err := municipalityTagAt(coordinate)
+	if err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+	if located != nil {
+		if request.MunicipalityID != nil && *request.MunicipalityID != located.ID {
+			return structs.DeviceRegistration{}, fmt.Errorf("%w: the coordinates lie in municipality %q", ErrInvalidRequest, located.Name)
+		}
+		request.MunicipalityID = &located.ID
+	}
+
+	// Step 3: A restricted meber could not see a new device without one of their municipalities
+	unrestricted, municipalities, err := accessibleMunicipalities(meberID)
+	if err != nil {
+		return structs.DeviceRegistration{}, err
+	}
+	if request.MunicipalityID == nil {
+		if !unrestricted {
+			return structs.DeviceRegistration{}, fmt.Errorf("%w: a new device needs a municipality you have access to", ErrInvalidRequest)
+		}
+	} else {
+		municipality, err := getMunicipality(*request.MunicipalityID)
+		if err != nil {
+			return structs.DeviceRegistration{}, err
+		}
+		if !unrestricted && !municipalities[municipality.Name] {
+			return structs.DeviceRegistration{}, fmt.Errorf("%w: no access to municipality %q", ErrAccessDenied, municipality.Name)
+		}
+	}
+
+	// Step 4: Create and provision the device
+	registration, err := repository.RegisterDevice(request, meberID, time.Now())
+	if err != nil {
+		return structs.DeviceRegistration{}, fmt.Errorf("error registering device: %w", err)
+	}
+	return registration, nil
+}
+
+// CheckDeviceCapabilities verifies that the type of every device has the capabilities the application requires
+func CheckDeviceCapabilities(deviceIDs []int64, appID int64) error {
+	appCapabilities, err := repository.GetApplicationCapabilities()
+	if err != nil {
+		return err
+	}
+	required := appCapabilities[appID]
+	if len(required) == 0 {
+		return nil
+	}
+
+	deviceCapabilities, err := repository.GetDeviceCapabilities(deviceIDs)
+	if err != nil {
+		return err
+	}
+	for _, deviceID := range deviceIDs {
+		if missing := missingCapabilities(required, deviceCapabilities[deviceID]); len(missing) > 0 {
+			return fmt.Errorf("%w: device %d can't run application %d: device type lacks capabilities: %s",
+				ErrInvalidRequest, deviceID, appID, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// missingCapabilities returns the required capabilities that are not in the capabilities of a device
+func missingCapabilities(required []string, capabilities map[string]bool) []string {
+	var missing []string
+	for _, capability := range required {
+		if !capabilities[capability] {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
+
+// getDeviceType retrieves a device type, returning ErrNotFound when it does not exist
+func getDeviceType(deviceTypeID int64) (*structs.DeviceType, error) {
+	deviceType, err := repository.GetDeviceTypeByID(deviceTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if deviceType == nil {
+		return nil, fmt.Errorf("%w: device type %d", ErrNotFound, deviceTypeID)
+	}
+	return deviceType, nil
+}
+
+// getMunicipality retrieves a location tag, returning ErrNotFound when the tag is not a municipality
+func getMunicipality(tagID int64) (*structs.Tag, error) {
+	tag, err := repository.GetTagByID(tagID)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil || tag.Type != "location" {
+		return nil, fmt.Errorf("%w: municipality %d", ErrNotFound, tagID)
+	}
+	return tag, nil
+}
+
+// normalizeCapabilities lowercases, deduplicates and validates capabilities
+func normalizeCapabilities(capabilities []string) ([]string, error) {
+	seen := make(map[string]bool, len(capabilities))
+	normalized := []string{}
+	for _, capability := range capabilities {
+		capability = strings.ToLower(strings.TrimSpace(capability))
+		if !capabilityPattern.MatchString(capability) {
+			return nil, fmt.Errorf("%w: invalid capability %q", ErrInvalidRequest, capability)
+		}
+		if !seen[capability] {
+			seen[capability] = true
+			normalized = append(normalized, capability)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// checkSensors deduplicates sensor IDs and verifies they exist and, when supported is not nil, are in supported
+func checkSensors(sensorIDs []int64, supported []int64) ([]int64, error) {
+	if len(sensorIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	sensors, err := repository.GetSensors()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[int64]bool, len(sensors))
+	for _, sensor := range sensors {
+		known[sensor.ID] = true
+	}
+	supportedSet := idSet(supported)
+
+	seen := make(map[int64]bool, len(sensorIDs))
+	var checked []int64
+	for _, sensorID := range sensorIDs {
+		if !known[sensorID] {
+			return nil, fmt.Errorf("%w: sensor %d", ErrNotFound, sensorID)
+		}
+		if supported != nil && !supportedSet[sensorID] {
+			return nil, fmt.Errorf("%w: sensor %d is not supported by the device type", ErrInvalidRequest, sensorID)
+		}
+		if !seen[sensorID] {
+			seen[sensorID] = true
+			checked = append(checked, sensorID)
+		}
+	}
+	return checked, nil
+}
@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+// mockProvisioningCatalog stubs a catalog with one device type supporting sensor 1, a municipality (tag 1),
+// an editable tag (tag 2), two sensors and one application. Meber 1 is an admin, other mebers are restricted to
+// Arnhem.
+func mockProvisioningCatalog(t *testing.T) {
+	originalGetRoles := repository.GetRolesForMeber
+	originalGetMeberTags := repository.GetMeberTags
+	originalGetDeviceType := repository.GetDeviceTypeByID
+	originalGetTag := repository.GetTagByID
+	originalGetSensors := repository.GetSensors
+	originalGetApplication := repository.GetApplicationByID
+	t.Cleanup(func() {
+		repository.GetRolesForMeber = originalGetRoles
+		repository.GetMeberTags = originalGetMeberTags
+		repository.GetDeviceTypeByID = originalGetDeviceType
+		repository.GetTagByID = originalGetTag
+		repository.GetSensors = originalGetSensors
+		repository.GetApplicationByID = originalGetApplication
+	})
+
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 1 {
+			return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+		}
+		return []structs.Role{{ID: 2, Name: "gemeente", IsRestricted: true}}, nil
+	}
+	repository.GetMeberTags = func(meberID int64) ([]string, error) { return []string{"Arnhem"}, nil }
+	repository.GetDeviceTypeByID = func(deviceTypeID int64) (*structs.DeviceType, error) {
+		if deviceTypeID != 1 {
+			return nil, nil
+		}
+		return &structs.DeviceType{ID: 1, Vendor: "Siemens", Model: "SICAM A8000", Capabilities: []string{"iec61850"}, SensorIDs: []int64{1}}, nil
+	}
+	repository.GetTagByID = func(tagID int64) (*structs.Tag, error) {
+		switch tagID {
+		case 1:
+			return &structs.Tag{ID: 1, Name: "Arnhem", Type: "location"}, nil
+		case 2:
+			return &structs.Tag{ID: 2, Name: "pilot", Type: "custom", IsEditable: true}, nil
+		case 3:
+			return &structs.Tag{ID: 3, Name: "Nijmegen", Type: "location"}, nil
+		}
+		return nil, nil
+	}
+	repository.GetSensors = func() ([]structs.Sensor, error) {
+		return []structs.Sensor{{ID: 1, Name: "spanningssensor"}, {ID: 2, Name: "straatlampen"}}, nil
+	}
+	repository.GetApplicationByID = func(appID int64) (*structs.Application, error) {
+		if appID != 1 {
+			return nil, nil
+		}
+		return &structs.Application{ID: 1, Name: "straatlampen app", Version: "1.0"}, nil
+	}
+}
+
+func TestCreateProvisioningTemplate(t *testing.T) {
+	mockProvisioningCatalog(t)
+
+	originalCreate := repository.CreateProvisioningTemplate
+	defer func() { repository.CreateProvisioningTemplate = originalCreate }()
+	var stored structs.ProvisioningTemplate
+	repository.CreateProvisioningTemplate = func(template structs.ProvisioningTemplate) (int64, error) {
+		stored = template
+		return 7, nil
+	}
+
+	deviceTypeID, municipalityID := int64(1), int64(1)
+	template, err := service.CreateProvisioningTemplate(1, structs.ProvisioningTemplate{
+		Name: " Arnhem SICAM ", DeviceTypeID: &deviceTypeID, MunicipalityID: &municipalityID,
+		TagIDs: []int64{2}, SensorIDs: []int64{1, 1}, AppIDs: []int64{1},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if template.ID != 7 || stored.Name != "Arnhem SICAM" || stored.CreatedBy != 1 {
+		t.Errorf("Expected the trimmed template to be stored by meber 1, got %+v", stored)
+	}
+	if len(stored.SensorIDs) != 1 {
+		t.Errorf("Expected duplicate sensors to be dropped, got %v", stored.SensorIDs)
+	}
+
+	unknownType := int64(9)
+	tests := []struct {
+		name     string
+		meberID  int64
+		template structs.ProvisioningTemplate
+		wantErr  error
+	}{
+		{"Not Admin", 2, structs.ProvisioningTemplate{Name: "pilot"}, service.ErrAccessDenied},
+		{"Name Required", 1, structs.ProvisioningTemplate{Name: " "}, service.ErrInvalidRequest},
+		{"Unknown Device Type", 1, structs.ProvisioningTemplate{Name: "pilot", DeviceTypeID: &unknownType}, service.ErrNotFound},
+		{"Municipality Is Not A Tag To Provision", 1, structs.ProvisioningTemplate{Name: "pilot", TagIDs: []int64{1}}, service.ErrInvalidRequest},
+		{"Sensor Not Supported By Type", 1, structs.ProvisioningTemplate{Name: "pilot", DeviceTypeID: &deviceTypeID, SensorIDs: []int64{2}}, service.ErrInvalidRequest},
+		{"Unknown Application", 1, structs.ProvisioningTemplate{Name: "pilot", AppIDs: []int64{5}}, service.ErrNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := service.CreateProvisioningTemplate(tc.meberID, tc.template); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRegisterDevice(t *testing.T) {
+	mockProvisioningCatalog(t)
+
+	originalRegister := repository.RegisterDevice
+	defer func() { repository.RegisterDevice = originalRegister }()
+	registered := 0
+	repository.RegisterDevice = func(request structs.DeviceRegistrationRequest, meberID int64, now time.Time) (structs.DeviceRegistration, error) {
+		registered++
+		return structs.DeviceRegistration{DeviceID: 42, AppliedTemplates: []int64{7}}, nil
+	}
+
+	arnhem, nijmegen, deviceTypeID := int64(1), int64(3), int64(1)
+	latitude, longitude := 51.98, 5.91
+
+	registration, err := service.RegisterDevice(2, structs.DeviceRegistrationRequest{
+		Name: "MSR_new", ConnectionType: "wired", Latitude: &latitude, Longitude: &longitude,
+		DeviceTypeID: &deviceTypeID, MunicipalityID: &arnhem,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if registration.DeviceID != 42 || len(registration.AppliedTemplates) != 1 {
+		t.Errorf("Unexpected registration %+v", registration)
+	}
+
+	tests := []struct {
+		name    string
+		meberID int64
+		request structs.DeviceRegistrationRequest
+		wantErr error
+	}{
+		{"Invalid Connection Type", 1, structs.DeviceRegistrationRequest{Name: "MSR_new", ConnectionType: "fiber"}, service.ErrInvalidRequest},
+		{"Latitude Without Longitude", 1, structs.DeviceRegistrationRequest{Name: "MSR_new", ConnectionType: "wired", Latitude: &latitude}, service.ErrInvalidRequest},
+		{"Without Coordinates", 1, structs.DeviceRegistrationRequest{Name: "MSR_new", ConnectionType: "wired"}, service.ErrInvalidRequest},
+		{"Restricted Meber Without Municipality", 2, structs.DeviceRegistrationRequest{Name: "MSR_new", ConnectionType: "wired", Latitude: &latitude, Longitude: &longitude}, service.ErrInvalidRequest},
+		{"Restricted Meber Outside Municipality", 2, structs.DeviceRegistrationRequest{Name: "MSR_new", ConnectionType: "wired", MunicipalityID: &nijmegen, Latitude: &latitude, Longitude: &longitude}, service.ErrAccessDenied},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := service.RegisterDevice(tc.meberID, tc.request); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	if registered != 1 {
+		t.Errorf("Expected only the valid registration to be stored, got %d", registered)
+	}
+}
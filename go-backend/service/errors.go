@@ -0,0 +1,10 @@
+package service
+
+import "errors"
+
+// Errors that handlers translate into a specific HTTP status
+var (
+	ErrAccessDenied   = errors.New("access denied")
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrNotFound       = errors.New("not found")
+)
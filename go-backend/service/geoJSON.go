@@ -0,0 +1,41 @@
+package service
+
+import (
+	"main/geo"
+	"main/structs"
+)
+
+// GetMunicipalityBoundaries returns the boundaries of the municipalities as a FeatureCollection of MultiPolygons
+func GetMunicipalityBoundaries() (structs.FeatureCollection, error) {
+	municipalities, err := geo.Municipalities()
+	if err != nil {
+		return structs.FeatureCollection{}, err
+	}
+
+	features := make([]structs.Feature, 0, len(municipalities))
+	for _, municipality := range municipalities {
+		features = append(features, municipality.Feature())
+	}
+	return structs.NewFeatureCollection(features), nil
+}
+
+// GetMapGeoJSON returns the accessible devices within the optional bounds as a FeatureCollection of points, so GIS
+// tools can load the map directly. With a group only its members are included.
+func GetMapGeoJSON(meberID int64, groupID *int64, bounds *structs.BoundingBox) (structs.FeatureCollection, error) {
+	devices, err := getMapDevices(meberID, groupID, bounds)
+	if err != nil {
+		return structs.FeatureCollection{}, err
+	}
+
+	features := make([]structs.Feature, 0, len(devices))
+	for _, device := range devices {
+		features = append(features, structs.PointFeature(device.ID, device.Coordinate, map[string]interface{}{
+			"id":           device.ID,
+			"name":         device.Name,
+			"status":       device.Status,
+			"municipality": device.Municipality,
+			"quarantined":  device.Quarantined,
+		}))
+	}
+	return structs.NewFeatureCollection(features), nil
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"encoding/json"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+)
+
+func TestGetMapGeoJSON(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+	}()
+
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 7, Name: "MSR_7", Status: "offline", Municipality: "Utrecht", Coordinate: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+
+	collection, err := service.GetMapGeoJSON(5, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	encoded, err := json.Marshal(collection)
+	if err != nil {
+		t.Fatalf("Unexpected error encoding the collection: %v", err)
+	}
+
+	// GeoJSON positions are longitude first
+	var decoded struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string    `json:"type"`
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unexpected error decoding the collection: %v", err)
+	}
+	if decoded.Type != "FeatureCollection" || len(decoded.Features) != 1 {
+		t.Fatalf("Expected a FeatureCollection with one feature, got %s", encoded)
+	}
+	feature := decoded.Features[0]
+	if feature.Geometry.Type != "Point" || feature.Geometry.Coordinates[0] != 5.1214 || feature.Geometry.Coordinates[1] != 52.0907 {
+		t.Errorf("Expected a point at [5.1214, 52.0907], got %+v", feature.Geometry)
+	}
+	if feature.Properties["status"] != "offline" || feature.Properties["municipality"] != "Utrecht" {
+		t.Errorf("Expected the status and municipality as properties, got %v", feature.Properties)
+	}
+}
+
+func TestGetMunicipalityBoundaries(t *testing.T) {
+	boundaries, err := service.GetMunicipalityBoundaries()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(boundaries.Features) == 0 {
+		t.Fatal("Expected the municipality boundaries to be loaded")
+	}
+	for _, feature := range boundaries.Features {
+		if feature.Geometry.Type != "MultiPolygon" || feature.Properties["name"] == "" {
+			t.Errorf("Expected named MultiPolygons, got %s %v", feature.Geometry.Type, feature.Properties["name"])
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"main/geo"
+	"strings"
+	"time"
+)
+
+// GIS export formats for Google Earth and desktop GIS tools
+const (
+	ExportFormatKML        = "kml"
+	ExportFormatGeoPackage = "gpkg"
+)
+
+// statusColors are the colours of the device statuses in GIS exports, matching the markers on the map
+var statusColors = []geo.Style{
+	{ID: "online", Color: "#22c55e"},
+	{ID: "offline", Color: "#ef4444"},
+	{ID: "error", Color: "#f97316"},
+	{ID: "app_issue", Color: "#eab308"},
+	{ID: MaintenanceStatus, Color: "#3b82f6"},
+}
+
+// errorRateBands colour municipalities by the share of their devices with an incident, the first band the error
+// rate doesn't exceed applies
+var errorRateBands = []struct {
+	upTo  float64
+	style geo.Style
+}{
+	{upTo: 0, style: geo.Style{ID: "none", Color: "#22c55e"}},
+	{upTo: 0.1, style: geo.Style{ID: "low", Color: "#eab308"}},
+	{upTo: 0.25, style: geo.Style{ID: "medium", Color: "#f97316"}},
+	{upTo: 1, style: geo.Style{ID: "high", Color: "#ef4444"}},
+}
+
+// ExportDeviceLayer returns the accessible devices, optionally narrowed down to a device group, as a GIS layer of
+// points styled by status. Devices in an active maintenance window are styled as in maintenance.
+func ExportDeviceLayer(meberID, groupID int64) (geo.Layer, error) {
+	records, err := ExportDeviceInventory(meberID, groupID)
+	if err != nil {
+		return geo.Layer{}, err
+	}
+	state, err := getMaintenanceState(time.Now())
+	if err != nil {
+		return geo.Layer{}, err
+	}
+
+	layer := geo.Layer{
+		Name:         "devices",
+		GeometryType: geo.GeometryPoint,
+		Columns: []geo.Column{
+			{Name: "name", Type: "TEXT"},
+			{Name: "status", Type: "TEXT"},
+			{Name: "connection_type", Type: "TEXT"},
+			{Name: "ip_address", Type: "TEXT"},
+			{Name: "municipality", Type: "TEXT"},
+			{Name: "tags", Type: "TEXT"},
+			{Name: "sensors", Type: "TEXT"},
+			{Name: "applications", Type: "TEXT"},
+			{Name: "color", Type: "TEXT"},
+		},
+		Styles:   statusColors,
+		Features: make([]geo.Feature, 0, len(records)),
+	}
+	colors := make(map[string]string, len(statusColors))
+	for _, style := range statusColors {
+		colors[style.ID] = style.Color
+	}
+
+	for _, record := range records {
+		status := record.Status
+		if state.inMaintenance[record.ID] {
+			status = MaintenanceStatus
+		}
+		layer.Features = append(layer.Features, geo.Feature{
+			ID:    record.ID,
+			Name:  record.Name,
+			Style: status,
+			Point: record.Coordinate,
+			Values: []interface{}{
+				record.Name,
+				status,
+				record.ConnectionType,
+				nullableExportValue(record.IPAddress),
+				nullableExportValue(record.Municipality),
+				strings.Join(record.Tags, inventoryListSeparator),
+				strings.Join(record.Sensors, inventoryListSeparator),
+				strings.Join(record.Applications, inventoryListSeparator),
+				colors[status],
+			},
+		})
+	}
+	return layer, nil
+}
+
+// ExportMunicipalityLayer returns the status aggregates of the municipalities with accessible devices joined to
+// their boundaries, styled by error rate. Devices without a known municipality have no area and are left out.
+func ExportMunicipalityLayer(meberID int64) (geo.Layer, error) {
+	statuses, err := GetMunicipalityStatus(meberID)
+	if err != nil {
+		return geo.Layer{}, err
+	}
+
+	layer := geo.Layer{
+		Name:         "municipalities",
+		GeometryType: geo.GeometryMultiPolygon,
+		Columns: []geo.Column{
+			{Name: "name", Type: "TEXT"},
+			{Name: "code", Type: "TEXT"},
+			{Name: "devices", Type: "INTEGER"},
+		},
+	}
+	for _, style := range statusColors {
+		layer.Columns = append(layer.Columns, geo.Column{Name: style.ID, Type: "INTEGER"})
+	}
+	layer.Columns = append(layer.Columns,
+		geo.Column{Name: "applications", Type: "INTEGER"},
+		geo.Column{Name: "error_rate", Type: "DOUBLE"},
+		geo.Column{Name: "last_incident_at", Type: "DATETIME"},
+		geo.Column{Name: "color", Type: "TEXT"},
+	)
+	for _, band := range errorRateBands {
+		layer.Styles = append(layer.Styles, band.style)
+	}
+
+	for _, status := range statuses {
+		municipality, ok, err := geo.MunicipalityByName(status.Municipality)
+		if err != nil {
+			return geo.Layer{}, err
+		}
+		if !ok {
+			continue
+		}
+
+		style := errorRateBands[len(errorRateBands)-1].style
+		for _, band := range errorRateBands {
+			if status.ErrorRate <= band.upTo {
+				style = band.style
+				break
+			}
+		}
+		values := []interface{}{municipality.Name, municipality.Code, int64(status.Devices)}
+		for _, statusColor := range statusColors {
+			values = append(values, int64(status.DeviceStatusCounts[statusColor.ID]))
+		}
+		var lastIncident interface{}
+		if status.LastIncidentAt != nil {
+			lastIncident = *status.LastIncidentAt
+		}
+		values = append(values, int64(status.Applications), status.ErrorRate, lastIncident, style.Color)
+
+		layer.Features = append(layer.Features, geo.Feature{
+			ID:     int64(len(layer.Features) + 1),
+			Name:   municipality.Name,
+			Style:  style.ID,
+			Area:   municipality.Boundary,
+			Values: values,
+		})
+	}
+	return layer, nil
+}
+
+// WriteGISExport writes the layers in a GIS export format, a KML document or a GeoPackage
+func WriteGISExport(w io.Writer, format, name string, layers ...geo.Layer) error {
+	switch format {
+	case ExportFormatKML:
+		return geo.WriteKML(w, name, layers...)
+	case ExportFormatGeoPackage:
+		return geo.WriteGeoPackage(w, time.Now(), layers...)
+	}
+	return fmt.Errorf("%w: unknown export format %q", ErrInvalidRequest, format)
+}
+
+// nullableExportValue leaves empty values out of an export instead of writing an empty string
+func nullableExportValue(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
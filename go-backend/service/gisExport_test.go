@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestExportDeviceLayer(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalInventory := repository.GetDeviceInventory
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetDeviceInventory = originalInventory
+		repository.GetMaintenanceWindows = originalWindows
+	}()
+
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return []int64{1, 2}, nil
+	}
+	repository.GetDeviceInventory = func(deviceIDs []int64) ([]structs.DeviceInventoryRecord, error) {
+		return []structs.DeviceInventoryRecord{
+			{ID: 1, Name: "MSR_1", Status: "online", ConnectionType: "wired", Municipality: "Utrecht", Coordinate: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}, Tags: []string{"pilot"}},
+			{ID: 2, Name: "MSR_2", Status: "error", ConnectionType: "wireless", Municipality: "Delft", Coordinate: structs.Coordinate{Latitude: 52.0116, Longitude: 4.3571}},
+		}, nil
+	}
+	// Device 2 is in maintenance, so it is styled as such instead of as an error
+	now := time.Now()
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return []structs.MaintenanceWindow{{ID: 1, TargetType: "device", TargetID: 2, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Recurrence: "none"}}, nil
+	}
+
+	layer, err := service.ExportDeviceLayer(5, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(layer.Features) != 2 || layer.Features[0].Style != "online" || layer.Features[1].Style != service.MaintenanceStatus {
+		t.Fatalf("Expected the devices styled online and in maintenance, got %+v", layer.Features)
+	}
+
+	// The KML refers to a shared style per status and has longitude first coordinates
+	var kml bytes.Buffer
+	if err := service.WriteGISExport(&kml, service.ExportFormatKML, "devices", layer); err != nil {
+		t.Fatalf("Unexpected error writing KML: %v", err)
+	}
+	var decoded struct {
+		Styles []struct {
+			ID    string `xml:"id,attr"`
+			Color string `xml:"IconStyle>color"`
+		} `xml:"Document>Style"`
+		Placemarks []struct {
+			Name        string `xml:"name"`
+			StyleURL    string `xml:"styleUrl"`
+			Coordinates string `xml:"Point>coordinates"`
+			Data        []struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value"`
+			} `xml:"ExtendedData>Data"`
+		} `xml:"Document>Folder>Placemark"`
+	}
+	if err := xml.Unmarshal(kml.Bytes(), &decoded); err != nil {
+		t.Fatalf("Unexpected error parsing the KML: %v", err)
+	}
+	if len(decoded.Placemarks) != 2 || decoded.Placemarks[0].StyleURL != "#devices-online" || decoded.Placemarks[0].Coordinates != "5.1214,52.0907" {
+		t.Fatalf("Expected placemarks styled by status, got %+v", decoded.Placemarks)
+	}
+	if decoded.Styles[0].ID != "devices-online" || decoded.Styles[0].Color != "ff5ec522" {
+		t.Errorf("Expected the online style in KML colour order, got %+v", decoded.Styles[0])
+	}
+	// Empty values such as the IP address are left out
+	for _, data := range decoded.Placemarks[0].Data {
+		if data.Name == "ip_address" {
+			t.Errorf("Expected no empty ip_address, got %q", data.Value)
+		}
+	}
+
+	// The GeoPackage is a SQLite database marked with the GeoPackage application ID
+	var gpkg bytes.Buffer
+	if err := service.WriteGISExport(&gpkg, service.ExportFormatGeoPackage, "devices", layer); err != nil {
+		t.Fatalf("Unexpected error writing GeoPackage: %v", err)
+	}
+	file := gpkg.Bytes()
+	if len(file) < 100 || string(file[:16]) != "SQLite format 3\x00" || string(file[68:72]) != "GPKG" {
+		t.Fatalf("Expected a SQLite file with the GPKG application ID")
+	}
+	if pageSize := int(binary.BigEndian.Uint16(file[16:])); len(file)%pageSize != 0 || int(binary.BigEndian.Uint32(file[28:]))*pageSize != len(file) {
+		t.Errorf("Expected the file to consist of the pages in its header, got %d bytes", len(file))
+	}
+	if !bytes.Contains(file, []byte(`CREATE TABLE "devices" (fid INTEGER PRIMARY KEY, geom POINT, "name" TEXT`)) {
+		t.Errorf("Expected a devices feature table")
+	}
+
+	if err := service.WriteGISExport(&gpkg, "shp", "devices", layer); err == nil {
+		t.Errorf("Expected an unknown format to be rejected")
+	}
+}
+
+func TestExportMunicipalityLayer(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	originalApplications := repository.GetApplicationStatusCounts
+	originalIncidents := repository.GetLastIncidents
+	defer func() {
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetApplicationStatusCounts = originalApplications
+		repository.GetLastIncidents = originalIncidents
+	}()
+
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 1, Status: "online", Municipality: "Delft"},
+			{ID: 2, Status: "offline", Municipality: "Delft"},
+			{ID: 3, Status: "online", Municipality: "Rotterdam"},
+			{ID: 4, Status: "error"},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+	repository.GetApplicationStatusCounts = func(deviceIDs []int64) (map[int64]map[string]int, error) {
+		return map[int64]map[string]int{}, nil
+	}
+	incident := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	repository.GetLastIncidents = func(deviceIDs []int64) (map[int64]time.Time, error) {
+		return map[int64]time.Time{2: incident}, nil
+	}
+
+	layer, err := service.ExportMunicipalityLayer(5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The device without a municipality has no area to join to
+	if len(layer.Features) != 2 {
+		t.Fatalf("Expected Delft and Rotterdam, got %+v", layer.Features)
+	}
+	delft, rotterdam := layer.Features[0], layer.Features[1]
+	if delft.Name != "Delft" || delft.Style != "high" || len(delft.Area) == 0 {
+		t.Errorf("Expected Delft with its boundary styled by its error rate of 50%%, got %s styled %s", delft.Name, delft.Style)
+	}
+	if rotterdam.Name != "Rotterdam" || rotterdam.Style != "none" {
+		t.Errorf("Expected Rotterdam without incidents, got %s styled %s", rotterdam.Name, rotterdam.Style)
+	}
+
+	values := make(map[string]interface{})
+	for i, column := range layer.Columns {
+		values[column.Name] = delft.Values[i]
+	}
+	if values["code"] != "0503" || values["devices"] != int64(2) || values["offline"] != int64(1) || values["error_rate"] != 0.5 {
+		t.Errorf("Expected the aggregates of Delft, got %v", values)
+	}
+	if lastIncident, ok := values["last_incident_at"].(time.Time); !ok || !lastIncident.Equal(incident) {
+		t.Errorf("Expected the last incident of Delft, got %v", values["last_incident_at"])
+	}
+
+	var gpkg bytes.Buffer
+	if err := service.WriteGISExport(&gpkg, service.ExportFormatGeoPackage, "municipalities", layer); err != nil {
+		t.Fatalf("Unexpected error writing GeoPackage: %v", err)
+	}
+	if !bytes.Contains(gpkg.Bytes(), []byte(`"error_rate" DOUBLE, "last_incident_at" DATETIME`)) {
+		t.Errorf("Expected the aggregates as columns of the municipalities table")
+	}
+}
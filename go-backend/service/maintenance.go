@@ -0,0 +1,355 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"main/repository"
+	"main/structs"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaintenanceStatus is the status shown for devices inside an active maintenance window
+const MaintenanceStatus = "maintenance"
+
+var recurrencePeriods = map[string]time.Duration{
+	"none":   0,
+	"daily":  24 * time.Hour,
+	"weekly": 7 * 24 * time.Hour,
+}
+
+// IsMaintenanceWindowActive reports whether an occurrence of the window is running at time t
+func IsMaintenanceWindowActive(window structs.MaintenanceWindow, t time.Time) bool {
+	if t.Before(window.StartsAt) {
+		return false
+	}
+
+	period := recurrencePeriods[window.Recurrence]
+	if period == 0 {
+		return t.Before(window.EndsAt)
+	}
+
+	// Find the start of the latest occurrence at or before t
+	occurrences := t.Sub(window.StartsAt) / period
+	occurrenceStart := window.StartsAt.Add(occurrences * period)
+	if window.RecurrenceUntil != nil && occurrenceStart.After(*window.RecurrenceUntil) {
+		return false
+	}
+
+	return t.Before(occurrenceStart.Add(window.EndsAt.Sub(window.StartsAt)))
+}
+
+// maintenanceWindowFinished reports whether the window has no occurrence left after time t
+func maintenanceWindowFinished(window structs.MaintenanceWindow, t time.Time) bool {
+	if recurrencePeriods[window.Recurrence] == 0 {
+		return !t.Before(window.EndsAt)
+	}
+	if window.RecurrenceUntil == nil {
+		return false
+	}
+	return !t.Before(window.RecurrenceUntil.Add(window.EndsAt.Sub(window.StartsAt)))
+}
+
+// CreateMaintenanceWindow validates and stores a maintenance window on a target the meber has access to
+func CreateMaintenanceWindow(meberID int64, window structs.MaintenanceWindow) (structs.MaintenanceWindow, error) {
+	// Step 1: Validate the schedule
+	window.Name = strings.TrimSpace(window.Name)
+	if window.Name == "" {
+		return structs.MaintenanceWindow{}, fmt.Errorf("%w: window name is required", ErrInvalidRequest)
+	}
+	if window.Recurrence == "" {
+		window.Recurrence = "none"
+	}
+	period, ok := recurrencePeriods[window.Recurrence]
+	if !ok {
+		return structs.MaintenanceWindow{}, fmt.Errorf("%w: recurrence must be none, daily or weekly", ErrInvalidRequest)
+	}
+	window.StartsAt = window.StartsAt.UTC().Truncate(time.Second)
+	window.EndsAt = window.EndsAt.UTC().Truncate(time.Second)
+	if !window.EndsAt.After(window.StartsAt) {
+		return structs.MaintenanceWindow{}, fmt.Errorf("%w: window must end after it starts", ErrInvalidRequest)
+	}
+	if period != 0 && window.EndsAt.Sub(window.StartsAt) >= period {
+		return structs.MaintenanceWindow{}, fmt.Errorf("%w: a %s window must be shorter than its period", ErrInvalidRequest, window.Recurrence)
+	}
+	if window.RecurrenceUntil != nil {
+		if period == 0 {
+			return structs.MaintenanceWindow{}, fmt.Errorf("%w: only a recurring window can have an end of recurrence", ErrInvalidRequest)
+		}
+		until := window.RecurrenceUntil.UTC().Truncate(time.Second)
+		if until.Before(window.StartsAt) {
+			return structs.MaintenanceWindow{}, fmt.Errorf("%w: recurrence must end after the window starts", ErrInvalidRequest)
+		}
+		window.RecurrenceUntil = &until
+	}
+
+	// Step 2: Check the meber has access to the target, which comes from the request so an unknown one is invalid
+	if err := checkMaintenanceTargetAccess(meberID, window); errors.Is(err, ErrNotFound) {
+		return structs.MaintenanceWindow{}, fmt.Errorf("%w: unknown %s %d", ErrInvalidRequest, window.TargetType, window.TargetID)
+	} else if err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+
+	// Step 3: Store the window
+	window.CreatedBy = meberID
+	windowID, err := repository.InsertMaintenanceWindow(window)
+	if err != nil {
+		return structs.MaintenanceWindow{}, err
+	}
+	window.ID = windowID
+	window.Active = IsMaintenanceWindowActive(window, time.Now())
+	if window.Active {
+		invalidateAllTiles()
+	}
+
+	return window, nil
+}
+
+// GetMaintenanceWindows lists the maintenance windows on targets the meber has access to
+func GetMaintenanceWindows(meberID int64) ([]structs.MaintenanceWindow, error) {
+	windows, err := repository.GetMaintenanceWindows()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching maintenance windows: %w", err)
+	}
+
+	now := time.Now()
+	visible := []structs.MaintenanceWindow{}
+	for _, window := range windows {
+		if err := checkMaintenanceTargetAccess(meberID, window); err != nil {
+			continue
+		}
+		window.Active = IsMaintenanceWindowActive(window, now)
+		visible = append(visible, window)
+	}
+	return visible, nil
+}
+
+// DeleteMaintenanceWindow removes a maintenance window on a target the meber has access to, only the creator of the
+// window and admins can remove it
+func DeleteMaintenanceWindow(meberID, windowID int64) error {
+	window, err := repository.GetMaintenanceWindowByID(windowID)
+	if err != nil {
+		return err
+	}
+	if window == nil {
+		return fmt.Errorf("%w: maintenance window %d", ErrNotFound, windowID)
+	}
+	if err := checkMaintenanceTargetAccess(meberID, *window); err != nil {
+		return err
+	}
+	if window.CreatedBy != meberID {
+		if err := requireAdmin(meberID); err != nil {
+			return err
+		}
+	}
+	if err := repository.DeleteMaintenanceWindow(windowID); err != nil {
+		return err
+	}
+	if IsMaintenanceWindowActive(*window, time.Now()) {
+		invalidateAllTiles()
+	}
+	return nil
+}
+
+// checkMaintenanceTargetAccess verifies that the meber has access to the device, group or municipality of a window
+func checkMaintenanceTargetAccess(meberID int64, window structs.MaintenanceWindow) error {
+	switch window.TargetType {
+	case "device":
+		return checkDeviceAccess(meberID, []int64{window.TargetID})
+	case "group":
+		_, err := visibleDeviceGroup(meberID, window.TargetID)
+		return err
+	case "municipality":
+		tag, err := repository.GetTagByID(window.TargetID)
+		if err != nil {
+			return err
+		}
+		if tag == nil || tag.Type != "location" {
+			return fmt.Errorf("%w: municipality %d", ErrNotFound, window.TargetID)
+		}
+		return checkMunicipalityAccess(meberID, tag.Name)
+	default:
+		return fmt.Errorf("%w: target type must be device, group or municipality", ErrInvalidRequest)
+	}
+}
+
+// checkMunicipalityAccess verifies that the meber is unrestricted or holds the location tag of the municipality
+func checkMunicipalityAccess(meberID int64, municipality string) error {
+	unrestricted, municipalities, err := accessibleMunicipalities(meberID)
+	if err != nil {
+		return err
+	}
+	if unrestricted || municipalities[municipality] {
+		return nil
+	}
+	return fmt.Errorf("%w: no access to municipality %s", ErrAccessDenied, municipality)
+}
+
+// accessibleMunicipalities returns whether the meber has an unrestricted role, and otherwise the tags the meber holds
+func accessibleMunicipalities(meberID int64) (bool, map[string]bool, error) {
+	roles, err := repository.GetRolesForMeber(meberID)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, role := range roles {
+		if !role.IsRestricted {
+			return true, nil, nil
+		}
+	}
+
+	tags, err := repository.GetMeberTags(meberID)
+	if err != nil {
+		return false, nil, err
+	}
+	names := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		names[tag] = true
+	}
+	return false, names, nil
+}
+
+// CheckInstallWindow verifies that none of the devices is restricted to installs inside a window that is closed at time now
+func CheckInstallWindow(deviceIDs []int64, now time.Time) error {
+	state, err := getMaintenanceState(now)
+	if err != nil {
+		return err
+	}
+	for _, deviceID := range deviceIDs {
+		if state.installBlocked[deviceID] {
+			return fmt.Errorf("%w: device %d only accepts installs during its maintenance window", ErrInvalidRequest, deviceID)
+		}
+	}
+	return nil
+}
+
+// maintenanceState holds which devices are in maintenance and which can't receive installs right now
+type maintenanceState struct {
+	inMaintenance  map[int64]bool
+	installBlocked map[int64]bool
+}
+
+// getMaintenanceState resolves all maintenance windows to the devices they cover at time now
+func getMaintenanceState(now time.Time) (maintenanceState, error) {
+	state := maintenanceState{inMaintenance: map[int64]bool{}, installBlocked: map[int64]bool{}}
+
+	windows, err := repository.GetMaintenanceWindows()
+	if err != nil {
+		return state, fmt.Errorf("error fetching maintenance windows: %w", err)
+	}
+
+	installOpen := map[int64]bool{}
+	for _, window := range windows {
+		if maintenanceWindowFinished(window, now) {
+			continue
+		}
+		active := IsMaintenanceWindowActive(window, now)
+		if !active && !window.InstallsOnlyInWindow {
+			continue
+		}
+
+		deviceIDs, err := maintenanceWindowDeviceIDs(window)
+		if err != nil {
+			log.Printf("Error resolving maintenance window %d: %v", window.ID, err)
+			continue
+		}
+
+		for _, deviceID := range deviceIDs {
+			if active {
+				state.inMaintenance[deviceID] = true
+			}
+			if window.InstallsOnlyInWindow {
+				if active {
+					installOpen[deviceID] = true
+				} else {
+					state.installBlocked[deviceID] = true
+				}
+			}
+		}
+	}
+
+	// A device covered by several windows can receive installs as soon as one of them is open
+	for deviceID := range installOpen {
+		delete(state.installBlocked, deviceID)
+	}
+
+	return state, nil
+}
+
+// MaintenanceTargetTTL bounds how long the devices of a group or municipality window are reused. Changes to
+// devices invalidate them right away, other changes to what a group matches show up within the TTL.
+const MaintenanceTargetTTL = time.Minute
+
+type maintenanceTargetKey struct {
+	windowID   int64
+	targetType string
+	targetID   int64
+	createdBy  int64
+}
+
+type cachedMaintenanceTarget struct {
+	deviceIDs []int64
+	expires   time.Time
+}
+
+// maintenanceTargets caches the devices of group and municipality windows, resolving them takes a query per
+// window and access checks for groups, on every map, tile and status request
+var maintenanceTargets = struct {
+	sync.Mutex
+	devices    map[maintenanceTargetKey]cachedMaintenanceTarget
+	generation uint64
+}{devices: make(map[maintenanceTargetKey]cachedMaintenanceTarget)}
+
+// maintenanceWindowDeviceIDs resolves the target of a window to device IDs, cached unless it is a single device
+func maintenanceWindowDeviceIDs(window structs.MaintenanceWindow) ([]int64, error) {
+	if window.TargetType == "device" {
+		return []int64{window.TargetID}, nil
+	}
+
+	key := maintenanceTargetKey{windowID: window.ID, targetType: window.TargetType, targetID: window.TargetID, createdBy: window.CreatedBy}
+	now := time.Now()
+	maintenanceTargets.Lock()
+	cached, ok := maintenanceTargets.devices[key]
+	generation := maintenanceTargets.generation
+	maintenanceTargets.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.deviceIDs, nil
+	}
+
+	deviceIDs, err := resolveMaintenanceTarget(window)
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices that changed while resolving may not be reflected, so the result is only kept without changes since
+	maintenanceTargets.Lock()
+	if maintenanceTargets.generation == generation {
+		maintenanceTargets.devices[key] = cachedMaintenanceTarget{deviceIDs: deviceIDs, expires: now.Add(MaintenanceTargetTTL)}
+	}
+	maintenanceTargets.Unlock()
+	return deviceIDs, nil
+}
+
+// invalidateMaintenanceTargets drops the cached devices of all windows, for changes to devices, groups or windows
+func invalidateMaintenanceTargets() {
+	maintenanceTargets.Lock()
+	defer maintenanceTargets.Unlock()
+
+	maintenanceTargets.generation++
+	maintenanceTargets.devices = make(map[maintenanceTargetKey]cachedMaintenanceTarget)
+}
+
+// resolveMaintenanceTarget looks up the devices in the target of a window
+func resolveMaintenanceTarget(window structs.MaintenanceWindow) ([]int64, error) {
+	switch window.TargetType {
+	case "device":
+		return []int64{window.TargetID}, nil
+	case "group":
+		// Groups are resolved as seen by the creator of the window, saved filters follow the current devices
+		return GetDeviceGroupDeviceIDs(window.CreatedBy, window.TargetID)
+	case "municipality":
+		return repository.GetDeviceIDsByTag(window.TargetID)
+	}
+	return nil, fmt.Errorf("unknown target type %q", window.TargetType)
+}
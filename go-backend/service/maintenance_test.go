@@ -0,0 +1,236 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestIsMaintenanceWindowActive(t *testing.T) {
+	start := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+	until := start.Add(3 * 24 * time.Hour)
+
+	testCases := []struct {
+		name     string
+		window   structs.MaintenanceWindow
+		at       time.Time
+		expected bool
+	}{
+		{"One-off before start", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "none"}, start.Add(-time.Minute), false},
+		{"One-off during window", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "none"}, start.Add(time.Hour), true},
+		{"One-off at end", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "none"}, start.Add(2 * time.Hour), false},
+		{"One-off next day", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "none"}, start.Add(25 * time.Hour), false},
+		{"Daily next day", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "daily"}, start.Add(25 * time.Hour), true},
+		{"Daily between occurrences", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "daily"}, start.Add(30 * time.Hour), false},
+		{"Daily after recurrence ended", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "daily", RecurrenceUntil: &until}, start.Add(97 * time.Hour), false},
+		{"Daily last occurrence", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "daily", RecurrenceUntil: &until}, start.Add(73 * time.Hour), true},
+		{"Weekly next day", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "weekly"}, start.Add(25 * time.Hour), false},
+		{"Weekly next week", structs.MaintenanceWindow{StartsAt: start, EndsAt: start.Add(2 * time.Hour), Recurrence: "weekly"}, start.Add(7*24*time.Hour + time.Hour), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if active := service.IsMaintenanceWindowActive(tc.window, tc.at); active != tc.expected {
+				t.Errorf("Expected active %v, got %v", tc.expected, active)
+			}
+		})
+	}
+}
+
+func TestCreateMaintenanceWindowValidation(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalTag := repository.GetTagByID
+	originalGroup := repository.GetDeviceGroupByID
+	defer func() {
+		repository.GetTagByID = originalTag
+		repository.GetDeviceGroupByID = originalGroup
+	}()
+
+	// Tag 3 is a team tag, there are no groups
+	repository.GetTagByID = func(tagID int64) (*structs.Tag, error) {
+		if tagID != 3 {
+			return nil, nil
+		}
+		return &structs.Tag{ID: 3, Name: "fraude", Type: "team"}, nil
+	}
+	repository.GetDeviceGroupByID = func(groupID int64) (*structs.DeviceGroup, error) {
+		return nil, nil
+	}
+
+	start := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		window structs.MaintenanceWindow
+	}{
+		{"Missing name", structs.MaintenanceWindow{TargetType: "device", TargetID: 1, StartsAt: start, EndsAt: start.Add(time.Hour)}},
+		{"Ends before start", structs.MaintenanceWindow{Name: "Test", TargetType: "device", TargetID: 1, StartsAt: start, EndsAt: start.Add(-time.Hour)}},
+		{"Unknown recurrence", structs.MaintenanceWindow{Name: "Test", TargetType: "device", TargetID: 1, StartsAt: start, EndsAt: start.Add(time.Hour), Recurrence: "monthly"}},
+		{"Longer than period", structs.MaintenanceWindow{Name: "Test", TargetType: "device", TargetID: 1, StartsAt: start, EndsAt: start.Add(25 * time.Hour), Recurrence: "daily"}},
+		{"Unknown target type", structs.MaintenanceWindow{Name: "Test", TargetType: "street", TargetID: 1, StartsAt: start, EndsAt: start.Add(time.Hour)}},
+		{"Unknown municipality", structs.MaintenanceWindow{Name: "Test", TargetType: "municipality", TargetID: 99, StartsAt: start, EndsAt: start.Add(time.Hour)}},
+		{"Tag is no municipality", structs.MaintenanceWindow{Name: "Test", TargetType: "municipality", TargetID: 3, StartsAt: start, EndsAt: start.Add(time.Hour)}},
+		{"Unknown group", structs.MaintenanceWindow{Name: "Test", TargetType: "group", TargetID: 99, StartsAt: start, EndsAt: start.Add(time.Hour)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.CreateMaintenanceWindow(1, tc.window)
+			if !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckInstallWindow(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() { repository.GetMaintenanceWindows = originalWindows }()
+
+	// Device 7 only accepts installs in a window that starts tomorrow
+	start := time.Now().Add(24 * time.Hour)
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return []structs.MaintenanceWindow{{
+			ID: 1, Name: "Install window", TargetType: "device", TargetID: 7,
+			StartsAt: start, EndsAt: start.Add(time.Hour), Recurrence: "none", InstallsOnlyInWindow: true,
+		}}, nil
+	}
+
+	err := service.CheckInstallWindow([]int64{7}, time.Now())
+	if !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest outside the window, got %v", err)
+	}
+	if err := service.CheckInstallWindow([]int64{7}, start.Add(time.Minute)); err != nil {
+		t.Errorf("Expected installs to be allowed inside the window, got %v", err)
+	}
+	if err := service.CheckInstallWindow([]int64{8}, time.Now()); err != nil {
+		t.Errorf("Expected installs on other devices to be allowed, got %v", err)
+	}
+}
+
+func TestMaintenanceTargetsAreCached(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalWindows := repository.GetMaintenanceWindows
+	originalByTag := repository.GetDeviceIDsByTag
+	originalStale := repository.GetStaleDevices
+	originalTransition := repository.RecordDeviceStatusTransition
+	defer func() {
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetDeviceIDsByTag = originalByTag
+		repository.GetStaleDevices = originalStale
+		repository.RecordDeviceStatusTransition = originalTransition
+	}()
+
+	// A running window on a municipality only accepting installs during the window
+	now := time.Now()
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return []structs.MaintenanceWindow{{
+			ID: 4242, Name: "Delft", TargetType: "municipality", TargetID: 50, CreatedBy: 1,
+			StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Recurrence: "none", InstallsOnlyInWindow: true,
+		}}, nil
+	}
+	lookups := 0
+	repository.GetDeviceIDsByTag = func(tagID int64) ([]int64, error) {
+		lookups++
+		return []int64{7}, nil
+	}
+	repository.GetStaleDevices = func(cutoff time.Time) ([]repository.StaleDevice, error) {
+		return []repository.StaleDevice{{ID: 7, Status: "online"}}, nil
+	}
+	repository.RecordDeviceStatusTransition = func(deviceID int64, previousStatus, status string, heartbeatBefore *time.Time, inMaintenance bool, now time.Time, logLevel, logDescription string) (bool, error) {
+		if !inMaintenance {
+			t.Errorf("Expected device 7 to go offline during maintenance")
+		}
+		// A heartbeat arriving after the stale devices were read has to keep the device online
+		if heartbeatBefore == nil || !heartbeatBefore.Equal(now.Add(-service.OfflineThreshold)) {
+			t.Errorf("Expected the transition to require no heartbeat since the cutoff, got %v", heartbeatBefore)
+		}
+		return true, nil
+	}
+
+	// Start without targets cached by earlier runs, a status change drops them
+	if err := service.DetectOfflineDevices(now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The municipality is resolved once for both checks
+	lookups = 0
+	for i := 0; i < 2; i++ {
+		if err := service.CheckInstallWindow([]int64{7}, now); err != nil {
+			t.Fatalf("Expected installs inside the window to be allowed, got %v", err)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("Expected the municipality to be resolved once, got %d lookups", lookups)
+	}
+
+	// A status change may move devices in or out of the target, so it is resolved again afterwards
+	if err := service.DetectOfflineDevices(now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lookups = 0
+	if err := service.CheckInstallWindow([]int64{7}, now); err != nil {
+		t.Fatalf("Expected installs inside the window to be allowed, got %v", err)
+	}
+	if lookups != 1 {
+		t.Errorf("Expected the municipality to be resolved again after a status change, got %d lookups", lookups)
+	}
+}
+
+func TestDeleteMaintenanceWindow(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalGet := repository.GetMaintenanceWindowByID
+	originalDelete := repository.DeleteMaintenanceWindow
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalGetRoles := repository.GetRolesForMeber
+	defer func() {
+		repository.GetMaintenanceWindowByID = originalGet
+		repository.DeleteMaintenanceWindow = originalDelete
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.GetRolesForMeber = originalGetRoles
+	}()
+
+	// Window 1 on device 7 was created by meber 2, every meber has access to the device
+	start := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+	repository.GetMaintenanceWindowByID = func(windowID int64) (*structs.MaintenanceWindow, error) {
+		if windowID != 1 {
+			return nil, nil
+		}
+		return &structs.MaintenanceWindow{ID: 1, TargetType: "device", TargetID: 7, StartsAt: start, EndsAt: start.Add(time.Hour), Recurrence: "none", CreatedBy: 2}, nil
+	}
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return filter.DeviceIDs, nil
+	}
+	// Meber 1 is an admin, the others are operators
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 1 {
+			return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+		}
+		return []structs.Role{{ID: 2, Name: "operator"}}, nil
+	}
+	var deleted []int64
+	repository.DeleteMaintenanceWindow = func(windowID int64) error {
+		deleted = append(deleted, windowID)
+		return nil
+	}
+
+	if err := service.DeleteMaintenanceWindow(3, 1); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected another operator to be denied, got %v", err)
+	}
+	if err := service.DeleteMaintenanceWindow(2, 1); err != nil {
+		t.Errorf("Expected the creator to remove the window, got %v", err)
+	}
+	if err := service.DeleteMaintenanceWindow(1, 1); err != nil {
+		t.Errorf("Expected an admin to remove the window, got %v", err)
+	}
+	if err := service.DeleteMaintenanceWindow(1, 9); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected an unknown window to be not found, got %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("Expected the window to be deleted twice, got %v", deleted)
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// MaxMapZoom is the highest zoom level of the map
+	MaxMapZoom = 22
+	// ClusterMaxZoom is the zoom level from which devices are no longer clustered
+	ClusterMaxZoom = 16
+
+	// clusterCellPixels is the size of a grid cell on screen, a 256 pixel tile holds 2^zoom cells around the world
+	clusterCellPixels = 60
+	tilePixels        = 256
+)
+
+// GetMapView returns the accessible devices within the bounding box, clustered on a grid that fits the zoom
+// level. Without bounds the whole map is used, with a group only its members are included.
+func GetMapView(meberID int64, groupID *int64, bounds *structs.BoundingBox, zoom int) (structs.MapView, error) {
+	if zoom < 0 || zoom > MaxMapZoom {
+		return structs.MapView{}, fmt.Errorf("%w: zoom must be between 0 and %d", ErrInvalidRequest, MaxMapZoom)
+	}
+
+	devices, err := getMapDevices(meberID, groupID, bounds)
+	if err != nil {
+		return structs.MapView{}, err
+	}
+
+	view := structs.MapView{Zoom: zoom, Clusters: []structs.MapCluster{}, Devices: []structs.EdgeDeviceMapResponse{}}
+	if zoom >= ClusterMaxZoom {
+		view.Devices = append(view.Devices, devices...)
+		return view, nil
+	}
+	view.Clusters, view.Devices = clusterDevices(devices, zoom)
+	return view, nil
+}
+
+// getMapDevices retrieves the accessible devices within the optional bounds, only the members of the group when
+// one is given
+func getMapDevices(meberID int64, groupID *int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+	if bounds != nil {
+		if err := validateBoundingBox(*bounds); err != nil {
+			return nil, err
+		}
+	}
+
+	devices, err := getDevicesForMap(meberID, bounds)
+	if err != nil {
+		return nil, err
+	}
+	if groupID == nil {
+		return devices, nil
+	}
+
+	memberIDs, err := GetDeviceGroupDeviceIDs(meberID, *groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := idSet(memberIDs)
+	filtered := []structs.EdgeDeviceMapResponse{}
+	for _, device := range devices {
+		if members[device.ID] {
+			filtered = append(filtered, device)
+		}
+	}
+	return filtered, nil
+}
+
+// validateBoundingBox checks that the bounds lie on the globe and are not inverted. Boxes crossing the
+// antimeridian are not supported, the devices are all in the Netherlands.
+func validateBoundingBox(bounds structs.BoundingBox) error {
+	if bounds.MinLongitude < -180 || bounds.MaxLongitude > 180 || bounds.MinLatitude < -90 || bounds.MaxLatitude > 90 {
+		return fmt.Errorf("%w: bounding box must lie within longitude -180 to 180 and latitude -90 to 90", ErrInvalidRequest)
+	}
+	if bounds.MinLongitude > bounds.MaxLongitude || bounds.MinLatitude > bounds.MaxLatitude {
+		return fmt.Errorf("%w: bounding box minimum must not exceed its maximum", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// getDevicesForMap retrieves the accessible devices within the bounds, showing devices in an active maintenance
+// window as in maintenance
+func getDevicesForMap(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+	devices, err := repository.GetAllDevicesForMap(meberID, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := getMaintenanceState(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		if state.inMaintenance[devices[i].ID] {
+			devices[i].Status = MaintenanceStatus
+		}
+	}
+	return devices, nil
+}
+
+// clusterDevices puts the devices on a grid of clusterCellPixels cells at the zoom level. Cells holding more than
+// one device become a cluster, a device alone in its cell is returned as is. The grid is anchored to the globe
+// rather than the bounding box, so clusters don't jump around while panning.
+func clusterDevices(devices []structs.EdgeDeviceMapResponse, zoom int) ([]structs.MapCluster, []structs.EdgeDeviceMapResponse) {
+	cellDegrees := 360 / math.Exp2(float64(zoom)) * clusterCellPixels / tilePixels
+
+	type cell struct{ x, y int64 }
+	cells := make(map[cell][]structs.EdgeDeviceMapResponse)
+	var order []cell
+	for _, device := range devices {
+		key := cell{
+			x: int64(math.Floor((device.Longitude + 180) / cellDegrees)),
+			y: int64(math.Floor((device.Latitude + 90) / cellDegrees)),
+		}
+		if _, seen := cells[key]; !seen {
+			order = append(order, key)
+		}
+		cells[key] = append(cells[key], device)
+	}
+
+	clusters := []structs.MapCluster{}
+	singles := []structs.EdgeDeviceMapResponse{}
+	for _, key := range order {
+		members := cells[key]
+		if len(members) == 1 {
+			singles = append(singles, members[0])
+			continue
+		}
+
+		cluster := structs.MapCluster{
+			Count:        len(members),
+			StatusCounts: make(map[string]int),
+			Bounds: structs.BoundingBox{
+				MinLongitude: members[0].Longitude, MaxLongitude: members[0].Longitude,
+				MinLatitude: members[0].Latitude, MaxLatitude: members[0].Latitude,
+			},
+		}
+		for _, member := range members {
+			cluster.Latitude += member.Latitude
+			cluster.Longitude += member.Longitude
+			cluster.StatusCounts[member.Status]++
+			cluster.Bounds.MinLongitude = math.Min(cluster.Bounds.MinLongitude, member.Longitude)
+			cluster.Bounds.MaxLongitude = math.Max(cluster.Bounds.MaxLongitude, member.Longitude)
+			cluster.Bounds.MinLatitude = math.Min(cluster.Bounds.MinLatitude, member.Latitude)
+			cluster.Bounds.MaxLatitude = math.Max(cluster.Bounds.MaxLatitude, member.Latitude)
+		}
+		cluster.Latitude /= float64(len(members))
+		cluster.Longitude /= float64(len(members))
+		clusters = append(clusters, cluster)
+	}
+
+	// Largest clusters first so clients can draw them below the smaller ones
+	sort.SliceStable(clusters, func(i, j int) bool { return clusters[i].Count > clusters[j].Count })
+	return clusters, singles
+}
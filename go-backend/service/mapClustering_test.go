@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+)
+
+func TestGetMapView(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+	}()
+
+	// Three devices in the centre of Utrecht, one in Amersfoort about 20 km away
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 1, Status: "online", Coordinate: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}},
+			{ID: 2, Status: "offline", Coordinate: structs.Coordinate{Latitude: 52.0910, Longitude: 5.1220}},
+			{ID: 3, Status: "online", Coordinate: structs.Coordinate{Latitude: 52.0912, Longitude: 5.1230}},
+			{ID: 4, Status: "online", Coordinate: structs.Coordinate{Latitude: 52.1561, Longitude: 5.3878}},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+
+	view, err := service.GetMapView(5, nil, nil, 9)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(view.Clusters) != 1 || len(view.Devices) != 1 || view.Devices[0].ID != 4 {
+		t.Fatalf("Expected a cluster for Utrecht and Amersfoort on its own, got %+v", view)
+	}
+	cluster := view.Clusters[0]
+	if cluster.Count != 3 || cluster.StatusCounts["online"] != 2 || cluster.StatusCounts["offline"] != 1 {
+		t.Errorf("Expected 2 online and 1 offline device in the cluster, got %+v", cluster)
+	}
+	if cluster.Bounds.MinLongitude != 5.1214 || cluster.Bounds.MaxLatitude != 52.0912 {
+		t.Errorf("Expected the cluster bounds to span its devices, got %+v", cluster.Bounds)
+	}
+
+	// All devices fall in a single cell when zoomed out far enough
+	if view, _ := service.GetMapView(5, nil, nil, 3); len(view.Clusters) != 1 || view.Clusters[0].Count != 4 {
+		t.Errorf("Expected a single cluster at zoom 3, got %+v", view)
+	}
+	// No clustering at street level
+	if view, _ := service.GetMapView(5, nil, nil, service.ClusterMaxZoom); len(view.Clusters) != 0 || len(view.Devices) != 4 {
+		t.Errorf("Expected individual devices at zoom %d, got %+v", service.ClusterMaxZoom, view)
+	}
+
+	inverted := structs.BoundingBox{MinLongitude: 6, MinLatitude: 52, MaxLongitude: 5, MaxLatitude: 53}
+	if _, err := service.GetMapView(5, nil, &inverted, 9); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for an inverted bounding box, got %v", err)
+	}
+	if _, err := service.GetMapView(5, nil, nil, 30); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for zoom 30, got %v", err)
+	}
+}
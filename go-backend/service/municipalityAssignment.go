@@ -0,0 +1,55 @@
+package service
+
+import (
+	"main/geo"
+	"main/repository"
+	"main/structs"
+)
+
+// municipalityTagAt returns the location tag of the municipality the coordinate lies in, nil when the coordinate
+// lies outside the known municipalities or the municipality has no location tag
+func municipalityTagAt(coordinate structs.Coordinate) (*structs.Tag, error) {
+	municipality, found, err := geo.MunicipalityAt(coordinate)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	tags, err := repository.GetTagsByNames([]string{municipality.Name})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if tag.Type == "location" {
+			return &tag, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetLocationMismatches lists the accessible devices whose location tag differs from the municipality their
+// coordinates lie in, including devices outside every municipality that still have a location tag
+func GetLocationMismatches(meberID int64) ([]structs.LocationMismatch, error) {
+	devices, err := getDevicesForMap(meberID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := []structs.LocationMismatch{}
+	for _, device := range devices {
+		expected, _, err := geo.MunicipalityAt(device.Coordinate)
+		if err != nil {
+			return nil, err
+		}
+		if expected.Name == device.Municipality {
+			continue
+		}
+		mismatches = append(mismatches, structs.LocationMismatch{
+			DeviceID:             device.ID,
+			Name:                 device.Name,
+			Coordinate:           device.Coordinate,
+			Municipality:         device.Municipality,
+			ExpectedMunicipality: expected.Name,
+		})
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestRegisterDeviceAssignsMunicipality(t *testing.T) {
+	mockProvisioningCatalog(t)
+
+	originalRegister := repository.RegisterDevice
+	originalTags := repository.GetTagsByNames
+	catalogTag := repository.GetTagByID
+	defer func() {
+		repository.RegisterDevice = originalRegister
+		repository.GetTagsByNames = originalTags
+	}()
+	var registered structs.DeviceRegistrationRequest
+	repository.RegisterDevice = func(request structs.DeviceRegistrationRequest, meberID int64, now time.Time) (structs.DeviceRegistration, error) {
+		registered = request
+		return structs.DeviceRegistration{DeviceID: 43}, nil
+	}
+	repository.GetTagsByNames = func(names []string) ([]structs.Tag, error) {
+		if len(names) == 1 && names[0] == "Delft" {
+			return []structs.Tag{{ID: 4, Name: "Delft", Type: "location"}}, nil
+		}
+		return nil, nil
+	}
+	repository.GetTagByID = func(tagID int64) (*structs.Tag, error) {
+		if tagID == 4 {
+			return &structs.Tag{ID: 4, Name: "Delft", Type: "location"}, nil
+		}
+		return catalogTag(tagID)
+	}
+
+	// Coordinates in the centre of Delft
+	latitude, longitude := 52.01, 4.36
+	if _, err := service.RegisterDevice(1, structs.DeviceRegistrationRequest{
+		Name: "MSR_delft", ConnectionType: "wired", Latitude: &latitude, Longitude: &longitude,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if registered.MunicipalityID == nil || *registered.MunicipalityID != 4 {
+		t.Errorf("Expected the device to be assigned Delft, got %v", registered.MunicipalityID)
+	}
+
+	nijmegen := int64(3)
+	_, err := service.RegisterDevice(1, structs.DeviceRegistrationRequest{
+		Name: "MSR_delft", ConnectionType: "wired", Latitude: &latitude, Longitude: &longitude, MunicipalityID: &nijmegen,
+	})
+	if !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for a municipality other than the one at the coordinates, got %v", err)
+	}
+}
+
+func TestGetLocationMismatches(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+	}()
+
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 1, Name: "MSR_1", Municipality: "Delft", Coordinate: structs.Coordinate{Latitude: 52.01, Longitude: 4.36}},
+			{ID: 2, Name: "MSR_2", Municipality: "Delft", Coordinate: structs.Coordinate{Latitude: 51.92, Longitude: 4.47}},
+			{ID: 3, Name: "MSR_3", Coordinate: structs.Coordinate{Latitude: 52.01, Longitude: 4.36}},
+			{ID: 4, Name: "MSR_4", Coordinate: structs.Coordinate{Latitude: 51.98, Longitude: 5.91}},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+
+	mismatches, err := service.GetLocationMismatches(5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Device 2 lies in Rotterdam and device 3 has no tag, device 4 lies outside every municipality without a tag
+	if len(mismatches) != 2 || mismatches[0].DeviceID != 2 || mismatches[0].ExpectedMunicipality != "Rotterdam" ||
+		mismatches[1].DeviceID != 3 || mismatches[1].ExpectedMunicipality != "Delft" {
+		t.Errorf("Expected devices 2 and 3 to be listed, got %+v", mismatches)
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"main/geo"
+	"main/repository"
+	"main/structs"
+	"sort"
+)
+
+// incidentStatuses are the device statuses that count towards the error rate of a municipality
+var incidentStatuses = map[string]bool{"offline": true, "error": true, "app_issue": true}
+
+// GetMunicipalityStatus aggregates the device and application statuses of the accessible devices per
+// municipality. Devices in an active maintenance window count as in maintenance rather than as an incident.
+func GetMunicipalityStatus(meberID int64) ([]structs.MunicipalityStatus, error) {
+	devices, err := getDevicesForMap(meberID, nil)
+	if err != nil {
+		return nil, err
+	}
+	deviceIDs := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	applicationCounts, err := repository.GetApplicationStatusCounts(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	lastIncidents, err := repository.GetLastIncidents(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	municipalities, err := geo.Municipalities()
+	if err != nil {
+		return nil, err
+	}
+	codes := make(map[string]string, len(municipalities))
+	for _, municipality := range municipalities {
+		codes[municipality.Name] = municipality.Code
+	}
+
+	byMunicipality := make(map[string]*structs.MunicipalityStatus)
+	incidents := make(map[string]int)
+	for _, device := range devices {
+		aggregate := byMunicipality[device.Municipality]
+		if aggregate == nil {
+			aggregate = &structs.MunicipalityStatus{
+				Municipality:            device.Municipality,
+				Code:                    codes[device.Municipality],
+				DeviceStatusCounts:      make(map[string]int),
+				ApplicationStatusCounts: make(map[string]int),
+			}
+			byMunicipality[device.Municipality] = aggregate
+		}
+
+		aggregate.Devices++
+		aggregate.DeviceStatusCounts[device.Status]++
+		if incidentStatuses[device.Status] {
+			incidents[device.Municipality]++
+		}
+		for status, count := range applicationCounts[device.ID] {
+			aggregate.Applications += count
+			aggregate.ApplicationStatusCounts[status] += count
+		}
+		if incident, ok := lastIncidents[device.ID]; ok && (aggregate.LastIncidentAt == nil || incident.After(*aggregate.LastIncidentAt)) {
+			aggregate.LastIncidentAt = &incident
+		}
+	}
+
+	statuses := make([]structs.MunicipalityStatus, 0, len(byMunicipality))
+	for municipality, aggregate := range byMunicipality {
+		aggregate.ErrorRate = float64(incidents[municipality]) / float64(aggregate.Devices)
+		statuses = append(statuses, *aggregate)
+	}
+	// Devices without a municipality go last
+	sort.Slice(statuses, func(i, j int) bool {
+		a, b := statuses[i].Municipality, statuses[j].Municipality
+		if a == "" || b == "" {
+			return b == ""
+		}
+		return a < b
+	})
+	return statuses, nil
+}
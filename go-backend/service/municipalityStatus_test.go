@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetMunicipalityStatus(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	originalApplications := repository.GetApplicationStatusCounts
+	originalIncidents := repository.GetLastIncidents
+	defer func() {
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetApplicationStatusCounts = originalApplications
+		repository.GetLastIncidents = originalIncidents
+	}()
+
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 1, Status: "online", Municipality: "Delft"},
+			{ID: 2, Status: "error", Municipality: "Delft"},
+			{ID: 3, Status: "offline", Municipality: "Delft"},
+			{ID: 4, Status: "online", Municipality: "Delft"},
+			{ID: 5, Status: "app_issue"},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+	repository.GetApplicationStatusCounts = func(deviceIDs []int64) (map[int64]map[string]int, error) {
+		return map[int64]map[string]int{1: {"online": 2}, 2: {"online": 1, "error": 1}, 5: {"warning": 1}}, nil
+	}
+	earlier, later := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC)
+	repository.GetLastIncidents = func(deviceIDs []int64) (map[int64]time.Time, error) {
+		return map[int64]time.Time{2: earlier, 3: later}, nil
+	}
+
+	statuses, err := service.GetMunicipalityStatus(7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(statuses) != 2 || statuses[0].Municipality != "Delft" || statuses[1].Municipality != "" {
+		t.Fatalf("Expected Delft followed by the devices without a municipality, got %+v", statuses)
+	}
+
+	delft := statuses[0]
+	if delft.Code != "0503" || delft.Devices != 4 || delft.DeviceStatusCounts["online"] != 2 || delft.ErrorRate != 0.5 {
+		t.Errorf("Expected code 0503, 4 devices of which 2 online and an error rate of 0.5, got %+v", delft)
+	}
+	if delft.Applications != 4 || delft.ApplicationStatusCounts["online"] != 3 || delft.ApplicationStatusCounts["error"] != 1 {
+		t.Errorf("Expected 3 online and 1 failing application, got %+v", delft.ApplicationStatusCounts)
+	}
+	if delft.LastIncidentAt == nil || !delft.LastIncidentAt.Equal(later) {
+		t.Errorf("Expected the last incident at %v, got %v", later, delft.LastIncidentAt)
+	}
+	if statuses[1].ErrorRate != 1 || statuses[1].LastIncidentAt != nil {
+		t.Errorf("Expected every device without a municipality to count as an incident, got %+v", statuses[1])
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"main/repository"
+	"main/structs"
+	"time"
+)
+
+const (
+	// DefaultMetricPoints is the number of buckets returned when the caller does not ask for a specific amount
+	DefaultMetricPoints = 500
+	// MaxMetricPoints caps the number of buckets so a single request can't pull the raw history
+	MaxMetricPoints = 2000
+	// MaxMetricSamples caps the number of samples a device reports per request, like the bulk chunks
+	MaxMetricSamples = 250
+	// MetricRawRetention is how long raw samples are kept before they are rolled into hourly aggregates
+	MetricRawRetention = 7 * 24 * time.Hour
+	// MetricAggregateRetention is how long the hourly aggregates are kept
+	MetricAggregateRetention = 2 * 365 * 24 * time.Hour
+
+	maxMetricValue     = 999.99 // performance_metric is a DECIMAL(5, 2)
+	maxMetricClockSkew = 5 * time.Minute
+)
+
+// RecordPerformanceMetrics validates and stores samples reported by a device
+func RecordPerformanceMetrics(deviceID int64, samples []structs.PerformanceMetricSample) error {
+	if len(samples) == 0 {
+		return fmt.Errorf("%w: no samples provided", ErrInvalidRequest)
+	}
+	if len(samples) > MaxMetricSamples {
+		return fmt.Errorf("%w: at most %d samples per request", ErrInvalidRequest, MaxMetricSamples)
+	}
+
+	now := time.Now()
+	for i := range samples {
+		// Devices that don't keep time themselves can leave the timestamp out
+		if samples[i].Timestamp.IsZero() {
+			samples[i].Timestamp = now
+		}
+		if samples[i].Timestamp.After(now.Add(maxMetricClockSkew)) {
+			return fmt.Errorf("%w: sample timestamp %s is in the future", ErrInvalidRequest, samples[i].Timestamp.Format(time.RFC3339))
+		}
+		if samples[i].Value < 0 || samples[i].Value > maxMetricValue {
+			return fmt.Errorf("%w: sample value %.2f out of range", ErrInvalidRequest, samples[i].Value)
+		}
+	}
+
+	return repository.InsertPerformanceMetricSamples(deviceID, samples)
+}
+
+// GetPerformanceMetrics returns the downsampled metric history of a device the meber has access to
+func GetPerformanceMetrics(meberID, deviceID int64, start, end time.Time, points int64) (structs.PerformanceMetricSeries, error) {
+	if !end.After(start) {
+		return structs.PerformanceMetricSeries{}, fmt.Errorf("%w: end must be after start", ErrInvalidRequest)
+	}
+	if points <= 0 {
+		points = DefaultMetricPoints
+	}
+	if points > MaxMetricPoints {
+		points = MaxMetricPoints
+	}
+
+	hasAccess, err := repository.MeberHasDeviceAccess(meberID, deviceID)
+	if err != nil {
+		return structs.PerformanceMetricSeries{}, err
+	}
+	if !hasAccess {
+		return structs.PerformanceMetricSeries{}, fmt.Errorf("%w: no access to device %d", ErrAccessDenied, deviceID)
+	}
+
+	// Round the bucket width up so the range never produces more than the requested amount of points
+	rangeSeconds := int64(end.Sub(start).Seconds())
+	bucketSeconds := (rangeSeconds + points - 1) / points
+	if bucketSeconds < 1 {
+		bucketSeconds = 1
+	}
+
+	buckets, err := repository.FetchPerformanceMetricBuckets(deviceID, start, end, bucketSeconds)
+	if err != nil {
+		return structs.PerformanceMetricSeries{}, fmt.Errorf("error fetching performance metrics: %w", err)
+	}
+	if buckets == nil {
+		buckets = []structs.PerformanceMetricBucket{}
+	}
+
+	return structs.PerformanceMetricSeries{
+		DeviceID:      deviceID,
+		Start:         start,
+		End:           end,
+		BucketSeconds: bucketSeconds,
+		Buckets:       buckets,
+	}, nil
+}
+
+// ApplyMetricRetention rolls old raw samples into aggregates and drops aggregates and connectivity samples past
+// their retention
+func ApplyMetricRetention(now time.Time) error {
+	// Align the cutoff to the hour so a single run never splits an hour over two aggregates
+	rawCutoff := now.Add(-MetricRawRetention).Truncate(time.Hour)
+	rolledUp, err := repository.RollupPerformanceMetricSamples(rawCutoff)
+	if err != nil {
+		return err
+	}
+
+	removed, err := repository.DeletePerformanceMetricAggregates(now.Add(-MetricAggregateRetention))
+	if err != nil {
+		return err
+	}
+
+	connectivityRemoved, err := repository.DeleteConnectivitySamples(now.Add(-ConnectivityRetention))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Metric retention: rolled up %d samples, removed %d aggregates and %d connectivity samples", rolledUp, removed, connectivityRemoved)
+	return nil
+}
+
+// RunMetricRetention applies the metric retention rules on every tick of the given interval
+func RunMetricRetention(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := ApplyMetricRetention(time.Now()); err != nil {
+			log.Printf("Error applying metric retention: %v", err)
+		}
+	}
+}
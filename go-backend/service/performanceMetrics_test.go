@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetPerformanceMetrics(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalAccess := repository.MeberHasDeviceAccess
+	originalFetch := repository.FetchPerformanceMetricBuckets
+	defer func() {
+		repository.MeberHasDeviceAccess = originalAccess
+		repository.FetchPerformanceMetricBuckets = originalFetch
+	}()
+
+	repository.MeberHasDeviceAccess = func(meberID int64, deviceID int64) (bool, error) {
+		return meberID == 1, nil
+	}
+
+	var requestedBucketSeconds int64
+	repository.FetchPerformanceMetricBuckets = func(deviceID int64, start, end time.Time, bucketSeconds int64) ([]structs.PerformanceMetricBucket, error) {
+		requestedBucketSeconds = bucketSeconds
+		return []structs.PerformanceMetricBucket{
+			{BucketStart: start, Min: 10, Max: 30, Avg: 20, Count: 12},
+		}, nil
+	}
+
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	start := end.Add(-365 * 24 * time.Hour)
+
+	// A year at 500 points should be downsampled into buckets of a bit over 17.5 hours
+	series, err := service.GetPerformanceMetrics(1, 42, start, end, 500)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requestedBucketSeconds != 63072 {
+		t.Errorf("Expected bucket size 63072 seconds, got %d", requestedBucketSeconds)
+	}
+	if series.BucketSeconds != requestedBucketSeconds {
+		t.Errorf("Expected series bucket size %d, got %d", requestedBucketSeconds, series.BucketSeconds)
+	}
+	if len(series.Buckets) != 1 || series.Buckets[0].Avg != 20 {
+		t.Errorf("Expected the repository buckets to be returned, got %+v", series.Buckets)
+	}
+
+	// Points above the maximum are capped
+	_, err = service.GetPerformanceMetrics(1, 42, end.Add(-time.Hour), end, 1000000)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requestedBucketSeconds != 2 {
+		t.Errorf("Expected bucket size 2 seconds when points are capped, got %d", requestedBucketSeconds)
+	}
+
+	// Meber without access to the device
+	_, err = service.GetPerformanceMetrics(2, 42, start, end, 500)
+	if !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected access denied error, got %v", err)
+	}
+
+	// Inverted range
+	_, err = service.GetPerformanceMetrics(1, 42, end, start, 500)
+	if !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected invalid request error, got %v", err)
+	}
+}
+
+func TestRecordPerformanceMetricsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []structs.PerformanceMetricSample
+	}{
+		{"No Samples", nil},
+		{"Negative Value", []structs.PerformanceMetricSample{{Timestamp: time.Now(), Value: -1}}},
+		{"Value Too Large", []structs.PerformanceMetricSample{{Timestamp: time.Now(), Value: 1000}}},
+		{"Future Timestamp", []structs.PerformanceMetricSample{{Timestamp: time.Now().Add(time.Hour), Value: 50}}},
+		{"Too Many Samples", make([]structs.PerformanceMetricSample, service.MaxMetricSamples+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.RecordPerformanceMetrics(1, tt.samples)
+			if !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("Expected invalid request error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"strings"
+	"time"
+)
+
+// quarantineCommandTypes are the only commands a quarantined device still receives, for investigating it
+var quarantineCommandTypes = []string{"collect_diagnostics"}
+
+const maxQuarantineReasonLength = 255
+
+// QuarantineDevice isolates a device the meber has access to: installs and commands other than diagnostics are
+// blocked and its credentials are revoked
+func QuarantineDevice(meberID int64, request structs.QuarantineRequest) error {
+	reason, err := validateQuarantineReason(request.Reason)
+	if err != nil {
+		return err
+	}
+	if err := checkDeviceAccess(meberID, []int64{request.DeviceID}); err != nil {
+		return err
+	}
+
+	quarantined, err := repository.QuarantineDevice(request.DeviceID, meberID, reason, quarantineCommandTypes, time.Now())
+	if err != nil {
+		return err
+	}
+	if !quarantined {
+		return fmt.Errorf("%w: device %d is already quarantined", ErrInvalidRequest, request.DeviceID)
+	}
+	invalidateDeviceTiles(request.DeviceID)
+	return nil
+}
+
+// ReleaseDevice lifts the quarantine of a device, only admins can do this and they have to give a reason
+func ReleaseDevice(meberID int64, request structs.QuarantineRequest) error {
+	reason, err := validateQuarantineReason(request.Reason)
+	if err != nil {
+		return err
+	}
+	if err := requireAdmin(meberID); err != nil {
+		return err
+	}
+
+	released, err := repository.ReleaseDeviceFromQuarantine(request.DeviceID, meberID, reason)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return fmt.Errorf("%w: device %d is not quarantined", ErrInvalidRequest, request.DeviceID)
+	}
+	invalidateDeviceTiles(request.DeviceID)
+	return nil
+}
+
+// GetQuarantinedDevices lists the quarantined devices the meber has access to
+func GetQuarantinedDevices(meberID int64) ([]structs.QuarantinedDevice, error) {
+	accessible, err := repository.GetDeviceIDsByFilter(meberID, structs.DeviceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := repository.GetQuarantinedDevices(accessible)
+	if err != nil {
+		return nil, err
+	}
+	if devices == nil {
+		devices = []structs.QuarantinedDevice{}
+	}
+	return devices, nil
+}
+
+// CheckQuarantine verifies that none of the devices is quarantined
+func CheckQuarantine(deviceIDs []int64) error {
+	quarantined, err := repository.GetQuarantinedDeviceIDs(deviceIDs)
+	if err != nil {
+		return err
+	}
+	if len(quarantined) > 0 {
+		return fmt.Errorf("%w: device %d is quarantined", ErrAccessDenied, quarantined[0])
+	}
+	return nil
+}
+
+// checkQuarantineCommand verifies that a command type may be sent to the devices, quarantined devices only
+// accept diagnostics
+func checkQuarantineCommand(commandType string, deviceIDs []int64) error {
+	for _, allowed := range quarantineCommandTypes {
+		if commandType == allowed {
+			return nil
+		}
+	}
+	if err := CheckQuarantine(deviceIDs); err != nil {
+		return fmt.Errorf("%w, only %s is allowed", err, strings.Join(quarantineCommandTypes, ", "))
+	}
+	return nil
+}
+
+// validateQuarantineReason trims the reason and checks that it is present and fits the audit trail
+func validateQuarantineReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", fmt.Errorf("%w: a reason is required", ErrInvalidRequest)
+	}
+	if len(reason) > maxQuarantineReasonLength {
+		return "", fmt.Errorf("%w: reason can be at most %d characters", ErrInvalidRequest, maxQuarantineReasonLength)
+	}
+	return reason, nil
+}
@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestQuarantineDevice(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalQuarantine := repository.QuarantineDevice
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.QuarantineDevice = originalQuarantine
+	}()
+
+	// Meber has access to device 1 only
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		if len(filter.DeviceIDs) == 1 && filter.DeviceIDs[0] == 1 {
+			return []int64{1}, nil
+		}
+		return nil, nil
+	}
+
+	var storedReason string
+	var allowedCommands []string
+	repository.QuarantineDevice = func(deviceID, meberID int64, reason string, allowed []string, now time.Time) (bool, error) {
+		storedReason = reason
+		allowedCommands = allowed
+		return storedReason != "already quarantined", nil
+	}
+
+	if err := service.QuarantineDevice(5, structs.QuarantineRequest{DeviceID: 1, Reason: "  unexpected outbound traffic "}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if storedReason != "unexpected outbound traffic" {
+		t.Errorf("Expected the trimmed reason to be stored, got %q", storedReason)
+	}
+	if len(allowedCommands) != 1 || allowedCommands[0] != "collect_diagnostics" {
+		t.Errorf("Expected only diagnostics to survive the quarantine, got %v", allowedCommands)
+	}
+
+	tests := []struct {
+		name    string
+		request structs.QuarantineRequest
+		wantErr error
+	}{
+		{"Reason Required", structs.QuarantineRequest{DeviceID: 1, Reason: " "}, service.ErrInvalidRequest},
+		{"No Access", structs.QuarantineRequest{DeviceID: 2, Reason: "suspicious"}, service.ErrAccessDenied},
+		{"Already Quarantined", structs.QuarantineRequest{DeviceID: 1, Reason: "already quarantined"}, service.ErrInvalidRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := service.QuarantineDevice(5, tc.request); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReleaseDevice(t *testing.T) {
+	originalGetRoles := repository.GetRolesForMeber
+	originalRelease := repository.ReleaseDeviceFromQuarantine
+	defer func() {
+		repository.GetRolesForMeber = originalGetRoles
+		repository.ReleaseDeviceFromQuarantine = originalRelease
+	}()
+
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 1 {
+			return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+		}
+		return []structs.Role{{ID: 2, Name: "operator", IsRestricted: true}}, nil
+	}
+	released := 0
+	repository.ReleaseDeviceFromQuarantine = func(deviceID, meberID int64, reason string) (bool, error) {
+		released++
+		return true, nil
+	}
+
+	if err := service.ReleaseDevice(5, structs.QuarantineRequest{DeviceID: 3, Reason: "reimaged"}); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied for a non-admin, got %v", err)
+	}
+	if err := service.ReleaseDevice(1, structs.QuarantineRequest{DeviceID: 3}); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest without a reason, got %v", err)
+	}
+	if err := service.ReleaseDevice(1, structs.QuarantineRequest{DeviceID: 3, Reason: "reimaged"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if released != 1 {
+		t.Errorf("Expected exactly one release, got %d", released)
+	}
+}
+
+func TestAuthenticateDeviceTokenAfterRevocation(t *testing.T) {
+	originalRevokedAt := repository.GetDeviceCredentialsRevokedAt
+	originalDecommissioned := repository.GetDeviceDecommissionedAt
+	defer func() {
+		repository.GetDeviceCredentialsRevokedAt = originalRevokedAt
+		repository.GetDeviceDecommissionedAt = originalDecommissioned
+	}()
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) { return true, nil, nil }
+
+	token, err := service.GenerateDeviceToken(8)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	repository.GetDeviceCredentialsRevokedAt = func(deviceID int64) (*time.Time, error) { return nil, nil }
+	if deviceID, err := service.AuthenticateDeviceToken(token); err != nil || deviceID != 8 {
+		t.Errorf("Expected device 8, got %d (%v)", deviceID, err)
+	}
+
+	// Credentials revoked after the token was issued
+	revokedAt := time.Now().Add(time.Minute)
+	repository.GetDeviceCredentialsRevokedAt = func(deviceID int64) (*time.Time, error) { return &revokedAt, nil }
+	if _, err := service.AuthenticateDeviceToken(token); err == nil {
+		t.Error("Expected a token issued before the revocation to be rejected")
+	}
+
+	// A token issued after the revocation is accepted again
+	revokedAt = time.Now().Add(-time.Hour)
+	if _, err := service.AuthenticateDeviceToken(token); err != nil {
+		t.Errorf("Expected a token issued after the revocation to be accepted, got %v", err)
+	}
+
+	// Unless the device was decommissioned, a replaced unit can't come back online with a new token
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) { return true, &revokedAt, nil }
+	if _, err := service.AuthenticateDeviceToken(token); err == nil {
+		t.Error("Expected the token of a decommissioned device to be rejected")
+	}
+}
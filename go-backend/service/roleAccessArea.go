@@ -0,0 +1,111 @@
+package service
+
+import (
+	"fmt"
+	"main/geo"
+	"main/repository"
+	"main/structs"
+)
+
+// GetRoleAccessArea returns the access area of a role as a GeoJSON MultiPolygon feature. Admins can see the area
+// of every role, other mebers only of the roles they hold.
+func GetRoleAccessArea(meberID, roleID int64) (structs.Feature, error) {
+	if err := requireAdmin(meberID); err != nil {
+		roles, rolesErr := repository.GetRolesForMeber(meberID)
+		if rolesErr != nil {
+			return structs.Feature{}, rolesErr
+		}
+		holdsRole := false
+		for _, role := range roles {
+			if role.ID == roleID {
+				holdsRole = true
+				break
+			}
+		}
+		if !holdsRole {
+			return structs.Feature{}, err
+		}
+	}
+
+	role, err := getRole(roleID)
+	if err != nil {
+		return structs.Feature{}, err
+	}
+	if !role.HasAccessArea {
+		return structs.Feature{}, fmt.Errorf("%w: role %d has no access area", ErrNotFound, roleID)
+	}
+	stored, err := repository.GetRoleAccessArea(roleID)
+	if err != nil {
+		return structs.Feature{}, err
+	}
+	area, err := geo.ParseArea([]byte(stored))
+	if err != nil {
+		return structs.Feature{}, fmt.Errorf("error parsing the stored access area of role %d: %w", roleID, err)
+	}
+	return roleAccessAreaFeature(*role, area), nil
+}
+
+// SetRoleAccessArea replaces the access area of a role with a GeoJSON Polygon or MultiPolygon, bare or as a
+// Feature. Restricted mebers holding the role can access the devices inside it on top of their tags.
+func SetRoleAccessArea(meberID, roleID int64, geoJSON []byte) (structs.Feature, error) {
+	if err := requireAdmin(meberID); err != nil {
+		return structs.Feature{}, err
+	}
+	role, err := getRole(roleID)
+	if err != nil {
+		return structs.Feature{}, err
+	}
+	area, err := geo.ParseArea(geoJSON)
+	if err != nil {
+		return structs.Feature{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	if err := repository.SetRoleAccessArea(roleID, meberID, area); err != nil {
+		return structs.Feature{}, err
+	}
+	// Which devices the holders of the role see has changed
+	invalidateAllTiles()
+
+	role.HasAccessArea = true
+	return roleAccessAreaFeature(*role, area), nil
+}
+
+// DeleteRoleAccessArea removes the access area of a role, leaving only its tags to grant access
+func DeleteRoleAccessArea(meberID, roleID int64) error {
+	if err := requireAdmin(meberID); err != nil {
+		return err
+	}
+	role, err := getRole(roleID)
+	if err != nil {
+		return err
+	}
+	if !role.HasAccessArea {
+		return fmt.Errorf("%w: role %d has no access area", ErrNotFound, roleID)
+	}
+
+	if err := repository.SetRoleAccessArea(roleID, meberID, nil); err != nil {
+		return err
+	}
+	invalidateAllTiles()
+	return nil
+}
+
+func getRole(roleID int64) (*structs.Role, error) {
+	role, err := repository.GetRoleByID(roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, fmt.Errorf("%w: role %d", ErrNotFound, roleID)
+	}
+	return role, nil
+}
+
+func roleAccessAreaFeature(role structs.Role, area geo.MultiPolygon) structs.Feature {
+	return structs.Feature{
+		Type:       "Feature",
+		ID:         role.ID,
+		Geometry:   structs.Geometry{Type: "MultiPolygon", Coordinates: area},
+		Properties: map[string]interface{}{"role_id": role.ID, "name": role.Name},
+	}
+}
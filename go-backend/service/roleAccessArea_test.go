@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"errors"
+	"main/geo"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+)
+
+func TestRoleAccessArea(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalGetRoles := repository.GetRolesForMeber
+	originalGetRole := repository.GetRoleByID
+	originalGetArea := repository.GetRoleAccessArea
+	originalSetArea := repository.SetRoleAccessArea
+	defer func() {
+		repository.GetRolesForMeber = originalGetRoles
+		repository.GetRoleByID = originalGetRole
+		repository.GetRoleAccessArea = originalGetArea
+		repository.SetRoleAccessArea = originalSetArea
+	}()
+
+	// Meber 1 is an admin, meber 2 a contractor holding role 2
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		if meberID == 1 {
+			return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+		}
+		return []structs.Role{{ID: 2, Name: "contractor", IsRestricted: true}}, nil
+	}
+	stored := map[int64]geo.MultiPolygon{}
+	repository.GetRoleByID = func(roleID int64) (*structs.Role, error) {
+		if roleID > 3 {
+			return nil, nil
+		}
+		_, hasArea := stored[roleID]
+		return &structs.Role{ID: roleID, Name: "contractor", IsRestricted: true, HasAccessArea: hasArea}, nil
+	}
+	repository.SetRoleAccessArea = func(roleID, meberID int64, area geo.MultiPolygon) error {
+		if area == nil {
+			delete(stored, roleID)
+		} else {
+			stored[roleID] = area
+		}
+		return nil
+	}
+	repository.GetRoleAccessArea = func(roleID int64) (string, error) {
+		// As returned by ST_AsGeoJSON
+		return `{"type": "MultiPolygon", "coordinates": [[[[5.1, 52.08], [5.14, 52.08], [5.14, 52.1], [5.1, 52.1], [5.1, 52.08]]]]}`, nil
+	}
+
+	// A Polygon feature around the centre of Utrecht, stored as a MultiPolygon
+	area := []byte(`{"type": "Feature", "properties": {}, "geometry": {"type": "Polygon",
+		"coordinates": [[[5.1, 52.08], [5.14, 52.08], [5.14, 52.1], [5.1, 52.1], [5.1, 52.08]]]}}`)
+	feature, err := service.SetRoleAccessArea(1, 2, area)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stored[2]) != 1 || !stored[2].Contains(structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}) {
+		t.Fatalf("Expected the polygon to be stored, got %v", stored[2])
+	}
+	if wkt := stored[2].WKT(); wkt != "MULTIPOLYGON(((5.1 52.08, 5.14 52.08, 5.14 52.1, 5.1 52.1, 5.1 52.08)))" {
+		t.Errorf("Expected the area as WKT in longitude latitude order, got %s", wkt)
+	}
+	if feature.Geometry.Type != "MultiPolygon" || feature.Properties["role_id"] != int64(2) {
+		t.Errorf("Expected the area as a MultiPolygon feature of the role, got %+v", feature)
+	}
+
+	// Only admins change areas, and only valid polygons of existing roles
+	if _, err := service.SetRoleAccessArea(2, 2, area); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected a non-admin to be denied, got %v", err)
+	}
+	if _, err := service.SetRoleAccessArea(1, 9, area); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected an unknown role to be not found, got %v", err)
+	}
+	invalid := map[string]string{
+		"open ring":     `{"type": "Polygon", "coordinates": [[[5.1, 52.08], [5.14, 52.08], [5.14, 52.1], [5.1, 52.1]]]}`,
+		"point":         `{"type": "Point", "coordinates": [5.1, 52.08]}`,
+		"out of range":  `{"type": "Polygon", "coordinates": [[[52.08, 5.1], [52.08, 185], [52.1, 185], [52.08, 5.1]]]}`,
+		"no polygons":   `{"type": "MultiPolygon", "coordinates": []}`,
+		"empty feature": `{"type": "Feature", "properties": {}}`,
+		"not json":      `POLYGON((5.1 52.08, 5.14 52.08, 5.1 52.08))`,
+	}
+	for name, geoJSON := range invalid {
+		if _, err := service.SetRoleAccessArea(1, 2, []byte(geoJSON)); !errors.Is(err, service.ErrInvalidRequest) {
+			t.Errorf("Expected %s to be rejected, got %v", name, err)
+		}
+	}
+
+	// The holder of the role and admins can see the area, other mebers can't
+	if _, err := service.GetRoleAccessArea(2, 2); err != nil {
+		t.Errorf("Expected the holder of the role to see its area, got %v", err)
+	}
+	if _, err := service.GetRoleAccessArea(1, 2); err != nil {
+		t.Errorf("Expected an admin to see the area, got %v", err)
+	}
+	if _, err := service.GetRoleAccessArea(2, 3); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected a meber without the role to be denied, got %v", err)
+	}
+
+	if err := service.DeleteRoleAccessArea(1, 2); err != nil || stored[2] != nil {
+		t.Fatalf("Expected the area to be removed, got %v", err)
+	}
+	if err := service.DeleteRoleAccessArea(1, 2); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected removing a missing area to be not found, got %v", err)
+	}
+	if _, err := service.GetRoleAccessArea(1, 2); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Expected a role without area to be not found, got %v", err)
+	}
+}
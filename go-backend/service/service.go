@@ -5,6 +5,7 @@ import (
 	"log"
 	"main/repository"
 	"main/structs"
+	"strings"
 	"time"
 )
 
@@ -16,15 +17,9 @@ import (
 //	return repository.GetAllDevices()
 //}
 
+// GetAllEdgeDevicesForMap retrieves all accessible devices for the map
 func GetAllEdgeDevicesForMap(meberID int64) ([]structs.EdgeDeviceMapResponse, error) {
-
-	// Call data layer to get devices filtered by user tags
-	devices, err := repository.GetAllDevicesForMap(meberID)
-	if err != nil {
-		return nil, err
-	}
-
-	return devices, nil
+	return getDevicesForMap(meberID, nil)
 }
 
 // GetAllDevicesWithApplications groups and converts data from the repository into DTO format
@@ -49,8 +44,7 @@ func GetAllDevicesWithApplications(meberID int64) ([]structs.DeviceWithApplicati
 				Status:         result.DeviceStatus,
 				LastContact:    result.DeviceLastContact,
 				ConnectionType: result.DeviceConnectionType,
-				Latitude:       result.Latitude,
-				Longitude:      result.Longitude,
+				Coordinate:     structs.Coordinate{Latitude: result.Latitude, Longitude: result.Longitude},
 				IPAddress:      result.DeviceIPAddress,
 				Applications:   []structs.ApplicationInstanceDTO{},
 				Tags:           []structs.Tag{},
@@ -99,9 +93,18 @@ func GetAllDevicesWithApplications(meberID int64) ([]structs.DeviceWithApplicati
 		}
 	}
 
+	// Devices inside an active maintenance window are shown as in maintenance
+	state, err := getMaintenanceState(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert the map to a slice for returning
 	var devicesWithApps []structs.DeviceWithApplicationsDTO
 	for _, deviceDTO := range deviceMap {
+		if state.inMaintenance[deviceDTO.DeviceID] {
+			deviceDTO.Status = MaintenanceStatus
+		}
 		devicesWithApps = append(devicesWithApps, *deviceDTO)
 	}
 
@@ -119,12 +122,49 @@ func GetMeberByID(meberID int64) (*structs.Meber, error) {
 	return repository.GetMeberByID(meberID)
 }
 
+// GetAppStoreData retrieves the applications with their required sensors, capabilities and resources
 func GetAppStoreData() ([]structs.ApplicationWithSensors, error) {
-	return repository.GetAppStoreData()
+	apps, err := repository.GetAppStoreData()
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, err := repository.GetApplicationCapabilities()
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := repository.GetApplicationRequirements()
+	if err != nil {
+		return nil, err
+	}
+	for i := range apps {
+		if required, ok := requirements[apps[i].ID]; ok {
+			apps[i].ResourceRequirements = &required
+		}
+		apps[i].RequiredCapabilities = capabilities[apps[i].ID]
+		if apps[i].RequiredCapabilities == nil {
+			apps[i].RequiredCapabilities = []string{}
+		}
+	}
+	return apps, nil
 }
 
 // GetEligibleDevices retrieves devices eligible for installing the given application
 func GetEligibleDevices(meberID int64, appID int64) ([]structs.EligibleDevice, error) {
+	return getEligibleDevices(meberID, appID, nil)
+}
+
+// GetEligibleGroupDevices retrieves the eligibility of the members of a device group for the given application
+func GetEligibleGroupDevices(meberID int64, appID int64, groupID int64) ([]structs.EligibleDevice, error) {
+	memberIDs, err := GetDeviceGroupDeviceIDs(meberID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return getEligibleDevices(meberID, appID, idSet(memberIDs))
+}
+
+// getEligibleDevices checks eligibility for the accessible devices, limited to onlyDevices when it is not nil
+func getEligibleDevices(meberID int64, appID int64, onlyDevices map[int64]bool) ([]structs.EligibleDevice, error) {
 	// Step 1: Get devices accessible by the user
 	devices, err := repository.GetDevicesByMeber(meberID)
 	if err != nil {
@@ -135,6 +175,9 @@ func GetEligibleDevices(meberID int64, appID int64) ([]structs.EligibleDevice, e
 	var deviceIDs []int64
 	deviceNameMap := make(map[int64]string)
 	for _, device := range devices {
+		if onlyDevices != nil && !onlyDevices[device.ID] {
+			continue
+		}
 		deviceIDs = append(deviceIDs, device.ID)
 		deviceNameMap[device.ID] = device.Name
 	}
@@ -150,10 +193,66 @@ func GetEligibleDevices(meberID int64, appID int64) ([]structs.EligibleDevice, e
 		eligibilityData[i].Device = deviceNameMap[deviceIDs[i]]
 	}
 
+	// Step 4: The type of the device needs every capability the application requires
+	appCapabilities, err := repository.GetApplicationCapabilities()
+	if err != nil {
+		return nil, err
+	}
+	if required := appCapabilities[appID]; len(required) > 0 {
+		deviceCapabilities, err := repository.GetDeviceCapabilities(deviceIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range eligibilityData {
+			missing := missingCapabilities(required, deviceCapabilities[deviceIDs[i]])
+			if len(missing) > 0 && eligibilityData[i].Eligible {
+				eligibilityData[i].Eligible = false
+				eligibilityData[i].Reason = "Device type lacks capabilities: " + strings.Join(missing, ", ")
+			}
+		}
+	}
+
+	// Step 5: The device needs headroom for the application on top of what it already runs
+	shortfalls, err := resourceShortfalls(deviceIDs, appID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range eligibilityData {
+		if reason, ok := shortfalls[deviceIDs[i]]; ok && eligibilityData[i].Eligible {
+			eligibilityData[i].Eligible = false
+			eligibilityData[i].Reason = reason
+		}
+	}
+
+	// Step 6: Devices that only accept installs during maintenance are ineligible outside their window
+	state, err := getMaintenanceState(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for i := range eligibilityData {
+		if state.installBlocked[deviceIDs[i]] && eligibilityData[i].Eligible {
+			eligibilityData[i].Eligible = false
+			eligibilityData[i].Reason = "Installs only allowed during maintenance window"
+		}
+	}
+
+	// Step 7: Quarantined devices don't accept installs at all
+	quarantined, err := repository.GetQuarantinedDeviceIDs(deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	quarantinedSet := idSet(quarantined)
+	for i := range eligibilityData {
+		if quarantinedSet[deviceIDs[i]] {
+			eligibilityData[i].Eligible = false
+			eligibilityData[i].Reason = "Device is quarantined"
+		}
+	}
+
 	return eligibilityData, nil
 }
 
-// AddApplicationsToDevices adds applications to the specified devices
+// AddApplicationsToDevices adds an application to the desired state of the specified devices
 func AddApplicationsToDevices(userID int64, appID int64, deviceIDs []int64) error {
 	// Step 1: Verify that the user has access to the devices
 	devices, err := repository.GetDevicesByMeber(userID)
@@ -168,16 +267,37 @@ func AddApplicationsToDevices(userID int64, appID int64, deviceIDs []int64) erro
 
 	for _, deviceID := range deviceIDs {
 		if !deviceAccessMap[deviceID] {
-			return fmt.Errorf("user does not have access to device %d", deviceID)
+			return fmt.Errorf("%w: user does not have access to device %d", ErrAccessDenied, deviceID)
 		}
 	}
 
-	// Step 2: Add application instances to the devices
-	for _, deviceID := range deviceIDs {
-		err := repository.AddApplicationInstance(deviceID, appID)
-		if err != nil {
-			return fmt.Errorf("error adding application %d to device %d: %w", appID, deviceID, err)
-		}
+	// Step 2: The application comes from the request, so an unknown one is an invalid request
+	app, err := repository.GetApplicationByID(appID)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		return fmt.Errorf("%w: unknown application %d", ErrInvalidRequest, appID)
+	}
+
+	// Step 3: Refuse installs on quarantined devices, on devices that only accept them during a maintenance window and
+	// on devices whose type can't run the application or without room for it
+	if err := CheckQuarantine(deviceIDs); err != nil {
+		return err
+	}
+	if err := CheckInstallWindow(deviceIDs, time.Now()); err != nil {
+		return err
+	}
+	if err := CheckDeviceCapabilities(deviceIDs, appID); err != nil {
+		return err
+	}
+	if err := CheckResourceHeadroom(deviceIDs, appID); err != nil {
+		return err
+	}
+
+	// Step 4: Record the catalog version as desired state, the device reports once it actually runs the application
+	if err := repository.SetDesiredApplications(deviceIDs, appID, app.Version, nil, userID); err != nil {
+		return fmt.Errorf("error adding application %d to devices: %w", appID, err)
 	}
 
 	return nil
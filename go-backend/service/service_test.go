@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"encoding/json"
+	"errors"
 	"main/repository"
 	"main/service"
 	"main/structs"
@@ -107,8 +109,12 @@ func TestGetAllDevicesWithApplications(t *testing.T) {
 	originalRepoFunc := repository.GetAllDevicesWithApplications
 	defer func() { repository.GetAllDevicesWithApplications = originalRepoFunc }()
 
-	// Replace the repository function with the mock
+	originalWindowsFunc := repository.GetMaintenanceWindows
+	defer func() { repository.GetMaintenanceWindows = originalWindowsFunc }()
+
+	// Replace the repository functions with the mocks
 	repository.GetAllDevicesWithApplications = mockGetAllDevicesWithApplications
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) { return nil, nil }
 
 	// Call the service function
 	devices, err := service.GetAllDevicesWithApplications(123)
@@ -224,3 +230,79 @@ func TestGetMeberByID(t *testing.T) {
 		t.Errorf("Expected no meber, got %+v", meber)
 	}
 }
+
+func TestAddApplicationsToDevices(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetDevicesByMeber
+	originalApp := repository.GetApplicationByID
+	originalQuarantined := repository.GetQuarantinedDeviceIDs
+	originalWindows := repository.GetMaintenanceWindows
+	originalRequirements := repository.GetApplicationRequirements
+	originalSetDesired := repository.SetDesiredApplications
+	originalAppCapabilities := repository.GetApplicationCapabilities
+	originalDeviceCapabilities := repository.GetDeviceCapabilities
+	defer func() {
+		repository.GetApplicationCapabilities = originalAppCapabilities
+		repository.GetDeviceCapabilities = originalDeviceCapabilities
+		repository.GetDevicesByMeber = originalDevices
+		repository.GetApplicationByID = originalApp
+		repository.GetQuarantinedDeviceIDs = originalQuarantined
+		repository.GetMaintenanceWindows = originalWindows
+		repository.GetApplicationRequirements = originalRequirements
+		repository.SetDesiredApplications = originalSetDesired
+	}()
+
+	// The meber has access to devices 1, 2 and 4, only application 1 exists and it needs a camera device 4 lacks
+	repository.GetDevicesByMeber = func(meberID int64) ([]structs.EdgeDevice, error) {
+		return []structs.EdgeDevice{{ID: 1}, {ID: 2}, {ID: 4}}, nil
+	}
+	repository.GetApplicationCapabilities = func() (map[int64][]string, error) {
+		return map[int64][]string{1: {"camera"}}, nil
+	}
+	repository.GetDeviceCapabilities = func(deviceIDs []int64) (map[int64]map[string]bool, error) {
+		return map[int64]map[string]bool{1: {"camera": true}, 2: {"camera": true, "lte": true}, 4: {"lte": true}}, nil
+	}
+	repository.GetApplicationByID = func(appID int64) (*structs.Application, error) {
+		if appID != 1 {
+			return nil, nil
+		}
+		return &structs.Application{ID: 1, Version: "1.2"}, nil
+	}
+	repository.GetQuarantinedDeviceIDs = func(deviceIDs []int64) ([]int64, error) { return nil, nil }
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) { return nil, nil }
+	repository.GetApplicationRequirements = func() (map[int64]structs.ResourceRequirements, error) {
+		return map[int64]structs.ResourceRequirements{}, nil
+	}
+	var installed []int64
+	repository.SetDesiredApplications = func(deviceIDs []int64, appID int64, version string, config json.RawMessage, meberID int64) error {
+		installed = deviceIDs
+		return nil
+	}
+
+	tests := []struct {
+		name      string
+		appID     int64
+		deviceIDs []int64
+		wantErr   error
+	}{
+		{"Install", 1, []int64{1, 2}, nil},
+		{"Unknown Application", 9, []int64{1}, service.ErrInvalidRequest},
+		{"Inaccessible Device", 1, []int64{1, 3}, service.ErrAccessDenied},
+		{"Device Type Lacks Capability", 1, []int64{1, 4}, service.ErrInvalidRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			installed = nil
+			err := service.AddApplicationsToDevices(42, tc.appID, tc.deviceIDs)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Expected %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr == nil && len(installed) != len(tc.deviceIDs) {
+				t.Errorf("Expected the application to be desired on %v, got %v", tc.deviceIDs, installed)
+			}
+			if tc.wantErr != nil && installed != nil {
+				t.Errorf("Expected nothing to be installed, got %v", installed)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+)
+
+const (
+	// MaxSearchRadiusMeters caps radius queries, beyond it the envelope no longer narrows anything down
+	MaxSearchRadiusMeters = 100000
+	// DefaultNearestDevices is the number of devices returned by a nearest query when none is given
+	DefaultNearestDevices = 10
+	maxNearestDevices     = 100
+
+	// nearestInitialRadiusMeters is the first radius searched for the nearest devices, it grows by
+	// nearestRadiusGrowth until enough devices are found or MaxSearchRadiusMeters is passed
+	nearestInitialRadiusMeters = 1000
+	nearestRadiusGrowth        = 4
+)
+
+// validateCoordinate checks that a coordinate lies on the globe
+func validateCoordinate(coordinate structs.Coordinate) error {
+	if coordinate.Latitude < -90 || coordinate.Latitude > 90 || coordinate.Longitude < -180 || coordinate.Longitude > 180 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90 and longitude between -180 and 180", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// GetDevicesInBoundingBox retrieves the accessible devices within the bounding box
+func GetDevicesInBoundingBox(meberID int64, bounds structs.BoundingBox) ([]structs.NearbyDevice, error) {
+	if err := validateBoundingBox(bounds); err != nil {
+		return nil, err
+	}
+	return repository.GetDevicesInBoundingBox(meberID, bounds)
+}
+
+// GetDevicesWithinRadius retrieves the accessible devices within radiusMeters of center, closest first
+func GetDevicesWithinRadius(meberID int64, center structs.Coordinate, radiusMeters float64) ([]structs.NearbyDevice, error) {
+	if err := validateCoordinate(center); err != nil {
+		return nil, err
+	}
+	if radiusMeters <= 0 || radiusMeters > MaxSearchRadiusMeters {
+		return nil, fmt.Errorf("%w: radius must be between 0 and %d meters", ErrInvalidRequest, MaxSearchRadiusMeters)
+	}
+	return repository.GetDevicesWithinRadius(meberID, center, radiusMeters)
+}
+
+// GetNearestDevices retrieves the limit accessible devices closest to center, closest first. The search starts in a
+// small radius so the spatial index does the work, and only scans every device when the area is sparsely covered.
+func GetNearestDevices(meberID int64, center structs.Coordinate, limit int) ([]structs.NearbyDevice, error) {
+	if err := validateCoordinate(center); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > maxNearestDevices {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidRequest, maxNearestDevices)
+	}
+
+	for radius := float64(nearestInitialRadiusMeters); radius <= MaxSearchRadiusMeters; radius *= nearestRadiusGrowth {
+		devices, err := repository.GetNearestDevices(meberID, center, limit, radius)
+		if err != nil {
+			return nil, err
+		}
+		if len(devices) == limit {
+			return devices, nil
+		}
+	}
+	return repository.GetNearestDevices(meberID, center, limit, 0)
+}
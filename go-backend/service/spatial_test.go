@@ -0,0 +1,50 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+)
+
+func TestGetNearestDevices(t *testing.T) {
+	originalNearest := repository.GetNearestDevices
+	defer func() { repository.GetNearestDevices = originalNearest }()
+
+	// Two devices lie within 5 km, a third one only 300 km away
+	var radii []float64
+	repository.GetNearestDevices = func(meberID int64, center structs.Coordinate, limit int, radiusMeters float64) ([]structs.NearbyDevice, error) {
+		radii = append(radii, radiusMeters)
+		var devices []structs.NearbyDevice
+		for i, distance := range []float64{800, 4500, 300000} {
+			if (radiusMeters == 0 || distance <= radiusMeters) && len(devices) < limit {
+				distance := distance
+				devices = append(devices, structs.NearbyDevice{ID: int64(i + 1), DistanceMeters: &distance})
+			}
+		}
+		return devices, nil
+	}
+
+	center := structs.Coordinate{Latitude: 52.09, Longitude: 5.12}
+	devices, err := service.GetNearestDevices(5, center, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(devices) != 2 || len(radii) != 3 || radii[2] != 16000 {
+		t.Errorf("Expected the search to stop at a 16 km radius, got %v after %v", devices, radii)
+	}
+
+	// Without enough devices nearby every device is searched
+	radii = nil
+	if devices, _ := service.GetNearestDevices(5, center, 3); len(devices) != 3 || radii[len(radii)-1] != 0 {
+		t.Errorf("Expected a search over all devices, got %v after %v", devices, radii)
+	}
+
+	if _, err := service.GetNearestDevices(5, structs.Coordinate{Latitude: 91}, 3); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for latitude 91, got %v", err)
+	}
+	if _, err := service.GetNearestDevices(5, center, 0); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for limit 0, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"time"
+)
+
+const (
+	// MaxPlaybackRange is the longest period of status changes returned at once
+	MaxPlaybackRange = 7 * 24 * time.Hour
+	// MaxPlaybackChanges is the number of status changes after which the change stream is cut off
+	MaxPlaybackChanges = 10000
+)
+
+// GetFleetStatusAt returns the accessible devices that existed at the given moment with the status they had then,
+// built from the status history. Devices without recorded changes around the moment are left out, their status at
+// the moment isn't known.
+func GetFleetStatusAt(meberID int64, at, now time.Time) (structs.FleetSnapshot, error) {
+	if at.After(now) {
+		return structs.FleetSnapshot{}, fmt.Errorf("%w: the moment lies in the future", ErrInvalidRequest)
+	}
+
+	devices, err := repository.GetPlaybackDevices(meberID, at, at)
+	if err != nil {
+		return structs.FleetSnapshot{}, err
+	}
+	deviceIDs := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	statuses, err := repository.GetDeviceStatusesAt(deviceIDs, at)
+	if err != nil {
+		return structs.FleetSnapshot{}, fmt.Errorf("error fetching device statuses: %w", err)
+	}
+	state, err := getMaintenanceState(at)
+	if err != nil {
+		return structs.FleetSnapshot{}, err
+	}
+
+	snapshot := structs.FleetSnapshot{At: at, Devices: make([]structs.PlaybackDevice, 0, len(devices))}
+	for _, device := range devices {
+		status, ok := statuses[device.ID]
+		if !ok {
+			continue
+		}
+		device.Status = status
+		device.InMaintenance = state.inMaintenance[device.ID]
+		snapshot.Devices = append(snapshot.Devices, device)
+	}
+	return snapshot, nil
+}
+
+// GetStatusChanges returns the status changes of the devices accessible between from and to in the order they
+// happened. When there are more than MaxPlaybackChanges the stream is cut off before the first change that didn't
+// fit and To is moved there, so the next page can be requested from To without missing or repeating changes.
+func GetStatusChanges(meberID int64, from, to time.Time) (structs.StatusChangeStream, error) {
+	if !to.After(from) {
+		return structs.StatusChangeStream{}, fmt.Errorf("%w: to must be after from", ErrInvalidRequest)
+	}
+	if to.Sub(from) > MaxPlaybackRange {
+		return structs.StatusChangeStream{}, fmt.Errorf("%w: the period can span at most %s", ErrInvalidRequest, MaxPlaybackRange)
+	}
+
+	// Replaced units keep their changes from before they were decommissioned
+	devices, err := repository.GetPlaybackDevices(meberID, from, to)
+	if err != nil {
+		return structs.StatusChangeStream{}, err
+	}
+	deviceIDs := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	changes, err := repository.GetStatusChanges(deviceIDs, from, to, MaxPlaybackChanges+1)
+	if err != nil {
+		return structs.StatusChangeStream{}, fmt.Errorf("error fetching status changes: %w", err)
+	}
+
+	stream := structs.StatusChangeStream{From: from, To: to, Changes: changes}
+	if len(changes) > MaxPlaybackChanges {
+		// Timestamps have a precision of a second, so only changes before the cut are certain to be complete
+		cut := changes[MaxPlaybackChanges].Timestamp
+		kept := MaxPlaybackChanges
+		for kept > 0 && !changes[kept-1].Timestamp.Before(cut) {
+			kept--
+		}
+		if kept == 0 {
+			// All changes fall in the same second, skip the rest of that second rather than repeat it
+			kept = MaxPlaybackChanges
+			cut = cut.Add(time.Second)
+		}
+		stream.Changes = changes[:kept]
+		stream.To = cut
+		stream.Truncated = true
+	}
+	return stream, nil
+}
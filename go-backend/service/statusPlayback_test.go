@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetFleetStatusAt(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetPlaybackDevices
+	originalStatuses := repository.GetDeviceStatusesAt
+	originalWindows := repository.GetMaintenanceWindows
+	defer func() {
+		repository.GetPlaybackDevices = originalDevices
+		repository.GetDeviceStatusesAt = originalStatuses
+		repository.GetMaintenanceWindows = originalWindows
+	}()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := now.Add(-2 * time.Hour)
+	var existing []time.Time
+	repository.GetPlaybackDevices = func(meberID int64, from, to time.Time) ([]structs.PlaybackDevice, error) {
+		existing = []time.Time{from, to}
+		return []structs.PlaybackDevice{
+			{ID: 1, Name: "MSR_1", Municipality: "Utrecht", Coordinate: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}},
+			{ID: 2, Name: "MSR_2", Municipality: "Utrecht", Coordinate: structs.Coordinate{Latitude: 52.0930, Longitude: 5.1180}},
+			{ID: 3, Name: "MSR_3", Municipality: "Delft", Coordinate: structs.Coordinate{Latitude: 52.0116, Longitude: 4.3571}},
+		}, nil
+	}
+	var requested time.Time
+	repository.GetDeviceStatusesAt = func(deviceIDs []int64, moment time.Time) (map[int64]string, error) {
+		requested = moment
+		// Device 3 has no recorded changes around the moment
+		return map[int64]string{1: "offline", 2: "app_issue"}, nil
+	}
+	// Device 2 was in a maintenance window at the moment, one that ended since doesn't matter for device 1
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return []structs.MaintenanceWindow{
+			{ID: 1, TargetType: "device", TargetID: 2, StartsAt: at.Add(-time.Hour), EndsAt: at.Add(time.Hour), Recurrence: "none"},
+			{ID: 2, TargetType: "device", TargetID: 1, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Recurrence: "none"},
+		}, nil
+	}
+
+	snapshot, err := service.GetFleetStatusAt(5, at, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Device 3 is left out, its status at the moment isn't known
+	if !requested.Equal(at) || !snapshot.At.Equal(at) || len(snapshot.Devices) != 2 {
+		t.Fatalf("Expected the two devices with history at %s, got %+v", at, snapshot)
+	}
+	if len(existing) != 2 || !existing[0].Equal(at) || !existing[1].Equal(at) {
+		t.Errorf("Expected the devices that existed at %s, got those between %v", at, existing)
+	}
+
+	expected := map[int64]struct {
+		status        string
+		inMaintenance bool
+	}{1: {"offline", false}, 2: {"app_issue", true}}
+	for _, device := range snapshot.Devices {
+		if want := expected[device.ID]; device.Status != want.status || device.InMaintenance != want.inMaintenance {
+			t.Errorf("Expected device %d to be %+v, got %+v", device.ID, want, device)
+		}
+	}
+	if snapshot.Devices[0].Latitude != 52.0907 || snapshot.Devices[0].Municipality != "Utrecht" {
+		t.Errorf("Expected the location of the device to be kept, got %+v", snapshot.Devices[0])
+	}
+
+	if _, err := service.GetFleetStatusAt(5, now.Add(time.Minute), now); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected a moment in the future to be rejected, got %v", err)
+	}
+}
+
+func TestGetStatusChanges(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetPlaybackDevices
+	originalChanges := repository.GetStatusChanges
+	defer func() {
+		repository.GetPlaybackDevices = originalDevices
+		repository.GetStatusChanges = originalChanges
+	}()
+
+	from := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	repository.GetPlaybackDevices = func(meberID int64, start, end time.Time) ([]structs.PlaybackDevice, error) {
+		if !start.Equal(from) || !end.Equal(to) {
+			t.Errorf("Expected the devices that existed between %s and %s, got %s and %s", from, to, start, end)
+		}
+		return []structs.PlaybackDevice{{ID: 1}, {ID: 2}}, nil
+	}
+
+	// More changes than fit, the last one that fits shares its second with the first one that doesn't
+	changes := make([]structs.StatusChange, 0, service.MaxPlaybackChanges+1)
+	for i := 0; i <= service.MaxPlaybackChanges; i++ {
+		second := i
+		if i == service.MaxPlaybackChanges {
+			second = i - 1
+		}
+		changes = append(changes, structs.StatusChange{DeviceID: int64(i%2 + 1), Status: "offline", Timestamp: from.Add(time.Duration(second) * 100 * time.Millisecond)})
+	}
+	var limit int
+	var deviceIDs []int64
+	repository.GetStatusChanges = func(ids []int64, start, end time.Time, max int) ([]structs.StatusChange, error) {
+		deviceIDs, limit = ids, max
+		return changes, nil
+	}
+
+	stream, err := service.GetStatusChanges(5, from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(deviceIDs) != 2 || limit != service.MaxPlaybackChanges+1 {
+		t.Errorf("Expected the changes of the accessible devices to be fetched with room for one more, got %v and %d", deviceIDs, limit)
+	}
+	if !stream.Truncated || !stream.From.Equal(from) {
+		t.Fatalf("Expected a truncated stream from %s, got truncated %v from %s", from, stream.Truncated, stream.From)
+	}
+	// Every change in the same second as the first one cut off is left for the next page
+	cut := changes[service.MaxPlaybackChanges].Timestamp
+	if !stream.To.Equal(cut) {
+		t.Errorf("Expected the stream to end at %s, got %s", cut, stream.To)
+	}
+	for _, change := range stream.Changes {
+		if !change.Timestamp.Before(cut) {
+			t.Fatalf("Expected only changes before %s, got one at %s", cut, change.Timestamp)
+		}
+	}
+	if len(stream.Changes) >= service.MaxPlaybackChanges {
+		t.Errorf("Expected the changes sharing the cut off second to be dropped, got %d", len(stream.Changes))
+	}
+
+	if _, err := service.GetStatusChanges(5, to, from); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected a reversed period to be rejected, got %v", err)
+	}
+	if _, err := service.GetStatusChanges(5, from, from.Add(service.MaxPlaybackRange+time.Hour)); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected a too long period to be rejected, got %v", err)
+	}
+}
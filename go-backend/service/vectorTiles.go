@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"main/geo"
+	"main/structs"
+	"sync"
+	"time"
+)
+
+const (
+	// TileCacheTTL bounds how long a cached tile is served, changes other than status changes such as new
+	// devices or maintenance windows starting show up after at most this long
+	TileCacheTTL = 5 * time.Minute
+	// maxCachedTiles bounds the memory used by the tile cache, it is emptied when full
+	maxCachedTiles = 10000
+	// tileBuffer is the margin in tile units around a tile whose devices are included, so markers on the edge of
+	// a tile are not cut off
+	tileBuffer = 64
+	// deviceTileLayer is the name of the layer holding the devices
+	deviceTileLayer = "devices"
+)
+
+type tileKey struct {
+	meberID int64
+	z, x, y int
+}
+
+type cachedTile struct {
+	data      []byte
+	deviceIDs []int64
+	expires   time.Time
+}
+
+// tileCache holds encoded tiles per meber, since access differs per meber. byDevice indexes the tiles a device
+// appears on, so a status change only drops those tiles. generation counts invalidations, so a tile built while
+// a device on it changed status is not stored.
+var tileCache = struct {
+	sync.Mutex
+	tiles      map[tileKey]cachedTile
+	byDevice   map[int64]map[tileKey]bool
+	generation uint64
+}{tiles: make(map[tileKey]cachedTile), byDevice: make(map[int64]map[tileKey]bool)}
+
+// GetDeviceTile returns tile x, y at zoom level z as a Mapbox Vector Tile holding the accessible devices with
+// their status, name and municipality. Tiles are cached until a device on them changes status.
+func GetDeviceTile(meberID int64, z, x, y int) ([]byte, error) {
+	if z < 0 || z > MaxMapZoom {
+		return nil, fmt.Errorf("%w: zoom must be between 0 and %d", ErrInvalidRequest, MaxMapZoom)
+	}
+	if n := 1 << z; x < 0 || x >= n || y < 0 || y >= n {
+		return nil, fmt.Errorf("%w: tile %d/%d does not exist at zoom level %d", ErrInvalidRequest, x, y, z)
+	}
+
+	key := tileKey{meberID: meberID, z: z, x: x, y: y}
+	now := time.Now()
+	tileCache.Lock()
+	cached, ok := tileCache.tiles[key]
+	generation := tileCache.generation
+	tileCache.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.data, nil
+	}
+
+	devices, err := getDevicesForMap(meberID, bufferedTileBounds(z, x, y))
+	if err != nil {
+		return nil, err
+	}
+
+	layer := geo.TileLayer{Name: deviceTileLayer, Extent: geo.TileExtent, Features: make([]geo.TileFeature, 0, len(devices))}
+	deviceIDs := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		px, py := geo.TileCoordinate(z, x, y, device.Coordinate, geo.TileExtent)
+		layer.Features = append(layer.Features, geo.TileFeature{
+			ID: uint64(device.ID),
+			X:  px,
+			Y:  py,
+			Properties: map[string]interface{}{
+				"status":       device.Status,
+				"name":         device.Name,
+				"municipality": device.Municipality,
+				"quarantined":  device.Quarantined,
+			},
+		})
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+	data := geo.EncodeVectorTile(layer)
+
+	storeTile(key, cachedTile{data: data, deviceIDs: deviceIDs, expires: now.Add(TileCacheTTL)}, generation)
+	return data, nil
+}
+
+// bufferedTileBounds returns the bounds of the tile widened by tileBuffer, clamped to the Web Mercator world
+func bufferedTileBounds(z, x, y int) *structs.BoundingBox {
+	bounds := geo.TileBounds(z, x, y)
+	margin := float64(tileBuffer) / geo.TileExtent
+	width := (bounds.MaxLongitude - bounds.MinLongitude) * margin
+	height := (bounds.MaxLatitude - bounds.MinLatitude) * margin
+	bounds.MinLongitude = max(bounds.MinLongitude-width, -180)
+	bounds.MaxLongitude = min(bounds.MaxLongitude+width, 180)
+	bounds.MinLatitude = max(bounds.MinLatitude-height, -geo.MaxMercatorLatitude)
+	bounds.MaxLatitude = min(bounds.MaxLatitude+height, geo.MaxMercatorLatitude)
+	return &bounds
+}
+
+// storeTile caches a tile and indexes it by the devices it shows, unless the cache was invalidated since the
+// given generation
+func storeTile(key tileKey, tile cachedTile, generation uint64) {
+	tileCache.Lock()
+	defer tileCache.Unlock()
+
+	if tileCache.generation != generation {
+		return
+	}
+	if len(tileCache.tiles) >= maxCachedTiles {
+		tileCache.tiles = make(map[tileKey]cachedTile)
+		tileCache.byDevice = make(map[int64]map[tileKey]bool)
+	}
+	tileCache.tiles[key] = tile
+	for _, deviceID := range tile.deviceIDs {
+		if tileCache.byDevice[deviceID] == nil {
+			tileCache.byDevice[deviceID] = make(map[tileKey]bool)
+		}
+		tileCache.byDevice[deviceID][key] = true
+	}
+}
+
+// invalidateDeviceTiles drops the cached tiles showing any of the devices, for every meber. The changed devices can
+// also enter or leave groups with maintenance windows, so the cached window targets are dropped too.
+func invalidateDeviceTiles(deviceIDs ...int64) {
+	invalidateMaintenanceTargets()
+
+	tileCache.Lock()
+	defer tileCache.Unlock()
+
+	tileCache.generation++
+	for _, deviceID := range deviceIDs {
+		for key := range tileCache.byDevice[deviceID] {
+			delete(tileCache.tiles, key)
+		}
+		delete(tileCache.byDevice, deviceID)
+	}
+}
+
+// invalidateAllTiles empties the tile cache, for changes that affect the status shown of many devices at once
+func invalidateAllTiles() {
+	invalidateMaintenanceTargets()
+
+	tileCache.Lock()
+	defer tileCache.Unlock()
+
+	tileCache.generation++
+	tileCache.tiles = make(map[tileKey]cachedTile)
+	tileCache.byDevice = make(map[int64]map[tileKey]bool)
+}
@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"bytes"
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"testing"
+	"time"
+)
+
+func TestGetDeviceTile(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalDevices := repository.GetAllDevicesForMap
+	originalWindows := repository.GetMaintenanceWindows
+	originalTouch := repository.TouchDeviceHeartbeat
+	originalStatus := repository.GetDeviceStatus
+	originalTransition := repository.RecordDeviceStatusTransition
+	originalFilter := repository.GetDeviceIDsByFilter
+	originalQuarantine := repository.QuarantineDevice
+	originalRelease := repository.ReleaseDeviceFromQuarantine
+	originalGetRoles := repository.GetRolesForMeber
+	defer func() {
+		repository.GetDeviceIDsByFilter = originalFilter
+		repository.QuarantineDevice = originalQuarantine
+		repository.ReleaseDeviceFromQuarantine = originalRelease
+		repository.GetRolesForMeber = originalGetRoles
+		repository.GetAllDevicesForMap = originalDevices
+		repository.GetMaintenanceWindows = originalWindows
+		repository.TouchDeviceHeartbeat = originalTouch
+		repository.GetDeviceStatus = originalStatus
+		repository.RecordDeviceStatusTransition = originalTransition
+	}()
+
+	// A device in the centre of Utrecht, which lies on tile 526/337 at zoom level 10
+	status := "online"
+	quarantined := false
+	queries := 0
+	repository.GetAllDevicesForMap = func(meberID int64, bounds *structs.BoundingBox) ([]structs.EdgeDeviceMapResponse, error) {
+		queries++
+		if bounds == nil || bounds.MinLongitude > 5.1214 || bounds.MaxLongitude < 5.1214 || bounds.MinLatitude > 52.0907 || bounds.MaxLatitude < 52.0907 {
+			t.Errorf("Expected the tile bounds to contain the device, got %+v", bounds)
+		}
+		return []structs.EdgeDeviceMapResponse{
+			{ID: 1, Name: "MSR_1", Status: status, Municipality: "Utrecht", Quarantined: quarantined, Coordinate: structs.Coordinate{Latitude: 52.0907, Longitude: 5.1214}},
+		}, nil
+	}
+	repository.GetMaintenanceWindows = func() ([]structs.MaintenanceWindow, error) {
+		return nil, nil
+	}
+	repository.TouchDeviceHeartbeat = func(deviceID int64, now time.Time) error {
+		return nil
+	}
+	repository.GetDeviceStatus = func(deviceID int64) (string, error) {
+		return status, nil
+	}
+	repository.RecordDeviceStatusTransition = func(deviceID int64, previousStatus, newStatus string, heartbeatBefore *time.Time, inMaintenance bool, now time.Time, logLevel, logDescription string) (bool, error) {
+		if previousStatus != status {
+			return false, nil
+		}
+		status = newStatus
+		return true, nil
+	}
+	repository.GetDeviceIDsByFilter = func(meberID int64, filter structs.DeviceFilter) ([]int64, error) {
+		return filter.DeviceIDs, nil
+	}
+	repository.QuarantineDevice = func(deviceID, meberID int64, reason string, allowed []string, now time.Time) (bool, error) {
+		quarantined = true
+		return true, nil
+	}
+	repository.ReleaseDeviceFromQuarantine = func(deviceID, meberID int64, reason string) (bool, error) {
+		quarantined = false
+		return true, nil
+	}
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+	}
+
+	tile, err := service.GetDeviceTile(42, 10, 526, 337)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, expected := range []string{"devices", "MSR_1", "Utrecht", "online"} {
+		if !bytes.Contains(tile, []byte(expected)) {
+			t.Errorf("Expected the tile to hold %q", expected)
+		}
+	}
+
+	// The second request is served from the cache
+	if _, err := service.GetDeviceTile(42, 10, 526, 337); err != nil || queries != 1 {
+		t.Errorf("Expected the cached tile to be served, got %d queries and error %v", queries, err)
+	}
+
+	// A status change of the device drops the tile
+	if err := service.RecordHeartbeat(1, "error"); err != nil {
+		t.Fatalf("Unexpected error recording heartbeat: %v", err)
+	}
+	tile, err = service.GetDeviceTile(42, 10, 526, 337)
+	if err != nil || queries != 2 || !bytes.Contains(tile, []byte("error")) {
+		t.Errorf("Expected the tile to be rebuilt with the new status, got %d queries and error %v", queries, err)
+	}
+
+	// A heartbeat that read the status before another change got there first changes nothing
+	repository.GetDeviceStatus = func(deviceID int64) (string, error) {
+		return "online", nil
+	}
+	if err := service.RecordHeartbeat(1, "app_issue"); err != nil {
+		t.Fatalf("Unexpected error recording heartbeat: %v", err)
+	}
+	if _, err := service.GetDeviceTile(42, 10, 526, 337); err != nil || queries != 2 || status != "error" {
+		t.Errorf("Expected the cached tile to be kept, got %d queries, status %s and error %v", queries, status, err)
+	}
+
+	// Quarantining and releasing the device drop the tile as well
+	if err := service.QuarantineDevice(42, structs.QuarantineRequest{DeviceID: 1, Reason: "unexpected outbound traffic"}); err != nil {
+		t.Fatalf("Unexpected error quarantining: %v", err)
+	}
+	if _, err := service.GetDeviceTile(42, 10, 526, 337); err != nil || queries != 3 {
+		t.Errorf("Expected the tile to be rebuilt after the quarantine, got %d queries and error %v", queries, err)
+	}
+	if err := service.ReleaseDevice(1, structs.QuarantineRequest{DeviceID: 1, Reason: "reimaged"}); err != nil {
+		t.Fatalf("Unexpected error releasing: %v", err)
+	}
+	if _, err := service.GetDeviceTile(42, 10, 526, 337); err != nil || queries != 4 {
+		t.Errorf("Expected the tile to be rebuilt after the release, got %d queries and error %v", queries, err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		z, x, y int
+	}{
+		{"Zoom Too High", service.MaxMapZoom + 1, 0, 0},
+		{"Column Outside The World", 2, 4, 1},
+		{"Negative Row", 2, 1, -1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := service.GetDeviceTile(42, tc.z, tc.x, tc.y); !errors.Is(err, service.ErrInvalidRequest) {
+				t.Errorf("Expected ErrInvalidRequest, got %v", err)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dgrijalva/jwt-go"
+	"main/repository"
+	"time"
 )
 
 var SecretKey = []byte("your_secret_key") // Totally secure btw
@@ -37,3 +39,97 @@ func VerifyToken(tokenString string) (int64, error) {
 
 	return meberID, nil
 }
+
+// DeviceTokenLifetime is how long a device token issued from the dashboard stays valid
+const DeviceTokenLifetime = 30 * 24 * time.Hour
+
+// GenerateDeviceToken creates a signed JWT that identifies an edge device on the device-facing endpoints
+func GenerateDeviceToken(deviceID int64) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"device_id": deviceID,
+		"iat":       time.Now().Unix(),
+		"exp":       time.Now().Add(DeviceTokenLifetime).Unix(),
+	})
+	return token.SignedString(SecretKey)
+}
+
+// VerifyDeviceToken verifies a device JWT and extracts the device ID
+func VerifyDeviceToken(tokenString string) (int64, error) {
+	deviceID, _, err := parseDeviceToken(tokenString)
+	return deviceID, err
+}
+
+// AuthenticateDeviceToken verifies a device JWT and rejects it when the credentials of the device were revoked
+// after the token was issued or the device was decommissioned
+func AuthenticateDeviceToken(tokenString string) (int64, error) {
+	deviceID, issuedAt, err := parseDeviceToken(tokenString)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkInService(deviceID); err != nil {
+		return 0, err
+	}
+
+	revokedAt, err := repository.GetDeviceCredentialsRevokedAt(deviceID)
+	if err != nil {
+		return 0, err
+	}
+	// Tokens without an issue time predate revocation support, so they are treated as issued before it
+	if revokedAt != nil && (issuedAt == nil || !issuedAt.After(*revokedAt)) {
+		return 0, errors.New("device token was revoked")
+	}
+
+	return deviceID, nil
+}
+
+// parseDeviceToken verifies a device JWT and extracts the device ID and, when present, the time it was issued
+func parseDeviceToken(tokenString string) (int64, *time.Time, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return SecretKey, nil
+	})
+
+	if err != nil || !token.Valid {
+		return 0, nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, nil, errors.New("invalid token claims")
+	}
+
+	// Meber tokens are signed with the same key, so the claim is what tells them apart
+	deviceIDFloat, ok := claims["device_id"].(float64)
+	if !ok {
+		return 0, nil, errors.New("device ID not found in token")
+	}
+
+	var issuedAt *time.Time
+	if iat, ok := claims["iat"].(float64); ok {
+		issued := time.Unix(int64(iat), 0)
+		issuedAt = &issued
+	}
+
+	return int64(deviceIDFloat), issuedAt, nil
+}
+
+// IssueDeviceToken creates a device token for a device the meber has access to
+func IssueDeviceToken(meberID, deviceID int64) (string, error) {
+	if err := checkDeviceRecordAccess(meberID, deviceID); err != nil {
+		return "", err
+	}
+	if err := checkInService(deviceID); err != nil {
+		return "", err
+	}
+
+	// A quarantined device only gets a new token from an admin, for investigating it
+	if err := CheckQuarantine([]int64{deviceID}); err != nil {
+		if !errors.Is(err, ErrAccessDenied) || requireAdmin(meberID) != nil {
+			return "", err
+		}
+	}
+
+	return GenerateDeviceToken(deviceID)
+}
@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"errors"
+	"main/repository"
 	"main/service"
 	"testing"
 	"time"
@@ -80,3 +82,72 @@ func TestVerifyToken(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyDeviceToken(t *testing.T) {
+	deviceToken, err := service.GenerateDeviceToken(42)
+	if err != nil {
+		t.Fatalf("Failed to generate device token: %v", err)
+	}
+
+	deviceID, err := service.VerifyDeviceToken(deviceToken)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if deviceID != 42 {
+		t.Errorf("Expected device ID 42, got %d", deviceID)
+	}
+
+	// A meber token must not be accepted as a device token and the other way around
+	meberToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"meber_id": 1,
+		"exp":      time.Now().Add(time.Hour).Unix(),
+	})
+	meberTokenString, _ := meberToken.SignedString(service.SecretKey)
+
+	if _, err := service.VerifyDeviceToken(meberTokenString); err == nil {
+		t.Error("Expected meber token to be rejected as device token")
+	}
+	if _, err := service.VerifyToken(deviceToken); err == nil {
+		t.Error("Expected device token to be rejected as meber token")
+	}
+}
+
+func TestIssueDeviceToken(t *testing.T) {
+	// Backup the original repository functions and restore them after the test
+	originalAccess := repository.MeberHasDeviceAccess
+	originalDecommissioned := repository.GetDeviceDecommissionedAt
+	originalQuarantined := repository.GetQuarantinedDeviceIDs
+	defer func() {
+		repository.MeberHasDeviceAccess = originalAccess
+		repository.GetDeviceDecommissionedAt = originalDecommissioned
+		repository.GetQuarantinedDeviceIDs = originalQuarantined
+	}()
+
+	// Meber has access to devices 1 and 2, device 2 was replaced
+	repository.MeberHasDeviceAccess = func(meberID int64, deviceID int64) (bool, error) {
+		return deviceID <= 2, nil
+	}
+	repository.GetDeviceDecommissionedAt = func(deviceID int64) (bool, *time.Time, error) {
+		if deviceID == 2 {
+			decommissionedAt := time.Now().Add(-time.Hour)
+			return true, &decommissionedAt, nil
+		}
+		return true, nil, nil
+	}
+	repository.GetQuarantinedDeviceIDs = func(deviceIDs []int64) ([]int64, error) { return nil, nil }
+
+	token, err := service.IssueDeviceToken(5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if deviceID, err := service.VerifyDeviceToken(token); err != nil || deviceID != 1 {
+		t.Errorf("Expected a token for device 1, got %d (%v)", deviceID, err)
+	}
+
+	if _, err := service.IssueDeviceToken(5, 2); !errors.Is(err, service.ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest for a decommissioned device, got %v", err)
+	}
+	if _, err := service.IssueDeviceToken(5, 3); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied without access, got %v", err)
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"main/repository"
+	"main/structs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrAddressPoolExhausted is returned when every address of the WireGuard network is in use
+var ErrAddressPoolExhausted = errors.New("WireGuard address pool exhausted")
+
+// WireGuardSettings describes the tunnel network, read from the WG_* environment variables
+type WireGuardSettings struct {
+	Network    *net.IPNet
+	HubAddress net.IP
+	ListenPort int
+	Endpoint   string // host:port the devices connect to
+}
+
+// hubKeyMutex keeps concurrent requests from generating two hub key pairs
+var hubKeyMutex sync.Mutex
+
+// LoadWireGuardSettings reads the tunnel settings, the defaults match kubernetes/wireguard2.yml
+func LoadWireGuardSettings() (WireGuardSettings, error) {
+	cidr := envOrDefault("WG_CIDR", "10.0.0.0/24")
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return WireGuardSettings{}, fmt.Errorf("invalid WG_CIDR %q", cidr)
+	}
+
+	hubAddress := net.ParseIP(envOrDefault("WG_HUB_ADDRESS", "10.0.0.1")).To4()
+	if hubAddress == nil || !network.Contains(hubAddress) {
+		return WireGuardSettings{}, fmt.Errorf("WG_HUB_ADDRESS must be an IPv4 address inside %s", cidr)
+	}
+
+	listenPort, err := strconv.Atoi(envOrDefault("WG_LISTEN_PORT", "51820"))
+	if err != nil || listenPort < 1 || listenPort > 65535 {
+		return WireGuardSettings{}, fmt.Errorf("invalid WG_LISTEN_PORT")
+	}
+
+	return WireGuardSettings{
+		Network:    network,
+		HubAddress: hubAddress,
+		ListenPort: listenPort,
+		Endpoint:   os.Getenv("WG_ENDPOINT"),
+	}, nil
+}
+
+// envOrDefault returns an environment variable, or fallback when it is not set
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// GenerateWireGuardKeyPair generates a Curve25519 key pair, base64 encoded like `wg genkey` and `wg pubkey`
+func GenerateWireGuardKeyPair() (privateKey string, publicKey string, err error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating WireGuard key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key.Bytes()), base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// WireGuardPublicKey derives the public key belonging to a base64 encoded private key
+func WireGuardPublicKey(privateKey string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid WireGuard private key: %w", err)
+	}
+	key, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid WireGuard private key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// NextWireGuardAddress returns the lowest host address of the network that is not the hub and not in use
+func NextWireGuardAddress(network *net.IPNet, hub net.IP, used []string) (string, error) {
+	taken := make(map[string]bool, len(used)+1)
+	for _, address := range used {
+		taken[address] = true
+	}
+	taken[hub.String()] = true
+
+	base := network.IP.To4()
+	ones, bits := network.Mask.Size()
+	size := uint32(1) << uint(bits-ones)
+	start := uint32(base[0])<<24 | uint32(base[1])<<16 | uint32(base[2])<<8 | uint32(base[3])
+
+	// Skip the network and broadcast address
+	for offset := uint32(1); offset+1 < size; offset++ {
+		value := start + offset
+		candidate := net.IPv4(byte(value>>24), byte(value>>16), byte(value>>8), byte(value)).String()
+		if !taken[candidate] {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no free address in %s", ErrAddressPoolExhausted, network)
+}
+
+// ProvisionWireGuardPeer generates a new key pair for a device and returns its wg-quick config. A device that
+// already has a peer keeps its tunnel address and gets its key rotated. The private key is not stored.
+func ProvisionWireGuardPeer(meberID, deviceID int64) (structs.WireGuardProvisioning, error) {
+	// Step 1: Check access and load the tunnel settings
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+	if err := CheckQuarantine([]int64{deviceID}); err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+	settings, err := LoadWireGuardSettings()
+	if err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+	_, hubPublicKey, err := wireGuardHubKeys()
+	if err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+
+	// Step 2: Generate the device key pair and store the peer with an allocated address
+	privateKey, publicKey, err := GenerateWireGuardKeyPair()
+	if err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+	peer, err := repository.UpsertWireGuardPeer(deviceID, publicKey, func(used []string) (string, error) {
+		return NextWireGuardAddress(settings.Network, settings.HubAddress, used)
+	})
+	if err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+
+	details := fmt.Sprintf("provisioned WireGuard peer %s with key %s", peer.Address, publicKey)
+	if err := repository.InsertAuditLog(&meberID, &deviceID, "wireguard_provisioned", details); err != nil {
+		return structs.WireGuardProvisioning{}, err
+	}
+
+	// Step 3: Render the device config, this is the only time the private key is handed out
+	return structs.WireGuardProvisioning{
+		Peer:   peer,
+		Config: RenderWireGuardPeerConfig(settings, peer.Address, privateKey, hubPublicKey),
+	}, nil
+}
+
+// GetWireGuardPeer retrieves the tunnel state of a device the meber has access to
+func GetWireGuardPeer(meberID, deviceID int64) (structs.WireGuardPeer, error) {
+	if err := checkDeviceAccess(meberID, []int64{deviceID}); err != nil {
+		return structs.WireGuardPeer{}, err
+	}
+	peers, err := repository.GetWireGuardPeers([]int64{deviceID})
+	if err != nil {
+		return structs.WireGuardPeer{}, err
+	}
+	if len(peers) == 0 {
+		return structs.WireGuardPeer{}, fmt.Errorf("%w: device %d has no WireGuard peer", ErrNotFound, deviceID)
+	}
+	return peers[0], nil
+}
+
+// GetWireGuardHubConfig renders the wg-quick config of the hub with every provisioned device as peer, admins only
+func GetWireGuardHubConfig(meberID int64) (string, error) {
+	if err := requireAdmin(meberID); err != nil {
+		return "", err
+	}
+	settings, err := LoadWireGuardSettings()
+	if err != nil {
+		return "", err
+	}
+	hubPrivateKey, _, err := wireGuardHubKeys()
+	if err != nil {
+		return "", err
+	}
+	peers, err := repository.GetWireGuardPeers(nil)
+	if err != nil {
+		return "", err
+	}
+	return RenderWireGuardHubConfig(settings, hubPrivateKey, peers), nil
+}
+
+// RecordWireGuardHandshakes stores the peer state the hub reports, admins only
+func RecordWireGuardHandshakes(meberID int64, handshakes []structs.WireGuardHandshake) (int, error) {
+	if err := requireAdmin(meberID); err != nil {
+		return 0, err
+	}
+	for _, handshake := range handshakes {
+		if _, err := base64.StdEncoding.DecodeString(handshake.PublicKey); err != nil || handshake.PublicKey == "" {
+			return 0, fmt.Errorf("%w: invalid public key %q", ErrInvalidRequest, handshake.PublicKey)
+		}
+		if handshake.LatestHandshake < 0 || handshake.TransferRx < 0 || handshake.TransferTx < 0 {
+			return 0, fmt.Errorf("%w: negative values for peer %s", ErrInvalidRequest, handshake.PublicKey)
+		}
+	}
+	return repository.UpdateWireGuardHandshakes(handshakes)
+}
+
+// wireGuardHubKeys returns the hub key pair, generating and storing it on first use
+func wireGuardHubKeys() (string, string, error) {
+	hubKeyMutex.Lock()
+	defer hubKeyMutex.Unlock()
+
+	privateKey, publicKey, err := repository.GetWireGuardHubKeys()
+	if err != nil || privateKey != "" {
+		return privateKey, publicKey, err
+	}
+
+	if privateKey, publicKey, err = GenerateWireGuardKeyPair(); err != nil {
+		return "", "", err
+	}
+	if err := repository.InsertWireGuardHubKeys(privateKey, publicKey); err != nil {
+		return "", "", err
+	}
+	// Another backend instance may have stored its key pair first, that one wins
+	return repository.GetWireGuardHubKeys()
+}
+
+// RenderWireGuardPeerConfig renders the wg-quick config of a device, routing the tunnel network through the hub
+func RenderWireGuardPeerConfig(settings WireGuardSettings, address, privateKey, hubPublicKey string) string {
+	ones, _ := settings.Network.Mask.Size()
+	var config strings.Builder
+	config.WriteString("[Interface]\n")
+	fmt.Fprintf(&config, "Address = %s/%d\n", address, ones)
+	fmt.Fprintf(&config, "PrivateKey = %s\n", privateKey)
+	config.WriteString("\n[Peer]\n")
+	fmt.Fprintf(&config, "PublicKey = %s\n", hubPublicKey)
+	if settings.Endpoint != "" {
+		fmt.Fprintf(&config, "Endpoint = %s\n", settings.Endpoint)
+	}
+	fmt.Fprintf(&config, "AllowedIPs = %s\n", settings.Network.String())
+	config.WriteString("PersistentKeepalive = 25\n")
+	return config.String()
+}
+
+// RenderWireGuardHubConfig renders the wg-quick config of the hub with one peer per device
+func RenderWireGuardHubConfig(settings WireGuardSettings, hubPrivateKey string, peers []structs.WireGuardPeer) string {
+	ones, _ := settings.Network.Mask.Size()
+	var config strings.Builder
+	config.WriteString("[Interface]\n")
+	fmt.Fprintf(&config, "Address = %s/%d\n", settings.HubAddress, ones)
+	fmt.Fprintf(&config, "ListenPort = %d\n", settings.ListenPort)
+	fmt.Fprintf(&config, "PrivateKey = %s\n", hubPrivateKey)
+	for _, peer := range peers {
+		fmt.Fprintf(&config, "\n# Device %d\n", peer.DeviceID)
+		config.WriteString("[Peer]\n")
+		fmt.Fprintf(&config, "PublicKey = %s\n", peer.PublicKey)
+		fmt.Fprintf(&config, "AllowedIPs = %s/32\n", peer.Address)
+	}
+	return config.String()
+}
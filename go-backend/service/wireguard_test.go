@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"errors"
+	"main/repository"
+	"main/service"
+	"main/structs"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestGenerateWireGuardKeyPair(t *testing.T) {
+	privateKey, publicKey, err := service.GenerateWireGuardKeyPair()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Keys are 32 bytes, base64 encoded like the output of `wg genkey`
+	if len(privateKey) != 44 || len(publicKey) != 44 {
+		t.Errorf("Expected 44 character keys, got %d and %d", len(privateKey), len(publicKey))
+	}
+
+	derived, err := service.WireGuardPublicKey(privateKey)
+	if err != nil {
+		t.Fatalf("Unexpected error deriving public key: %v", err)
+	}
+	if derived != publicKey {
+		t.Errorf("Public key %s does not belong to the private key, expected %s", publicKey, derived)
+	}
+}
+
+func TestNextWireGuardAddress(t *testing.T) {
+	_, network, _ := net.ParseCIDR("10.0.0.0/29")
+	hub := net.ParseIP("10.0.0.1")
+
+	tests := []struct {
+		name     string
+		used     []string
+		expected string
+		wantErr  bool
+	}{
+		{"First Free Address After Hub", nil, "10.0.0.2", false},
+		{"Skips Used Addresses", []string{"10.0.0.2", "10.0.0.3"}, "10.0.0.4", false},
+		{"Reuses Released Address", []string{"10.0.0.3", "10.0.0.4"}, "10.0.0.2", false},
+		{"Never Hands Out Broadcast", []string{"10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}, "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := service.NextWireGuardAddress(network, hub, tc.used)
+			if tc.wantErr {
+				if !errors.Is(err, service.ErrAddressPoolExhausted) {
+					t.Errorf("Expected ErrAddressPoolExhausted, got %v (%s)", err, address)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if address != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, address)
+			}
+		})
+	}
+}
+
+func TestRenderWireGuardConfigs(t *testing.T) {
+	_, network, _ := net.ParseCIDR("10.0.0.0/24")
+	settings := service.WireGuardSettings{
+		Network:    network,
+		HubAddress: net.ParseIP("10.0.0.1"),
+		ListenPort: 51820,
+		Endpoint:   "vpn.example.com:51820",
+	}
+
+	peerConfig := service.RenderWireGuardPeerConfig(settings, "10.0.0.7", "devicePrivateKey=", "hubPublicKey=")
+	for _, line := range []string{"Address = 10.0.0.7/24", "PrivateKey = devicePrivateKey=", "PublicKey = hubPublicKey=",
+		"Endpoint = vpn.example.com:51820", "AllowedIPs = 10.0.0.0/24", "PersistentKeepalive = 25"} {
+		if !strings.Contains(peerConfig, line+"\n") {
+			t.Errorf("Expected peer config to contain %q, got:\n%s", line, peerConfig)
+		}
+	}
+
+	peers := []structs.WireGuardPeer{{DeviceID: 3, Address: "10.0.0.2", PublicKey: "deviceThree="}, {DeviceID: 9, Address: "10.0.0.3", PublicKey: "deviceNine="}}
+	hubConfig := service.RenderWireGuardHubConfig(settings, "hubPrivateKey=", peers)
+	for _, line := range []string{"Address = 10.0.0.1/24", "ListenPort = 51820", "PrivateKey = hubPrivateKey=",
+		"PublicKey = deviceThree=", "AllowedIPs = 10.0.0.2/32", "PublicKey = deviceNine=", "AllowedIPs = 10.0.0.3/32"} {
+		if !strings.Contains(hubConfig, line+"\n") {
+			t.Errorf("Expected hub config to contain %q, got:\n%s", line, hubConfig)
+		}
+	}
+}
+
+func TestWireGuardHubConfigRequiresAdmin(t *testing.T) {
+	// Backup the original repository function and restore it after the test
+	originalGetRoles := repository.GetRolesForMeber
+	defer func() { repository.GetRolesForMeber = originalGetRoles }()
+
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 2, Name: "operator", IsRestricted: true}}, nil
+	}
+
+	if _, err := service.GetWireGuardHubConfig(5); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied for a non-admin, got %v", err)
+	}
+	if _, err := service.RecordWireGuardHandshakes(5, nil); !errors.Is(err, service.ErrAccessDenied) {
+		t.Errorf("Expected ErrAccessDenied for a non-admin, got %v", err)
+	}
+
+	// Admins may record the handshakes the hub reports
+	originalUpdate := repository.UpdateWireGuardHandshakes
+	defer func() { repository.UpdateWireGuardHandshakes = originalUpdate }()
+	repository.UpdateWireGuardHandshakes = func(handshakes []structs.WireGuardHandshake) (int, error) {
+		return len(handshakes), nil
+	}
+	repository.GetRolesForMeber = func(meberID int64) ([]structs.Role, error) {
+		return []structs.Role{{ID: 1, Name: "admin", IsAdmin: true}}, nil
+	}
+	handshakes := []structs.WireGuardHandshake{{PublicKey: "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=", LatestHandshake: 1700000000}}
+	if updated, err := service.RecordWireGuardHandshakes(1, handshakes); err != nil || updated != 1 {
+		t.Errorf("Expected an admin to record the handshakes, got %d, %v", updated, err)
+	}
+}
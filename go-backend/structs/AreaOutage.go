@@ -0,0 +1,31 @@
+package structs
+
+import "time"
+
+// OfflineDevice is a device that went offline outside maintenance and has not come back
+type OfflineDevice struct {
+	DeviceID int64  `json:"device_id"`
+	Name     string `json:"name"`
+	Coordinate
+	OfflineSince time.Time `json:"offline_since"`
+}
+
+// AreaOutage is an outage of an area, detected when neighbouring devices went offline around the same time
+type AreaOutage struct {
+	ID           int64              `json:"id"`
+	StartedAt    time.Time          `json:"started_at"` // When the first device of the outage went offline
+	DetectedAt   time.Time          `json:"detected_at"`
+	ResolvedAt   *time.Time         `json:"resolved_at"`
+	Area         Geometry           `json:"area"`          // GeoJSON Polygon around the devices
+	DeviceCount  int                `json:"device_count"`  // All devices in the outage, including ones the meber can't see
+	OfflineCount int                `json:"offline_count"` // Devices in the outage that are still offline
+	Devices      []AreaOutageDevice `json:"devices"`
+}
+
+// AreaOutageDevice is a device affected by an area outage with its current status
+type AreaOutageDevice struct {
+	DeviceID int64  `json:"device_id"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Coordinate
+}
@@ -0,0 +1,13 @@
+package structs
+
+import "time"
+
+// AuditLogEntry records an action performed on a device by a meber, a device or the system
+type AuditLogEntry struct {
+	ID        int64     `json:"id"`
+	MeberID   *int64    `json:"meber_id,omitempty"`
+	DeviceID  *int64    `json:"device_id,omitempty"`
+	Action    string    `json:"action"`
+	Details   string    `json:"details"`
+	Timestamp time.Time `json:"timestamp"`
+}
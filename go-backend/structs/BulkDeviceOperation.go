@@ -0,0 +1,32 @@
+package structs
+
+// BulkDeviceRequest describes a change applied to every device selected by the ID list and/or filter
+type BulkDeviceRequest struct {
+	DeviceIDs  []int64           `json:"device_ids"`
+	Filter     *DeviceFilter     `json:"filter"`
+	AddTags    []string          `json:"add_tags"`
+	RemoveTags []string          `json:"remove_tags"`
+	Status     string            `json:"status"` // Status override, left empty to keep the current status
+	Attributes *DeviceAttributes `json:"attributes"`
+}
+
+// DeviceAttributes holds the device attributes that can be updated in bulk, nil fields are left unchanged
+type DeviceAttributes struct {
+	ConnectionType *string `json:"connection_type"`
+	IPAddress      *string `json:"ip_address"`
+}
+
+// BulkDeviceResult is the outcome of a bulk operation for a single device
+type BulkDeviceResult struct {
+	DeviceID int64  `json:"device_id"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BulkDeviceReport summarises a bulk operation
+type BulkDeviceReport struct {
+	Total     int                `json:"total"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []BulkDeviceResult `json:"results"`
+}
@@ -0,0 +1,60 @@
+package structs
+
+import "time"
+
+// ConnectivitySample is a single measurement of the connection quality reported by a device
+type ConnectivitySample struct {
+	Timestamp         time.Time `json:"timestamp"`
+	SignalDBM         *int      `json:"signal_dbm"` // Only reported by wireless devices
+	LatencyMS         float64   `json:"latency_ms"`
+	PacketLossPercent float64   `json:"packet_loss_percent"`
+}
+
+// ConnectivityBucket holds the averaged connection quality of all samples within one bucket
+type ConnectivityBucket struct {
+	BucketStart          time.Time `json:"bucket_start"`
+	AvgSignalDBM         *float64  `json:"avg_signal_dbm"` // Nil when no sample in the bucket had a signal strength
+	AvgLatencyMS         float64   `json:"avg_latency_ms"`
+	MaxLatencyMS         float64   `json:"max_latency_ms"`
+	AvgPacketLossPercent float64   `json:"avg_packet_loss_percent"`
+	Count                int64     `json:"count"`
+}
+
+// ConnectivityHistory is the response for the connection quality history of a single device
+type ConnectivityHistory struct {
+	DeviceID      int64                `json:"device_id"`
+	Start         time.Time            `json:"start"`
+	End           time.Time            `json:"end"`
+	BucketSeconds int64                `json:"bucket_seconds"`
+	Buckets       []ConnectivityBucket `json:"buckets"`
+	StatusChanges int                  `json:"status_changes"` // Changes from and to offline in the range
+	Flapping      bool                 `json:"flapping"`
+}
+
+// DeviceConnectivity summarizes the connection quality of a device over a period
+type DeviceConnectivity struct {
+	DeviceID             int64    `json:"device_id"`
+	Name                 string   `json:"name"`
+	ConnectionType       string   `json:"connection_type"`
+	Municipality         string   `json:"municipality"` // Empty when the device has no location tag
+	SampleCount          int64    `json:"sample_count"`
+	AvgSignalDBM         *float64 `json:"avg_signal_dbm"`
+	AvgLatencyMS         float64  `json:"avg_latency_ms"`
+	AvgPacketLossPercent float64  `json:"avg_packet_loss_percent"`
+	StatusChanges        int      `json:"status_changes"`
+	Flapping             bool     `json:"flapping"`
+	Score                float64  `json:"score"` // Higher is worse, filled in by the service
+}
+
+// MunicipalityConnectivity lists the worst connected devices of a municipality, worst first
+type MunicipalityConnectivity struct {
+	Municipality string               `json:"municipality"`
+	Devices      []DeviceConnectivity `json:"devices"`
+}
+
+// ConnectivityRanking is the response of the worst connected devices per municipality
+type ConnectivityRanking struct {
+	Start          time.Time                  `json:"start"`
+	End            time.Time                  `json:"end"`
+	Municipalities []MunicipalityConnectivity `json:"municipalities"`
+}
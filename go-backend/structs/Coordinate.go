@@ -0,0 +1,17 @@
+package structs
+
+// Coordinate is a WGS84 position in degrees. The JSON API uses latitude and longitude fields, the database
+// stores it as POINT(longitude, latitude) like GeoJSON does, so ST_X is the longitude and ST_Y the latitude.
+type Coordinate struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// NearbyDevice is a device found by a spatial query
+type NearbyDevice struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Coordinate
+	DistanceMeters *float64 `json:"distance_meters,omitempty"` // Distance to the queried point, nil for bounding box queries
+}
@@ -0,0 +1,43 @@
+package structs
+
+import "time"
+
+// DeviceAsset is the asset information of a device, next to its operational data
+type DeviceAsset struct {
+	DeviceID        int64      `json:"device_id"`
+	Name            string     `json:"name"`
+	SerialNumber    string     `json:"serial_number"`
+	Vendor          string     `json:"vendor"`
+	FirmwareVersion string     `json:"firmware_version"`
+	InstalledOn     *time.Time `json:"installed_on"` // Date only
+	WarrantyEnd     *time.Time `json:"warranty_end"` // Date only
+	LifecycleStage  string     `json:"lifecycle_stage"`
+	StageChangedAt  time.Time  `json:"stage_changed_at"`
+}
+
+// DeviceAssetUpdate changes the asset information of a device, only fields that are set are written. Dates are
+// given as YYYY-MM-DD, an empty string clears a field.
+type DeviceAssetUpdate struct {
+	DeviceID        int64   `json:"device_id"`
+	SerialNumber    *string `json:"serial_number"`
+	Vendor          *string `json:"vendor"`
+	FirmwareVersion *string `json:"firmware_version"`
+	InstalledOn     *string `json:"installed_on"`
+	WarrantyEnd     *string `json:"warranty_end"`
+}
+
+// LifecycleTransitionRequest moves a device to another lifecycle stage
+type LifecycleTransitionRequest struct {
+	DeviceID int64  `json:"device_id"`
+	Stage    string `json:"stage"`
+	Reason   string `json:"reason"`
+}
+
+// AssetReport lists the devices that need attention from asset management
+type AssetReport struct {
+	GeneratedAt        time.Time     `json:"generated_at"`
+	WarrantyDays       int           `json:"warranty_days"` // Horizon for NearingWarrantyEnd
+	StuckDays          int           `json:"stuck_days"`    // Time in ordered or installed after which a device is stuck
+	NearingWarrantyEnd []DeviceAsset `json:"nearing_warranty_end"`
+	StuckInStage       []DeviceAsset `json:"stuck_in_stage"`
+}
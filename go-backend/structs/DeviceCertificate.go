@@ -0,0 +1,35 @@
+package structs
+
+import "time"
+
+// DeviceCertificate is a client certificate issued by the local CA, bound to a single device
+type DeviceCertificate struct {
+	Serial           string     `json:"serial"`
+	DeviceID         int64      `json:"device_id"`
+	Fingerprint      string     `json:"fingerprint"` // SHA-256 of the DER encoded certificate
+	NotBefore        time.Time  `json:"not_before"`
+	NotAfter         time.Time  `json:"not_after"`
+	IssuedAt         time.Time  `json:"issued_at"`
+	RevokedAt        *time.Time `json:"revoked_at"`
+	RevocationReason string     `json:"revocation_reason,omitempty"`
+}
+
+// DeviceCertificateBundle is handed to a device on enrollment and renewal
+type DeviceCertificateBundle struct {
+	Certificate      DeviceCertificate `json:"certificate"`
+	CertificatePEM   string            `json:"certificate_pem"`
+	CACertificatePEM string            `json:"ca_certificate_pem"`
+}
+
+// EnrollmentToken is a one-time token a device exchanges for its first certificate
+type EnrollmentToken struct {
+	DeviceID  int64     `json:"device_id"`
+	Token     string    `json:"token"` // Only returned when the token is created
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// EnrollmentRequest is sent by a device to enroll, the CSR is PEM encoded
+type EnrollmentRequest struct {
+	Token string `json:"token"`
+	CSR   string `json:"csr"`
+}
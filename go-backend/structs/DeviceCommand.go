@@ -0,0 +1,37 @@
+package structs
+
+import "time"
+
+// DeviceCommand is a command queued for an edge device, e.g. a reboot or diagnostics collection
+type DeviceCommand struct {
+	ID             int64      `json:"id"`
+	DeviceID       int64      `json:"device_id"`
+	Type           string     `json:"type"`
+	AppInstanceID  *int64     `json:"app_instance_id,omitempty"` // Only set for restart_application
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	MaxAttempts    int        `json:"max_attempts"`
+	TimeoutSeconds int        `json:"timeout_seconds"`
+	Result         *string    `json:"result,omitempty"`
+	CreatedBy      int64      `json:"created_by"`
+	CreatedAt      time.Time  `json:"created_at"`
+	SentAt         *time.Time `json:"sent_at,omitempty"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
+
+// DeviceCommandRequest queues a command for a list of devices and/or the members of a device group
+type DeviceCommandRequest struct {
+	Type           string  `json:"type"`
+	DeviceIDs      []int64 `json:"device_ids"`
+	GroupID        int64   `json:"group_id"`
+	AppInstanceID  *int64  `json:"app_instance_id"`
+	TimeoutSeconds int     `json:"timeout_seconds"`
+	MaxAttempts    int     `json:"max_attempts"`
+}
+
+// DeviceCommandResult is what a device reports after executing a command
+type DeviceCommandResult struct {
+	CommandID int64  `json:"command_id"`
+	Success   bool   `json:"success"`
+	Output    string `json:"output"`
+}
@@ -0,0 +1,20 @@
+package structs
+
+// DeviceFilter selects devices on their attributes, every non-empty field narrows the selection
+type DeviceFilter struct {
+	DeviceIDs       []int64  `json:"device_ids,omitempty"`
+	Statuses        []string `json:"statuses,omitempty"`
+	ConnectionTypes []string `json:"connection_types,omitempty"`
+	Municipalities  []string `json:"municipalities,omitempty"` // Device has one of these location tags
+	Tags            []string `json:"tags,omitempty"`           // Device has at least one of these tags
+	Sensors         []string `json:"sensors,omitempty"`        // Device has all of these sensors
+	Applications    []string `json:"applications,omitempty"`   // Device runs at least one of these applications
+	NameContains    string   `json:"name_contains,omitempty"`
+}
+
+// IsEmpty reports whether the filter has no conditions at all
+func (f DeviceFilter) IsEmpty() bool {
+	return len(f.DeviceIDs) == 0 && len(f.Statuses) == 0 && len(f.ConnectionTypes) == 0 &&
+		len(f.Municipalities) == 0 && len(f.Tags) == 0 && len(f.Sensors) == 0 &&
+		len(f.Applications) == 0 && f.NameContains == ""
+}
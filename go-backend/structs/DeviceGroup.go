@@ -0,0 +1,11 @@
+package structs
+
+// DeviceGroup is a named set of devices, either with explicit members (static) or defined by a saved filter
+type DeviceGroup struct {
+	ID      int64         `json:"id"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type"` // "static" or "filter"
+	Filter  *DeviceFilter `json:"filter,omitempty"`
+	OwnerID int64         `json:"owner_id"`
+	RoleID  *int64        `json:"role_id"` // Nullable, set when the group is shared with a role
+}
@@ -0,0 +1,43 @@
+package structs
+
+// DeviceInventoryRecord is one device as exported to, or imported from, the asset inventory
+type DeviceInventoryRecord struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	ConnectionType string `json:"connection_type"`
+	IPAddress      string `json:"ip_address"`
+	Coordinate
+	Municipality string   `json:"municipality"`
+	Tags         []string `json:"tags"`         // Tags other than the municipality
+	Sensors      []string `json:"sensors"`      // Sensor names
+	Applications []string `json:"applications"` // Export only, applications are installed through the desired state
+}
+
+// DeviceImportRow is a validated import row and what applying it will do
+type DeviceImportRow struct {
+	Row            int                   `json:"row"`    // Line number in the CSV, the header is line 1
+	Action         string                `json:"action"` // "create" or "update"
+	Record         DeviceInventoryRecord `json:"record"`
+	Fields         map[string]bool       `json:"-"` // Columns with a value on this row, only those are written on update
+	MunicipalityID *int64                `json:"-"`
+	TagIDs         []int64               `json:"-"`
+	SensorIDs      []int64               `json:"-"`
+}
+
+// DeviceImportError is a validation error on a single row of an import
+type DeviceImportError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// DeviceImportReport is the outcome of an import, nothing is applied when it contains errors
+type DeviceImportReport struct {
+	DryRun  bool                `json:"dry_run"`
+	Applied bool                `json:"applied"`
+	Created int                 `json:"created"`
+	Updated int                 `json:"updated"`
+	Rows    []DeviceImportRow   `json:"rows"`
+	Errors  []DeviceImportError `json:"errors"`
+}
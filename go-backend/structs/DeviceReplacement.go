@@ -0,0 +1,23 @@
+package structs
+
+import "time"
+
+// DeviceReplacementRequest replaces failed hardware with a new unit at the same location
+type DeviceReplacementRequest struct {
+	OldDeviceID    int64  `json:"old_device_id"`
+	Name           string `json:"name"`            // Defaults to the name of the old device
+	ConnectionType string `json:"connection_type"` // Defaults to the connection type of the old device
+	IPAddress      string `json:"ip_address"`
+	DeviceTypeID   *int64 `json:"device_type_id"` // Defaults to the type of the old device
+	Reason         string `json:"reason"`
+}
+
+// DeviceReplacement links a decommissioned device to the device that replaced it
+type DeviceReplacement struct {
+	ID          int64     `json:"id"`
+	OldDeviceID int64     `json:"old_device_id"`
+	NewDeviceID int64     `json:"new_device_id"`
+	ReplacedBy  *int64    `json:"replaced_by"`
+	Reason      string    `json:"reason"`
+	ReplacedAt  time.Time `json:"replaced_at"`
+}
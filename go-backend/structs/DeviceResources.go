@@ -0,0 +1,37 @@
+package structs
+
+import "time"
+
+// DeviceResourceReport is the resource usage and capacity a device reports about itself
+type DeviceResourceReport struct {
+	CPUCores        int     `json:"cpu_cores"`
+	CPUUsagePercent float64 `json:"cpu_usage_percent"`
+	MemoryTotalMB   int64   `json:"memory_total_mb"`
+	MemoryUsedMB    int64   `json:"memory_used_mb"`
+	DiskTotalMB     int64   `json:"disk_total_mb"`
+	DiskUsedMB      int64   `json:"disk_used_mb"`
+}
+
+// DeviceResources are the last reported resources of a device. A device that never reported falls back to the
+// capacity of its device type with no usage, ReportedAt is nil then.
+type DeviceResources struct {
+	DeviceID int64 `json:"device_id"`
+	DeviceResourceReport
+	ReportedAt *time.Time        `json:"reported_at"`
+	Headroom   *ResourceHeadroom `json:"headroom,omitempty"` // Filled in by the service
+}
+
+// ResourceHeadroom is what is left on a device after its usage and the applications it still has to install
+type ResourceHeadroom struct {
+	CPUMillicores int64 `json:"cpu_millicores"`
+	MemoryMB      int64 `json:"memory_mb"`
+	DiskMB        int64 `json:"disk_mb"`
+}
+
+// ResourceRequirements are the resources an application needs on a device
+type ResourceRequirements struct {
+	AppID         int64 `json:"app_id"`
+	CPUMillicores int64 `json:"cpu_millicores"`
+	MemoryMB      int64 `json:"memory_mb"`
+	DiskMB        int64 `json:"disk_mb"`
+}
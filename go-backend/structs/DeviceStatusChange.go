@@ -0,0 +1,12 @@
+package structs
+
+import "time"
+
+// DeviceStatusChange is a recorded transition of a device's status
+type DeviceStatusChange struct {
+	DeviceID       int64     `json:"device_id"`
+	PreviousStatus *string   `json:"previous_status"`
+	Status         string    `json:"status"`
+	InMaintenance  bool      `json:"in_maintenance"`
+	Timestamp      time.Time `json:"timestamp"`
+}
@@ -0,0 +1,52 @@
+package structs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DesiredApplication is an application the backend wants to run on a device
+type DesiredApplication struct {
+	AppID   int64           `json:"app_id"`
+	Name    string          `json:"name"`
+	Version string          `json:"version"`
+	Config  json.RawMessage `json:"config,omitempty"` // Empty when the application runs with its default config
+}
+
+// ReportedApplication is an application a device reports as actually running
+type ReportedApplication struct {
+	AppID   int64           `json:"app_id"`
+	Name    string          `json:"name"`
+	Version string          `json:"version"`
+	Config  json.RawMessage `json:"config,omitempty"`
+	Status  string          `json:"status"` // "online", "offline", "error" or "warning"
+}
+
+// DeviceTwinDrift is a single difference between the desired and reported state of a device
+type DeviceTwinDrift struct {
+	AppID           int64  `json:"app_id"`
+	Name            string `json:"name"`
+	Type            string `json:"type"` // "missing", "unexpected", "version_mismatch" or "config_mismatch"
+	DesiredVersion  string `json:"desired_version,omitempty"`
+	ReportedVersion string `json:"reported_version,omitempty"`
+}
+
+// DeviceTwin combines the desired and reported state of a device with the drift between them
+type DeviceTwin struct {
+	DeviceID   int64                 `json:"device_id"`
+	DeviceName string                `json:"device_name"`
+	Desired    []DesiredApplication  `json:"desired"`
+	Reported   []ReportedApplication `json:"reported"`
+	ReportedAt *time.Time            `json:"reported_at"` // Nil when the device never reported its state
+	Drift      []DeviceTwinDrift     `json:"drift"`
+	InSync     bool                  `json:"in_sync"`
+}
+
+// DesiredApplicationRequest sets the desired version and config of an application on devices or a device group
+type DesiredApplicationRequest struct {
+	AppID     int64           `json:"application_id"`
+	DeviceIDs []int64         `json:"device_ids"`
+	GroupID   int64           `json:"group_id"`
+	Version   string          `json:"version"` // Defaults to the catalog version of the application
+	Config    json.RawMessage `json:"config"`
+}
@@ -0,0 +1,55 @@
+package structs
+
+// DeviceType is a hardware model in the device type catalog
+type DeviceType struct {
+	ID           int64    `json:"id"`
+	Vendor       string   `json:"vendor"`
+	Model        string   `json:"model"`
+	Capabilities []string `json:"capabilities"`
+	SensorIDs    []int64  `json:"sensor_ids"` // Sensors the model supports
+	CPUCores     int      `json:"cpu_cores"`  // Resource capacity, 0 when unknown
+	MemoryMB     int      `json:"memory_mb"`
+	StorageMB    int      `json:"storage_mb"`
+}
+
+// DeviceTypeAssignment sets the type of existing devices
+type DeviceTypeAssignment struct {
+	DeviceTypeID int64   `json:"device_type_id"`
+	DeviceIDs    []int64 `json:"device_ids"`
+}
+
+// ApplicationCapabilities are the capabilities a device type needs to run an application
+type ApplicationCapabilities struct {
+	AppID        int64    `json:"app_id"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// ProvisioningTemplate holds the defaults applied to a newly registered device. A template only applies to devices
+// of its type and in its municipality, when those are set.
+type ProvisioningTemplate struct {
+	ID             int64   `json:"id"`
+	Name           string  `json:"name"`
+	DeviceTypeID   *int64  `json:"device_type_id"`
+	MunicipalityID *int64  `json:"municipality_id"` // Location tag
+	TagIDs         []int64 `json:"tag_ids"`
+	SensorIDs      []int64 `json:"sensor_ids"`
+	AppIDs         []int64 `json:"app_ids"` // Added to the desired state of the device
+	CreatedBy      int64   `json:"created_by"`
+}
+
+// DeviceRegistrationRequest registers a new device
+type DeviceRegistrationRequest struct {
+	Name           string   `json:"name"`
+	ConnectionType string   `json:"connection_type"`
+	IPAddress      string   `json:"ip_address"`
+	Latitude       *float64 `json:"latitude"` // Required, a pointer to tell a missing value from 0
+	Longitude      *float64 `json:"longitude"`
+	DeviceTypeID   *int64   `json:"device_type_id"`
+	MunicipalityID *int64   `json:"municipality_id"` // Location tag
+}
+
+// DeviceRegistration is the outcome of registering a device
+type DeviceRegistration struct {
+	DeviceID         int64   `json:"device_id"`
+	AppliedTemplates []int64 `json:"applied_templates"`
+}
@@ -3,25 +3,24 @@ package structs
 import "time"
 
 type EdgeDevice struct {
-	ID                int64     `json:"id"`
-	Name              string    `json:"name"`
-	Status            string    `json:"status"`
-	LastContact       time.Time `json:"last_contact"`
-	ConnectionType    string    `json:"connection_type"`
-	Latitude          float64   `json:"latitude"`
-	Longitude         float64   `json:"longitude"`
-	IPAddress         string    `json:"ip_address"`
-	Municipality      string    `json:"municipality"`
-	PerformanceMetric float64   `json:"performance_metric"`
+	ID             int64     `json:"id"`
+	Name           string    `json:"name"`
+	Status         string    `json:"status"`
+	LastContact    time.Time `json:"last_contact"`
+	ConnectionType string    `json:"connection_type"`
+	Coordinate
+	IPAddress         string  `json:"ip_address"`
+	Municipality      string  `json:"municipality"`
+	PerformanceMetric float64 `json:"performance_metric"`
 }
 
 type EdgeDeviceMapResponse struct {
-	ID           int64   `json:"id"`
-	Name         string  `json:"name"`
-	Status       string  `json:"status"`
-	Municipality string  `json:"municipality"`
-	Latitude     float64 `json:"latitude"`
-	Longitude    float64 `json:"longitude"`
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	Municipality string `json:"municipality"`
+	Coordinate
+	Quarantined bool `json:"quarantined"`
 }
 
 // ApplicationWithSensors represents an application and its associated sensors
@@ -30,6 +29,9 @@ type ApplicationWithSensors struct {
 	Name        string   `json:"name"`
 	Description string   `json:"description"`
 	Sensors     []Sensor `json:"sensors"`
+
+	RequiredCapabilities []string              `json:"required_capabilities"` // Capabilities the device type needs
+	ResourceRequirements *ResourceRequirements `json:"resource_requirements"` // Nil when the application declares none
 }
 
 // EligibleDevice represents a device and its eligibility status for installing an application
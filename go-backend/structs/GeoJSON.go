@@ -0,0 +1,39 @@
+package structs
+
+// FeatureCollection is a GeoJSON FeatureCollection (RFC 7946), positions are given as [longitude, latitude]
+type FeatureCollection struct {
+	Type     string    `json:"type"` // Always FeatureCollection
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON Feature, a geometry with the properties of what it shows
+type Feature struct {
+	Type       string                 `json:"type"` // Always Feature
+	ID         interface{}            `json:"id,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Geometry is a GeoJSON geometry, the shape of Coordinates depends on the type such as Point or MultiPolygon
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// NewFeatureCollection wraps the features in a FeatureCollection, an empty collection has an empty features array
+func NewFeatureCollection(features []Feature) FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+// PointFeature returns a Point feature at the coordinate
+func PointFeature(id interface{}, coordinate Coordinate, properties map[string]interface{}) Feature {
+	return Feature{
+		Type:       "Feature",
+		ID:         id,
+		Geometry:   Geometry{Type: "Point", Coordinates: []float64{coordinate.Longitude, coordinate.Latitude}},
+		Properties: properties,
+	}
+}
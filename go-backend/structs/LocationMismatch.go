@@ -0,0 +1,10 @@
+package structs
+
+// LocationMismatch is a device whose location tag does not match the municipality its coordinates lie in
+type LocationMismatch struct {
+	DeviceID             int64  `json:"device_id"`
+	Name                 string `json:"name"`
+	Coordinate                  // Position of the device
+	Municipality         string `json:"municipality"`          // Location tag, empty when the device has none
+	ExpectedMunicipality string `json:"expected_municipality"` // Municipality at the coordinates, empty outside all of them
+}
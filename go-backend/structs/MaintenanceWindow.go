@@ -0,0 +1,18 @@
+package structs
+
+import "time"
+
+// MaintenanceWindow is a one-off or recurring period in which devices are expected to be worked on
+type MaintenanceWindow struct {
+	ID                   int64      `json:"id"`
+	Name                 string     `json:"name"`
+	TargetType           string     `json:"target_type"` // "device", "group" or "municipality"
+	TargetID             int64      `json:"target_id"`
+	StartsAt             time.Time  `json:"starts_at"`
+	EndsAt               time.Time  `json:"ends_at"`    // End of the first occurrence
+	Recurrence           string     `json:"recurrence"` // "none", "daily" or "weekly"
+	RecurrenceUntil      *time.Time `json:"recurrence_until,omitempty"`
+	InstallsOnlyInWindow bool       `json:"installs_only_in_window"`
+	CreatedBy            int64      `json:"created_by"`
+	Active               bool       `json:"active"` // Computed, true while an occurrence is running
+}
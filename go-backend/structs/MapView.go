@@ -0,0 +1,25 @@
+package structs
+
+// BoundingBox is the area of the map in view, in degrees
+type BoundingBox struct {
+	MinLongitude float64 `json:"min_longitude"`
+	MinLatitude  float64 `json:"min_latitude"`
+	MaxLongitude float64 `json:"max_longitude"`
+	MaxLatitude  float64 `json:"max_latitude"`
+}
+
+// MapCluster is a group of nearby devices shown as a single marker
+type MapCluster struct {
+	Coordinate                  // Centroid of the devices in the cluster
+	Count        int            `json:"count"`
+	StatusCounts map[string]int `json:"status_counts"`
+	Bounds       BoundingBox    `json:"bounds"` // Zooming to these bounds splits the cluster
+}
+
+// MapView is the response of /map for a bounding box and zoom level. Devices that are not close to any other
+// device are returned individually, at high zoom levels all of them are.
+type MapView struct {
+	Zoom     int                     `json:"zoom"`
+	Clusters []MapCluster            `json:"clusters"`
+	Devices  []EdgeDeviceMapResponse `json:"devices"`
+}
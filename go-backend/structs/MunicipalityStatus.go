@@ -0,0 +1,15 @@
+package structs
+
+import "time"
+
+// MunicipalityStatus aggregates the health of the accessible devices in a municipality, for coloring its polygon
+type MunicipalityStatus struct {
+	Municipality            string         `json:"municipality"` // Empty for devices without a location tag
+	Code                    string         `json:"code"`         // Municipality code of the boundary, empty when there is none
+	Devices                 int            `json:"devices"`
+	DeviceStatusCounts      map[string]int `json:"device_status_counts"`
+	Applications            int            `json:"applications"`
+	ApplicationStatusCounts map[string]int `json:"application_status_counts"`
+	ErrorRate               float64        `json:"error_rate"`       // Share of the devices offline, in error or with an app issue
+	LastIncidentAt          *time.Time     `json:"last_incident_at"` // Last time a device left online outside maintenance
+}
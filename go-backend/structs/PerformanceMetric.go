@@ -0,0 +1,27 @@
+package structs
+
+import "time"
+
+// PerformanceMetricSample is a single performance metric value reported by a device
+type PerformanceMetricSample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// PerformanceMetricBucket holds the downsampled values of all samples that fall within one bucket
+type PerformanceMetricBucket struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Min         float64   `json:"min"`
+	Max         float64   `json:"max"`
+	Avg         float64   `json:"avg"`
+	Count       int64     `json:"count"`
+}
+
+// PerformanceMetricSeries is the response for a metric range query of a single device
+type PerformanceMetricSeries struct {
+	DeviceID      int64                     `json:"device_id"`
+	Start         time.Time                 `json:"start"`
+	End           time.Time                 `json:"end"`
+	BucketSeconds int64                     `json:"bucket_seconds"`
+	Buckets       []PerformanceMetricBucket `json:"buckets"`
+}
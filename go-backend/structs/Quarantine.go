@@ -0,0 +1,18 @@
+package structs
+
+import "time"
+
+// QuarantinedDevice is a device that is isolated because it may be compromised
+type QuarantinedDevice struct {
+	DeviceID      int64     `json:"device_id"`
+	Name          string    `json:"name"`
+	QuarantinedAt time.Time `json:"quarantined_at"`
+	QuarantinedBy *int64    `json:"quarantined_by"`
+	Reason        string    `json:"reason"`
+}
+
+// QuarantineRequest puts a device in quarantine or releases it, a reason is always required
+type QuarantineRequest struct {
+	DeviceID int64  `json:"device_id"`
+	Reason   string `json:"reason"`
+}
@@ -6,4 +6,6 @@ type Role struct {
 	Description  string `json:"description"`
 	IsAdmin      bool   `json:"is_admin"`
 	IsRestricted bool   `json:"is_restricted"`
+	// HasAccessArea is set when the role grants the devices inside its access area, see /roles/access-area
+	HasAccessArea bool `json:"has_access_area"`
 }
@@ -0,0 +1,37 @@
+package structs
+
+import "time"
+
+// FleetSnapshot is the status of the fleet at a moment in the past
+type FleetSnapshot struct {
+	At      time.Time        `json:"at"`
+	Devices []PlaybackDevice `json:"devices"`
+}
+
+// PlaybackDevice is a device on the map with the status it had at the moment of the snapshot
+type PlaybackDevice struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Municipality string `json:"municipality"`
+	Coordinate
+	Status        string `json:"status"`
+	InMaintenance bool   `json:"in_maintenance"`
+}
+
+// StatusChange is a recorded change of the status of a device
+type StatusChange struct {
+	DeviceID       int64     `json:"device_id"`
+	PreviousStatus *string   `json:"previous_status"`
+	Status         string    `json:"status"`
+	InMaintenance  bool      `json:"in_maintenance"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// StatusChangeStream holds the status changes between two moments, meant to be applied on top of the snapshot at
+// From. Truncated is set when the changes were cut off at the limit, the next page starts at the last timestamp.
+type StatusChangeStream struct {
+	From      time.Time      `json:"from"`
+	To        time.Time      `json:"to"`
+	Changes   []StatusChange `json:"changes"`
+	Truncated bool           `json:"truncated"`
+}
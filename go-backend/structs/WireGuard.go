@@ -0,0 +1,30 @@
+package structs
+
+import "time"
+
+// WireGuardPeer is the tunnel configuration and last reported state of a device
+type WireGuardPeer struct {
+	DeviceID        int64      `json:"device_id"`
+	Address         string     `json:"address"`
+	PublicKey       string     `json:"public_key"`
+	CreatedAt       time.Time  `json:"created_at"`
+	LatestHandshake *time.Time `json:"latest_handshake"` // Nil until the hub reported a handshake
+	Endpoint        string     `json:"endpoint"`
+	TransferRx      int64      `json:"transfer_rx"`
+	TransferTx      int64      `json:"transfer_tx"`
+}
+
+// WireGuardProvisioning is returned once when a peer is provisioned, it is the only time the private key is shown
+type WireGuardProvisioning struct {
+	Peer   WireGuardPeer `json:"peer"`
+	Config string        `json:"config"` // wg-quick configuration for the device
+}
+
+// WireGuardHandshake is the state of one peer as reported by the hub, matching a line of `wg show wg0 dump`
+type WireGuardHandshake struct {
+	PublicKey       string `json:"public_key"`
+	LatestHandshake int64  `json:"latest_handshake"` // Unix seconds, 0 when there was no handshake yet
+	Endpoint        string `json:"endpoint"`
+	TransferRx      int64  `json:"transfer_rx"`
+	TransferTx      int64  `json:"transfer_tx"`
+}
@@ -415,7 +415,9 @@ export default function DeviceTable({ devices, isInstallMode, onDeviceToggle, se
                                     : device.status === 'warning' ||
                                     device.status === 'app_issue'
                                         ? 'bg-yellow-500 text-black'
-                                        : 'bg-red-500 text-white'
+                                        : device.status === 'maintenance'
+                                            ? 'bg-blue-500 text-white'
+                                            : 'bg-red-500 text-white'
                         } px-2 py-1`}
                     >
                       {device.status === 'app_issue' ? 'App Issue' : device.status}
@@ -15,8 +15,7 @@ import "leaflet.markercluster/dist/MarkerCluster.Default.css";
 import "leaflet.markercluster";
 import "leaflet-draw/dist/leaflet.draw.css";
 
-// Import high-level GeoJSON data
-import { stedinGeojson } from "@/data/StedinGeojson";
+const backendUrl = process.env.NEXT_PUBLIC_BACKEND_URL;
 
 // Create custom icons for markers
 const createCustomIcon = (imageName) => L.icon({
@@ -74,7 +73,7 @@ const getRegionColor = (regionData) => {
   return `rgba(${r}, ${g}, ${b}, 0.5)`;
 };
 
-function MapContent({ geoLevel, onItemClick, selectedItems, onDragSelect, onDragDeselect, mapData, aggregatedData, isDeselectMode }) {
+function MapContent({ boundaries, geoLevel, onItemClick, selectedItems, onDragSelect, onDragDeselect, mapData, aggregatedData, isDeselectMode }) {
   const map = useMap();
   const featureGroupRef = useRef();
   const markerClusterGroupRef = useRef();
@@ -104,19 +103,20 @@ function MapContent({ geoLevel, onItemClick, selectedItems, onDragSelect, onDrag
       }
 
       const markers = mapData.map((feature) => {
-        const { latitude, longitude, name, status, municipality } = feature;
+        const { latitude, longitude, name, status, municipality, quarantined } = feature;
 
         const isSelected = selectedItems.some(item =>
           (typeof item === 'string' && item === name) ||
           (typeof item === 'object' && item.name === name)
         );
 
-        let icon = isSelected ? SelectedIcon : status.toLowerCase() === "online" ? OnlineIcon : OfflineIcon;
+        // Quarantined devices are isolated, so they never show as healthy
+        let icon = isSelected ? SelectedIcon : status.toLowerCase() === "online" && !quarantined ? OnlineIcon : OfflineIcon;
 
         // Only create a marker if it's selected or not in deselect mode
         if (!isDeselectMode || isSelected) {
-          return L.marker([latitude, longitude], { icon, status })
-            .bindPopup(name)
+          return L.marker([latitude, longitude], { icon, status, quarantined })
+            .bindPopup(quarantined ? `${name} (quarantined)` : name)
             .on('click', () => onItemClick(name, municipality, status));
         }
         return null;
@@ -127,6 +127,7 @@ function MapContent({ geoLevel, onItemClick, selectedItems, onDragSelect, onDrag
         iconCreateFunction: (cluster) => {
           const childMarkers = cluster.getAllChildMarkers();
           const hasIssue = childMarkers.some(marker => 
+            marker.options.quarantined ||
             ['error', 'app_issue', 'offline'].includes(marker.options.status.toLowerCase())
           );
           
@@ -176,10 +177,10 @@ function MapContent({ geoLevel, onItemClick, selectedItems, onDragSelect, onDrag
 
   return (
     <>
-      {geoLevel === 0 && (
+      {geoLevel === 0 && boundaries && (
         <GeoJSON
           key="high-level"
-          data={stedinGeojson}
+          data={boundaries}
           style={(feature) => {
             const regionName = feature.properties.name;
             const regionData = aggregatedData[regionName];
@@ -231,8 +232,31 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
   const [isDeselectMode, setIsDeselectMode] = useState(false);
   const [mapKey, setMapKey] = useState(0);
   const [aggregatedData, setAggregatedData] = useState({});
+  const [boundaries, setBoundaries] = useState(null);
   const router = useRouter();
 
+  useEffect(() => {
+    // Fetch the municipality boundaries for the high-level view
+    const fetchBoundaries = async () => {
+      try {
+        const token = localStorage.getItem('token');
+        const response = await fetch(`${backendUrl}/municipalities/boundaries`, {
+          method: 'GET',
+          headers: {
+            'Authorization': `Bearer ${token}`
+          }
+        });
+        if (!response.ok) {
+          throw new Error(`HTTP error! Status: ${response.status}`);
+        }
+        setBoundaries(await response.json());
+      } catch (error) {
+        console.error('Error fetching municipality boundaries:', error);
+      }
+    };
+    fetchBoundaries();
+  }, []);
+
   useEffect(() => {
     // Aggregate data for high-level view
     const aggregated = mapData.reduce((acc, item) => {
@@ -295,7 +319,7 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
   
   const handleDragSelect = useCallback((bounds) => {
     if (geoLevel === 0) {
-      const selectedRegions = stedinGeojson.features.filter(feature => {
+      const selectedRegions = (boundaries?.features ?? []).filter(feature => {
         const polygon = L.polygon(feature.geometry.coordinates[0]);
         return bounds.intersects(polygon.getBounds());
       }).map(feature => feature.properties.name);
@@ -322,11 +346,11 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
         }
       });
     }
-  }, [geoLevel, isMultiSelect, mapData]);
+  }, [boundaries, geoLevel, isMultiSelect, mapData]);
 
   const handleDragDeselect = useCallback((bounds) => {
     if (geoLevel === 0) {
-      const deselectedRegions = stedinGeojson.features.filter(feature => {
+      const deselectedRegions = (boundaries?.features ?? []).filter(feature => {
         const polygon = L.polygon(feature.geometry.coordinates[0]);
         return bounds.intersects(polygon.getBounds());
       }).map(feature => feature.properties.name);
@@ -341,7 +365,7 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
         typeof item === 'string' ? !deselectedMarkers.includes(item) : !deselectedMarkers.includes(item.name)
       ));
     }
-  }, [geoLevel, mapData]);
+  }, [boundaries, geoLevel, mapData]);
 
   const getStatusColor = (status) => {
     switch (status.toLowerCase()) {
@@ -351,6 +375,8 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
       case "error":
       case "app_issue":
         return "text-red-500";
+      case "maintenance":
+        return "text-blue-500";
       default:
         return "text-gray-500";
     }
@@ -430,6 +456,7 @@ export default function InteractiveMap({ geoLevel = 0, filters, mapData }) {
                   attribution='&copy; <a href="https://www.openstreetmap.org/copyright">OpenStreetMap</a> contributors'
                 />
                 <MapContent 
+                  boundaries={boundaries}
                   geoLevel={geoLevel} 
                   onItemClick={handleItemClick}
                   selectedItems={selectedItems}
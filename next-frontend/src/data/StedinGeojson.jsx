@@ -1,16988 +0,0 @@
-export const stedinGeojson =
-{
-  "type": "FeatureCollection",
-  "features": [
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0518",
-        "name": "'s-Gravenhage",
-        "regioFacet": "tcm:106-353763-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/den-haag/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.254157958996224,
-                52.09974388484657
-              ],
-              [
-                4.25208587977568,
-                52.1040454325393
-              ],
-              [
-                4.267965861323267,
-                52.10735346981207
-              ],
-              [
-                4.279732359167885,
-                52.11550944515522
-              ],
-              [
-                4.278913612979,
-                52.11749310657228
-              ],
-              [
-                4.280313605705334,
-                52.118207830392265
-              ],
-              [
-                4.282736451972145,
-                52.117670569823616
-              ],
-              [
-                4.294039982924449,
-                52.12340008686797
-              ],
-              [
-                4.30250579425295,
-                52.1286159964978
-              ],
-              [
-                4.33306041385497,
-                52.1057307803622
-              ],
-              [
-                4.33031736570331,
-                52.1043420082524
-              ],
-              [
-                4.33226427528763,
-                52.103040083168
-              ],
-              [
-                4.32328202568052,
-                52.0992165092325
-              ],
-              [
-                4.32748445911613,
-                52.0955670943145
-              ],
-              [
-                4.33725293023635,
-                52.0987695094611
-              ],
-              [
-                4.33857337366045,
-                52.0974348075037
-              ],
-              [
-                4.34231809291114,
-                52.0974818267394
-              ],
-              [
-                4.35848925816683,
-                52.1057613117091
-              ],
-              [
-                4.37270929698843,
-                52.0947725928808
-              ],
-              [
-                4.37146675049377,
-                52.0938346506029
-              ],
-              [
-                4.37351447117473,
-                52.0922967984259
-              ],
-              [
-                4.33521319178541,
-                52.0750003993839
-              ],
-              [
-                4.35551870246318,
-                52.0680398009164
-              ],
-              [
-                4.34219573201003,
-                52.0611345591736
-              ],
-              [
-                4.34800360076542,
-                52.0564981121851
-              ],
-              [
-                4.35228147179327,
-                52.0593940954388
-              ],
-              [
-                4.35734978736061,
-                52.0562547102735
-              ],
-              [
-                4.35982271871744,
-                52.0573663295463
-              ],
-              [
-                4.36082611105522,
-                52.0584683361269
-              ],
-              [
-                4.35601112083758,
-                52.061567463364
-              ],
-              [
-                4.37999584241373,
-                52.0747450141421
-              ],
-              [
-                4.38458266358515,
-                52.0762342732629
-              ],
-              [
-                4.38865095379416,
-                52.0729737848162
-              ],
-              [
-                4.39462152526151,
-                52.0759069171859
-              ],
-              [
-                4.39709232272531,
-                52.0747632022672
-              ],
-              [
-                4.40392354547461,
-                52.0778452596012
-              ],
-              [
-                4.42069070692745,
-                52.0634552366368
-              ],
-              [
-                4.42248965472893,
-                52.0571940388819
-              ],
-              [
-                4.41523088703197,
-                52.0570129018987
-              ],
-              [
-                4.42207240400165,
-                52.0516730866836
-              ],
-              [
-                4.40162477730792,
-                52.0535364146123
-              ],
-              [
-                4.39854953832331,
-                52.0512178687819
-              ],
-              [
-                4.39444499823951,
-                52.0540538992274
-              ],
-              [
-                4.37264043128566,
-                52.0410057235822
-              ],
-              [
-                4.37985819689384,
-                52.0359522821941
-              ],
-              [
-                4.38743617323685,
-                52.0386469136898
-              ],
-              [
-                4.39389101240709,
-                52.0329174194438
-              ],
-              [
-                4.37973528852192,
-                52.026732680908
-              ],
-              [
-                4.37349918718748,
-                52.0325988070262
-              ],
-              [
-                4.35976951826012,
-                52.026546040764
-              ],
-              [
-                4.35377785558629,
-                52.0306759810006
-              ],
-              [
-                4.35040561874383,
-                52.0394729788049
-              ],
-              [
-                4.3463947506263,
-                52.042891670526
-              ],
-              [
-                4.34687749305293,
-                52.0455175534762
-              ],
-              [
-                4.35263870815154,
-                52.0490951740043
-              ],
-              [
-                4.34450385671337,
-                52.0540565073517
-              ],
-              [
-                4.34679960785435,
-                52.0568503489143
-              ],
-              [
-                4.34004718492707,
-                52.0618058931909
-              ],
-              [
-                4.32136054351173,
-                52.0546208088936
-              ],
-              [
-                4.31988199236423,
-                52.0519935894978
-              ],
-              [
-                4.29836672319985,
-                52.04555709967
-              ],
-              [
-                4.29095168968574,
-                52.0415729738952
-              ],
-              [
-                4.29792993092026,
-                52.0294100259713
-              ],
-              [
-                4.3076385852445,
-                52.0181513580977
-              ],
-              [
-                4.30266406193456,
-                52.0164880476659
-              ],
-              [
-                4.30107536504236,
-                52.0178689504089
-              ],
-              [
-                4.29551214895177,
-                52.0148484311835
-              ],
-              [
-                4.28973608882916,
-                52.0176478128915
-              ],
-              [
-                4.29434458442854,
-                52.0193586475478
-              ],
-              [
-                4.28673371836111,
-                52.0229845827711
-              ],
-              [
-                4.28825454952034,
-                52.0237576269914
-              ],
-              [
-                4.28524986104984,
-                52.0274223239395
-              ],
-              [
-                4.28171077891887,
-                52.0274309880951
-              ],
-              [
-                4.27266268283692,
-                52.0359171243175
-              ],
-              [
-                4.24821710291762,
-                52.0252257932454
-              ],
-              [
-                4.23501472156618,
-                52.0392949886051
-              ],
-              [
-                4.22832625564934,
-                52.0346420939583
-              ],
-              [
-                4.2192267134929,
-                52.0337367776028
-              ],
-              [
-                4.21229222098121,
-                52.0307116228485
-              ],
-              [
-                4.20958452660492,
-                52.0336546922181
-              ],
-              [
-                4.21243434641776,
-                52.0340330551014
-              ],
-              [
-                4.19885354447891,
-                52.0500023586511
-              ],
-              [
-                4.19632876619849,
-                52.0556769533483
-              ],
-              [
-                4.191161856402397,
-                52.05869577796468
-              ],
-              [
-                4.254157958996224,
-                52.09974388484657
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0611",
-        "name": "Cromstrijen",
-        "regioFacet": "tcm:106-353761-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/cromstrijen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.40468501147489,
-                51.7651001826063
-              ],
-              [
-                4.4141675813211,
-                51.7791337092674
-              ],
-              [
-                4.42453491368842,
-                51.7858751954209
-              ],
-              [
-                4.43716353469973,
-                51.7813657162359
-              ],
-              [
-                4.46598477406932,
-                51.7883310426923
-              ],
-              [
-                4.46638690670699,
-                51.7849777903228
-              ],
-              [
-                4.47415040831701,
-                51.7764564850661
-              ],
-              [
-                4.48331241977928,
-                51.7770472752549
-              ],
-              [
-                4.48394465321535,
-                51.7713210261683
-              ],
-              [
-                4.48656165582289,
-                51.7706368835035
-              ],
-              [
-                4.48431543782758,
-                51.761847459894
-              ],
-              [
-                4.48690183577975,
-                51.7569980943496
-              ],
-              [
-                4.50595743758053,
-                51.7520512324613
-              ],
-              [
-                4.51823347218955,
-                51.744643056532
-              ],
-              [
-                4.51808644298872,
-                51.7409580776451
-              ],
-              [
-                4.51624506457498,
-                51.7420739988715
-              ],
-              [
-                4.50995196529841,
-                51.7409810144063
-              ],
-              [
-                4.50470059461117,
-                51.7375243638052
-              ],
-              [
-                4.50871904941533,
-                51.7279866958026
-              ],
-              [
-                4.52452768860171,
-                51.7214607977973
-              ],
-              [
-                4.518075794407,
-                51.715088802619
-              ],
-              [
-                4.53492354424312,
-                51.7072303659012
-              ],
-              [
-                4.534916152148158,
-                51.70019529457243
-              ],
-              [
-                4.525546698296801,
-                51.70059797563078
-              ],
-              [
-                4.510994783191919,
-                51.7027757152663
-              ],
-              [
-                4.49204285730324,
-                51.70688105461282
-              ],
-              [
-                4.474735607728938,
-                51.71194163517414
-              ],
-              [
-                4.416476369596571,
-                51.71735524322035
-              ],
-              [
-                4.399767086723693,
-                51.72167084368084
-              ],
-              [
-                4.398179768592119,
-                51.725187680165675
-              ],
-              [
-                4.384782297074407,
-                51.73055349017614
-              ],
-              [
-                4.40468501147489,
-                51.7651001826063
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1924",
-        "name": "Goeree-Overflakkee",
-        "regioFacet": "tcm:106-353766-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/goeree-overflakkee/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.153065637838549,
-                51.69732348832636
-              ],
-              [
-                4.128160528567943,
-                51.70182518950168
-              ],
-              [
-                4.090223426939152,
-                51.70485497538095
-              ],
-              [
-                4.060694052319423,
-                51.70634958641922
-              ],
-              [
-                4.053942405318893,
-                51.70620622512209
-              ],
-              [
-                4.0392208001932834,
-                51.72537451054866
-              ],
-              [
-                4.035037757309,
-                51.7301935872909
-              ],
-              [
-                4.032188956374415,
-                51.73462057583443
-              ],
-              [
-                4.027640597117202,
-                51.73820550436486
-              ],
-              [
-                4.02590872198511,
-                51.74053388637073
-              ],
-              [
-                4.022671634705784,
-                51.74084053208671
-              ],
-              [
-                4.020389679475065,
-                51.74320811670531
-              ],
-              [
-                4.017865191688411,
-                51.74997845453309
-              ],
-              [
-                4.016232387677154,
-                51.75679749405028
-              ],
-              [
-                4.021899855634166,
-                51.76717848796795
-              ],
-              [
-                4.01993858084777,
-                51.77881886498139
-              ],
-              [
-                4.004117791486811,
-                51.79099998103615
-              ],
-              [
-                4.000578288225347,
-                51.79602113488662
-              ],
-              [
-                3.983086117299287,
-                51.80102331635583
-              ],
-              [
-                3.967174340975702,
-                51.79584783796089
-              ],
-              [
-                3.9473052136814406,
-                51.80117239045948
-              ],
-              [
-                3.9371177675376616,
-                51.79721052537053
-              ],
-              [
-                3.9363000571572497,
-                51.790509994327
-              ],
-              [
-                3.9383482044895297,
-                51.78606927022703
-              ],
-              [
-                3.962919667364608,
-                51.776739425748445
-              ],
-              [
-                3.965871607263698,
-                51.774213607736456
-              ],
-              [
-                3.953153169272327,
-                51.775859344465964
-              ],
-              [
-                3.9393841922985167,
-                51.77620504166018
-              ],
-              [
-                3.918922172310389,
-                51.77275998918937
-              ],
-              [
-                3.90331249542152,
-                51.76881477328467
-              ],
-              [
-                3.8823873432515894,
-                51.77888956502646
-              ],
-              [
-                3.875365786643,
-                51.779787137777994
-              ],
-              [
-                3.8715601962574913,
-                51.77810978306192
-              ],
-              [
-                3.8690555404099936,
-                51.775809212033515
-              ],
-              [
-                3.8657483295094868,
-                51.77460246894617
-              ],
-              [
-                3.8600814358223143,
-                51.76516486335829
-              ],
-              [
-                3.8640675723073774,
-                51.76045459726262
-              ],
-              [
-                3.870717415315971,
-                51.75700556873161
-              ],
-              [
-                3.8775818196452443,
-                51.759355318618645
-              ],
-              [
-                3.8837476734344483,
-                51.76077785345872
-              ],
-              [
-                3.886053876034981,
-                51.759759660591314
-              ],
-              [
-                3.884384674326301,
-                51.75748766792877
-              ],
-              [
-                3.879404262579814,
-                51.75636165799098
-              ],
-              [
-                3.8752641719301297,
-                51.750684071014845
-              ],
-              [
-                3.865233170401684,
-                51.750416614761775
-              ],
-              [
-                3.84742819083488,
-                51.7534010117429
-              ],
-              [
-                3.8365294466706708,
-                51.75956042537923
-              ],
-              [
-                3.8389434830506604,
-                51.76179985258429
-              ],
-              [
-                3.8418602824940162,
-                51.76346137771513
-              ],
-              [
-                3.8449964843301023,
-                51.76444148833409
-              ],
-              [
-                3.85320952147634,
-                51.7657228642864
-              ],
-              [
-                3.8581848659510594,
-                51.7684884701026
-              ],
-              [
-                3.8623809490021905,
-                51.77529809851276
-              ],
-              [
-                3.8605217521795065,
-                51.7777383099164
-              ],
-              [
-                3.860401340149842,
-                51.77951077891507
-              ],
-              [
-                3.8619471473168194,
-                51.78025678957492
-              ],
-              [
-                3.8645785513084414,
-                51.780333172302136
-              ],
-              [
-                3.8648120975196543,
-                51.78389610204449
-              ],
-              [
-                3.863227520713332,
-                51.78865213925876
-              ],
-              [
-                3.854088663701982,
-                51.80308640019494
-              ],
-              [
-                3.853102843612018,
-                51.80780671660431
-              ],
-              [
-                3.8551759099519054,
-                51.811005430314395
-              ],
-              [
-                3.860739290598262,
-                51.815811939795964
-              ],
-              [
-                3.86654248122391,
-                51.81965474739357
-              ],
-              [
-                3.8892936847767974,
-                51.82561976356563
-              ],
-              [
-                3.9036837582983166,
-                51.829278878111054
-              ],
-              [
-                3.9191710287229533,
-                51.83514173607723
-              ],
-              [
-                3.933122978509914,
-                51.83859302205344
-              ],
-              [
-                3.9438718078338804,
-                51.83833771649013
-              ],
-              [
-                3.955526365409658,
-                51.84227838757276
-              ],
-              [
-                3.9655923009029705,
-                51.845369890137945
-              ],
-              [
-                3.9711951490035595,
-                51.845438308098366
-              ],
-              [
-                3.978268103966685,
-                51.844508296966296
-              ],
-              [
-                3.9943315933106223,
-                51.84881345442232
-              ],
-              [
-                4.007848469915819,
-                51.85078430239476
-              ],
-              [
-                4.014511225917688,
-                51.85041014596763
-              ],
-              [
-                4.019814088205534,
-                51.84839629278946
-              ],
-              [
-                4.01548317916721,
-                51.84038594502421
-              ],
-              [
-                4.034399630311039,
-                51.83191804070001
-              ],
-              [
-                4.043588323969977,
-                51.829717352543625
-              ],
-              [
-                4.045894302906709,
-                51.82877456678912
-              ],
-              [
-                4.054549979421145,
-                51.82122954109301
-              ],
-              [
-                4.074460122423236,
-                51.81358285938505
-              ],
-              [
-                4.121281528469346,
-                51.795652221863634
-              ],
-              [
-                4.136657921687549,
-                51.79939897743155
-              ],
-              [
-                4.140134342526587,
-                51.80319692985182
-              ],
-              [
-                4.147846430993484,
-                51.805589200660386
-              ],
-              [
-                4.150294937282066,
-                51.804854737720966
-              ],
-              [
-                4.164886323112951,
-                51.79683644274558
-              ],
-              [
-                4.14360078967357,
-                51.78831947978551
-              ],
-              [
-                4.156060334718295,
-                51.78363176411772
-              ],
-              [
-                4.1605589181698175,
-                51.78072542603596
-              ],
-              [
-                4.179686232673235,
-                51.77699223003317
-              ],
-              [
-                4.18855942750948,
-                51.77548532937503
-              ],
-              [
-                4.195252238151962,
-                51.77681150258076
-              ],
-              [
-                4.217196523996179,
-                51.777615708053474
-              ],
-              [
-                4.218409950541232,
-                51.77679698688411
-              ],
-              [
-                4.208241081479892,
-                51.763957096063216
-              ],
-              [
-                4.246160767048703,
-                51.743215274194284
-              ],
-              [
-                4.262404697923562,
-                51.72869591745071
-              ],
-              [
-                4.298649899263193,
-                51.71690592685274
-              ],
-              [
-                4.322688841767574,
-                51.70528989054479
-              ],
-              [
-                4.343362965024198,
-                51.702987289369545
-              ],
-              [
-                4.368976703937717,
-                51.7028119870964
-              ],
-              [
-                4.38019912924695,
-                51.70251916852672
-              ],
-              [
-                4.388740760239534,
-                51.70667924991517
-              ],
-              [
-                4.394124321565059,
-                51.71079553186381
-              ],
-              [
-                4.394938143073653,
-                51.71104686228236
-              ],
-              [
-                4.398469989860317,
-                51.71062140280271
-              ],
-              [
-                4.401080677711924,
-                51.70941766248011
-              ],
-              [
-                4.401701968179568,
-                51.70844544753932
-              ],
-              [
-                4.397650071394445,
-                51.707190634245954
-              ],
-              [
-                4.398789174791417,
-                51.70469065158758
-              ],
-              [
-                4.400565034620424,
-                51.704671582979564
-              ],
-              [
-                4.406161400080705,
-                51.70532150513381
-              ],
-              [
-                4.407055824345312,
-                51.70522043198242
-              ],
-              [
-                4.399840140089078,
-                51.701874733859626
-              ],
-              [
-                4.4020854110412415,
-                51.69925550734496
-              ],
-              [
-                4.403729343267125,
-                51.69873908718329
-              ],
-              [
-                4.39362115575423,
-                51.6932962204102
-              ],
-              [
-                4.3855406716330965,
-                51.692917648486684
-              ],
-              [
-                4.377140056487379,
-                51.69140912098922
-              ],
-              [
-                4.371083112672554,
-                51.68824962568163
-              ],
-              [
-                4.368983093456652,
-                51.6848364052298
-              ],
-              [
-                4.365741852477162,
-                51.678208190919776
-              ],
-              [
-                4.3623843301735175,
-                51.673939275098064
-              ],
-              [
-                4.356824853092287,
-                51.67084251182341
-              ],
-              [
-                4.3399502071416425,
-                51.665326693127525
-              ],
-              [
-                4.330426281792605,
-                51.66278429860763
-              ],
-              [
-                4.314546160313323,
-                51.660391136312334
-              ],
-              [
-                4.2951489458126275,
-                51.658491653952964
-              ],
-              [
-                4.284593897867996,
-                51.65467562624984
-              ],
-              [
-                4.279143968188575,
-                51.65299166660717
-              ],
-              [
-                4.26644982406933,
-                51.65304612494288
-              ],
-              [
-                4.211391884308546,
-                51.65846192041248
-              ],
-              [
-                4.196070183152708,
-                51.66804009679307
-              ],
-              [
-                4.154887299675176,
-                51.68363404912244
-              ],
-              [
-                4.153065637838549,
-                51.69732348832636
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0716",
-        "name": "Tholen",
-        "regioFacet": "tcm:106-353746-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/tholen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.076495289573464,
-                51.61312020147548
-              ],
-              [
-                4.087359143536019,
-                51.61223623109744
-              ],
-              [
-                4.103496224034694,
-                51.60971043899684
-              ],
-              [
-                4.1142047864575995,
-                51.602540191509036
-              ],
-              [
-                4.133165687174183,
-                51.60306156488329
-              ],
-              [
-                4.152451566245912,
-                51.6065376946675
-              ],
-              [
-                4.1643483903117255,
-                51.60855345254869
-              ],
-              [
-                4.172463157961795,
-                51.61385776892871
-              ],
-              [
-                4.163947023355229,
-                51.613319168791385
-              ],
-              [
-                4.1536431608354585,
-                51.612458290684685
-              ],
-              [
-                4.126423501746403,
-                51.61329849660784
-              ],
-              [
-                4.100124755813263,
-                51.6310148111842
-              ],
-              [
-                4.099170694464537,
-                51.6330225644099
-              ],
-              [
-                4.097527808293507,
-                51.64279704886808
-              ],
-              [
-                4.11130424204555,
-                51.6525240199282
-              ],
-              [
-                4.12382782742267,
-                51.6578615335861
-              ],
-              [
-                4.172794308741,
-                51.6461347191807
-              ],
-              [
-                4.17742796513996,
-                51.6428180705392
-              ],
-              [
-                4.19177703253745,
-                51.6383739338199
-              ],
-              [
-                4.19588516063746,
-                51.6394135591821
-              ],
-              [
-                4.198676506659472,
-                51.647508579939775
-              ],
-              [
-                4.208211903278002,
-                51.64380853839729
-              ],
-              [
-                4.2129310189162545,
-                51.640873695953466
-              ],
-              [
-                4.215727526022842,
-                51.638743219991795
-              ],
-              [
-                4.2243112127174385,
-                51.63725600645162
-              ],
-              [
-                4.22866617152791,
-                51.63650721021197
-              ],
-              [
-                4.238361556096374,
-                51.647208092874834
-              ],
-              [
-                4.2404686415822574,
-                51.64782085445837
-              ],
-              [
-                4.24278620278233,
-                51.6470630008829
-              ],
-              [
-                4.23816824028669,
-                51.6342978801569
-              ],
-              [
-                4.2119445139756,
-                51.6267885947811
-              ],
-              [
-                4.19927270875819,
-                51.6195386049237
-              ],
-              [
-                4.19280611086814,
-                51.6115399815891
-              ],
-              [
-                4.19012409973587,
-                51.6027510434342
-              ],
-              [
-                4.19143059053461,
-                51.5956283479522
-              ],
-              [
-                4.19571796682896,
-                51.5889751817815
-              ],
-              [
-                4.22812111889726,
-                51.5637163051097
-              ],
-              [
-                4.23520176848634,
-                51.5539526467879
-              ],
-              [
-                4.23506833890459,
-                51.5414821573313
-              ],
-              [
-                4.21879978585768,
-                51.5089613939746
-              ],
-              [
-                4.21892721300327,
-                51.5048584534681
-              ],
-              [
-                4.23032777065728,
-                51.4889042531924
-              ],
-              [
-                4.217248334525976,
-                51.487717352774695
-              ],
-              [
-                4.216944672168525,
-                51.48944520180855
-              ],
-              [
-                4.215142453192445,
-                51.49133632196889
-              ],
-              [
-                4.174342269848498,
-                51.502689636687414
-              ],
-              [
-                4.171720454205321,
-                51.506215019396365
-              ],
-              [
-                4.172266435427511,
-                51.508143050332095
-              ],
-              [
-                4.165906607077739,
-                51.51026333189988
-              ],
-              [
-                4.141862983031539,
-                51.52118804436004
-              ],
-              [
-                4.076594189352743,
-                51.525131320654566
-              ],
-              [
-                4.064927380354994,
-                51.53332180720775
-              ],
-              [
-                4.052371096685879,
-                51.546977629963976
-              ],
-              [
-                4.027962559997324,
-                51.556310651009305
-              ],
-              [
-                4.013495694077625,
-                51.57003485122036
-              ],
-              [
-                4.003316418050117,
-                51.57508635668247
-              ],
-              [
-                3.9893123895458236,
-                51.5765261783118
-              ],
-              [
-                3.983974112143537,
-                51.580857801818354
-              ],
-              [
-                3.9839340200320894,
-                51.58544954475961
-              ],
-              [
-                3.9873304116973305,
-                51.59055657673045
-              ],
-              [
-                3.988697546522721,
-                51.59346069786313
-              ],
-              [
-                4.003397805857428,
-                51.5950036380357
-              ],
-              [
-                4.019543500939953,
-                51.598907485667205
-              ],
-              [
-                4.038962550755954,
-                51.60442910588862
-              ],
-              [
-                4.053734306845004,
-                51.60899967941855
-              ],
-              [
-                4.063039128564733,
-                51.61274358045279
-              ],
-              [
-                4.076495289573464,
-                51.61312020147548
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0703",
-        "name": "Reimerswaal",
-        "regioFacet": "tcm:106-353742-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/reimerswaal/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.01368415874963,
-                51.5176932907202
-              ],
-              [
-                4.017561809029536,
-                51.5192428735104
-              ],
-              [
-                4.024911006245182,
-                51.51181861234743
-              ],
-              [
-                4.038641893165964,
-                51.50570814279711
-              ],
-              [
-                4.051592089477719,
-                51.50343305462022
-              ],
-              [
-                4.057569491220032,
-                51.49970745140306
-              ],
-              [
-                4.058538021445006,
-                51.49712916798219
-              ],
-              [
-                4.058741933793626,
-                51.48600429681002
-              ],
-              [
-                4.062265192934484,
-                51.473845813910344
-              ],
-              [
-                4.079273763915083,
-                51.45883513286199
-              ],
-              [
-                4.084069029440227,
-                51.45819247987211
-              ],
-              [
-                4.093632753607492,
-                51.44721112217586
-              ],
-              [
-                4.1061850995681635,
-                51.444507022671026
-              ],
-              [
-                4.130625177794798,
-                51.4366150794081
-              ],
-              [
-                4.144523280053791,
-                51.432138003333634
-              ],
-              [
-                4.159583845443425,
-                51.43697494670915
-              ],
-              [
-                4.179986941619358,
-                51.44389985572546
-              ],
-              [
-                4.188916804700195,
-                51.44388477653808
-              ],
-              [
-                4.22271259922098,
-                51.43868207458662
-              ],
-              [
-                4.217261694934502,
-                51.48765132886957
-              ],
-              [
-                4.229928249722426,
-                51.48857408114678
-              ],
-              [
-                4.27585163861987,
-                51.425049407426
-              ],
-              [
-                4.26644687150841,
-                51.4199794801409
-              ],
-              [
-                4.2637322575564,
-                51.4164491058365
-              ],
-              [
-                4.26906103582889,
-                51.4003473880563
-              ],
-              [
-                4.26863359661402,
-                51.3881264479206
-              ],
-              [
-                4.26634902249129,
-                51.3865406304337
-              ],
-              [
-                4.26838205025137,
-                51.3835458865884
-              ],
-              [
-                4.27369768813555,
-                51.3831498848715
-              ],
-              [
-                4.27742457835393,
-                51.3760352385227
-              ],
-              [
-                4.2391805410186665,
-                51.374839200802434
-              ],
-              [
-                4.235425163439688,
-                51.38558517963182
-              ],
-              [
-                4.230997116839882,
-                51.38996119230624
-              ],
-              [
-                4.2278674531835785,
-                51.39269033564733
-              ],
-              [
-                4.21632113156913,
-                51.39772552917589
-              ],
-              [
-                4.205932320567989,
-                51.39958070543722
-              ],
-              [
-                4.185454391568486,
-                51.39975342748075
-              ],
-              [
-                4.1541300865594435,
-                51.39416476464535
-              ],
-              [
-                4.13828352687816,
-                51.396600219055045
-              ],
-              [
-                4.07944314543915,
-                51.40734430869099
-              ],
-              [
-                4.061161859920864,
-                51.41392093942109
-              ],
-              [
-                4.0512648892126,
-                51.41962600265416
-              ],
-              [
-                4.033218936534326,
-                51.432388908626876
-              ],
-              [
-                4.0068605374198505,
-                51.44027206730623
-              ],
-              [
-                3.9914506814827,
-                51.4498030830015
-              ],
-              [
-                3.99886551024209,
-                51.4562731328919
-              ],
-              [
-                4.00732282691803,
-                51.4586342001926
-              ],
-              [
-                4.00473118506696,
-                51.5048432894408
-              ],
-              [
-                4.01359281280657,
-                51.5075881781571
-              ],
-              [
-                4.01737546645407,
-                51.5124310223086
-              ],
-              [
-                4.01368415874963,
-                51.5176932907202
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0715",
-        "name": "Terneuzen",
-        "regioFacet": "tcm:106-353745-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/terneuzen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.71240870880543,
-                51.315790671127
-              ],
-              [
-                3.68628368588288,
-                51.321598781352
-              ],
-              [
-                3.66620418399222,
-                51.3198117090862
-              ],
-              [
-                3.66382773200577,
-                51.3252388827323
-              ],
-              [
-                3.6599912728739,
-                51.3248868647191
-              ],
-              [
-                3.65797215748996,
-                51.3333504981744
-              ],
-              [
-                3.65330982165022,
-                51.3317500618128
-              ],
-              [
-                3.64414900197835,
-                51.3418307226848
-              ],
-              [
-                3.64959920562017,
-                51.3427315248354
-              ],
-              [
-                3.64724216480814,
-                51.3500487458712
-              ],
-              [
-                3.65419652686573,
-                51.3483902204821
-              ],
-              [
-                3.65939819131409,
-                51.3511352578923
-              ],
-              [
-                3.68286708575619,
-                51.3534832166128
-              ],
-              [
-                3.68238634402624,
-                51.3552731673482
-              ],
-              [
-                3.68784512127283,
-                51.3555768736302
-              ],
-              [
-                3.68981466944879,
-                51.3533506788695
-              ],
-              [
-                3.69381437916064,
-                51.3524737800297
-              ],
-              [
-                3.6932218046729552,
-                51.36448833290984
-              ],
-              [
-                3.7104612073443066,
-                51.35663884408844
-              ],
-              [
-                3.7171978204315907,
-                51.35309893521263
-              ],
-              [
-                3.729994470043157,
-                51.349690266089596
-              ],
-              [
-                3.740459946750943,
-                51.349073553793936
-              ],
-              [
-                3.7582248478055056,
-                51.34745382098734
-              ],
-              [
-                3.7717244786111364,
-                51.3500592397844
-              ],
-              [
-                3.7891715642440227,
-                51.34958947127487
-              ],
-              [
-                3.8267686228108198,
-                51.34346955893381
-              ],
-              [
-                3.8521505509605154,
-                51.33578233146187
-              ],
-              [
-                3.8675856486454,
-                51.336736110461736
-              ],
-              [
-                3.875886629392962,
-                51.34660214819745
-              ],
-              [
-                3.9209066199000078,
-                51.3621177190115
-              ],
-              [
-                3.94256113504284,
-                51.3648476195025
-              ],
-              [
-                3.95704678277763,
-                51.3648126558935
-              ],
-              [
-                3.95765238930296,
-                51.3638307303944
-              ],
-              [
-                3.95459939666004,
-                51.3569614872907
-              ],
-              [
-                3.95939242033847,
-                51.3513229804877
-              ],
-              [
-                3.96174510492555,
-                51.3326562840036
-              ],
-              [
-                3.95327553962521,
-                51.3082909001086
-              ],
-              [
-                3.95710971031761,
-                51.3048749426719
-              ],
-              [
-                3.97188471139341,
-                51.3003708903979
-              ],
-              [
-                3.97887925727707,
-                51.3008145331836
-              ],
-              [
-                3.98223669492032,
-                51.249109821459
-              ],
-              [
-                3.98759032346319,
-                51.2498094611161
-              ],
-              [
-                3.99311921325004,
-                51.248003404802
-              ],
-              [
-                3.99411287725811,
-                51.2428255120026
-              ],
-              [
-                3.9968951100138,
-                51.2411134439289
-              ],
-              [
-                4.00336052590743,
-                51.243312033355
-              ],
-              [
-                4.00569296966836,
-                51.2418759046652
-              ],
-              [
-                3.98843507677155,
-                51.2333028388427
-              ],
-              [
-                3.98620496715707,
-                51.2341595213976
-              ],
-              [
-                3.97891869492704,
-                51.2253662231851
-              ],
-              [
-                3.96429146271358,
-                51.2239544602081
-              ],
-              [
-                3.95856237021744,
-                51.2158072804222
-              ],
-              [
-                3.94663946068075,
-                51.21550795878
-              ],
-              [
-                3.9359800473662,
-                51.2118927602901
-              ],
-              [
-                3.92941848813522,
-                51.220260366105
-              ],
-              [
-                3.92544981373611,
-                51.217106290281
-              ],
-              [
-                3.91963217658716,
-                51.2170707589066
-              ],
-              [
-                3.91852716059509,
-                51.2148380689416
-              ],
-              [
-                3.91600471451814,
-                51.2146339272746
-              ],
-              [
-                3.91862860348518,
-                51.2077634868885
-              ],
-              [
-                3.91533745131552,
-                51.2081698353019
-              ],
-              [
-                3.91154688217331,
-                51.2054446378515
-              ],
-              [
-                3.89947133699625,
-                51.2017422520761
-              ],
-              [
-                3.89408204614176,
-                51.2034355599141
-              ],
-              [
-                3.88634956281057,
-                51.2001621200253
-              ],
-              [
-                3.87762558414169,
-                51.2080130156303
-              ],
-              [
-                3.89024394987336,
-                51.2139959130056
-              ],
-              [
-                3.89334646525616,
-                51.2186624652429
-              ],
-              [
-                3.88854850130444,
-                51.2230180782319
-              ],
-              [
-                3.85957377063304,
-                51.2108306856532
-              ],
-              [
-                3.85241135850714,
-                51.2132157334085
-              ],
-              [
-                3.84964269442333,
-                51.211733444322
-              ],
-              [
-                3.83636543105088,
-                51.2137957186259
-              ],
-              [
-                3.82511637338101,
-                51.2089308396846
-              ],
-              [
-                3.80602709990008,
-                51.2133793119466
-              ],
-              [
-                3.80404702069131,
-                51.2132023168935
-              ],
-              [
-                3.80498973871659,
-                51.2101206279738
-              ],
-              [
-                3.7992914818187,
-                51.2108643678475
-              ],
-              [
-                3.78962728699367,
-                51.2158811830925
-              ],
-              [
-                3.78845528752239,
-                51.2263728099973
-              ],
-              [
-                3.78945151792935,
-                51.2457862771634
-              ],
-              [
-                3.79547331242889,
-                51.256053332257
-              ],
-              [
-                3.78543376417013,
-                51.2584673161856
-              ],
-              [
-                3.77378362530378,
-                51.2639752221229
-              ],
-              [
-                3.7693421331652,
-                51.2598497590264
-              ],
-              [
-                3.76251373486405,
-                51.2617808254118
-              ],
-              [
-                3.75571436142558,
-                51.2693441448099
-              ],
-              [
-                3.75241255132558,
-                51.2702666836291
-              ],
-              [
-                3.7517257311366,
-                51.2691604840207
-              ],
-              [
-                3.72308836376636,
-                51.2733453361443
-              ],
-              [
-                3.71891347368907,
-                51.2715354240565
-              ],
-              [
-                3.71270105885807,
-                51.2721030822423
-              ],
-              [
-                3.69393370522114,
-                51.2760390420358
-              ],
-              [
-                3.6981812389198,
-                51.2827142066406
-              ],
-              [
-                3.70141849974911,
-                51.2826722507297
-              ],
-              [
-                3.69809073186678,
-                51.2850146339886
-              ],
-              [
-                3.70661063516097,
-                51.288826019627
-              ],
-              [
-                3.7059628865263,
-                51.3002914964105
-              ],
-              [
-                3.70402960404139,
-                51.302479147816
-              ],
-              [
-                3.7071433036557,
-                51.3066364002337
-              ],
-              [
-                3.70358243028794,
-                51.3099431877979
-              ],
-              [
-                3.71240870880543,
-                51.315790671127
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1676",
-        "name": "Schouwen-Duiveland",
-        "regioFacet": "tcm:106-353743-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/schouwen-duiveland/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.7315443693857477,
-                51.650607419517364
-              ],
-              [
-                3.7268832328629724,
-                51.65023779205025
-              ],
-              [
-                3.7197413234981633,
-                51.64927968024862
-              ],
-              [
-                3.7155310483699804,
-                51.65310297082973
-              ],
-              [
-                3.6987110590023,
-                51.67152224515416
-              ],
-              [
-                3.689497116948894,
-                51.67801310830157
-              ],
-              [
-                3.6818553624218233,
-                51.68757122906324
-              ],
-              [
-                3.6789174815425807,
-                51.69708823527388
-              ],
-              [
-                3.679116349816154,
-                51.70696412499388
-              ],
-              [
-                3.6802094692880876,
-                51.70975970847411
-              ],
-              [
-                3.6890223497430257,
-                51.72059671334466
-              ],
-              [
-                3.7015410303828964,
-                51.72826948631479
-              ],
-              [
-                3.7217970538005334,
-                51.738261714161155
-              ],
-              [
-                3.7227492641809556,
-                51.738087246680024
-              ],
-              [
-                3.724007556597459,
-                51.73745508681269
-              ],
-              [
-                3.731220111967289,
-                51.73762338925694
-              ],
-              [
-                3.736684084316897,
-                51.73782863484509
-              ],
-              [
-                3.740714046750267,
-                51.73710548324282
-              ],
-              [
-                3.7662167952531647,
-                51.74333260361627
-              ],
-              [
-                3.7752757603305636,
-                51.744409974496975
-              ],
-              [
-                3.786450460106214,
-                51.744408472376904
-              ],
-              [
-                3.796400574927642,
-                51.74320268720038
-              ],
-              [
-                3.805536742613718,
-                51.74189310050886
-              ],
-              [
-                3.8133575135878206,
-                51.74084595056908
-              ],
-              [
-                3.817133350898879,
-                51.740530215227665
-              ],
-              [
-                3.820296928555276,
-                51.74087102459853
-              ],
-              [
-                3.8232883011985783,
-                51.74336894629059
-              ],
-              [
-                3.824026765519145,
-                51.74588126941879
-              ],
-              [
-                3.823728265347081,
-                51.74655771001891
-              ],
-              [
-                3.822948206821803,
-                51.746810458842404
-              ],
-              [
-                3.8222038505239038,
-                51.74718397678832
-              ],
-              [
-                3.8221184688125467,
-                51.747612419574956
-              ],
-              [
-                3.8229933179042686,
-                51.7502563882711
-              ],
-              [
-                3.8293472589840265,
-                51.75447089589059
-              ],
-              [
-                3.8369594760591106,
-                51.7592845649632
-              ],
-              [
-                3.842592300978222,
-                51.75612685848232
-              ],
-              [
-                3.842245218915086,
-                51.752475597741544
-              ],
-              [
-                3.8455956281380597,
-                51.7405881440538
-              ],
-              [
-                3.887463640063063,
-                51.74525651273207
-              ],
-              [
-                3.9364888088395875,
-                51.74810033548615
-              ],
-              [
-                3.9390017643940016,
-                51.74868176822285
-              ],
-              [
-                3.9532123004078104,
-                51.748191081018405
-              ],
-              [
-                3.9595942273415434,
-                51.74747834812018
-              ],
-              [
-                3.9570595052904967,
-                51.75145278040853
-              ],
-              [
-                3.946438143997077,
-                51.76200488190309
-              ],
-              [
-                3.943701652464924,
-                51.76707166409922
-              ],
-              [
-                3.9462651938079296,
-                51.76820704424932
-              ],
-              [
-                3.9550645540616935,
-                51.76345565092428
-              ],
-              [
-                3.9698107504393105,
-                51.75103055775443
-              ],
-              [
-                3.9781639774259405,
-                51.75521605057169
-              ],
-              [
-                3.9797684146896217,
-                51.76050696260421
-              ],
-              [
-                3.982589465760312,
-                51.76101761251259
-              ],
-              [
-                3.997859983539179,
-                51.75975804598627
-              ],
-              [
-                4.005204989324161,
-                51.75952939377979
-              ],
-              [
-                4.011035707316329,
-                51.75825937664512
-              ],
-              [
-                4.011400034592873,
-                51.75713240866622
-              ],
-              [
-                3.996284485594039,
-                51.75194402123941
-              ],
-              [
-                4.002562767358466,
-                51.740629339099044
-              ],
-              [
-                4.0153432605801544,
-                51.73036059930192
-              ],
-              [
-                4.016336126294852,
-                51.72623810402975
-              ],
-              [
-                4.0107689084887435,
-                51.69935310895258
-              ],
-              [
-                4.044576837179639,
-                51.68466350025195
-              ],
-              [
-                4.091339955922191,
-                51.68763295299417
-              ],
-              [
-                4.122572015280608,
-                51.680368280970356
-              ],
-              [
-                4.13791891832653,
-                51.679564777081126
-              ],
-              [
-                4.154926390530273,
-                51.68364395140451
-              ],
-              [
-                4.20270061368942,
-                51.6607643134768
-              ],
-              [
-                4.19588516063746,
-                51.6394135591821
-              ],
-              [
-                4.19177703253745,
-                51.6383739338199
-              ],
-              [
-                4.17742796513996,
-                51.6428180705392
-              ],
-              [
-                4.172794308741,
-                51.6461347191807
-              ],
-              [
-                4.13781654029594,
-                51.65725587434652
-              ],
-              [
-                4.118522985208696,
-                51.66751845486482
-              ],
-              [
-                4.105192866029627,
-                51.65810392088694
-              ],
-              [
-                4.096857578048652,
-                51.647386867230715
-              ],
-              [
-                4.090692036491419,
-                51.64395798055202
-              ],
-              [
-                4.080782795921997,
-                51.63934845371088
-              ],
-              [
-                4.074007465976078,
-                51.63443404202101
-              ],
-              [
-                4.069429154094744,
-                51.6313978739354
-              ],
-              [
-                4.061515224670654,
-                51.62866487478481
-              ],
-              [
-                4.056292651350204,
-                51.62801207680394
-              ],
-              [
-                4.049406719798919,
-                51.63145247269615
-              ],
-              [
-                4.035109967338962,
-                51.625015704763285
-              ],
-              [
-                4.023790760488736,
-                51.62279013408896
-              ],
-              [
-                4.012970020025999,
-                51.61545354835115
-              ],
-              [
-                3.9874161697697525,
-                51.615085658306675
-              ],
-              [
-                3.98037036254843,
-                51.6137094344543
-              ],
-              [
-                3.96836976986053,
-                51.6126718351961
-              ],
-              [
-                3.95857657164651,
-                51.6137631446414
-              ],
-              [
-                3.9487028216633,
-                51.6191892123854
-              ],
-              [
-                3.9278976934961833,
-                51.62840054148942
-              ],
-              [
-                3.91820916653061,
-                51.628522061627194
-              ],
-              [
-                3.889847161503254,
-                51.63200394728202
-              ],
-              [
-                3.8762848155766574,
-                51.662892132361726
-              ],
-              [
-                3.8469272318128334,
-                51.67581909247112
-              ],
-              [
-                3.8226611739275294,
-                51.683834405179205
-              ],
-              [
-                3.7720080362847686,
-                51.6811936218113
-              ],
-              [
-                3.7315443693857477,
-                51.650607419517364
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0677",
-        "name": "Hulst",
-        "regioFacet": "tcm:106-353738-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/hulst/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.97816767079599,
-                51.3015655414464
-              ],
-              [
-                3.97199783853086,
-                51.3003390929926
-              ],
-              [
-                3.95710971031761,
-                51.3048749426719
-              ],
-              [
-                3.95327553962521,
-                51.3082909001086
-              ],
-              [
-                3.96174510492555,
-                51.3326562840036
-              ],
-              [
-                3.95939242033847,
-                51.3513229804877
-              ],
-              [
-                3.95459939666004,
-                51.3569614872907
-              ],
-              [
-                3.95704678277763,
-                51.3648126558935
-              ],
-              [
-                3.94256113504284,
-                51.3648476195025
-              ],
-              [
-                3.942480438393945,
-                51.3658462467168
-              ],
-              [
-                3.9627729146673403,
-                51.37522473893416
-              ],
-              [
-                3.9618396045016584,
-                51.40033573623489
-              ],
-              [
-                3.9694340742451724,
-                51.407098203193456
-              ],
-              [
-                3.9936605677310606,
-                51.40617876338523
-              ],
-              [
-                4.019182173531518,
-                51.40178249476631
-              ],
-              [
-                4.022094982594691,
-                51.400767613631416
-              ],
-              [
-                4.031855379282554,
-                51.39390444983564
-              ],
-              [
-                4.038126036718089,
-                51.37904723302458
-              ],
-              [
-                4.050714865880286,
-                51.36668757005128
-              ],
-              [
-                4.0871605509879245,
-                51.36450705321103
-              ],
-              [
-                4.097377564776228,
-                51.360773652002194
-              ],
-              [
-                4.104646644857654,
-                51.35966975923724
-              ],
-              [
-                4.1244483067223525,
-                51.366796235497446
-              ],
-              [
-                4.13111947680137,
-                51.3647969382469
-              ],
-              [
-                4.139054335044774,
-                51.36148276867351
-              ],
-              [
-                4.152607214437085,
-                51.368791981413345
-              ],
-              [
-                4.186835331815239,
-                51.38392649939922
-              ],
-              [
-                4.187686996799019,
-                51.38373220772773
-              ],
-              [
-                4.192560961639124,
-                51.37657571185334
-              ],
-              [
-                4.197792565260057,
-                51.37625561845846
-              ],
-              [
-                4.2058905396146065,
-                51.3749396623582
-              ],
-              [
-                4.210626062447233,
-                51.37187458942191
-              ],
-              [
-                4.22093798088541,
-                51.3614591149269
-              ],
-              [
-                4.23680527321215,
-                51.3497554472223
-              ],
-              [
-                4.16613168504418,
-                51.2928928865123
-              ],
-              [
-                4.07063313263849,
-                51.2507386114892
-              ],
-              [
-                4.05791084290877,
-                51.2434211581154
-              ],
-              [
-                4.04055211513735,
-                51.2413176155459
-              ],
-              [
-                4.03653732535447,
-                51.2456784104149
-              ],
-              [
-                4.02449049319176,
-                51.2414998443733
-              ],
-              [
-                4.02093408111037,
-                51.2453655570665
-              ],
-              [
-                4.01480697319797,
-                51.2450754215739
-              ],
-              [
-                4.00569296966836,
-                51.2418759046652
-              ],
-              [
-                4.0032568928418,
-                51.2432952478384
-              ],
-              [
-                3.9968951100138,
-                51.2411134439289
-              ],
-              [
-                3.99411287725811,
-                51.2428255120026
-              ],
-              [
-                3.99311921325004,
-                51.248003404802
-              ],
-              [
-                3.98759032346319,
-                51.2498094611161
-              ],
-              [
-                3.98223669492032,
-                51.249109821459
-              ],
-              [
-                3.97816767079599,
-                51.3015655414464
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1714",
-        "name": "Sluis",
-        "regioFacet": "tcm:106-353744-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/sluis/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.37101667393473,
-                51.3750178214238
-              ],
-              [
-                3.37206236280263,
-                51.376722024432
-              ],
-              [
-                3.37517419599279,
-                51.3765527120163
-              ],
-              [
-                3.37480226126581,
-                51.3780479230554
-              ],
-              [
-                3.37745110642455,
-                51.3777006750876
-              ],
-              [
-                3.38172014387887,
-                51.3804935149508
-              ],
-              [
-                3.42248173417064,
-                51.3914167066075
-              ],
-              [
-                3.4327452667266,
-                51.3925915579649
-              ],
-              [
-                3.44025044157444,
-                51.3911829682448
-              ],
-              [
-                3.44810068733405,
-                51.3919480203223
-              ],
-              [
-                3.45517620861254,
-                51.3948478998062
-              ],
-              [
-                3.4825600320567,
-                51.3995731546911
-              ],
-              [
-                3.49799072506806,
-                51.4042097882336
-              ],
-              [
-                3.50345990519071,
-                51.4078300785548
-              ],
-              [
-                3.51500634728023,
-                51.4090160126068
-              ],
-              [
-                3.55090802182762,
-                51.4059958669416
-              ],
-              [
-                3.55738514305952,
-                51.4029215292259
-              ],
-              [
-                3.55733931867121,
-                51.4008138788542
-              ],
-              [
-                3.55941907991898,
-                51.4060417847455
-              ],
-              [
-                3.69146975597587,
-                51.397616393432
-              ],
-              [
-                3.69381437916064,
-                51.3524737800297
-              ],
-              [
-                3.68981466944879,
-                51.3533506788695
-              ],
-              [
-                3.68784512127283,
-                51.3555768736302
-              ],
-              [
-                3.68238634402624,
-                51.3552731673482
-              ],
-              [
-                3.68286708575619,
-                51.3534832166128
-              ],
-              [
-                3.65939819131409,
-                51.3511352578923
-              ],
-              [
-                3.65419652686573,
-                51.3483902204821
-              ],
-              [
-                3.64724216480814,
-                51.3500487458712
-              ],
-              [
-                3.64959920562017,
-                51.3427315248354
-              ],
-              [
-                3.64414900197835,
-                51.3418307226848
-              ],
-              [
-                3.65330982165022,
-                51.3317500618128
-              ],
-              [
-                3.65797215748996,
-                51.3333504981744
-              ],
-              [
-                3.6599912728739,
-                51.3248868647191
-              ],
-              [
-                3.66382773200577,
-                51.3252388827323
-              ],
-              [
-                3.66620418399222,
-                51.3198117090862
-              ],
-              [
-                3.68628368588288,
-                51.321598781352
-              ],
-              [
-                3.71240870880543,
-                51.315790671127
-              ],
-              [
-                3.70358243028794,
-                51.3099431877979
-              ],
-              [
-                3.70715584764472,
-                51.306546767388
-              ],
-              [
-                3.70402960404139,
-                51.302479147816
-              ],
-              [
-                3.7059628865263,
-                51.3002914964105
-              ],
-              [
-                3.7065450344333,
-                51.2887008611469
-              ],
-              [
-                3.69809073186678,
-                51.2850146339886
-              ],
-              [
-                3.70141849974911,
-                51.2826722507297
-              ],
-              [
-                3.6981812389198,
-                51.2827142066406
-              ],
-              [
-                3.69393370522114,
-                51.2760390420358
-              ],
-              [
-                3.68993238133424,
-                51.2806089600033
-              ],
-              [
-                3.67791077016251,
-                51.2805266768765
-              ],
-              [
-                3.67675669417806,
-                51.2830646239236
-              ],
-              [
-                3.65810025189624,
-                51.290188524968
-              ],
-              [
-                3.64440607790048,
-                51.2901108489446
-              ],
-              [
-                3.64081547256249,
-                51.288085854059
-              ],
-              [
-                3.62571005385449,
-                51.2933382955674
-              ],
-              [
-                3.62122787954012,
-                51.292765102926
-              ],
-              [
-                3.61473098200299,
-                51.2977265915111
-              ],
-              [
-                3.59083194593744,
-                51.304416569521
-              ],
-              [
-                3.59001701691565,
-                51.3059522644594
-              ],
-              [
-                3.58111284263797,
-                51.2983212991941
-              ],
-              [
-                3.58792792002284,
-                51.2929604610946
-              ],
-              [
-                3.58338507125506,
-                51.292254233524
-              ],
-              [
-                3.58387951784166,
-                51.2888845646617
-              ],
-              [
-                3.58181205077379,
-                51.2869125099536
-              ],
-              [
-                3.57975637267153,
-                51.2888643857321
-              ],
-              [
-                3.5761594476188,
-                51.2880071297637
-              ],
-              [
-                3.57194128139021,
-                51.2919634705683
-              ],
-              [
-                3.56263612460634,
-                51.295858461662
-              ],
-              [
-                3.5597592178318,
-                51.2954546879091
-              ],
-              [
-                3.55805617964387,
-                51.2911933221278
-              ],
-              [
-                3.55504429169289,
-                51.2896353289463
-              ],
-              [
-                3.54342664559354,
-                51.291197756619
-              ],
-              [
-                3.53810715077364,
-                51.2836651682382
-              ],
-              [
-                3.53366547932044,
-                51.283488772768
-              ],
-              [
-                3.52912976263325,
-                51.2887437264182
-              ],
-              [
-                3.51541480316044,
-                51.2871505990285
-              ],
-              [
-                3.52759417697669,
-                51.2460401551391
-              ],
-              [
-                3.44827648197972,
-                51.2414925155469
-              ],
-              [
-                3.43231383070986,
-                51.2456093581815
-              ],
-              [
-                3.42747886704139,
-                51.2446569367946
-              ],
-              [
-                3.424598656948,
-                51.2542079577712
-              ],
-              [
-                3.41801186333576,
-                51.2576933884768
-              ],
-              [
-                3.41684267318642,
-                51.260010081801
-              ],
-              [
-                3.4114911628541,
-                51.2569031813061
-              ],
-              [
-                3.40750531531614,
-                51.2569201950731
-              ],
-              [
-                3.40316227409453,
-                51.2624631661346
-              ],
-              [
-                3.39464300428227,
-                51.2653166679798
-              ],
-              [
-                3.3879793724386,
-                51.273191830673
-              ],
-              [
-                3.37690435127578,
-                51.276009410688
-              ],
-              [
-                3.37549850772494,
-                51.2790790381507
-              ],
-              [
-                3.38182610844181,
-                51.2819960040847
-              ],
-              [
-                3.38079622947927,
-                51.2869614683497
-              ],
-              [
-                3.37028702913265,
-                51.2919404174688
-              ],
-              [
-                3.36638102287166,
-                51.2964814114502
-              ],
-              [
-                3.36679625876522,
-                51.2993440183315
-              ],
-              [
-                3.37807945375459,
-                51.3026503449535
-              ],
-              [
-                3.35837818923798,
-                51.3149814199478
-              ],
-              [
-                3.38534014876057,
-                51.3341248573703
-              ],
-              [
-                3.38351299562995,
-                51.3412050020318
-              ],
-              [
-                3.37407069353449,
-                51.3485078200605
-              ],
-              [
-                3.37499003258113,
-                51.3588871786237
-              ],
-              [
-                3.36306221596669,
-                51.3728805348513
-              ],
-              [
-                3.37101667393473,
-                51.3750178214238
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1695",
-        "name": "Noord-Beveland",
-        "regioFacet": "tcm:106-353741-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/noord-beveland/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.79433832673576,
-                51.6048746599259
-              ],
-              [
-                3.81479865969127,
-                51.6075036443279
-              ],
-              [
-                3.84876668830029,
-                51.6059796074825
-              ],
-              [
-                3.86795514462283,
-                51.5977062737415
-              ],
-              [
-                3.87484875743253,
-                51.5968102051503
-              ],
-              [
-                3.87904141663578,
-                51.5936707100488
-              ],
-              [
-                3.92476051488258,
-                51.6078608537795
-              ],
-              [
-                3.93682521357662,
-                51.5565312842899
-              ],
-              [
-                3.96095895823842,
-                51.5514773176288
-              ],
-              [
-                3.93797910531657,
-                51.5357776828835
-              ],
-              [
-                3.93254951429699,
-                51.537255927044
-              ],
-              [
-                3.932447351857,
-                51.540449405323
-              ],
-              [
-                3.92695098579388,
-                51.5446344615838
-              ],
-              [
-                3.92401635381261,
-                51.5455943915635
-              ],
-              [
-                3.92229254278007,
-                51.5445029983133
-              ],
-              [
-                3.92250651263732,
-                51.5467107055456
-              ],
-              [
-                3.91448445019673,
-                51.5469759473434
-              ],
-              [
-                3.91314818874766,
-                51.5490537390712
-              ],
-              [
-                3.90788843109842,
-                51.5502818122138
-              ],
-              [
-                3.89513172910207,
-                51.5496503805499
-              ],
-              [
-                3.87094478927088,
-                51.5419385077056
-              ],
-              [
-                3.86008376436042,
-                51.5411543302177
-              ],
-              [
-                3.85247747745925,
-                51.5431930378647
-              ],
-              [
-                3.8348138397146,
-                51.5529848863736
-              ],
-              [
-                3.81682554982493,
-                51.5489835232468
-              ],
-              [
-                3.80187744471841,
-                51.5435061725238
-              ],
-              [
-                3.79358159361963,
-                51.5424795320197
-              ],
-              [
-                3.76828783490461,
-                51.5527732761892
-              ],
-              [
-                3.75991186482507,
-                51.5532253418411
-              ],
-              [
-                3.74002317419461,
-                51.5459107424609
-              ],
-              [
-                3.73597345375445,
-                51.5421241365183
-              ],
-              [
-                3.7187945934636,
-                51.5343521043708
-              ],
-              [
-                3.71360717187264,
-                51.5303669954425
-              ],
-              [
-                3.71187904498703,
-                51.5310665319684
-              ],
-              [
-                3.70567192742069,
-                51.5244864814436
-              ],
-              [
-                3.70038603966994,
-                51.5223521687465
-              ],
-              [
-                3.69783485159236,
-                51.5239887749743
-              ],
-              [
-                3.69524284673439,
-                51.5308276710449
-              ],
-              [
-                3.69862850323421,
-                51.543217973654
-              ],
-              [
-                3.69598874563285,
-                51.5490079838751
-              ],
-              [
-                3.67107768429047,
-                51.5638293297014
-              ],
-              [
-                3.64639804773879,
-                51.570550118217
-              ],
-              [
-                3.63854261584162,
-                51.5900045925135
-              ],
-              [
-                3.63016960674013,
-                51.5956942706877
-              ],
-              [
-                3.65452382629394,
-                51.5960935547722
-              ],
-              [
-                3.68954780619894,
-                51.6015152617662
-              ],
-              [
-                3.69569219150669,
-                51.5996102676365
-              ],
-              [
-                3.69909218327099,
-                51.6010615550694
-              ],
-              [
-                3.70873356844012,
-                51.5986079150729
-              ],
-              [
-                3.73015763451674,
-                51.5974415991975
-              ],
-              [
-                3.7694309647754,
-                51.6008199837879
-              ],
-              [
-                3.79433832673576,
-                51.6048746599259
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0654",
-        "name": "Borsele",
-        "regioFacet": "tcm:106-353736-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/borsele/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.70364793141337,
-                51.4822703467898
-              ],
-              [
-                3.70009117524698,
-                51.4831945303995
-              ],
-              [
-                3.69905046566549,
-                51.4923409706217
-              ],
-              [
-                3.70553632008838,
-                51.5024559072593
-              ],
-              [
-                3.77374390940976,
-                51.4974741153855
-              ],
-              [
-                3.78297385203943,
-                51.4946114777788
-              ],
-              [
-                3.80033002998577,
-                51.4856086183437
-              ],
-              [
-                3.81046298432194,
-                51.4831253124851
-              ],
-              [
-                3.84122814840472,
-                51.4852986038105
-              ],
-              [
-                3.86965657473259,
-                51.4825703312043
-              ],
-              [
-                3.87442286401537,
-                51.4800134419653
-              ],
-              [
-                3.88161188918863,
-                51.4810418886611
-              ],
-              [
-                3.91287767075087,
-                51.4791215776405
-              ],
-              [
-                3.90841397621005,
-                51.4719446729385
-              ],
-              [
-                3.9089232166113,
-                51.4679721974686
-              ],
-              [
-                3.9162730027125,
-                51.4634021261235
-              ],
-              [
-                3.92851023455079,
-                51.4587906498427
-              ],
-              [
-                3.92543273711983,
-                51.4515196629865
-              ],
-              [
-                3.928075605006904,
-                51.436758111803385
-              ],
-              [
-                3.9194138923851756,
-                51.422091006344026
-              ],
-              [
-                3.901604063626462,
-                51.395774648859124
-              ],
-              [
-                3.897049042740248,
-                51.393456022038286
-              ],
-              [
-                3.885339082540985,
-                51.39414904513697
-              ],
-              [
-                3.873249070064918,
-                51.397160146359795
-              ],
-              [
-                3.863758185280631,
-                51.39498184460339
-              ],
-              [
-                3.8601136583460764,
-                51.39127411970239
-              ],
-              [
-                3.812650289851498,
-                51.385199173539405
-              ],
-              [
-                3.790771631976855,
-                51.39796573512581
-              ],
-              [
-                3.775391591618783,
-                51.4054726551704
-              ],
-              [
-                3.7771016303777736,
-                51.409870526073405
-              ],
-              [
-                3.764121488738915,
-                51.40972877886844
-              ],
-              [
-                3.755061196665609,
-                51.414473470286396
-              ],
-              [
-                3.7445309457425586,
-                51.41141500592927
-              ],
-              [
-                3.73660014182675,
-                51.4111127940404
-              ],
-              [
-                3.72447776739453,
-                51.4146013692733
-              ],
-              [
-                3.71042627124281,
-                51.4239380970914
-              ],
-              [
-                3.71805297198028,
-                51.4276513781448
-              ],
-              [
-                3.69639183078517,
-                51.4458511368932
-              ],
-              [
-                3.70983668731084,
-                51.453247195483
-              ],
-              [
-                3.71742353168671,
-                51.4696076721178
-              ],
-              [
-                3.71058453643611,
-                51.4715119281623
-              ],
-              [
-                3.6985066252929,
-                51.4696927910716
-              ],
-              [
-                3.69567808483348,
-                51.4711147149577
-              ],
-              [
-                3.70622803368283,
-                51.4774789221531
-              ],
-              [
-                3.70364793141337,
-                51.4822703467898
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0664",
-        "name": "Goes",
-        "regioFacet": "tcm:106-353737-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/goes/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.76751293378609,
-                51.4982953261064
-              ],
-              [
-                3.70553632008838,
-                51.5024559072593
-              ],
-              [
-                3.70560852621822,
-                51.5036415796997
-              ],
-              [
-                3.71085370491213,
-                51.503470237804
-              ],
-              [
-                3.71110315869276,
-                51.5067206847089
-              ],
-              [
-                3.74524500105052,
-                51.5124719466651
-              ],
-              [
-                3.74694347716421,
-                51.5168524677589
-              ],
-              [
-                3.71360717187264,
-                51.5303669954425
-              ],
-              [
-                3.7187945934636,
-                51.5343521043708
-              ],
-              [
-                3.73655712964029,
-                51.542454359653
-              ],
-              [
-                3.74030810347426,
-                51.5460554735896
-              ],
-              [
-                3.75991186482507,
-                51.5532253418411
-              ],
-              [
-                3.76828783490461,
-                51.5527732761892
-              ],
-              [
-                3.79358159361963,
-                51.5424795320197
-              ],
-              [
-                3.80187744471841,
-                51.5435061725238
-              ],
-              [
-                3.81682554982493,
-                51.5489835232468
-              ],
-              [
-                3.8348138397146,
-                51.5529848863736
-              ],
-              [
-                3.85247747745925,
-                51.5431930378647
-              ],
-              [
-                3.86008376436042,
-                51.5411543302177
-              ],
-              [
-                3.87094478927088,
-                51.5419385077056
-              ],
-              [
-                3.89513172910207,
-                51.5496503805499
-              ],
-              [
-                3.90788843109842,
-                51.5502818122138
-              ],
-              [
-                3.91314818874766,
-                51.5490537390712
-              ],
-              [
-                3.91448445019673,
-                51.5469759473434
-              ],
-              [
-                3.92250651263732,
-                51.5467107055456
-              ],
-              [
-                3.92229254278007,
-                51.5445029983133
-              ],
-              [
-                3.92401635381261,
-                51.5455943915635
-              ],
-              [
-                3.92754018860791,
-                51.5443426233965
-              ],
-              [
-                3.9343280711084,
-                51.5364643873469
-              ],
-              [
-                3.95229754186055,
-                51.5313024616583
-              ],
-              [
-                3.97144922151464,
-                51.5284485543541
-              ],
-              [
-                3.96350603910795,
-                51.5206132196737
-              ],
-              [
-                3.96516940738309,
-                51.506350379066
-              ],
-              [
-                3.9438884420792,
-                51.4974102119073
-              ],
-              [
-                3.94018454467292,
-                51.4931431757345
-              ],
-              [
-                3.92572563365361,
-                51.4918246482838
-              ],
-              [
-                3.92474378646699,
-                51.485372351419
-              ],
-              [
-                3.92091843596091,
-                51.4861144337416
-              ],
-              [
-                3.91501856198028,
-                51.4847995976331
-              ],
-              [
-                3.91676442351866,
-                51.481596597007
-              ],
-              [
-                3.91287767075087,
-                51.4791215776405
-              ],
-              [
-                3.88161188918863,
-                51.4810418886611
-              ],
-              [
-                3.87442286401537,
-                51.4800134419653
-              ],
-              [
-                3.86965657473259,
-                51.4825703312043
-              ],
-              [
-                3.84122814840472,
-                51.4852986038105
-              ],
-              [
-                3.81046298432194,
-                51.4831253124851
-              ],
-              [
-                3.79971051107158,
-                51.4858537162635
-              ],
-              [
-                3.78022600774525,
-                51.4957398087497
-              ],
-              [
-                3.76751293378609,
-                51.4982953261064
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0678",
-        "name": "Kapelle",
-        "regioFacet": "tcm:106-353739-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/kapelle/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.97104754749644,
-                51.5277084013069
-              ],
-              [
-                3.94549536772944,
-                51.5327936401009
-              ],
-              [
-                3.93797910531657,
-                51.5357776828835
-              ],
-              [
-                3.96095895823842,
-                51.5514773176288
-              ],
-              [
-                3.98601556646606,
-                51.5462236142275
-              ],
-              [
-                3.98347777624624,
-                51.5450631772158
-              ],
-              [
-                3.98727142102693,
-                51.5432595881283
-              ],
-              [
-                3.99205321950351,
-                51.5450985153855
-              ],
-              [
-                3.99843266476606,
-                51.5443331634791
-              ],
-              [
-                4.00548543808696,
-                51.5399674109563
-              ],
-              [
-                4.01307990178018,
-                51.538244245492
-              ],
-              [
-                4.01366779063981,
-                51.5174509957965
-              ],
-              [
-                4.01738812139634,
-                51.5124504537072
-              ],
-              [
-                4.01359281280657,
-                51.5075881781571
-              ],
-              [
-                4.00473118506696,
-                51.5048432894408
-              ],
-              [
-                4.00732282691803,
-                51.4586342001926
-              ],
-              [
-                3.99886551024209,
-                51.4562731328919
-              ],
-              [
-                3.9914506814827,
-                51.4498030830015
-              ],
-              [
-                3.9853126010476,
-                51.4529275302666
-              ],
-              [
-                3.96771244042784,
-                51.4563216445561
-              ],
-              [
-                3.94957617386712,
-                51.4540162023541
-              ],
-              [
-                3.94215885758856,
-                51.4515237386921
-              ],
-              [
-                3.93535152927829,
-                51.4470127839368
-              ],
-              [
-                3.92543273711983,
-                51.4515196629865
-              ],
-              [
-                3.92851023455079,
-                51.4587906498427
-              ],
-              [
-                3.90912911540562,
-                51.4678068554209
-              ],
-              [
-                3.90841397621005,
-                51.4719446729385
-              ],
-              [
-                3.91287767075087,
-                51.4791215776405
-              ],
-              [
-                3.91676442351866,
-                51.481596597007
-              ],
-              [
-                3.91501856198028,
-                51.4847995976331
-              ],
-              [
-                3.92091843596091,
-                51.4861144337416
-              ],
-              [
-                3.92479481649581,
-                51.4854247725176
-              ],
-              [
-                3.92572563365361,
-                51.4918246482838
-              ],
-              [
-                3.94018454467292,
-                51.4931431757345
-              ],
-              [
-                3.9438884420792,
-                51.4974102119073
-              ],
-              [
-                3.96516940738309,
-                51.506350379066
-              ],
-              [
-                3.96350603910795,
-                51.5206132196737
-              ],
-              [
-                3.97104754749644,
-                51.5277084013069
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0687",
-        "name": "Middelburg",
-        "regioFacet": "tcm:106-353740-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/middelburg/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.6588403414212,
-                51.5192611523592
-              ],
-              [
-                3.68083564893496,
-                51.5349578133406
-              ],
-              [
-                3.69638225957619,
-                51.5351838722241
-              ],
-              [
-                3.69533836529533,
-                51.5304634643184
-              ],
-              [
-                3.70038603966994,
-                51.5223521687465
-              ],
-              [
-                3.70567192742069,
-                51.5244864814436
-              ],
-              [
-                3.71187904498703,
-                51.5310665319684
-              ],
-              [
-                3.74694347716421,
-                51.5168524677589
-              ],
-              [
-                3.74524500105052,
-                51.5124719466651
-              ],
-              [
-                3.71110315869276,
-                51.5067206847089
-              ],
-              [
-                3.71085370491213,
-                51.503470237804
-              ],
-              [
-                3.70560852621822,
-                51.5036415796997
-              ],
-              [
-                3.69905046566549,
-                51.4923409706217
-              ],
-              [
-                3.70009117524698,
-                51.4831945303995
-              ],
-              [
-                3.70364793141337,
-                51.4822703467898
-              ],
-              [
-                3.6920268830587,
-                51.4798085385074
-              ],
-              [
-                3.66278654072261,
-                51.4685364342956
-              ],
-              [
-                3.65558506279429,
-                51.4682658295221
-              ],
-              [
-                3.64834970401671,
-                51.4698450971018
-              ],
-              [
-                3.62921480889994,
-                51.4809144391279
-              ],
-              [
-                3.6216558635772,
-                51.4754845092783
-              ],
-              [
-                3.61772882972103,
-                51.4777549726989
-              ],
-              [
-                3.60890595949963,
-                51.4755248026101
-              ],
-              [
-                3.60176054818298,
-                51.4793262398308
-              ],
-              [
-                3.59965880278481,
-                51.4752945558445
-              ],
-              [
-                3.58937583717388,
-                51.4798629121604
-              ],
-              [
-                3.58698060948606,
-                51.4792496624858
-              ],
-              [
-                3.57963044939981,
-                51.4892158591334
-              ],
-              [
-                3.58503026620292,
-                51.4909083875394
-              ],
-              [
-                3.58492001955049,
-                51.4943149278183
-              ],
-              [
-                3.5752962977089,
-                51.5066237751985
-              ],
-              [
-                3.58342318826331,
-                51.5086610110785
-              ],
-              [
-                3.57883772425017,
-                51.5124250915963
-              ],
-              [
-                3.5828483926854,
-                51.5134735743711
-              ],
-              [
-                3.58368430030877,
-                51.5196266418324
-              ],
-              [
-                3.59663482934983,
-                51.522451612314
-              ],
-              [
-                3.59378253918492,
-                51.5286401543617
-              ],
-              [
-                3.58977851703557,
-                51.5318113233525
-              ],
-              [
-                3.60262140174374,
-                51.5349102796445
-              ],
-              [
-                3.60354451014267,
-                51.5339573628412
-              ],
-              [
-                3.61315813143206,
-                51.5351419806468
-              ],
-              [
-                3.61713506082999,
-                51.5252565334707
-              ],
-              [
-                3.63393868658183,
-                51.5259913324039
-              ],
-              [
-                3.63454415130012,
-                51.520542326553
-              ],
-              [
-                3.64159677861656,
-                51.5196931986825
-              ],
-              [
-                3.65650609038864,
-                51.5221729191108
-              ],
-              [
-                3.6588403414212,
-                51.5192611523592
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0717",
-        "name": "Veere",
-        "regioFacet": "tcm:106-353747-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/veere/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.5540196626981793,
-                51.58946438249438
-              ],
-              [
-                3.7019346999013796,
-                51.64463453272153
-              ],
-              [
-                3.7382083239903383,
-                51.636893891070535
-              ],
-              [
-                3.70796211217301,
-                51.598725776495
-              ],
-              [
-                3.69909218327099,
-                51.6010615550694
-              ],
-              [
-                3.69569219150669,
-                51.5996102676365
-              ],
-              [
-                3.68954780619894,
-                51.6015152617662
-              ],
-              [
-                3.65452382629394,
-                51.5960935547722
-              ],
-              [
-                3.63016366130651,
-                51.5957728471712
-              ],
-              [
-                3.63854261584162,
-                51.5900045925135
-              ],
-              [
-                3.64639804773879,
-                51.570550118217
-              ],
-              [
-                3.67107768429047,
-                51.5638293297014
-              ],
-              [
-                3.69598874563285,
-                51.5490079838751
-              ],
-              [
-                3.69864483504288,
-                51.5438200458782
-              ],
-              [
-                3.69638225957619,
-                51.5351838722241
-              ],
-              [
-                3.68083564893496,
-                51.5349578133406
-              ],
-              [
-                3.6588403414212,
-                51.5192611523592
-              ],
-              [
-                3.65650609038864,
-                51.5221729191108
-              ],
-              [
-                3.64159677861656,
-                51.5196931986825
-              ],
-              [
-                3.63454415130012,
-                51.520542326553
-              ],
-              [
-                3.63393868658183,
-                51.5259913324039
-              ],
-              [
-                3.61713506082999,
-                51.5252565334707
-              ],
-              [
-                3.61315813143206,
-                51.5351419806468
-              ],
-              [
-                3.60354451014267,
-                51.5339573628412
-              ],
-              [
-                3.60262140174374,
-                51.5349102796445
-              ],
-              [
-                3.58977851703557,
-                51.5318113233525
-              ],
-              [
-                3.59378253918492,
-                51.5286401543617
-              ],
-              [
-                3.59663482934983,
-                51.522451612314
-              ],
-              [
-                3.58368430030877,
-                51.5196266418324
-              ],
-              [
-                3.5828483926854,
-                51.5134735743711
-              ],
-              [
-                3.57883772425017,
-                51.5124250915963
-              ],
-              [
-                3.58342318826331,
-                51.5086610110785
-              ],
-              [
-                3.5752962977089,
-                51.5066237751985
-              ],
-              [
-                3.58492001955049,
-                51.4943149278183
-              ],
-              [
-                3.58503026620292,
-                51.4909083875394
-              ],
-              [
-                3.57963044939981,
-                51.4892158591334
-              ],
-              [
-                3.58697877842918,
-                51.479253365319
-              ],
-              [
-                3.58392315601009,
-                51.4784576320714
-              ],
-              [
-                3.58234206096549,
-                51.4758120497455
-              ],
-              [
-                3.57570238215597,
-                51.4744472623029
-              ],
-              [
-                3.57429497292673,
-                51.4774394256156
-              ],
-              [
-                3.570674960997,
-                51.4768753410339
-              ],
-              [
-                3.56605766813857,
-                51.4782487012776
-              ],
-              [
-                3.56456713623325,
-                51.4704297517074
-              ],
-              [
-                3.56022675063778,
-                51.4711862560103
-              ],
-              [
-                3.55517066162652,
-                51.4679019879847
-              ],
-              [
-                3.55276116882829,
-                51.4709555064229
-              ],
-              [
-                3.54434590664893,
-                51.4664994351648
-              ],
-              [
-                3.52537844163394,
-                51.4622461440332
-              ],
-              [
-                3.49617491247223,
-                51.4924915055981
-              ],
-              [
-                3.45430626109027,
-                51.5107463765281
-              ],
-              [
-                3.44110479513354,
-                51.5212532332637
-              ],
-              [
-                3.43371625139045,
-                51.5247711889863
-              ],
-              [
-                3.43206227195056,
-                51.5284465040136
-              ],
-              [
-                3.43467379178131,
-                51.5422500691818
-              ],
-              [
-                3.45651951426205,
-                51.5498977790558
-              ],
-              [
-                3.4845989826181283,
-                51.56230682776338
-              ],
-              [
-                3.526424905668268,
-                51.57825352619807
-              ],
-              [
-                3.5540196626981793,
-                51.58946438249438
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1930",
-        "name": "Nissewaard",
-        "regioFacet": "tcm:106-401749-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/nissewaard/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.19210613,
-                51.8431691
-              ],
-              [
-                4.19760995,
-                51.8470016
-              ],
-              [
-                4.19825723,
-                51.8498892
-              ],
-              [
-                4.18527252,
-                51.8497076
-              ],
-              [
-                4.23938315,
-                51.8729859
-              ],
-              [
-                4.26610492,
-                51.864657
-              ],
-              [
-                4.33555384,
-                51.8643017
-              ],
-              [
-                4.34831531,
-                51.8519594
-              ],
-              [
-                4.3675994,
-                51.8443831
-              ],
-              [
-                4.38514594,
-                51.842064
-              ],
-              [
-                4.41280757,
-                51.8429582
-              ],
-              [
-                4.43248233,
-                51.8370461
-              ],
-              [
-                4.42100268,
-                51.8298744
-              ],
-              [
-                4.39713182,
-                51.8312751
-              ],
-              [
-                4.36908227,
-                51.8169814
-              ],
-              [
-                4.35452783,
-                51.8191913
-              ],
-              [
-                4.33815049,
-                51.8167719
-              ],
-              [
-                4.33069816,
-                51.8129476
-              ],
-              [
-                4.30736661,
-                51.8091845
-              ],
-              [
-                4.29641285,
-                51.8022065
-              ],
-              [
-                4.26859605,
-                51.8048391
-              ],
-              [
-                4.26321291,
-                51.8026572
-              ],
-              [
-                4.24663994,
-                51.7853475
-              ],
-              [
-                4.2375626,
-                51.7827717
-              ],
-              [
-                4.22283899,
-                51.7879058
-              ],
-              [
-                4.21324684,
-                51.7852673
-              ],
-              [
-                4.20841093,
-                51.787944
-              ],
-              [
-                4.18627635,
-                51.7924938
-              ],
-              [
-                4.17311474,
-                51.7922341
-              ],
-              [
-                4.15028257,
-                51.8050175
-              ],
-              [
-                4.15929422,
-                51.8233717
-              ],
-              [
-                4.16818981,
-                51.8261156
-              ],
-              [
-                4.18663836,
-                51.8354545
-              ],
-              [
-                4.18692888,
-                51.8388351
-              ],
-              [
-                4.19210613,
-                51.8431691
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1916",
-        "name": "Leidschendam-Voorburg",
-        "regioFacet": "tcm:106-353781-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/leidschendam-voorburg/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.37351447117473,
-                52.0922967984259
-              ],
-              [
-                4.37214558501238,
-                52.0943809495179
-              ],
-              [
-                4.40115906560949,
-                52.1118699930428
-              ],
-              [
-                4.42790258451118,
-                52.1014702422015
-              ],
-              [
-                4.4668450645326,
-                52.1289798925208
-              ],
-              [
-                4.47294143991681,
-                52.1237031842473
-              ],
-              [
-                4.47297541607657,
-                52.118951609278
-              ],
-              [
-                4.47082578687286,
-                52.1177081019291
-              ],
-              [
-                4.49041365845919,
-                52.0978583844287
-              ],
-              [
-                4.49301807418669,
-                52.087751912134
-              ],
-              [
-                4.47427472739028,
-                52.0852606641087
-              ],
-              [
-                4.47565986061659,
-                52.0812963000769
-              ],
-              [
-                4.45675207201338,
-                52.0788148301982
-              ],
-              [
-                4.4208325154946,
-                52.0625800458217
-              ],
-              [
-                4.40392354547461,
-                52.0778452596012
-              ],
-              [
-                4.39709232272531,
-                52.0747632022672
-              ],
-              [
-                4.39462152526151,
-                52.0759069171859
-              ],
-              [
-                4.38865095379416,
-                52.0729737848162
-              ],
-              [
-                4.38458266358515,
-                52.0762342732629
-              ],
-              [
-                4.35601112083758,
-                52.061567463364
-              ],
-              [
-                4.36082611105522,
-                52.0584683361269
-              ],
-              [
-                4.35962561610658,
-                52.0572559188762
-              ],
-              [
-                4.35734978736061,
-                52.0562547102735
-              ],
-              [
-                4.35228147179327,
-                52.0593940954388
-              ],
-              [
-                4.34800360076542,
-                52.0564981121851
-              ],
-              [
-                4.34219573201003,
-                52.0611345591736
-              ],
-              [
-                4.35551870246318,
-                52.0680398009164
-              ],
-              [
-                4.33521319178541,
-                52.0750003993839
-              ],
-              [
-                4.37351447117473,
-                52.0922967984259
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0503",
-        "name": "Delft",
-        "regioFacet": "tcm:106-353762-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/delft/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.34104456079856,
-                52.0028438362672
-              ],
-              [
-                4.33845322690571,
-                52.0056907329017
-              ],
-              [
-                4.33637085638882,
-                52.0047531800291
-              ],
-              [
-                4.3326556934841,
-                52.0078828383193
-              ],
-              [
-                4.32324442243217,
-                52.0043356116002
-              ],
-              [
-                4.32063573787635,
-                52.0093220313016
-              ],
-              [
-                4.35577083254136,
-                52.0259884261016
-              ],
-              [
-                4.37349918718748,
-                52.0325988070262
-              ],
-              [
-                4.38624489994702,
-                52.0233081905294
-              ],
-              [
-                4.39451594081357,
-                52.0112556451795
-              ],
-              [
-                4.38834552284363,
-                52.009447639991
-              ],
-              [
-                4.38865568358531,
-                52.0054935408255
-              ],
-              [
-                4.38591573282734,
-                52.0048352531544
-              ],
-              [
-                4.39703012843406,
-                51.9789740204582
-              ],
-              [
-                4.40115232855608,
-                51.9783460012973
-              ],
-              [
-                4.40791087672722,
-                51.9681986042396
-              ],
-              [
-                4.40293341297289,
-                51.9663163128915
-              ],
-              [
-                4.39380981168715,
-                51.9775034749249
-              ],
-              [
-                4.38362248118203,
-                51.975815866422
-              ],
-              [
-                4.38630287880998,
-                51.9722140505778
-              ],
-              [
-                4.36917546462479,
-                51.9688704646731
-              ],
-              [
-                4.36817383922118,
-                51.971581758085
-              ],
-              [
-                4.3595402323711,
-                51.9701353962777
-              ],
-              [
-                4.35662588140513,
-                51.9772962558745
-              ],
-              [
-                4.33822732378908,
-                51.9741777146846
-              ],
-              [
-                4.33272364193561,
-                51.9804566490898
-              ],
-              [
-                4.33185520297508,
-                51.9844897280139
-              ],
-              [
-                4.32411192780407,
-                51.9898586025647
-              ],
-              [
-                4.32573234482612,
-                51.9919512242988
-              ],
-              [
-                4.33310954289294,
-                51.9931717966067
-              ],
-              [
-                4.33152500123165,
-                51.9978521707188
-              ],
-              [
-                4.33771691592929,
-                51.9988024802427
-              ],
-              [
-                4.33583429176399,
-                52.0010553125918
-              ],
-              [
-                4.34104456079856,
-                52.0028438362672
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0556",
-        "name": "Maassluis",
-        "regioFacet": "tcm:106-353783-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/maassluis/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.23216665742637,
-                51.9207094460818
-              ],
-              [
-                4.22370230117173,
-                51.9282467630257
-              ],
-              [
-                4.21271769247552,
-                51.9337274619873
-              ],
-              [
-                4.22829203108518,
-                51.947991500681
-              ],
-              [
-                4.2317670218169,
-                51.9429223516774
-              ],
-              [
-                4.23907594934719,
-                51.9436330520043
-              ],
-              [
-                4.26104891785222,
-                51.9266815104642
-              ],
-              [
-                4.27096016900955,
-                51.9234774166952
-              ],
-              [
-                4.29261100904603,
-                51.9212363182668
-              ],
-              [
-                4.26757121107334,
-                51.9053350410713
-              ],
-              [
-                4.2411195993909,
-                51.9148713772212
-              ],
-              [
-                4.23216665742637,
-                51.9207094460818
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1926",
-        "name": "Pijnacker-Nootdorp",
-        "regioFacet": "tcm:106-353794-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/pijnacker-nootdorp/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.39392842740383,
-                52.0330539137213
-              ],
-              [
-                4.38743617323685,
-                52.0386469136898
-              ],
-              [
-                4.37985819689384,
-                52.0359522821941
-              ],
-              [
-                4.37264043128566,
-                52.0410057235822
-              ],
-              [
-                4.39444499823951,
-                52.0540538992274
-              ],
-              [
-                4.39854953832331,
-                52.0512178687819
-              ],
-              [
-                4.40162477730792,
-                52.0535364146123
-              ],
-              [
-                4.45056413808226,
-                52.0492102058446
-              ],
-              [
-                4.45764380653775,
-                52.0386186580673
-              ],
-              [
-                4.47074367885301,
-                52.0404777085916
-              ],
-              [
-                4.4767902542183,
-                52.0316845456528
-              ],
-              [
-                4.46732368732518,
-                52.0254080626406
-              ],
-              [
-                4.45714644597904,
-                52.0220907874847
-              ],
-              [
-                4.45854683025317,
-                52.0156214951696
-              ],
-              [
-                4.46156168109808,
-                52.0126514131984
-              ],
-              [
-                4.45538895231141,
-                52.0067979928507
-              ],
-              [
-                4.4588999803665,
-                52.0046443531204
-              ],
-              [
-                4.45871715552102,
-                52.0021964328169
-              ],
-              [
-                4.44977590408964,
-                52.0005027663848
-              ],
-              [
-                4.45287764620339,
-                51.9972705324651
-              ],
-              [
-                4.44724792015525,
-                51.9924090542307
-              ],
-              [
-                4.42444629632379,
-                51.9796110208422
-              ],
-              [
-                4.42751675666802,
-                51.9762095705202
-              ],
-              [
-                4.40791087672722,
-                51.9681986042396
-              ],
-              [
-                4.40115232855608,
-                51.9783460012973
-              ],
-              [
-                4.39703012843406,
-                51.9789740204582
-              ],
-              [
-                4.38591573282734,
-                52.0048352531544
-              ],
-              [
-                4.38865568358531,
-                52.0054935408255
-              ],
-              [
-                4.38834552284363,
-                52.009447639991
-              ],
-              [
-                4.39451594081357,
-                52.0112556451795
-              ],
-              [
-                4.38624489994702,
-                52.0233081905294
-              ],
-              [
-                4.37973528852192,
-                52.026732680908
-              ],
-              [
-                4.39392842740383,
-                52.0330539137213
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0599",
-        "name": "Rotterdam",
-        "regioFacet": "tcm:106-353798-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/rotterdam/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.41675072567468,
-                51.9382126613046
-              ],
-              [
-                4.41549216997665,
-                51.9407585416991
-              ],
-              [
-                4.41080290174909,
-                51.9382677313173
-              ],
-              [
-                4.40392016801912,
-                51.9372526135658
-              ],
-              [
-                4.39344792286714,
-                51.9442595192052
-              ],
-              [
-                4.39518781536608,
-                51.9469270400371
-              ],
-              [
-                4.38891030243858,
-                51.9455298248614
-              ],
-              [
-                4.37930946578153,
-                51.9634066253013
-              ],
-              [
-                4.38977941362407,
-                51.9651264002742
-              ],
-              [
-                4.39356412737389,
-                51.9624716338294
-              ],
-              [
-                4.42813190163536,
-                51.9766200954045
-              ],
-              [
-                4.44668207598489,
-                51.9627859523818
-              ],
-              [
-                4.45051351424854,
-                51.9658129067277
-              ],
-              [
-                4.46009839879173,
-                51.9687824783918
-              ],
-              [
-                4.46324098446115,
-                51.977526511946
-              ],
-              [
-                4.46901215030637,
-                51.9805491586639
-              ],
-              [
-                4.49525804654053,
-                51.9674029933702
-              ],
-              [
-                4.49579968799046,
-                51.9718444853346
-              ],
-              [
-                4.5185864772973,
-                51.9602862838775
-              ],
-              [
-                4.52101310546259,
-                51.9634525597282
-              ],
-              [
-                4.53498510741231,
-                51.9682723010152
-              ],
-              [
-                4.53587420877656,
-                51.9704765458968
-              ],
-              [
-                4.53985509534751,
-                51.9698657485702
-              ],
-              [
-                4.54768628290483,
-                51.9728066102212
-              ],
-              [
-                4.55655937291411,
-                51.968455207836
-              ],
-              [
-                4.56241373944195,
-                51.9719267175324
-              ],
-              [
-                4.56693603259525,
-                51.9711898133734
-              ],
-              [
-                4.57388287141302,
-                51.9726601300218
-              ],
-              [
-                4.57899981927374,
-                51.9757871932523
-              ],
-              [
-                4.57352940255825,
-                51.9745761172857
-              ],
-              [
-                4.57022990149522,
-                51.9790300995084
-              ],
-              [
-                4.57891848724302,
-                51.9811342005901
-              ],
-              [
-                4.57731222822984,
-                51.9864048316578
-              ],
-              [
-                4.57968715848174,
-                51.9872671567479
-              ],
-              [
-                4.57893575912046,
-                51.9898698638702
-              ],
-              [
-                4.5943675179436,
-                51.9942815510051
-              ],
-              [
-                4.59877304155865,
-                51.9879685918232
-              ],
-              [
-                4.59376885590536,
-                51.9760001813181
-              ],
-              [
-                4.60180826450007,
-                51.9721400985211
-              ],
-              [
-                4.59002194696687,
-                51.9685809605949
-              ],
-              [
-                4.56801288090096,
-                51.958079905877
-              ],
-              [
-                4.56436713113545,
-                51.9599245350308
-              ],
-              [
-                4.56205310884728,
-                51.9588745442297
-              ],
-              [
-                4.5640634379892,
-                51.950808852336
-              ],
-              [
-                4.57277964515161,
-                51.9434561111395
-              ],
-              [
-                4.56059446102156,
-                51.9409100780848
-              ],
-              [
-                4.56559924620336,
-                51.9247567168312
-              ],
-              [
-                4.55691246565784,
-                51.919912009442
-              ],
-              [
-                4.53754956475637,
-                51.9197783769474
-              ],
-              [
-                4.54052258986392,
-                51.9152418607485
-              ],
-              [
-                4.53822209173301,
-                51.9153441593683
-              ],
-              [
-                4.53731170734832,
-                51.9135545396722
-              ],
-              [
-                4.54213595410097,
-                51.9062045556646
-              ],
-              [
-                4.54769603462573,
-                51.907173800437
-              ],
-              [
-                4.550262687705,
-                51.9148179462373
-              ],
-              [
-                4.5539958743627,
-                51.9166177993198
-              ],
-              [
-                4.55402493497616,
-                51.9147795535981
-              ],
-              [
-                4.55649588774665,
-                51.9148031381622
-              ],
-              [
-                4.55682830896494,
-                51.9103857060344
-              ],
-              [
-                4.55105057902737,
-                51.909637944003
-              ],
-              [
-                4.54923843572362,
-                51.9048821240703
-              ],
-              [
-                4.55508755972862,
-                51.9056464572711
-              ],
-              [
-                4.57632845447595,
-                51.903031718863
-              ],
-              [
-                4.56911495990605,
-                51.8781503402201
-              ],
-              [
-                4.56145636036516,
-                51.8782016780017
-              ],
-              [
-                4.54257866644455,
-                51.8707986533437
-              ],
-              [
-                4.53202663377358,
-                51.8687767114226
-              ],
-              [
-                4.52609543767934,
-                51.8697307572239
-              ],
-              [
-                4.49404026921609,
-                51.8689681791646
-              ],
-              [
-                4.47991551595265,
-                51.8652744617618
-              ],
-              [
-                4.48040261583466,
-                51.8623275600006
-              ],
-              [
-                4.4718627872085,
-                51.861667236314
-              ],
-              [
-                4.44102022537672,
-                51.8659097411825
-              ],
-              [
-                4.43470030486663,
-                51.8691093796471
-              ],
-              [
-                4.41814858240375,
-                51.8723747404795
-              ],
-              [
-                4.41225288212748,
-                51.8714285808575
-              ],
-              [
-                4.40577093059429,
-                51.8731981946996
-              ],
-              [
-                4.3948461613781,
-                51.8723327069048
-              ],
-              [
-                4.38852678256794,
-                51.8688208986552
-              ],
-              [
-                4.38444957564059,
-                51.8616089908949
-              ],
-              [
-                4.38366452519404,
-                51.8421182535961
-              ],
-              [
-                4.36759940328029,
-                51.8443831080286
-              ],
-              [
-                4.35258237208218,
-                51.849504515876
-              ],
-              [
-                4.34268019456023,
-                51.855895441037
-              ],
-              [
-                4.33555384260874,
-                51.8643017436892
-              ],
-              [
-                4.2661049297417,
-                51.8646570094816
-              ],
-              [
-                4.24089036119168,
-                51.8723563915903
-              ],
-              [
-                4.2329696165462,
-                51.8774857982126
-              ],
-              [
-                4.22769211620472,
-                51.8877218821526
-              ],
-              [
-                4.21659181429438,
-                51.8985364410851
-              ],
-              [
-                4.17647462043577,
-                51.9179196719027
-              ],
-              [
-                4.16818680518225,
-                51.9190570158313
-              ],
-              [
-                4.16701818885872,
-                51.9231254859356
-              ],
-              [
-                4.16266851498686,
-                51.9266519454962
-              ],
-              [
-                4.14348823971667,
-                51.9317036422648
-              ],
-              [
-                4.10772382718376,
-                51.9361416062152
-              ],
-              [
-                4.10171964040216,
-                51.9270092775237
-              ],
-              [
-                4.10200273094084,
-                51.9235301560871
-              ],
-              [
-                4.09289389285003,
-                51.9325279395235
-              ],
-              [
-                4.07018146111234,
-                51.9332906938586
-              ],
-              [
-                3.9934891811652373,
-                51.91570653221236
-              ],
-              [
-                3.98298550901177,
-                51.92015926296965
-              ],
-              [
-                3.961419474015484,
-                51.95570949771207
-              ],
-              [
-                3.9613957590981066,
-                51.961848351328925
-              ],
-              [
-                3.963317875499906,
-                51.96672339960452
-              ],
-              [
-                3.9667064386339757,
-                51.971311804835096
-              ],
-              [
-                3.9721552937504905,
-                51.97590021006568
-              ],
-              [
-                3.981907901515121,
-                51.98493433115202
-              ],
-              [
-                4.00439709139327,
-                51.98935311570986
-              ],
-              [
-                4.022022790489046,
-                51.987615089695424
-              ],
-              [
-                4.0364772117783785,
-                51.987529395429796
-              ],
-              [
-                4.073032539848789,
-                51.97702961770386
-              ],
-              [
-                4.0955487014031915,
-                51.98449959787291
-              ],
-              [
-                4.124385455661779,
-                52.00197880924332
-              ],
-              [
-                4.13251763252161,
-                51.9985425949582
-              ],
-              [
-                4.13098726323887,
-                51.997179315657
-              ],
-              [
-                4.13568802276178,
-                51.992635526063
-              ],
-              [
-                4.15097168717399,
-                51.9876361970413
-              ],
-              [
-                4.1472807051702,
-                51.9813932823027
-              ],
-              [
-                4.1553486666654,
-                51.9797074632335
-              ],
-              [
-                4.15506257179836,
-                51.9778380563131
-              ],
-              [
-                4.17589818955367,
-                51.9716937876005
-              ],
-              [
-                4.18749279692752,
-                51.9668370135168
-              ],
-              [
-                4.18716394179831,
-                51.9656581162983
-              ],
-              [
-                4.19060881632785,
-                51.9659816191256
-              ],
-              [
-                4.18672385265465,
-                51.9626759130491
-              ],
-              [
-                4.19239603778806,
-                51.954805937859
-              ],
-              [
-                4.21968772163404,
-                51.9415248009986
-              ],
-              [
-                4.21271769247552,
-                51.9337274619873
-              ],
-              [
-                4.22404086409043,
-                51.9280193560774
-              ],
-              [
-                4.23675809568954,
-                51.9171097486777
-              ],
-              [
-                4.27496552462464,
-                51.9024399005493
-              ],
-              [
-                4.29209172447951,
-                51.8977273796474
-              ],
-              [
-                4.32950883353898,
-                51.8940625348336
-              ],
-              [
-                4.36003466598577,
-                51.8994313819585
-              ],
-              [
-                4.39197848494412,
-                51.8966873462016
-              ],
-              [
-                4.41371031596056,
-                51.9007546217073
-              ],
-              [
-                4.41233831789996,
-                51.9024211114924
-              ],
-              [
-                4.41567695494093,
-                51.9050247806135
-              ],
-              [
-                4.4090753217175,
-                51.9120497341279
-              ],
-              [
-                4.41525184634784,
-                51.9144839313934
-              ],
-              [
-                4.41701249255489,
-                51.9225218708407
-              ],
-              [
-                4.40816525715422,
-                51.9320428697096
-              ],
-              [
-                4.40946540239946,
-                51.9361515213671
-              ],
-              [
-                4.41675072567468,
-                51.9382126613046
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0622",
-        "name": "Vlaardingen",
-        "regioFacet": "tcm:106-353805-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/vlaardingen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.27034429190451,
-                51.9070991494067
-              ],
-              [
-                4.31369331460118,
-                51.9348901269811
-              ],
-              [
-                4.3228932058058,
-                51.9427122359588
-              ],
-              [
-                4.32573602100337,
-                51.9422281302187
-              ],
-              [
-                4.33655642650975,
-                51.947397002185
-              ],
-              [
-                4.35067135930857,
-                51.9515065189897
-              ],
-              [
-                4.3625688400189,
-                51.934907037961
-              ],
-              [
-                4.36392208208413,
-                51.9276874612182
-              ],
-              [
-                4.36182571837014,
-                51.9265710960252
-              ],
-              [
-                4.36219201338674,
-                51.9240953146322
-              ],
-              [
-                4.36551264525702,
-                51.9215461915629
-              ],
-              [
-                4.37062268499617,
-                51.89935552598
-              ],
-              [
-                4.35654545538361,
-                51.899045127537
-              ],
-              [
-                4.33527891599984,
-                51.8944289518161
-              ],
-              [
-                4.32225017248199,
-                51.8942756207769
-              ],
-              [
-                4.28653372317048,
-                51.898895744205
-              ],
-              [
-                4.26757121107334,
-                51.9053350410713
-              ],
-              [
-                4.27034429190451,
-                51.9070991494067
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0214",
-        "name": "Buren",
-        "regioFacet": "tcm:106-353454-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/buren/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.43805159997148,
-                51.9856719054497
-              ],
-              [
-                5.47099056205627,
-                51.9829872090989
-              ],
-              [
-                5.48621400501514,
-                51.9838264416365
-              ],
-              [
-                5.51248139543545,
-                51.9730813802252
-              ],
-              [
-                5.53894216284452,
-                51.9678270325731
-              ],
-              [
-                5.5495610745332,
-                51.959196891481
-              ],
-              [
-                5.55693377691665,
-                51.955711022757
-              ],
-              [
-                5.58661658744748,
-                51.950828946294
-              ],
-              [
-                5.58456091850177,
-                51.9474025421615
-              ],
-              [
-                5.57187196119777,
-                51.9415365602441
-              ],
-              [
-                5.56657790367128,
-                51.9416683795757
-              ],
-              [
-                5.5606955900044,
-                51.9440566902723
-              ],
-              [
-                5.54944482279627,
-                51.9423024288459
-              ],
-              [
-                5.53350837444658,
-                51.9433041146004
-              ],
-              [
-                5.53995901837284,
-                51.9370649303295
-              ],
-              [
-                5.54844114206898,
-                51.9342527361915
-              ],
-              [
-                5.5541391994102,
-                51.9342725121768
-              ],
-              [
-                5.5538766989494,
-                51.9229592953733
-              ],
-              [
-                5.55683674074135,
-                51.9192080909413
-              ],
-              [
-                5.55174899739599,
-                51.9177948616813
-              ],
-              [
-                5.54770574937245,
-                51.9216470680416
-              ],
-              [
-                5.51229874480867,
-                51.9213750903797
-              ],
-              [
-                5.50529473739547,
-                51.9239264271358
-              ],
-              [
-                5.49838295180544,
-                51.9231169999318
-              ],
-              [
-                5.49355712905224,
-                51.9185065875972
-              ],
-              [
-                5.48982679544848,
-                51.9182470748006
-              ],
-              [
-                5.48642137260727,
-                51.9189345766015
-              ],
-              [
-                5.4868667811048,
-                51.9210373161528
-              ],
-              [
-                5.48901482539838,
-                51.92101910435
-              ],
-              [
-                5.48783971895225,
-                51.9229371957357
-              ],
-              [
-                5.49089612919575,
-                51.9247445078682
-              ],
-              [
-                5.47652722443326,
-                51.9208697706571
-              ],
-              [
-                5.46007106305368,
-                51.924131965163
-              ],
-              [
-                5.44697955714131,
-                51.9205716021762
-              ],
-              [
-                5.43511173221359,
-                51.9206704257792
-              ],
-              [
-                5.43186843499894,
-                51.9185038132817
-              ],
-              [
-                5.42298733869857,
-                51.9170139255808
-              ],
-              [
-                5.41847629329684,
-                51.9134446790392
-              ],
-              [
-                5.41125678164416,
-                51.9120088065267
-              ],
-              [
-                5.409625872624,
-                51.9078575158499
-              ],
-              [
-                5.40447058885371,
-                51.9052316807833
-              ],
-              [
-                5.39946598929069,
-                51.8983905699826
-              ],
-              [
-                5.39260636845606,
-                51.8968404468236
-              ],
-              [
-                5.35378264882353,
-                51.8780663734359
-              ],
-              [
-                5.34524198858588,
-                51.8776247638731
-              ],
-              [
-                5.34166487800033,
-                51.873485985392
-              ],
-              [
-                5.33884923810733,
-                51.8742321066188
-              ],
-              [
-                5.33945606370216,
-                51.8778740460049
-              ],
-              [
-                5.33549865748312,
-                51.881638985767
-              ],
-              [
-                5.32682997922069,
-                51.8825878282739
-              ],
-              [
-                5.31551391570797,
-                51.8804041391464
-              ],
-              [
-                5.31548904804028,
-                51.8825981587108
-              ],
-              [
-                5.32199737129917,
-                51.8856676436594
-              ],
-              [
-                5.32336642382892,
-                51.8890103482788
-              ],
-              [
-                5.31276677638817,
-                51.8958029991871
-              ],
-              [
-                5.30667665635686,
-                51.8961548552557
-              ],
-              [
-                5.29732351561554,
-                51.901579046555
-              ],
-              [
-                5.29489340599712,
-                51.9000523134662
-              ],
-              [
-                5.27361075358713,
-                51.9200744522356
-              ],
-              [
-                5.28518491285603,
-                51.9225770554036
-              ],
-              [
-                5.2855080526673,
-                51.9302327608499
-              ],
-              [
-                5.28097666197444,
-                51.9331677978622
-              ],
-              [
-                5.26776126277386,
-                51.932756053174
-              ],
-              [
-                5.25516542672053,
-                51.9353886461951
-              ],
-              [
-                5.24192155952711,
-                51.945515828465
-              ],
-              [
-                5.26008207207125,
-                51.951695419424
-              ],
-              [
-                5.25531834957568,
-                51.9542211093103
-              ],
-              [
-                5.27037360417586,
-                51.9654266197614
-              ],
-              [
-                5.3008941375279,
-                51.961568558462
-              ],
-              [
-                5.31485694592988,
-                51.955619990748
-              ],
-              [
-                5.32343914414693,
-                51.9550971040709
-              ],
-              [
-                5.3352206858336,
-                51.9580440967117
-              ],
-              [
-                5.34709475905665,
-                51.9669974873532
-              ],
-              [
-                5.35403750488842,
-                51.969981733103
-              ],
-              [
-                5.36184471949485,
-                51.9709663790002
-              ],
-              [
-                5.37690854556797,
-                51.9691614040716
-              ],
-              [
-                5.38706987802058,
-                51.9698307412578
-              ],
-              [
-                5.4172940996625,
-                51.9763371084667
-              ],
-              [
-                5.43805159997148,
-                51.9856719054497
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0216",
-        "name": "Culemborg",
-        "regioFacet": "tcm:106-353455-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/culemborg/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.21248875650087,
-                51.960388022291
-              ],
-              [
-                5.22150744767728,
-                51.9652851856638
-              ],
-              [
-                5.2308510067707,
-                51.976348536006
-              ],
-              [
-                5.23827039013344,
-                51.9788943872345
-              ],
-              [
-                5.24652374509151,
-                51.9774982344718
-              ],
-              [
-                5.25753961383643,
-                51.9702600396389
-              ],
-              [
-                5.27037360417586,
-                51.9654266197614
-              ],
-              [
-                5.25531834957568,
-                51.9542211093103
-              ],
-              [
-                5.26008207207125,
-                51.951695419424
-              ],
-              [
-                5.24192155952711,
-                51.945515828465
-              ],
-              [
-                5.25526522518407,
-                51.9358286336399
-              ],
-              [
-                5.24260317458401,
-                51.9271947651376
-              ],
-              [
-                5.23318038842905,
-                51.9235874695282
-              ],
-              [
-                5.21180991397895,
-                51.9196453634024
-              ],
-              [
-                5.20768561894273,
-                51.9262135365731
-              ],
-              [
-                5.15919605678526,
-                51.9196301196075
-              ],
-              [
-                5.16679705206686,
-                51.9260201460552
-              ],
-              [
-                5.15830929533744,
-                51.9284501023433
-              ],
-              [
-                5.15035937021001,
-                51.9281891901796
-              ],
-              [
-                5.14243287556953,
-                51.9249365800755
-              ],
-              [
-                5.14845984819258,
-                51.9294851890313
-              ],
-              [
-                5.14827623518085,
-                51.9327373116208
-              ],
-              [
-                5.15146713459403,
-                51.9336152519283
-              ],
-              [
-                5.180226656525,
-                51.9674483239783
-              ],
-              [
-                5.19762525828142,
-                51.9604527106208
-              ],
-              [
-                5.20598882032303,
-                51.9594324814672
-              ],
-              [
-                5.21248875650087,
-                51.960388022291
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0236",
-        "name": "Geldermalsen",
-        "regioFacet": "tcm:106-353464-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/geldermalsen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.13808749509216,
-                51.872905957819
-              ],
-              [
-                5.12072909560015,
-                51.8714578669154
-              ],
-              [
-                5.11809253202647,
-                51.8776218009041
-              ],
-              [
-                5.12089193908738,
-                51.8831931101228
-              ],
-              [
-                5.11427458582909,
-                51.8892657551397
-              ],
-              [
-                5.11767129555002,
-                51.8925699005298
-              ],
-              [
-                5.11689047313936,
-                51.898032423414
-              ],
-              [
-                5.11940886370849,
-                51.8986052602702
-              ],
-              [
-                5.13227754634319,
-                51.9148817578143
-              ],
-              [
-                5.13643575346573,
-                51.9145084266385
-              ],
-              [
-                5.13567474584763,
-                51.9182227408624
-              ],
-              [
-                5.14243287556953,
-                51.9249365800755
-              ],
-              [
-                5.15035937021001,
-                51.9281891901796
-              ],
-              [
-                5.15830929533744,
-                51.9284501023433
-              ],
-              [
-                5.16679705206686,
-                51.9260201460552
-              ],
-              [
-                5.15919605678526,
-                51.9196301196075
-              ],
-              [
-                5.20768561894273,
-                51.9262135365731
-              ],
-              [
-                5.21180991397895,
-                51.9196453634024
-              ],
-              [
-                5.23394421693762,
-                51.9238650626544
-              ],
-              [
-                5.24344022734213,
-                51.9276207733361
-              ],
-              [
-                5.25506188037595,
-                51.9354825380068
-              ],
-              [
-                5.26776126277386,
-                51.932756053174
-              ],
-              [
-                5.28097666197444,
-                51.9331677978622
-              ],
-              [
-                5.2849468662791,
-                51.9306916459209
-              ],
-              [
-                5.28677777045699,
-                51.9282089070629
-              ],
-              [
-                5.28518491285603,
-                51.9225770554036
-              ],
-              [
-                5.27361075358713,
-                51.9200744522356
-              ],
-              [
-                5.29489340599712,
-                51.9000523134662
-              ],
-              [
-                5.29732351561554,
-                51.901579046555
-              ],
-              [
-                5.30667665635686,
-                51.8961548552557
-              ],
-              [
-                5.31276677638817,
-                51.8958029991871
-              ],
-              [
-                5.32336642382892,
-                51.8890103482788
-              ],
-              [
-                5.3233428042941,
-                51.8868359870982
-              ],
-              [
-                5.31629404593808,
-                51.8833230401276
-              ],
-              [
-                5.31514841177422,
-                51.8805932815014
-              ],
-              [
-                5.32660300377813,
-                51.8825733484098
-              ],
-              [
-                5.33407139933103,
-                51.881889717527
-              ],
-              [
-                5.33767035319694,
-                51.8806430175696
-              ],
-              [
-                5.33955144757545,
-                51.8773506868271
-              ],
-              [
-                5.33658310010568,
-                51.8699663376018
-              ],
-              [
-                5.32803260100655,
-                51.8647967955848
-              ],
-              [
-                5.32836241225531,
-                51.8593502367116
-              ],
-              [
-                5.31613722681825,
-                51.8568928869848
-              ],
-              [
-                5.3088632085982,
-                51.851760309813
-              ],
-              [
-                5.27995562091074,
-                51.8571836498314
-              ],
-              [
-                5.26175759605162,
-                51.8550862901481
-              ],
-              [
-                5.24164796079964,
-                51.8564077290243
-              ],
-              [
-                5.23924975761889,
-                51.8543667728835
-              ],
-              [
-                5.22990818976594,
-                51.8563800657652
-              ],
-              [
-                5.21835576424736,
-                51.8519136201119
-              ],
-              [
-                5.19193637340786,
-                51.8475876158538
-              ],
-              [
-                5.14964235514752,
-                51.8473043310426
-              ],
-              [
-                5.15054386249787,
-                51.8613667404662
-              ],
-              [
-                5.13808749509216,
-                51.872905957819
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0733",
-        "name": "Lingewaal",
-        "regioFacet": "tcm:106-353470-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/lingewaal/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.99951078105314,
-                51.8449416263255
-              ],
-              [
-                4.99915128496728,
-                51.8522846447239
-              ],
-              [
-                4.99472904272613,
-                51.8617213761685
-              ],
-              [
-                5.00552208709206,
-                51.8579125728085
-              ],
-              [
-                5.01652809543071,
-                51.8591896397263
-              ],
-              [
-                5.05878480110326,
-                51.8580619955283
-              ],
-              [
-                5.06364452030005,
-                51.8610577459177
-              ],
-              [
-                5.05219884033121,
-                51.8666430072882
-              ],
-              [
-                5.05482236474297,
-                51.8727420779113
-              ],
-              [
-                5.08053240414626,
-                51.8755247253657
-              ],
-              [
-                5.0863395901515,
-                51.8807689634201
-              ],
-              [
-                5.08815941342757,
-                51.8870943567455
-              ],
-              [
-                5.09097455706619,
-                51.8889764266091
-              ],
-              [
-                5.11273680672669,
-                51.8877761783713
-              ],
-              [
-                5.11427458582909,
-                51.8892657551397
-              ],
-              [
-                5.12089193908738,
-                51.8831931101228
-              ],
-              [
-                5.11809253202647,
-                51.8776218009041
-              ],
-              [
-                5.12057876888504,
-                51.8715725678041
-              ],
-              [
-                5.12286138552064,
-                51.8707638118463
-              ],
-              [
-                5.13808749509216,
-                51.872905957819
-              ],
-              [
-                5.15051568566838,
-                51.8614135220119
-              ],
-              [
-                5.14927127660922,
-                51.8481821120588
-              ],
-              [
-                5.15408581205733,
-                51.8474312409578
-              ],
-              [
-                5.15252315719335,
-                51.8316195904613
-              ],
-              [
-                5.15847512324181,
-                51.8234002441951
-              ],
-              [
-                5.15928034017158,
-                51.8164799096668
-              ],
-              [
-                5.14090745023406,
-                51.8141194492153
-              ],
-              [
-                5.13016719824033,
-                51.8146581850838
-              ],
-              [
-                5.100833947809,
-                51.8245036123879
-              ],
-              [
-                5.08749154840652,
-                51.8268029228934
-              ],
-              [
-                5.07411030985308,
-                51.8258207873277
-              ],
-              [
-                5.04718134848422,
-                51.8179130660102
-              ],
-              [
-                5.02879039323774,
-                51.8183236890786
-              ],
-              [
-                5.02635176720462,
-                51.8188567383574
-              ],
-              [
-                5.03141500202702,
-                51.8410848008601
-              ],
-              [
-                5.01147112495554,
-                51.842430261424
-              ],
-              [
-                4.99951078105314,
-                51.8449416263255
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1740",
-        "name": "Neder-Betuwe",
-        "regioFacet": "tcm:106-353476-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/neder-betuwe/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.55683538905389,
-                51.9192081288472
-              ],
-              [
-                5.5538766989494,
-                51.9229592953733
-              ],
-              [
-                5.5541391994102,
-                51.9342725121768
-              ],
-              [
-                5.54844114206898,
-                51.9342527361915
-              ],
-              [
-                5.53995901837284,
-                51.9370649303295
-              ],
-              [
-                5.53388092216222,
-                51.9434816169124
-              ],
-              [
-                5.54944482279627,
-                51.9423024288459
-              ],
-              [
-                5.5606955900044,
-                51.9440566902723
-              ],
-              [
-                5.56657790367128,
-                51.9416683795757
-              ],
-              [
-                5.57187196119777,
-                51.9415365602441
-              ],
-              [
-                5.58456091850177,
-                51.9474025421615
-              ],
-              [
-                5.58661658744748,
-                51.950828946294
-              ],
-              [
-                5.60578232541482,
-                51.9431597075245
-              ],
-              [
-                5.61906149568667,
-                51.9413249509596
-              ],
-              [
-                5.62891636955676,
-                51.9366726644267
-              ],
-              [
-                5.63416855430933,
-                51.9364110666636
-              ],
-              [
-                5.63991239226001,
-                51.9385020607809
-              ],
-              [
-                5.64962164691727,
-                51.9500211806407
-              ],
-              [
-                5.64425929647319,
-                51.9523488564954
-              ],
-              [
-                5.64892254702541,
-                51.9540012902818
-              ],
-              [
-                5.6659889913974,
-                51.9527994745059
-              ],
-              [
-                5.65480129295252,
-                51.9465444809783
-              ],
-              [
-                5.65041918404418,
-                51.9474530790893
-              ],
-              [
-                5.64633388187726,
-                51.9410723157138
-              ],
-              [
-                5.65318334418391,
-                51.9430263739697
-              ],
-              [
-                5.65434530133354,
-                51.941411270051
-              ],
-              [
-                5.66114428595475,
-                51.943607339388
-              ],
-              [
-                5.66313794195819,
-                51.9407117507833
-              ],
-              [
-                5.66572267805406,
-                51.9414049574463
-              ],
-              [
-                5.66775857014609,
-                51.9388918016633
-              ],
-              [
-                5.65961876959885,
-                51.9370819154242
-              ],
-              [
-                5.66035094369712,
-                51.9296122238665
-              ],
-              [
-                5.67093164167724,
-                51.9305452874227
-              ],
-              [
-                5.68877181067798,
-                51.9351403306639
-              ],
-              [
-                5.69303069118669,
-                51.9346475316762
-              ],
-              [
-                5.69632230358886,
-                51.9312511227262
-              ],
-              [
-                5.68981057084953,
-                51.932492424801
-              ],
-              [
-                5.68445404434368,
-                51.9298000585893
-              ],
-              [
-                5.67843480084155,
-                51.9291046195664
-              ],
-              [
-                5.67581002071597,
-                51.9261531859054
-              ],
-              [
-                5.67763064338091,
-                51.9242876276353
-              ],
-              [
-                5.67600926587611,
-                51.9211149636201
-              ],
-              [
-                5.68121100304319,
-                51.9183196939821
-              ],
-              [
-                5.69627761738388,
-                51.9208522365369
-              ],
-              [
-                5.69219546056215,
-                51.9183950955567
-              ],
-              [
-                5.69979001430017,
-                51.914253521588
-              ],
-              [
-                5.70593792763521,
-                51.9082231740382
-              ],
-              [
-                5.70531771581071,
-                51.9040360617599
-              ],
-              [
-                5.70814274287922,
-                51.9012491021029
-              ],
-              [
-                5.70635791701855,
-                51.8979887741804
-              ],
-              [
-                5.71102153401361,
-                51.8965898423346
-              ],
-              [
-                5.70665939306917,
-                51.8932915649798
-              ],
-              [
-                5.69215935042076,
-                51.8955207939989
-              ],
-              [
-                5.66694773723814,
-                51.8956708363426
-              ],
-              [
-                5.63486113318673,
-                51.8991128385309
-              ],
-              [
-                5.5990866383103,
-                51.8991209688707
-              ],
-              [
-                5.56527240311672,
-                51.9017677204947
-              ],
-              [
-                5.54726315290259,
-                51.8996232311552
-              ],
-              [
-                5.52436143156699,
-                51.8926921582826
-              ],
-              [
-                5.50903115453536,
-                51.8898448688927
-              ],
-              [
-                5.46391674620419,
-                51.8890089459114
-              ],
-              [
-                5.45552579218346,
-                51.9045620743545
-              ],
-              [
-                5.47245103624039,
-                51.9080688731787
-              ],
-              [
-                5.47215132841614,
-                51.9117165920892
-              ],
-              [
-                5.47051943016497,
-                51.911424790272
-              ],
-              [
-                5.46712677472725,
-                51.9151560487818
-              ],
-              [
-                5.46973012929588,
-                51.9198777365917
-              ],
-              [
-                5.46164374885386,
-                51.9216207659756
-              ],
-              [
-                5.45392244541386,
-                51.9205172522105
-              ],
-              [
-                5.45338451746069,
-                51.9227613570231
-              ],
-              [
-                5.46489882245376,
-                51.9238946054122
-              ],
-              [
-                5.47652722443326,
-                51.9208697706571
-              ],
-              [
-                5.49089612919575,
-                51.9247445078682
-              ],
-              [
-                5.48783971895225,
-                51.9229371957357
-              ],
-              [
-                5.48901482539838,
-                51.92101910435
-              ],
-              [
-                5.4868667811048,
-                51.9210373161528
-              ],
-              [
-                5.48642137260727,
-                51.9189345766015
-              ],
-              [
-                5.49041982487765,
-                51.9182264478893
-              ],
-              [
-                5.4936837411219,
-                51.9185831426167
-              ],
-              [
-                5.49827123334255,
-                51.9230754361732
-              ],
-              [
-                5.50529473739547,
-                51.9239264271358
-              ],
-              [
-                5.51229874480867,
-                51.9213750903797
-              ],
-              [
-                5.54770574937245,
-                51.9216470680416
-              ],
-              [
-                5.55174899739599,
-                51.9177948616813
-              ],
-              [
-                5.55683538905389,
-                51.9192081288472
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0304",
-        "name": "Neerijnen",
-        "regioFacet": "tcm:106-353477-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/neerijnen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.33208824659748,
-                51.8572953581925
-              ],
-              [
-                5.33834686689876,
-                51.8587264910045
-              ],
-              [
-                5.34280803642755,
-                51.8567119415268
-              ],
-              [
-                5.34810494217591,
-                51.8588412548064
-              ],
-              [
-                5.36006965730052,
-                51.8583624250286
-              ],
-              [
-                5.3784077382683,
-                51.8633999311876
-              ],
-              [
-                5.39168817206703,
-                51.8539236337953
-              ],
-              [
-                5.39523915917513,
-                51.85293350099
-              ],
-              [
-                5.39954652432629,
-                51.8546187830157
-              ],
-              [
-                5.4079767563203,
-                51.8544114798685
-              ],
-              [
-                5.41523417800408,
-                51.8518010179983
-              ],
-              [
-                5.40589659522057,
-                51.8411565023084
-              ],
-              [
-                5.38538606269466,
-                51.8281856165923
-              ],
-              [
-                5.36775490273372,
-                51.8094276073909
-              ],
-              [
-                5.35752371313907,
-                51.8047099065599
-              ],
-              [
-                5.3493950465374,
-                51.8039772918671
-              ],
-              [
-                5.34164235882041,
-                51.8054729293067
-              ],
-              [
-                5.31980626875921,
-                51.8205630787131
-              ],
-              [
-                5.30460030121013,
-                51.8239633516186
-              ],
-              [
-                5.2924813765769,
-                51.8236547290269
-              ],
-              [
-                5.27396362828751,
-                51.8198401044355
-              ],
-              [
-                5.25169719085086,
-                51.8179149813594
-              ],
-              [
-                5.2128820000599,
-                51.8100488816143
-              ],
-              [
-                5.19708866393737,
-                51.8111089328913
-              ],
-              [
-                5.17355180912624,
-                51.8168116300141
-              ],
-              [
-                5.15928034017158,
-                51.8164799096668
-              ],
-              [
-                5.15847512324181,
-                51.8234002441951
-              ],
-              [
-                5.15252315719335,
-                51.8316195904613
-              ],
-              [
-                5.15408581205733,
-                51.8474312409578
-              ],
-              [
-                5.19219613575472,
-                51.8476078396406
-              ],
-              [
-                5.21835576424736,
-                51.8519136201119
-              ],
-              [
-                5.22990818976594,
-                51.8563800657652
-              ],
-              [
-                5.23924975761889,
-                51.8543667728835
-              ],
-              [
-                5.24164796079964,
-                51.8564077290243
-              ],
-              [
-                5.26175759605162,
-                51.8550862901481
-              ],
-              [
-                5.27995562091074,
-                51.8571836498314
-              ],
-              [
-                5.30839420368255,
-                51.8516933301076
-              ],
-              [
-                5.31462518168057,
-                51.8562199608674
-              ],
-              [
-                5.32229690770495,
-                51.8586075795198
-              ],
-              [
-                5.32808472559646,
-                51.8592625962894
-              ],
-              [
-                5.32829433762437,
-                51.8569750379586
-              ],
-              [
-                5.33208824659748,
-                51.8572953581925
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0281",
-        "name": "Tiel",
-        "regioFacet": "tcm:106-353491-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/tiel/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.3784077382683,
-                51.8633999311876
-              ],
-              [
-                5.36006965730052,
-                51.8583624250286
-              ],
-              [
-                5.34810494217591,
-                51.8588412548064
-              ],
-              [
-                5.34280803642755,
-                51.8567119415268
-              ],
-              [
-                5.33834686689876,
-                51.8587264910045
-              ],
-              [
-                5.32829433762437,
-                51.8569750379586
-              ],
-              [
-                5.32803260100655,
-                51.8647967955848
-              ],
-              [
-                5.33658310010568,
-                51.8699663376018
-              ],
-              [
-                5.3390239367577,
-                51.874034156813
-              ],
-              [
-                5.3419563645572,
-                51.8735957370032
-              ],
-              [
-                5.34524198858588,
-                51.8776247638731
-              ],
-              [
-                5.35378264882353,
-                51.8780663734359
-              ],
-              [
-                5.39260636845606,
-                51.8968404468236
-              ],
-              [
-                5.39982441568653,
-                51.8985758877453
-              ],
-              [
-                5.40447058885371,
-                51.9052316807833
-              ],
-              [
-                5.409625872624,
-                51.9078575158499
-              ],
-              [
-                5.41135770162954,
-                51.9120618793355
-              ],
-              [
-                5.41847629329684,
-                51.9134446790392
-              ],
-              [
-                5.42355917123522,
-                51.9172563332607
-              ],
-              [
-                5.43186843499894,
-                51.9185038132817
-              ],
-              [
-                5.43589538361091,
-                51.9207921630615
-              ],
-              [
-                5.44697955714131,
-                51.9205716021762
-              ],
-              [
-                5.45338451746069,
-                51.9227613570231
-              ],
-              [
-                5.45392244541386,
-                51.9205172522105
-              ],
-              [
-                5.46164374885386,
-                51.9216207659756
-              ],
-              [
-                5.46973012929588,
-                51.9198777365917
-              ],
-              [
-                5.46712677472725,
-                51.9151560487818
-              ],
-              [
-                5.47051943016497,
-                51.911424790272
-              ],
-              [
-                5.47215132841614,
-                51.9117165920892
-              ],
-              [
-                5.47245103624039,
-                51.9080688731787
-              ],
-              [
-                5.45552579218346,
-                51.9045620743545
-              ],
-              [
-                5.46391674620419,
-                51.8890089459114
-              ],
-              [
-                5.44828399442274,
-                51.8857139780006
-              ],
-              [
-                5.43353811899752,
-                51.8772260363959
-              ],
-              [
-                5.42086813968144,
-                51.8635209957593
-              ],
-              [
-                5.41523417800408,
-                51.8518010179983
-              ],
-              [
-                5.4079767563203,
-                51.8544114798685
-              ],
-              [
-                5.39954652432629,
-                51.8546187830157
-              ],
-              [
-                5.39523915917513,
-                51.85293350099
-              ],
-              [
-                5.39168817206703,
-                51.8539236337953
-              ],
-              [
-                5.3784077382683,
-                51.8633999311876
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0307",
-        "name": "Amersfoort",
-        "regioFacet": "tcm:106-353709-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/amersfoort/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.34989762226956,
-                52.1756050376984
-              ],
-              [
-                5.34092174245972,
-                52.1780181498804
-              ],
-              [
-                5.33643967269255,
-                52.1827176446838
-              ],
-              [
-                5.32432539730455,
-                52.1822562237478
-              ],
-              [
-                5.32384458901705,
-                52.1884954634832
-              ],
-              [
-                5.31024832990233,
-                52.1919487933615
-              ],
-              [
-                5.30933835084535,
-                52.1947287846574
-              ],
-              [
-                5.31428202657501,
-                52.2030227262656
-              ],
-              [
-                5.33069173942941,
-                52.2033848322056
-              ],
-              [
-                5.3278820599117,
-                52.2093500415099
-              ],
-              [
-                5.33054136807914,
-                52.2147317078285
-              ],
-              [
-                5.39273546600888,
-                52.2055277056233
-              ],
-              [
-                5.39552235645886,
-                52.2191538463369
-              ],
-              [
-                5.40722183299865,
-                52.2134046538973
-              ],
-              [
-                5.40966992711699,
-                52.2185795655897
-              ],
-              [
-                5.43471392657667,
-                52.2058757801007
-              ],
-              [
-                5.43923713039434,
-                52.2046014298056
-              ],
-              [
-                5.44103935995821,
-                52.2056934340034
-              ],
-              [
-                5.43808620589498,
-                52.1845848194341
-              ],
-              [
-                5.43987554379137,
-                52.1711974535761
-              ],
-              [
-                5.44882436182861,
-                52.1677042617356
-              ],
-              [
-                5.47992236303664,
-                52.1658145534526
-              ],
-              [
-                5.47243330445649,
-                52.1628508643941
-              ],
-              [
-                5.48211609577451,
-                52.1597956803858
-              ],
-              [
-                5.48149553655442,
-                52.1582973072753
-              ],
-              [
-                5.48432966462774,
-                52.1580328260357
-              ],
-              [
-                5.48376705860723,
-                52.153981097639
-              ],
-              [
-                5.48130372717803,
-                52.1504703543639
-              ],
-              [
-                5.4766470541616,
-                52.1522314584332
-              ],
-              [
-                5.45934006444059,
-                52.1529147802354
-              ],
-              [
-                5.44220392971769,
-                52.1615040662196
-              ],
-              [
-                5.42360795080812,
-                52.1576364774954
-              ],
-              [
-                5.4178435724524,
-                52.1547846816769
-              ],
-              [
-                5.41651312789449,
-                52.1506987483523
-              ],
-              [
-                5.408318342427,
-                52.1425882118511
-              ],
-              [
-                5.40939097707767,
-                52.140387422082
-              ],
-              [
-                5.40756324892438,
-                52.1419598095324
-              ],
-              [
-                5.39807469973049,
-                52.133547805957
-              ],
-              [
-                5.37438878726753,
-                52.1309928066324
-              ],
-              [
-                5.35563520946169,
-                52.1368295721912
-              ],
-              [
-                5.33403645967312,
-                52.1204148529242
-              ],
-              [
-                5.31091980137222,
-                52.1083843857164
-              ],
-              [
-                5.30645863609248,
-                52.1102714620087
-              ],
-              [
-                5.33126784444741,
-                52.1266301999452
-              ],
-              [
-                5.33385166222731,
-                52.1313453497095
-              ],
-              [
-                5.3309685883178,
-                52.1372905748193
-              ],
-              [
-                5.33629811143349,
-                52.1458556911836
-              ],
-              [
-                5.33872028167228,
-                52.1600565087815
-              ],
-              [
-                5.34348372605805,
-                52.1602705414887
-              ],
-              [
-                5.34989762226956,
-                52.1756050376984
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0308",
-        "name": "Baarn",
-        "regioFacet": "tcm:106-353710-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/baarn/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.30649072155394,
-                52.2194399580426
-              ],
-              [
-                5.31232335933579,
-                52.2213095309987
-              ],
-              [
-                5.31475451921185,
-                52.2241070844272
-              ],
-              [
-                5.31312856568485,
-                52.2256490171499
-              ],
-              [
-                5.31120698299548,
-                52.2245674718137
-              ],
-              [
-                5.31159369879249,
-                52.2265078213086
-              ],
-              [
-                5.30683606111203,
-                52.2297045193111
-              ],
-              [
-                5.30826282791508,
-                52.2318352764007
-              ],
-              [
-                5.31227797462251,
-                52.2328288281389
-              ],
-              [
-                5.33578493185145,
-                52.2285691841983
-              ],
-              [
-                5.33432349166473,
-                52.2201231088576
-              ],
-              [
-                5.32790771956654,
-                52.2096161125351
-              ],
-              [
-                5.33069173942941,
-                52.2033848322056
-              ],
-              [
-                5.31431137364747,
-                52.2030210366566
-              ],
-              [
-                5.31383109040346,
-                52.2041923246564
-              ],
-              [
-                5.3106057190979,
-                52.2034509574751
-              ],
-              [
-                5.30852134242568,
-                52.2044740072425
-              ],
-              [
-                5.30390684344636,
-                52.199960300334
-              ],
-              [
-                5.26784899797349,
-                52.1873833762953
-              ],
-              [
-                5.23904131885961,
-                52.1676222687677
-              ],
-              [
-                5.23790072176829,
-                52.171250909064
-              ],
-              [
-                5.23615639477702,
-                52.1711489434703
-              ],
-              [
-                5.23631578916449,
-                52.1674582491812
-              ],
-              [
-                5.22388921548205,
-                52.1653795750703
-              ],
-              [
-                5.22099497793629,
-                52.1782604729356
-              ],
-              [
-                5.21207556481343,
-                52.176373929257
-              ],
-              [
-                5.21600286298781,
-                52.1880608690971
-              ],
-              [
-                5.21325408010254,
-                52.1900818348045
-              ],
-              [
-                5.21423235048602,
-                52.2007862663908
-              ],
-              [
-                5.22473024177213,
-                52.22519709284
-              ],
-              [
-                5.28746433558959,
-                52.2228831093293
-              ],
-              [
-                5.30096955202839,
-                52.2235242928659
-              ],
-              [
-                5.30649072155394,
-                52.2194399580426
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0312",
-        "name": "Bunnik",
-        "regioFacet": "tcm:106-353711-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/bunnik/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.19155615440589,
-                52.0430360395795
-              ],
-              [
-                5.17893072651698,
-                52.0463404096305
-              ],
-              [
-                5.1607435515391,
-                52.0455146782122
-              ],
-              [
-                5.15303062584153,
-                52.05675729448
-              ],
-              [
-                5.15775529137095,
-                52.0583418327019
-              ],
-              [
-                5.15689066054875,
-                52.0634268520758
-              ],
-              [
-                5.15950262453599,
-                52.0672629472472
-              ],
-              [
-                5.15566167691345,
-                52.0714559573653
-              ],
-              [
-                5.16071905268769,
-                52.0805626842375
-              ],
-              [
-                5.16691725509118,
-                52.0766812179271
-              ],
-              [
-                5.17160624919469,
-                52.0786749814733
-              ],
-              [
-                5.17929257187749,
-                52.0779021587296
-              ],
-              [
-                5.18140161193457,
-                52.0761923824359
-              ],
-              [
-                5.192639261548,
-                52.0779142501756
-              ],
-              [
-                5.20040062499663,
-                52.0768670363711
-              ],
-              [
-                5.22502198598348,
-                52.0649217362635
-              ],
-              [
-                5.21913193676999,
-                52.0597524509234
-              ],
-              [
-                5.2474230961169,
-                52.0586465495656
-              ],
-              [
-                5.251677307301,
-                52.0539577229383
-              ],
-              [
-                5.24800595021134,
-                52.0474128209064
-              ],
-              [
-                5.25103958209662,
-                52.0457574484457
-              ],
-              [
-                5.24949439856883,
-                52.0409860099445
-              ],
-              [
-                5.25267733210536,
-                52.0385995678979
-              ],
-              [
-                5.25779662715724,
-                52.0382858430998
-              ],
-              [
-                5.25749885893434,
-                52.0326774380242
-              ],
-              [
-                5.26388021532936,
-                52.0319693088577
-              ],
-              [
-                5.26929825474153,
-                52.0291703081466
-              ],
-              [
-                5.27086679332332,
-                52.0274033372109
-              ],
-              [
-                5.26323940165507,
-                52.0243801018921
-              ],
-              [
-                5.27169312165552,
-                52.014609189345
-              ],
-              [
-                5.2799099103904,
-                52.010721585574
-              ],
-              [
-                5.28015323266384,
-                52.0089112623691
-              ],
-              [
-                5.27570164037783,
-                52.005720280124
-              ],
-              [
-                5.27748724852244,
-                52.0000619638305
-              ],
-              [
-                5.2710924334713,
-                51.9978424308112
-              ],
-              [
-                5.26683496837998,
-                51.9994417001489
-              ],
-              [
-                5.26473623523904,
-                52.0032201266212
-              ],
-              [
-                5.25956256652728,
-                52.0009992174149
-              ],
-              [
-                5.24319059237593,
-                51.9987930289442
-              ],
-              [
-                5.23057202404211,
-                52.0103559109858
-              ],
-              [
-                5.2240357444764,
-                52.013703726695
-              ],
-              [
-                5.20199184914229,
-                52.0174701178609
-              ],
-              [
-                5.20352706971689,
-                52.0200760989762
-              ],
-              [
-                5.19910316383602,
-                52.0252188912896
-              ],
-              [
-                5.20089290626005,
-                52.0317134845016
-              ],
-              [
-                5.19543250209017,
-                52.0364269142866
-              ],
-              [
-                5.19250361727129,
-                52.0344087178984
-              ],
-              [
-                5.18954135775839,
-                52.0369884559086
-              ],
-              [
-                5.19155615440589,
-                52.0430360395795
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0313",
-        "name": "Bunschoten",
-        "regioFacet": "tcm:106-353712-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/bunschoten/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.33157646278342,
-                52.2741394310999
-              ],
-              [
-                5.33956358803822,
-                52.2877317215263
-              ],
-              [
-                5.40464332868036,
-                52.2496304757499
-              ],
-              [
-                5.39321952912459,
-                52.2207230569495
-              ],
-              [
-                5.39552235645886,
-                52.2191538463369
-              ],
-              [
-                5.39273546600888,
-                52.2055277056233
-              ],
-              [
-                5.33054136807914,
-                52.2147317078285
-              ],
-              [
-                5.33432349166473,
-                52.2201231088576
-              ],
-              [
-                5.33578493185145,
-                52.2285691841983
-              ],
-              [
-                5.31227797462251,
-                52.2328288281389
-              ],
-              [
-                5.31068037138745,
-                52.2463259131734
-              ],
-              [
-                5.31412899316945,
-                52.2519541641775
-              ],
-              [
-                5.324337170988,
-                52.2530081772913
-              ],
-              [
-                5.32862132683277,
-                52.255365564876
-              ],
-              [
-                5.32206246583438,
-                52.2627481042839
-              ],
-              [
-                5.32715792172634,
-                52.2665633566637
-              ],
-              [
-                5.33157646278342,
-                52.2741394310999
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0310",
-        "name": "De Bilt",
-        "regioFacet": "tcm:106-353713-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/de-bilt/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.16085420733361,
-                52.1056059885384
-              ],
-              [
-                5.16191501098807,
-                52.1072153668951
-              ],
-              [
-                5.15038081165554,
-                52.1108753847265
-              ],
-              [
-                5.14760292760717,
-                52.1201031691126
-              ],
-              [
-                5.14016345541815,
-                52.1205820224238
-              ],
-              [
-                5.12652348666215,
-                52.1246044780787
-              ],
-              [
-                5.10709284979423,
-                52.1332111584803
-              ],
-              [
-                5.12052895106188,
-                52.1455411379847
-              ],
-              [
-                5.09488971501795,
-                52.1553490252009
-              ],
-              [
-                5.1188451943187,
-                52.1724060408381
-              ],
-              [
-                5.11529372920215,
-                52.1798527902487
-              ],
-              [
-                5.12135897767218,
-                52.1809552375992
-              ],
-              [
-                5.1925859653706,
-                52.1778329440702
-              ],
-              [
-                5.21550072710236,
-                52.2042734239757
-              ],
-              [
-                5.21325408010254,
-                52.1900818348045
-              ],
-              [
-                5.21600286298781,
-                52.1880608690971
-              ],
-              [
-                5.21207556481343,
-                52.176373929257
-              ],
-              [
-                5.22099497793629,
-                52.1782604729356
-              ],
-              [
-                5.22121880126781,
-                52.1745712951413
-              ],
-              [
-                5.22270164353561,
-                52.1746871386661
-              ],
-              [
-                5.23093038642207,
-                52.1167888838763
-              ],
-              [
-                5.21821918119546,
-                52.1136726620097
-              ],
-              [
-                5.22232888558977,
-                52.1038932439755
-              ],
-              [
-                5.21081468407299,
-                52.1007270703115
-              ],
-              [
-                5.21272719757031,
-                52.0976743260803
-              ],
-              [
-                5.20860875339233,
-                52.0936189091128
-              ],
-              [
-                5.21058763680523,
-                52.0903144248352
-              ],
-              [
-                5.20722975943574,
-                52.0864392671952
-              ],
-              [
-                5.20551720333786,
-                52.0868295450007
-              ],
-              [
-                5.20552461586224,
-                52.0848955887499
-              ],
-              [
-                5.18991320468732,
-                52.0862413904161
-              ],
-              [
-                5.1861700359415,
-                52.0929276779238
-              ],
-              [
-                5.18227041788959,
-                52.0944110582565
-              ],
-              [
-                5.18056411373272,
-                52.0931318686782
-              ],
-              [
-                5.16090425167967,
-                52.0945755393628
-              ],
-              [
-                5.15617134135651,
-                52.0985894210349
-              ],
-              [
-                5.16085420733361,
-                52.1056059885384
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0736",
-        "name": "De Ronde Venen",
-        "regioFacet": "tcm:106-353714-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/de-ronde-venen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.90854705456478,
-                52.2526826130861
-              ],
-              [
-                4.91097024757446,
-                52.2526822372857
-              ],
-              [
-                4.91730328307649,
-                52.2568172419628
-              ],
-              [
-                4.91811789820164,
-                52.2616954386786
-              ],
-              [
-                4.92325663084645,
-                52.2630903814095
-              ],
-              [
-                4.92474790667804,
-                52.2670005957655
-              ],
-              [
-                4.92939051081773,
-                52.2683533301634
-              ],
-              [
-                4.92665142618144,
-                52.2724447897682
-              ],
-              [
-                4.92644444774172,
-                52.2797796132823
-              ],
-              [
-                4.9338012785098,
-                52.2808931568234
-              ],
-              [
-                4.94269151990819,
-                52.2781839584546
-              ],
-              [
-                4.95379769409955,
-                52.2780269829118
-              ],
-              [
-                4.96105363472349,
-                52.2781741995461
-              ],
-              [
-                4.96224396938414,
-                52.2802065259016
-              ],
-              [
-                4.96616561911401,
-                52.2809798385156
-              ],
-              [
-                4.96767433743686,
-                52.2795822997482
-              ],
-              [
-                4.96972630703791,
-                52.283120826215
-              ],
-              [
-                4.9835058910963,
-                52.2903681351256
-              ],
-              [
-                4.9974753677299,
-                52.2891183534325
-              ],
-              [
-                5.0017893462386,
-                52.292792906211
-              ],
-              [
-                5.00383450501076,
-                52.2985093146073
-              ],
-              [
-                5.01154008385426,
-                52.3033021522846
-              ],
-              [
-                5.02154319683453,
-                52.3024568484788
-              ],
-              [
-                5.02186720942006,
-                52.2826532673641
-              ],
-              [
-                5.00746303863206,
-                52.2317045153493
-              ],
-              [
-                4.99741494793489,
-                52.2308172423852
-              ],
-              [
-                4.99472757702341,
-                52.2324742259148
-              ],
-              [
-                4.99472978658668,
-                52.2302663379597
-              ],
-              [
-                4.98041360205452,
-                52.2268473936898
-              ],
-              [
-                4.98287423447946,
-                52.2224155947977
-              ],
-              [
-                4.97374660126052,
-                52.2205984150554
-              ],
-              [
-                4.96659572436292,
-                52.2000523132605
-              ],
-              [
-                4.96138767151611,
-                52.1994751182074
-              ],
-              [
-                4.96080855216223,
-                52.1978197816999
-              ],
-              [
-                4.95088127023712,
-                52.1986384983666
-              ],
-              [
-                4.94838271860317,
-                52.193598182959
-              ],
-              [
-                4.94857157345972,
-                52.1857846826705
-              ],
-              [
-                4.94571262374908,
-                52.1858129890786
-              ],
-              [
-                4.93399527296676,
-                52.1769450342135
-              ],
-              [
-                4.93500683679857,
-                52.1758548024215
-              ],
-              [
-                4.93140995716724,
-                52.1737097558928
-              ],
-              [
-                4.93246800818737,
-                52.1724936857311
-              ],
-              [
-                4.9286063192259,
-                52.1672001109697
-              ],
-              [
-                4.90826279929794,
-                52.1614943988887
-              ],
-              [
-                4.89174254801725,
-                52.1617708608011
-              ],
-              [
-                4.85558182910959,
-                52.1789171737521
-              ],
-              [
-                4.84392085582236,
-                52.18012162623
-              ],
-              [
-                4.82344480074159,
-                52.193265697136
-              ],
-              [
-                4.82220913381478,
-                52.1961245803488
-              ],
-              [
-                4.8143998746193,
-                52.1993092514449
-              ],
-              [
-                4.81359431058614,
-                52.2018471170438
-              ],
-              [
-                4.80292512784945,
-                52.2010277555596
-              ],
-              [
-                4.80214966453662,
-                52.2176336010367
-              ],
-              [
-                4.79442987444678,
-                52.2234233987331
-              ],
-              [
-                4.79457569813664,
-                52.2267306636317
-              ],
-              [
-                4.80540377748053,
-                52.2279887059206
-              ],
-              [
-                4.81445519403425,
-                52.2272240992209
-              ],
-              [
-                4.83010717672335,
-                52.2309126658242
-              ],
-              [
-                4.83868666337111,
-                52.2353656524243
-              ],
-              [
-                4.84282606906243,
-                52.2353392203428
-              ],
-              [
-                4.8472796768046,
-                52.2397653090801
-              ],
-              [
-                4.85162565541634,
-                52.2389949301492
-              ],
-              [
-                4.85125428711312,
-                52.241866940711
-              ],
-              [
-                4.85493788612411,
-                52.2421456177839
-              ],
-              [
-                4.85752171680026,
-                52.2477230541711
-              ],
-              [
-                4.86858256762034,
-                52.2515791905736
-              ],
-              [
-                4.87003650416481,
-                52.2533613079644
-              ],
-              [
-                4.87385293864756,
-                52.2507426324812
-              ],
-              [
-                4.88271883844095,
-                52.2537115146761
-              ],
-              [
-                4.88701649551371,
-                52.2508988384439
-              ],
-              [
-                4.89129731455605,
-                52.2533006107314
-              ],
-              [
-                4.89856854506795,
-                52.2509322393442
-              ],
-              [
-                4.9029307413266,
-                52.2531818042983
-              ],
-              [
-                4.90854705456478,
-                52.2526826130861
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0317",
-        "name": "Eemnes",
-        "regioFacet": "tcm:106-353715-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/eemnes/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.24475912939962,
-                52.2539856168577
-              ],
-              [
-                5.26597769081277,
-                52.2819261661127
-              ],
-              [
-                5.30583442858206,
-                52.2773285674714
-              ],
-              [
-                5.33546144001623,
-                52.2902184127283
-              ],
-              [
-                5.33956358803822,
-                52.2877317215263
-              ],
-              [
-                5.32745475745008,
-                52.2669353748827
-              ],
-              [
-                5.32213341615217,
-                52.2630621755163
-              ],
-              [
-                5.32862132683277,
-                52.255365564876
-              ],
-              [
-                5.324337170988,
-                52.2530081772913
-              ],
-              [
-                5.31412899316945,
-                52.2519541641775
-              ],
-              [
-                5.31068037138745,
-                52.2463259131734
-              ],
-              [
-                5.31331838902849,
-                52.2341553813576
-              ],
-              [
-                5.30663763501081,
-                52.2301215087994
-              ],
-              [
-                5.31159369879249,
-                52.2265078213086
-              ],
-              [
-                5.31120698299548,
-                52.2245674718137
-              ],
-              [
-                5.31312856568485,
-                52.2256490171499
-              ],
-              [
-                5.31475451921185,
-                52.2241070844272
-              ],
-              [
-                5.31264662752289,
-                52.2214953427017
-              ],
-              [
-                5.30687512575289,
-                52.2192733965188
-              ],
-              [
-                5.30096955202839,
-                52.2235242928659
-              ],
-              [
-                5.28746433558959,
-                52.2228831093293
-              ],
-              [
-                5.22290124725797,
-                52.2252609489747
-              ],
-              [
-                5.24475912939962,
-                52.2539856168577
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0321",
-        "name": "Houten",
-        "regioFacet": "tcm:106-353716-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/houten/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.13099327806941,
-                52.0247238071174
-              ],
-              [
-                5.1217741616556,
-                52.0304328023631
-              ],
-              [
-                5.12968164465498,
-                52.0498641924993
-              ],
-              [
-                5.1247179725424,
-                52.049915438308
-              ],
-              [
-                5.12683195701212,
-                52.054918222766
-              ],
-              [
-                5.14567568247492,
-                52.0525591159382
-              ],
-              [
-                5.15303062584153,
-                52.05675729448
-              ],
-              [
-                5.1607435515391,
-                52.0455146782122
-              ],
-              [
-                5.17893072651698,
-                52.0463404096305
-              ],
-              [
-                5.19155615440589,
-                52.0430360395795
-              ],
-              [
-                5.18954135775839,
-                52.0369884559086
-              ],
-              [
-                5.19250361727129,
-                52.0344087178984
-              ],
-              [
-                5.19543250209017,
-                52.0364269142866
-              ],
-              [
-                5.20089290626005,
-                52.0317134845016
-              ],
-              [
-                5.19910316383602,
-                52.0252188912896
-              ],
-              [
-                5.20352706971689,
-                52.0200760989762
-              ],
-              [
-                5.20199184914229,
-                52.0174701178609
-              ],
-              [
-                5.21937402373343,
-                52.0152153749809
-              ],
-              [
-                5.22940845346028,
-                52.0111929975604
-              ],
-              [
-                5.24538526706911,
-                51.9959837173291
-              ],
-              [
-                5.25620424559042,
-                51.9937252856421
-              ],
-              [
-                5.25332533135481,
-                51.9923350220094
-              ],
-              [
-                5.25414113161744,
-                51.9860706861933
-              ],
-              [
-                5.27144659792108,
-                51.9811335279242
-              ],
-              [
-                5.26604752329305,
-                51.9758070211905
-              ],
-              [
-                5.25627113811491,
-                51.9709413976114
-              ],
-              [
-                5.24526015293064,
-                51.9779994906401
-              ],
-              [
-                5.23978030867793,
-                51.9789396281414
-              ],
-              [
-                5.23420140650953,
-                51.9780629944345
-              ],
-              [
-                5.22910898286552,
-                51.9749064270216
-              ],
-              [
-                5.21973761949964,
-                51.9638704446438
-              ],
-              [
-                5.20743361489299,
-                51.9594779392739
-              ],
-              [
-                5.19432858217251,
-                51.9613015232299
-              ],
-              [
-                5.17548457494428,
-                51.9690525196538
-              ],
-              [
-                5.15509432864688,
-                51.9715863609138
-              ],
-              [
-                5.14596345470764,
-                51.9780337815635
-              ],
-              [
-                5.13967676129287,
-                51.9939432327519
-              ],
-              [
-                5.13386803515409,
-                51.9966872506745
-              ],
-              [
-                5.11956321045021,
-                51.9999241324006
-              ],
-              [
-                5.12838627354889,
-                52.0247811057988
-              ],
-              [
-                5.13099327806941,
-                52.0247238071174
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0353",
-        "name": "IJsselstein",
-        "regioFacet": "tcm:106-353717-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/ijsselstein/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.02029537001564,
-                52.0256270672275
-              ],
-              [
-                4.99519469788527,
-                52.0217203793222
-              ],
-              [
-                4.99404625858014,
-                52.0297400613783
-              ],
-              [
-                4.98313898028152,
-                52.0277845499131
-              ],
-              [
-                4.97837911790677,
-                52.0397231060941
-              ],
-              [
-                4.98327664474866,
-                52.0402366626929
-              ],
-              [
-                4.99100712247517,
-                52.0528836955915
-              ],
-              [
-                4.99633081329995,
-                52.0527827169892
-              ],
-              [
-                5.00493433641061,
-                52.0555586266083
-              ],
-              [
-                5.01028366611272,
-                52.0537410176687
-              ],
-              [
-                5.01707454133016,
-                52.0611428704292
-              ],
-              [
-                5.02161043981234,
-                52.0558078867586
-              ],
-              [
-                5.02711959474529,
-                52.0553956134597
-              ],
-              [
-                5.0284510270655,
-                52.0506232714215
-              ],
-              [
-                5.03806443459577,
-                52.0441548104554
-              ],
-              [
-                5.04990685796391,
-                52.0308695869206
-              ],
-              [
-                5.055339663306,
-                52.0297382270693
-              ],
-              [
-                5.06102076972511,
-                52.0262819829996
-              ],
-              [
-                5.06280103921702,
-                52.0268231973217
-              ],
-              [
-                5.07486076753207,
-                52.0035535004533
-              ],
-              [
-                5.07247268728185,
-                52.00021062064
-              ],
-              [
-                5.0757041273341,
-                52.000919368893
-              ],
-              [
-                5.07753524212198,
-                51.9974518304484
-              ],
-              [
-                5.06628618554913,
-                51.9940734051496
-              ],
-              [
-                5.06263811588578,
-                51.9912330759381
-              ],
-              [
-                5.05308225641815,
-                51.9953846599753
-              ],
-              [
-                5.05424025738445,
-                51.9963187070579
-              ],
-              [
-                5.04255525533454,
-                52.0056355293685
-              ],
-              [
-                5.03406276513745,
-                52.0038459226626
-              ],
-              [
-                5.02029537001564,
-                52.0256270672275
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0327",
-        "name": "Leusden",
-        "regioFacet": "tcm:106-353718-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/leusden/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.41412794577873,
-                52.1484515901493
-              ],
-              [
-                5.4178435724524,
-                52.1547846816769
-              ],
-              [
-                5.42327482807861,
-                52.1575155219376
-              ],
-              [
-                5.44190101160771,
-                52.1615158558228
-              ],
-              [
-                5.45934006444059,
-                52.1529147802354
-              ],
-              [
-                5.47674748114634,
-                52.1522175586509
-              ],
-              [
-                5.48524781330703,
-                52.1490598404454
-              ],
-              [
-                5.49898246827153,
-                52.1473933553756
-              ],
-              [
-                5.49730624327361,
-                52.1414434996647
-              ],
-              [
-                5.51407939074438,
-                52.1359230612821
-              ],
-              [
-                5.51192753041128,
-                52.1321046462175
-              ],
-              [
-                5.49791305951202,
-                52.1302828903217
-              ],
-              [
-                5.49675907556536,
-                52.1248142828069
-              ],
-              [
-                5.49469492818335,
-                52.1246978858237
-              ],
-              [
-                5.49691768176234,
-                52.1195574854648
-              ],
-              [
-                5.50535394548977,
-                52.1197504451269
-              ],
-              [
-                5.50375599247667,
-                52.1165856315465
-              ],
-              [
-                5.50754867297731,
-                52.1156445474292
-              ],
-              [
-                5.50770206772927,
-                52.1138969621486
-              ],
-              [
-                5.50394963844629,
-                52.1137202823288
-              ],
-              [
-                5.49891651940619,
-                52.1160176356386
-              ],
-              [
-                5.48644708872801,
-                52.1153597347834
-              ],
-              [
-                5.47907903174769,
-                52.1174261786452
-              ],
-              [
-                5.47582548642828,
-                52.1120711020918
-              ],
-              [
-                5.47603713445071,
-                52.1050121521515
-              ],
-              [
-                5.47370706446309,
-                52.1047596267655
-              ],
-              [
-                5.47198731912033,
-                52.1014556820441
-              ],
-              [
-                5.46751649589529,
-                52.1004002564734
-              ],
-              [
-                5.45786596267342,
-                52.1053692519535
-              ],
-              [
-                5.44380666834458,
-                52.1070745606507
-              ],
-              [
-                5.43793794548845,
-                52.0997439984828
-              ],
-              [
-                5.42962902400937,
-                52.0959104635834
-              ],
-              [
-                5.42104052238218,
-                52.096901226155
-              ],
-              [
-                5.41015851349091,
-                52.1032632579577
-              ],
-              [
-                5.40452086694367,
-                52.1041063960456
-              ],
-              [
-                5.40133209488863,
-                52.0893335316996
-              ],
-              [
-                5.37800991023058,
-                52.0904716040578
-              ],
-              [
-                5.33587431843876,
-                52.0959698255149
-              ],
-              [
-                5.31091980137222,
-                52.1083843857164
-              ],
-              [
-                5.33403645967312,
-                52.1204148529242
-              ],
-              [
-                5.35563520946169,
-                52.1368295721912
-              ],
-              [
-                5.37438878726753,
-                52.1309928066324
-              ],
-              [
-                5.39807469973049,
-                52.133547805957
-              ],
-              [
-                5.40756324892438,
-                52.1419598095324
-              ],
-              [
-                5.40939097707767,
-                52.140387422082
-              ],
-              [
-                5.408318342427,
-                52.1425882118511
-              ],
-              [
-                5.41412794577873,
-                52.1484515901493
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0331",
-        "name": "Lopik",
-        "regioFacet": "tcm:106-353719-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/lopik/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.95336462774321,
-                51.9624411194336
-              ],
-              [
-                4.94330536785846,
-                51.9639427979272
-              ],
-              [
-                4.93700908396657,
-                51.9622298514529
-              ],
-              [
-                4.92449371262799,
-                51.9489459458519
-              ],
-              [
-                4.91551545396329,
-                51.9428784350925
-              ],
-              [
-                4.87779292059291,
-                51.9380306740307
-              ],
-              [
-                4.8712228824198,
-                51.9546434934465
-              ],
-              [
-                4.86080596305931,
-                51.9677840285796
-              ],
-              [
-                4.84562186865524,
-                51.9749849305693
-              ],
-              [
-                4.8341066016807,
-                51.9856312809405
-              ],
-              [
-                4.90973467419174,
-                52.0110414146662
-              ],
-              [
-                4.9198672922809,
-                52.0099884248736
-              ],
-              [
-                4.95491779004187,
-                52.0173472040886
-              ],
-              [
-                4.98071537199474,
-                52.02088523367
-              ],
-              [
-                4.99216215385565,
-                52.0210324892164
-              ],
-              [
-                5.02029537001564,
-                52.0256270672275
-              ],
-              [
-                5.03406276513745,
-                52.0038459226626
-              ],
-              [
-                5.04255525533454,
-                52.0056355293685
-              ],
-              [
-                5.05424025738445,
-                51.9963187070579
-              ],
-              [
-                5.05308225641815,
-                51.9953846599753
-              ],
-              [
-                5.06263811588578,
-                51.9912330759381
-              ],
-              [
-                5.04499531853341,
-                51.9819589496781
-              ],
-              [
-                5.03777526074295,
-                51.9727173003151
-              ],
-              [
-                5.03284889726989,
-                51.9697462222134
-              ],
-              [
-                5.02237566465462,
-                51.9697266565124
-              ],
-              [
-                5.003009511148,
-                51.9781382333176
-              ],
-              [
-                4.99807352373156,
-                51.9778715033279
-              ],
-              [
-                4.98804536182628,
-                51.9739928492382
-              ],
-              [
-                4.97543610807983,
-                51.9628858949479
-              ],
-              [
-                4.96833456800241,
-                51.9593926710643
-              ],
-              [
-                4.95336462774321,
-                51.9624411194336
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0335",
-        "name": "Montfoort",
-        "regioFacet": "tcm:106-353720-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/montfoort/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.92668102144546,
-                52.0299868733721
-              ],
-              [
-                4.91139802755439,
-                52.0401816236435
-              ],
-              [
-                4.91711779802655,
-                52.0437908551914
-              ],
-              [
-                4.90650466516769,
-                52.0534756400295
-              ],
-              [
-                4.89602589654426,
-                52.0506877113021
-              ],
-              [
-                4.89541133945586,
-                52.0482339235309
-              ],
-              [
-                4.87550648921391,
-                52.0615797856324
-              ],
-              [
-                4.87145791110594,
-                52.0592246017398
-              ],
-              [
-                4.86822503919311,
-                52.0634463311763
-              ],
-              [
-                4.87320041225178,
-                52.0687453916028
-              ],
-              [
-                4.90508326488818,
-                52.0700147753793
-              ],
-              [
-                4.92466104606333,
-                52.0775905707474
-              ],
-              [
-                4.9361425050395,
-                52.0799203296448
-              ],
-              [
-                4.95633325186052,
-                52.0702993578049
-              ],
-              [
-                4.95677334386214,
-                52.0659328884188
-              ],
-              [
-                4.97050084663896,
-                52.06756012077
-              ],
-              [
-                4.98261015447411,
-                52.0649499852146
-              ],
-              [
-                4.99609853934116,
-                52.0673426535838
-              ],
-              [
-                5.01801050787315,
-                52.06221897319
-              ],
-              [
-                5.01028366611272,
-                52.0537410176687
-              ],
-              [
-                5.00493433641061,
-                52.0555586266083
-              ],
-              [
-                4.99633081329995,
-                52.0527827169892
-              ],
-              [
-                4.99100712247517,
-                52.0528836955915
-              ],
-              [
-                4.98327664474866,
-                52.0402366626929
-              ],
-              [
-                4.97837911790677,
-                52.0397231060941
-              ],
-              [
-                4.98313898028152,
-                52.0277845499131
-              ],
-              [
-                4.99404625858014,
-                52.0297400613783
-              ],
-              [
-                4.99519903423107,
-                52.0215027622091
-              ],
-              [
-                4.95491779004187,
-                52.0173472040886
-              ],
-              [
-                4.92004482598463,
-                52.0100139999928
-              ],
-              [
-                4.90497460596845,
-                52.026477526849
-              ],
-              [
-                4.92130485369247,
-                52.0257181280998
-              ],
-              [
-                4.92565433084185,
-                52.0276792410804
-              ],
-              [
-                4.92668102144546,
-                52.0299868733721
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0356",
-        "name": "Nieuwegein",
-        "regioFacet": "tcm:106-353721-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/nieuwegein/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.06600613193261,
-                52.0188877882215
-              ],
-              [
-                5.06251147661326,
-                52.0286027499631
-              ],
-              [
-                5.064183326284,
-                52.0517481371191
-              ],
-              [
-                5.0697635932118,
-                52.0615053049395
-              ],
-              [
-                5.07562945789532,
-                52.062227605692
-              ],
-              [
-                5.08998624110807,
-                52.0607288071714
-              ],
-              [
-                5.0941241537068,
-                52.058754719642
-              ],
-              [
-                5.09735213029946,
-                52.0594805033111
-              ],
-              [
-                5.10439766167619,
-                52.0499378099335
-              ],
-              [
-                5.10734436278537,
-                52.0500256036337
-              ],
-              [
-                5.11126147421158,
-                52.0583235783504
-              ],
-              [
-                5.12056941351848,
-                52.0545031791629
-              ],
-              [
-                5.12683195701212,
-                52.054918222766
-              ],
-              [
-                5.1247179725424,
-                52.049915438308
-              ],
-              [
-                5.12968164465498,
-                52.0498641924993
-              ],
-              [
-                5.1217741616556,
-                52.0304328023631
-              ],
-              [
-                5.13099327806941,
-                52.0247238071174
-              ],
-              [
-                5.12838627354889,
-                52.0247811057988
-              ],
-              [
-                5.11956321045021,
-                51.9999241324006
-              ],
-              [
-                5.11050972087107,
-                52.0023824091493
-              ],
-              [
-                5.10015991253566,
-                52.0030163956362
-              ],
-              [
-                5.09030899514398,
-                52.0016060422537
-              ],
-              [
-                5.07753524212198,
-                51.9974518304484
-              ],
-              [
-                5.0757041273341,
-                52.000919368893
-              ],
-              [
-                5.07247268728185,
-                52.00021062064
-              ],
-              [
-                5.07486076753207,
-                52.0035535004533
-              ],
-              [
-                5.06600613193261,
-                52.0188877882215
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0589",
-        "name": "Oudewater",
-        "regioFacet": "tcm:106-353722-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/oudewater/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.82970649705876,
-                52.0494762027107
-              ],
-              [
-                4.84796752273049,
-                52.0581363322016
-              ],
-              [
-                4.85630507683875,
-                52.0591517857419
-              ],
-              [
-                4.86822503919311,
-                52.0634463311763
-              ],
-              [
-                4.87145791110594,
-                52.0592246017398
-              ],
-              [
-                4.87550648921391,
-                52.0615797856324
-              ],
-              [
-                4.89541133945586,
-                52.0482339235309
-              ],
-              [
-                4.89602589654426,
-                52.0506877113021
-              ],
-              [
-                4.90650466516769,
-                52.0534756400295
-              ],
-              [
-                4.91711779802655,
-                52.0437908551914
-              ],
-              [
-                4.91139802755439,
-                52.0401816236435
-              ],
-              [
-                4.92668102144546,
-                52.0299868733721
-              ],
-              [
-                4.92565433084185,
-                52.0276792410804
-              ],
-              [
-                4.92130485369247,
-                52.0257181280998
-              ],
-              [
-                4.90497460596845,
-                52.026477526849
-              ],
-              [
-                4.92004482598463,
-                52.0100139999928
-              ],
-              [
-                4.90884491727867,
-                52.0108263088554
-              ],
-              [
-                4.83521784862055,
-                51.9861430189583
-              ],
-              [
-                4.81780590595768,
-                51.9997668875048
-              ],
-              [
-                4.85712655681938,
-                52.0059595064393
-              ],
-              [
-                4.84748093307173,
-                52.0177151024615
-              ],
-              [
-                4.83333477670654,
-                52.0164229329235
-              ],
-              [
-                4.82499425728531,
-                52.0172366073696
-              ],
-              [
-                4.81561622844231,
-                52.0139807249443
-              ],
-              [
-                4.80352320325605,
-                52.0139561579075
-              ],
-              [
-                4.79870020848367,
-                52.0222717265155
-              ],
-              [
-                4.80993342004021,
-                52.0267645156089
-              ],
-              [
-                4.80670437056194,
-                52.0331673000291
-              ],
-              [
-                4.81182222848688,
-                52.0338245095269
-              ],
-              [
-                4.81719293063217,
-                52.036931095164
-              ],
-              [
-                4.82970649705876,
-                52.0494762027107
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0340",
-        "name": "Rhenen",
-        "regioFacet": "tcm:106-353724-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/rhenen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.50326645970001,
-                52.0063268259274
-              ],
-              [
-                5.51259217384812,
-                52.0185160955598
-              ],
-              [
-                5.53367672548496,
-                52.0126651368089
-              ],
-              [
-                5.53236600936441,
-                52.0109001245618
-              ],
-              [
-                5.55110003948814,
-                51.9998049847443
-              ],
-              [
-                5.55322924121064,
-                51.9990308392305
-              ],
-              [
-                5.55804505742879,
-                52.0036320181324
-              ],
-              [
-                5.57047857635004,
-                51.9969583547764
-              ],
-              [
-                5.58948874322352,
-                52.0094555214318
-              ],
-              [
-                5.59199767245971,
-                52.0013922899695
-              ],
-              [
-                5.60280477310168,
-                51.9948691693505
-              ],
-              [
-                5.60997226576531,
-                51.9936915318887
-              ],
-              [
-                5.61390525974471,
-                51.9901798066672
-              ],
-              [
-                5.6150830533764,
-                51.9826870986466
-              ],
-              [
-                5.6260641937701,
-                51.9740700621036
-              ],
-              [
-                5.62485568952624,
-                51.970448363227
-              ],
-              [
-                5.61832929414493,
-                51.9662575960079
-              ],
-              [
-                5.62025822811935,
-                51.9665823243488
-              ],
-              [
-                5.62497828540259,
-                51.9600650499803
-              ],
-              [
-                5.62722375498403,
-                51.9523861647081
-              ],
-              [
-                5.62016249500824,
-                51.9514981096628
-              ],
-              [
-                5.62044561269291,
-                51.9481664233407
-              ],
-              [
-                5.6164279340422,
-                51.9493535432671
-              ],
-              [
-                5.60593964764397,
-                51.943124782982
-              ],
-              [
-                5.5909917823595,
-                51.9497757830664
-              ],
-              [
-                5.5556578206137,
-                51.956156922462
-              ],
-              [
-                5.53752048492987,
-                51.9682853260706
-              ],
-              [
-                5.51248139543545,
-                51.9730813802252
-              ],
-              [
-                5.48621400501514,
-                51.9838264416365
-              ],
-              [
-                5.47766449998344,
-                51.983472669908
-              ],
-              [
-                5.4778498178391,
-                51.9876917099666
-              ],
-              [
-                5.48064096378864,
-                51.9888642312118
-              ],
-              [
-                5.47962495286822,
-                51.9917144367273
-              ],
-              [
-                5.48459472425683,
-                51.9877595729553
-              ],
-              [
-                5.48756658768657,
-                51.9932564632037
-              ],
-              [
-                5.49089771896638,
-                51.9965610516897
-              ],
-              [
-                5.49420167486427,
-                51.9949010586211
-              ],
-              [
-                5.50326645970001,
-                52.0063268259274
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0342",
-        "name": "Soest",
-        "regioFacet": "tcm:106-353725-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/soest/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.34771205769226,
-                52.1704623514715
-              ],
-              [
-                5.34348372605805,
-                52.1602705414887
-              ],
-              [
-                5.33872028167228,
-                52.1600565087815
-              ],
-              [
-                5.33629811143349,
-                52.1458556911836
-              ],
-              [
-                5.3309685883178,
-                52.1372905748193
-              ],
-              [
-                5.33385166222731,
-                52.1313453497095
-              ],
-              [
-                5.33126784444741,
-                52.1266301999452
-              ],
-              [
-                5.30645863609248,
-                52.1102714620087
-              ],
-              [
-                5.29182345826533,
-                52.1145427543168
-              ],
-              [
-                5.28213830852901,
-                52.1120629245335
-              ],
-              [
-                5.27193611074462,
-                52.1270455315953
-              ],
-              [
-                5.26410182157954,
-                52.1250800682698
-              ],
-              [
-                5.25591556534354,
-                52.1367240583627
-              ],
-              [
-                5.25479395885957,
-                52.1495159211955
-              ],
-              [
-                5.24317262858538,
-                52.1700764157904
-              ],
-              [
-                5.24675252533019,
-                52.1740450253876
-              ],
-              [
-                5.26840648130044,
-                52.1876782732631
-              ],
-              [
-                5.30390684344636,
-                52.199960300334
-              ],
-              [
-                5.30891378345716,
-                52.2045599847681
-              ],
-              [
-                5.31447554078322,
-                52.2034828797674
-              ],
-              [
-                5.3093161059222,
-                52.1946613699783
-              ],
-              [
-                5.31059458662078,
-                52.1914860617232
-              ],
-              [
-                5.31473256786714,
-                52.1915779458145
-              ],
-              [
-                5.32384458901705,
-                52.1884954634832
-              ],
-              [
-                5.32432539730455,
-                52.1822562237478
-              ],
-              [
-                5.33643967269255,
-                52.1827176446838
-              ],
-              [
-                5.34092174245972,
-                52.1780181498804
-              ],
-              [
-                5.34989762226956,
-                52.1756050376984
-              ],
-              [
-                5.34771205769226,
-                52.1704623514715
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1904",
-        "name": "Stichtse Vecht",
-        "regioFacet": "tcm:106-353726-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/stichtse-vecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.98287423447946,
-                52.2224155947977
-              ],
-              [
-                4.98041360205452,
-                52.2268473936898
-              ],
-              [
-                4.99472978658668,
-                52.2302663379597
-              ],
-              [
-                4.9935872896923,
-                52.2320992324754
-              ],
-              [
-                4.99741494793489,
-                52.2308172423852
-              ],
-              [
-                5.00746303863206,
-                52.2317045153493
-              ],
-              [
-                5.02106833427617,
-                52.2829891153533
-              ],
-              [
-                5.02438163310948,
-                52.2813574452165
-              ],
-              [
-                5.03052841827572,
-                52.2884871797563
-              ],
-              [
-                5.04277865792204,
-                52.2824881378016
-              ],
-              [
-                5.05040433110515,
-                52.2878719808784
-              ],
-              [
-                5.05276914512603,
-                52.286485253273
-              ],
-              [
-                5.05954029972701,
-                52.289320131605
-              ],
-              [
-                5.0654714870554,
-                52.2851981556072
-              ],
-              [
-                5.06590118646106,
-                52.280549620311
-              ],
-              [
-                5.06307024573694,
-                52.281841312873
-              ],
-              [
-                5.06283839690037,
-                52.284797999315
-              ],
-              [
-                5.06055970082163,
-                52.2851760898856
-              ],
-              [
-                5.05075345614893,
-                52.2818178048573
-              ],
-              [
-                5.04860172375266,
-                52.2770296079481
-              ],
-              [
-                5.04301379046662,
-                52.2779596921501
-              ],
-              [
-                5.03847091109835,
-                52.277038199915
-              ],
-              [
-                5.03315627688502,
-                52.2753444363992
-              ],
-              [
-                5.02981373062269,
-                52.2720435017775
-              ],
-              [
-                5.02302079254564,
-                52.2728476749587
-              ],
-              [
-                5.02777885590953,
-                52.2675073101032
-              ],
-              [
-                5.02658206074656,
-                52.2641480511833
-              ],
-              [
-                5.03250160262668,
-                52.2601412366982
-              ],
-              [
-                5.031803803899,
-                52.2551763797845
-              ],
-              [
-                5.03573299007083,
-                52.2526541947759
-              ],
-              [
-                5.04006229871797,
-                52.2564988829675
-              ],
-              [
-                5.04294340256438,
-                52.2559769277662
-              ],
-              [
-                5.04663402512058,
-                52.2485411200313
-              ],
-              [
-                5.04585421038589,
-                52.2433816227283
-              ],
-              [
-                5.05772990848882,
-                52.2353527774886
-              ],
-              [
-                5.04158557096068,
-                52.2308882214265
-              ],
-              [
-                5.04051763934085,
-                52.2298338164305
-              ],
-              [
-                5.04248445534839,
-                52.2287798818523
-              ],
-              [
-                5.03710178376014,
-                52.2253416473327
-              ],
-              [
-                5.04279565803766,
-                52.224039508934
-              ],
-              [
-                5.03666560420121,
-                52.2243601526094
-              ],
-              [
-                5.03714490879833,
-                52.2162029891593
-              ],
-              [
-                5.03532956277234,
-                52.2144622645839
-              ],
-              [
-                5.03729033685901,
-                52.2142151783627
-              ],
-              [
-                5.03378795664214,
-                52.2137139937498
-              ],
-              [
-                5.03340058731224,
-                52.2098362303304
-              ],
-              [
-                5.03644471246542,
-                52.2095679513034
-              ],
-              [
-                5.036649899529,
-                52.2021379852439
-              ],
-              [
-                5.02262103635995,
-                52.2039414613661
-              ],
-              [
-                5.02301174599941,
-                52.201746933034
-              ],
-              [
-                5.02129296038883,
-                52.2018857417132
-              ],
-              [
-                5.03720107006,
-                52.1979490603415
-              ],
-              [
-                5.03813407881007,
-                52.1951631710584
-              ],
-              [
-                5.03250322767578,
-                52.1841134063713
-              ],
-              [
-                5.04204930991025,
-                52.1833700108387
-              ],
-              [
-                5.04642100607386,
-                52.165928986783
-              ],
-              [
-                5.11529372920215,
-                52.1798527902487
-              ],
-              [
-                5.1188451943187,
-                52.1724060408381
-              ],
-              [
-                5.09488971501795,
-                52.1553490252009
-              ],
-              [
-                5.12052895106188,
-                52.1455411379847
-              ],
-              [
-                5.10339742352514,
-                52.1298483701979
-              ],
-              [
-                5.08716452384681,
-                52.1356714548381
-              ],
-              [
-                5.07615846315723,
-                52.127049119904
-              ],
-              [
-                5.07232804318951,
-                52.1253250487148
-              ],
-              [
-                5.07019866438536,
-                52.1271814859531
-              ],
-              [
-                5.06120454190943,
-                52.1225617774732
-              ],
-              [
-                5.04755601976091,
-                52.1299724108174
-              ],
-              [
-                5.03267561373434,
-                52.116767994041
-              ],
-              [
-                5.01221260097858,
-                52.1296004154767
-              ],
-              [
-                5.00310171888224,
-                52.1420505964424
-              ],
-              [
-                4.98638775971803,
-                52.135249137023
-              ],
-              [
-                4.98523799267069,
-                52.1324371316412
-              ],
-              [
-                4.97393834693755,
-                52.1296732378177
-              ],
-              [
-                4.97009598213959,
-                52.1224416693191
-              ],
-              [
-                4.96099210673178,
-                52.1333676099668
-              ],
-              [
-                4.94398449418102,
-                52.1274955230945
-              ],
-              [
-                4.93469716101929,
-                52.1386048341347
-              ],
-              [
-                4.92360171365657,
-                52.1473188780651
-              ],
-              [
-                4.9117775755383,
-                52.1442544736588
-              ],
-              [
-                4.90963796181041,
-                52.1618708908732
-              ],
-              [
-                4.9286063192259,
-                52.1672001109697
-              ],
-              [
-                4.93246800818737,
-                52.1724936857311
-              ],
-              [
-                4.93140995716724,
-                52.1737097558928
-              ],
-              [
-                4.93500683679857,
-                52.1758548024215
-              ],
-              [
-                4.93399527296676,
-                52.1769450342135
-              ],
-              [
-                4.94571262374908,
-                52.1858129890786
-              ],
-              [
-                4.94857157345972,
-                52.1857846826705
-              ],
-              [
-                4.94838271860317,
-                52.193598182959
-              ],
-              [
-                4.95088127023712,
-                52.1986384983666
-              ],
-              [
-                4.96080855216223,
-                52.1978197816999
-              ],
-              [
-                4.96138767151611,
-                52.1994751182074
-              ],
-              [
-                4.96659572436292,
-                52.2000523132605
-              ],
-              [
-                4.97374660126052,
-                52.2205984150554
-              ],
-              [
-                4.98287423447946,
-                52.2224155947977
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0344",
-        "name": "Utrecht (Ut)",
-        "regioFacet": "tcm:106-353727-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/utrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.03251093499644,
-                52.1168365451888
-              ],
-              [
-                5.04755601976091,
-                52.1299724108174
-              ],
-              [
-                5.06120454190943,
-                52.1225617774732
-              ],
-              [
-                5.07019866438536,
-                52.1271814859531
-              ],
-              [
-                5.07232804318951,
-                52.1253250487148
-              ],
-              [
-                5.07615846315723,
-                52.127049119904
-              ],
-              [
-                5.08716452384681,
-                52.1356714548381
-              ],
-              [
-                5.10108436323113,
-                52.1298446259374
-              ],
-              [
-                5.10339742352514,
-                52.1298483701979
-              ],
-              [
-                5.10709284979423,
-                52.1332111584803
-              ],
-              [
-                5.12652348666215,
-                52.1246044780787
-              ],
-              [
-                5.14016345541815,
-                52.1205820224238
-              ],
-              [
-                5.14816079441292,
-                52.1198027708727
-              ],
-              [
-                5.15038081165554,
-                52.1108753847265
-              ],
-              [
-                5.16191501098807,
-                52.1072153668951
-              ],
-              [
-                5.15640275851172,
-                52.0981485432077
-              ],
-              [
-                5.16179164663995,
-                52.0942992654609
-              ],
-              [
-                5.18056411373272,
-                52.0931318686782
-              ],
-              [
-                5.18070265332657,
-                52.0946084604745
-              ],
-              [
-                5.1861700359415,
-                52.0929276779238
-              ],
-              [
-                5.18974023996872,
-                52.0863503417233
-              ],
-              [
-                5.1927631691435,
-                52.0860611406559
-              ],
-              [
-                5.19515497060471,
-                52.0773462840127
-              ],
-              [
-                5.18140161193457,
-                52.0761923824359
-              ],
-              [
-                5.17929257187749,
-                52.0779021587296
-              ],
-              [
-                5.17160624919469,
-                52.0786749814733
-              ],
-              [
-                5.16691725509118,
-                52.0766812179271
-              ],
-              [
-                5.16017989544904,
-                52.080296157782
-              ],
-              [
-                5.16044141608283,
-                52.07778845008
-              ],
-              [
-                5.15566167691345,
-                52.0714559573653
-              ],
-              [
-                5.15950262453599,
-                52.0672629472472
-              ],
-              [
-                5.15689066054875,
-                52.0634268520758
-              ],
-              [
-                5.15775529137095,
-                52.0583418327019
-              ],
-              [
-                5.14573371168721,
-                52.052563728908
-              ],
-              [
-                5.12445605537482,
-                52.0552569246869
-              ],
-              [
-                5.12133906649286,
-                52.0543703120054
-              ],
-              [
-                5.11126147421158,
-                52.0583235783504
-              ],
-              [
-                5.10734436278537,
-                52.0500256036337
-              ],
-              [
-                5.10439766167619,
-                52.0499378099335
-              ],
-              [
-                5.09735213029946,
-                52.0594805033111
-              ],
-              [
-                5.0941241537068,
-                52.058754719642
-              ],
-              [
-                5.08938790287163,
-                52.0608256536207
-              ],
-              [
-                5.07052687271553,
-                52.0618688608354
-              ],
-              [
-                5.0643987985278,
-                52.0527236082133
-              ],
-              [
-                5.06309420924728,
-                52.0268667432
-              ],
-              [
-                5.06102076972511,
-                52.0262819829996
-              ],
-              [
-                5.04949511805108,
-                52.0312047266457
-              ],
-              [
-                5.03806443459577,
-                52.0441548104554
-              ],
-              [
-                5.0284510270655,
-                52.0506232714215
-              ],
-              [
-                5.02711959474529,
-                52.0553956134597
-              ],
-              [
-                5.02113319707927,
-                52.0562450448094
-              ],
-              [
-                5.01801050787315,
-                52.06221897319
-              ],
-              [
-                4.99949825753427,
-                52.0661314456022
-              ],
-              [
-                5.00501126063066,
-                52.0884852967372
-              ],
-              [
-                4.99421672258445,
-                52.0903664948256
-              ],
-              [
-                4.98932715011901,
-                52.1007553053626
-              ],
-              [
-                4.97565972794362,
-                52.0997895825734
-              ],
-              [
-                4.97298984786704,
-                52.1018090113868
-              ],
-              [
-                4.97609694740045,
-                52.107822851209
-              ],
-              [
-                4.97962491711826,
-                52.1087224300602
-              ],
-              [
-                4.98022784358299,
-                52.112225662256
-              ],
-              [
-                4.97009598213959,
-                52.1224416693191
-              ],
-              [
-                4.97393834693755,
-                52.1296732378177
-              ],
-              [
-                4.98523799267069,
-                52.1324371316412
-              ],
-              [
-                4.98638775971803,
-                52.135249137023
-              ],
-              [
-                5.00310171888224,
-                52.1420505964424
-              ],
-              [
-                5.01221260097858,
-                52.1296004154767
-              ],
-              [
-                5.03251093499644,
-                52.1168365451888
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1581",
-        "name": "Utrechtse Heuvelrug",
-        "regioFacet": "tcm:106-353728-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/utrechtse-heuvelrug/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.33260927604571,
-                52.0787427765801
-              ],
-              [
-                5.33225088515795,
-                52.0841633773011
-              ],
-              [
-                5.38452721598977,
-                52.0782684041892
-              ],
-              [
-                5.38834081781665,
-                52.0749319361671
-              ],
-              [
-                5.4057724827262,
-                52.0752464016449
-              ],
-              [
-                5.40706122716278,
-                52.0725768204238
-              ],
-              [
-                5.39953689168823,
-                52.0692105842781
-              ],
-              [
-                5.40081595021796,
-                52.0678313593946
-              ],
-              [
-                5.39895634849313,
-                52.0669382662234
-              ],
-              [
-                5.40189632995302,
-                52.0642985705232
-              ],
-              [
-                5.41004658095101,
-                52.0694075914646
-              ],
-              [
-                5.42205784980437,
-                52.0729578347962
-              ],
-              [
-                5.43558126197546,
-                52.0728772244476
-              ],
-              [
-                5.43831583951382,
-                52.0703518883136
-              ],
-              [
-                5.43645119246864,
-                52.0616092801739
-              ],
-              [
-                5.43510509683321,
-                52.0595184576702
-              ],
-              [
-                5.43033990584265,
-                52.05889093436
-              ],
-              [
-                5.42863948175035,
-                52.0553443776362
-              ],
-              [
-                5.43518748227586,
-                52.0478498235975
-              ],
-              [
-                5.44570271462771,
-                52.0452588763214
-              ],
-              [
-                5.44852975956477,
-                52.0431232207269
-              ],
-              [
-                5.45352467172372,
-                52.0499799907175
-              ],
-              [
-                5.46113808278457,
-                52.0472590579665
-              ],
-              [
-                5.46087729420581,
-                52.0486379493849
-              ],
-              [
-                5.4657024632539,
-                52.0508144356916
-              ],
-              [
-                5.47719475850741,
-                52.0528549152197
-              ],
-              [
-                5.48929640411156,
-                52.0570335736061
-              ],
-              [
-                5.51568124335421,
-                52.0541911506024
-              ],
-              [
-                5.517424867507,
-                52.0467499255545
-              ],
-              [
-                5.52938126486101,
-                52.040245861517
-              ],
-              [
-                5.49420167486427,
-                51.9949010586211
-              ],
-              [
-                5.49089771896638,
-                51.9965610516897
-              ],
-              [
-                5.48529383496729,
-                51.9909650258787
-              ],
-              [
-                5.48459472425683,
-                51.9877595729553
-              ],
-              [
-                5.47962495286822,
-                51.9917144367273
-              ],
-              [
-                5.48064096378864,
-                51.9888642312118
-              ],
-              [
-                5.4778498178391,
-                51.9876917099666
-              ],
-              [
-                5.47766449998344,
-                51.983472669908
-              ],
-              [
-                5.45404576312201,
-                51.9836941790942
-              ],
-              [
-                5.44481710629114,
-                51.9857880233334
-              ],
-              [
-                5.43760662760976,
-                51.9856085781647
-              ],
-              [
-                5.41815557997757,
-                51.976605702213
-              ],
-              [
-                5.39097606837177,
-                51.9707301951541
-              ],
-              [
-                5.37839414831992,
-                51.9861194189319
-              ],
-              [
-                5.399422289221,
-                51.9879481225132
-              ],
-              [
-                5.41527726485031,
-                51.9967016859359
-              ],
-              [
-                5.39968738186706,
-                52.0027266522384
-              ],
-              [
-                5.38164705402704,
-                52.0021378019238
-              ],
-              [
-                5.35025997035803,
-                52.0160374557966
-              ],
-              [
-                5.34429157241309,
-                52.0201936879718
-              ],
-              [
-                5.32398548631481,
-                52.0228254433446
-              ],
-              [
-                5.31464923941601,
-                52.0270014707513
-              ],
-              [
-                5.29121998984623,
-                52.0083538118372
-              ],
-              [
-                5.27890662523564,
-                52.0108608740817
-              ],
-              [
-                5.27169312165552,
-                52.014609189345
-              ],
-              [
-                5.26323940165507,
-                52.0243801018921
-              ],
-              [
-                5.27086051582056,
-                52.027481006348
-              ],
-              [
-                5.26388021532936,
-                52.0319693088577
-              ],
-              [
-                5.25749885893434,
-                52.0326774380242
-              ],
-              [
-                5.25779662715724,
-                52.0382858430998
-              ],
-              [
-                5.25267733210536,
-                52.0385995678979
-              ],
-              [
-                5.24947781092288,
-                52.041014779466
-              ],
-              [
-                5.25103958209662,
-                52.0457574484457
-              ],
-              [
-                5.24800595021134,
-                52.0474128209064
-              ],
-              [
-                5.251677307301,
-                52.0539577229383
-              ],
-              [
-                5.24698982321782,
-                52.0584030873545
-              ],
-              [
-                5.25642021207403,
-                52.0630037741006
-              ],
-              [
-                5.25788976651855,
-                52.0658823141682
-              ],
-              [
-                5.29076014212463,
-                52.0865677202621
-              ],
-              [
-                5.32042276277817,
-                52.0703269022464
-              ],
-              [
-                5.33260927604571,
-                52.0787427765801
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0345",
-        "name": "Veenendaal",
-        "regioFacet": "tcm:106-353729-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/veenendaal/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.52216953231472,
-                52.0308476977547
-              ],
-              [
-                5.52934788106073,
-                52.0402024263986
-              ],
-              [
-                5.53142014515622,
-                52.0394688109617
-              ],
-              [
-                5.54072949333131,
-                52.0426696108934
-              ],
-              [
-                5.54463954251981,
-                52.0493733050596
-              ],
-              [
-                5.57085294158012,
-                52.0479969871347
-              ],
-              [
-                5.56738576442459,
-                52.040605674335
-              ],
-              [
-                5.59003013463569,
-                52.0343870616298
-              ],
-              [
-                5.58958867859907,
-                52.0311765881428
-              ],
-              [
-                5.58671162389602,
-                52.031290421023
-              ],
-              [
-                5.58650254667648,
-                52.0291710414139
-              ],
-              [
-                5.58719961167958,
-                52.0204499251351
-              ],
-              [
-                5.5902421010498,
-                52.0202667379104
-              ],
-              [
-                5.58962161031172,
-                52.0155948147838
-              ],
-              [
-                5.58692224632307,
-                52.0157131749384
-              ],
-              [
-                5.58601462662123,
-                52.0117762745355
-              ],
-              [
-                5.58948874322352,
-                52.0094555214318
-              ],
-              [
-                5.57047857635004,
-                51.9969583547764
-              ],
-              [
-                5.55804505742879,
-                52.0036320181324
-              ],
-              [
-                5.55322924121064,
-                51.9990308392305
-              ],
-              [
-                5.53514058653824,
-                52.0088866281595
-              ],
-              [
-                5.53581915461376,
-                52.0100507532646
-              ],
-              [
-                5.53236600936441,
-                52.0109001245618
-              ],
-              [
-                5.53367672548496,
-                52.0126651368089
-              ],
-              [
-                5.51259217384812,
-                52.0185160955598
-              ],
-              [
-                5.52216953231472,
-                52.0308476977547
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0620",
-        "name": "Vianen",
-        "regioFacet": "tcm:106-353730-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/vianen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.11985709768566,
-                51.9998098680563
-              ],
-              [
-                5.13386803515409,
-                51.9966872506745
-              ],
-              [
-                5.13967676129287,
-                51.9939432327519
-              ],
-              [
-                5.14553979118845,
-                51.9786798570372
-              ],
-              [
-                5.15183429481828,
-                51.9730136425258
-              ],
-              [
-                5.180226656525,
-                51.9674483239783
-              ],
-              [
-                5.15094199959902,
-                51.9333533588908
-              ],
-              [
-                5.13335200803588,
-                51.9377546355204
-              ],
-              [
-                5.13013804499733,
-                51.9349116503481
-              ],
-              [
-                5.08884283918147,
-                51.9500048650618
-              ],
-              [
-                5.05445860407509,
-                51.9547913813409
-              ],
-              [
-                5.05846739985361,
-                51.9605537040854
-              ],
-              [
-                5.06464237017003,
-                51.9645910091343
-              ],
-              [
-                5.05438380392872,
-                51.9765854857731
-              ],
-              [
-                5.04884240472239,
-                51.9740600303291
-              ],
-              [
-                5.04779444330012,
-                51.9765576961926
-              ],
-              [
-                5.04040220479064,
-                51.9774436251702
-              ],
-              [
-                5.04499531853341,
-                51.9819589496781
-              ],
-              [
-                5.06628618554913,
-                51.9940734051496
-              ],
-              [
-                5.08904282703026,
-                52.0012679783163
-              ],
-              [
-                5.10654205717453,
-                52.00291897937
-              ],
-              [
-                5.11985709768566,
-                51.9998098680563
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0352",
-        "name": "Wijk bij Duurstede",
-        "regioFacet": "tcm:106-353731-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/wijk-bij-duurstede/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.29121998984623,
-                52.0083538118372
-              ],
-              [
-                5.31464923941601,
-                52.0270014707513
-              ],
-              [
-                5.32398548631481,
-                52.0228254433446
-              ],
-              [
-                5.34429157241309,
-                52.0201936879718
-              ],
-              [
-                5.35025997035803,
-                52.0160374557966
-              ],
-              [
-                5.38160059742945,
-                52.0021516774834
-              ],
-              [
-                5.39890559750155,
-                52.0029228010692
-              ],
-              [
-                5.41527726485031,
-                51.9967016859359
-              ],
-              [
-                5.399422289221,
-                51.9879481225132
-              ],
-              [
-                5.37839414831992,
-                51.9861194189319
-              ],
-              [
-                5.39097606837177,
-                51.9707301951541
-              ],
-              [
-                5.37979976789508,
-                51.9691075266245
-              ],
-              [
-                5.36347955794442,
-                51.9709294977334
-              ],
-              [
-                5.35504208638512,
-                51.9702305161008
-              ],
-              [
-                5.34709475905665,
-                51.9669974873532
-              ],
-              [
-                5.33509422480797,
-                51.9579869837976
-              ],
-              [
-                5.31832208080288,
-                51.9550192724678
-              ],
-              [
-                5.3008941375279,
-                51.961568558462
-              ],
-              [
-                5.27371010373871,
-                51.964541623117
-              ],
-              [
-                5.25626629852031,
-                51.9709391991108
-              ],
-              [
-                5.26604752329305,
-                51.9758070211905
-              ],
-              [
-                5.27144659792108,
-                51.9811335279242
-              ],
-              [
-                5.25414113161744,
-                51.9860706861933
-              ],
-              [
-                5.25332533135481,
-                51.9923350220094
-              ],
-              [
-                5.25620424559042,
-                51.9937252856421
-              ],
-              [
-                5.24538526706911,
-                51.9959837173291
-              ],
-              [
-                5.24235510652724,
-                51.9986619728771
-              ],
-              [
-                5.25956256652728,
-                52.0009992174149
-              ],
-              [
-                5.26473623523904,
-                52.0032201266212
-              ],
-              [
-                5.26709907790529,
-                51.9992449941615
-              ],
-              [
-                5.27219516975527,
-                51.9977475546462
-              ],
-              [
-                5.27751761304832,
-                52.0000966054827
-              ],
-              [
-                5.27570164037783,
-                52.005720280124
-              ],
-              [
-                5.28015323266384,
-                52.0089112623691
-              ],
-              [
-                5.2799099103904,
-                52.010721585574
-              ],
-              [
-                5.29121998984623,
-                52.0083538118372
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0632",
-        "name": "Woerden",
-        "regioFacet": "tcm:106-353732-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/woerden/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.82438949445659,
-                52.1054030739958
-              ],
-              [
-                4.8253147415371,
-                52.1071510878392
-              ],
-              [
-                4.79204272303586,
-                52.1223116816186
-              ],
-              [
-                4.79834161727011,
-                52.1252987241747
-              ],
-              [
-                4.80260084352459,
-                52.1250597512784
-              ],
-              [
-                4.8077660960953,
-                52.1286218893776
-              ],
-              [
-                4.80748552537597,
-                52.1350215834158
-              ],
-              [
-                4.8099598652822,
-                52.138432318956
-              ],
-              [
-                4.83285787491289,
-                52.1447623571809
-              ],
-              [
-                4.8341281950725,
-                52.1428764895668
-              ],
-              [
-                4.83670464255443,
-                52.1428799208872
-              ],
-              [
-                4.83736643465786,
-                52.1406495923432
-              ],
-              [
-                4.84027608137948,
-                52.1415607444697
-              ],
-              [
-                4.84287191124514,
-                52.1394595524909
-              ],
-              [
-                4.85147861250099,
-                52.1396744010765
-              ],
-              [
-                4.85523866730597,
-                52.1372096169464
-              ],
-              [
-                4.87451848125608,
-                52.1388314137199
-              ],
-              [
-                4.87362346477432,
-                52.1560825078137
-              ],
-              [
-                4.89217825656838,
-                52.161797918799
-              ],
-              [
-                4.90963796181041,
-                52.1618708908732
-              ],
-              [
-                4.9117775755383,
-                52.1442544736588
-              ],
-              [
-                4.92360171365657,
-                52.1473188780651
-              ],
-              [
-                4.93469716101929,
-                52.1386048341347
-              ],
-              [
-                4.94398449418102,
-                52.1274955230945
-              ],
-              [
-                4.96099210673178,
-                52.1333676099668
-              ],
-              [
-                4.9802246317746,
-                52.1122561387739
-              ],
-              [
-                4.97962491711826,
-                52.1087224300602
-              ],
-              [
-                4.97609694740045,
-                52.107822851209
-              ],
-              [
-                4.9732769352556,
-                52.10137407832
-              ],
-              [
-                4.97565972794362,
-                52.0997895825734
-              ],
-              [
-                4.98932715011901,
-                52.1007553053626
-              ],
-              [
-                4.99421672258445,
-                52.0903664948256
-              ],
-              [
-                5.00501126063066,
-                52.0884852967372
-              ],
-              [
-                4.99949825753427,
-                52.0661314456022
-              ],
-              [
-                4.99584525886211,
-                52.0673342626828
-              ],
-              [
-                4.98259104611585,
-                52.0649504588952
-              ],
-              [
-                4.97050084663896,
-                52.06756012077
-              ],
-              [
-                4.95677334386214,
-                52.0659328884188
-              ],
-              [
-                4.95633325186052,
-                52.0702993578049
-              ],
-              [
-                4.9361425050395,
-                52.0799203296448
-              ],
-              [
-                4.92466104606333,
-                52.0775905707474
-              ],
-              [
-                4.90508326488818,
-                52.0700147753793
-              ],
-              [
-                4.87451639002379,
-                52.068190745601
-              ],
-              [
-                4.84220378623563,
-                52.0690055864305
-              ],
-              [
-                4.82980790362704,
-                52.066823401298
-              ],
-              [
-                4.83403538898179,
-                52.0727358487494
-              ],
-              [
-                4.83304526000421,
-                52.0748269282901
-              ],
-              [
-                4.82627568810712,
-                52.0746435740339
-              ],
-              [
-                4.82334790361734,
-                52.0933685246257
-              ],
-              [
-                4.82438949445659,
-                52.1054030739958
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0351",
-        "name": "Woudenberg",
-        "regioFacet": "tcm:106-353733-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/woudenberg/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.37959277011975,
-                52.0786654940501
-              ],
-              [
-                5.33225088515795,
-                52.0841633773011
-              ],
-              [
-                5.29830071108785,
-                52.0964453967703
-              ],
-              [
-                5.32246238148539,
-                52.1019009192916
-              ],
-              [
-                5.33429745628569,
-                52.0963332439367
-              ],
-              [
-                5.34056771681714,
-                52.0950315421396
-              ],
-              [
-                5.37800991023058,
-                52.0904716040578
-              ],
-              [
-                5.40133209488863,
-                52.0893335316996
-              ],
-              [
-                5.40452086694367,
-                52.1041063960456
-              ],
-              [
-                5.41015851349091,
-                52.1032632579577
-              ],
-              [
-                5.42104052238218,
-                52.096901226155
-              ],
-              [
-                5.42962902400937,
-                52.0959104635834
-              ],
-              [
-                5.43793794548845,
-                52.0997439984828
-              ],
-              [
-                5.44380666834458,
-                52.1070745606507
-              ],
-              [
-                5.45786596267342,
-                52.1053692519535
-              ],
-              [
-                5.46751649589529,
-                52.1004002564734
-              ],
-              [
-                5.47198731912033,
-                52.1014556820441
-              ],
-              [
-                5.47370706446309,
-                52.1047596267655
-              ],
-              [
-                5.47603713445071,
-                52.1050121521515
-              ],
-              [
-                5.47582548642828,
-                52.1120711020918
-              ],
-              [
-                5.47907903174769,
-                52.1174261786452
-              ],
-              [
-                5.49371152384506,
-                52.113065221195
-              ],
-              [
-                5.49386174171479,
-                52.1110972616644
-              ],
-              [
-                5.48430174320533,
-                52.1055421161764
-              ],
-              [
-                5.48608269689163,
-                52.0936567678948
-              ],
-              [
-                5.47188580599709,
-                52.0893814278289
-              ],
-              [
-                5.464053566881,
-                52.083056175642
-              ],
-              [
-                5.45967809901103,
-                52.0820034081289
-              ],
-              [
-                5.45924292291899,
-                52.0802257513851
-              ],
-              [
-                5.4654347679705,
-                52.0784291199056
-              ],
-              [
-                5.46700882697,
-                52.0756272248633
-              ],
-              [
-                5.48389745953263,
-                52.0696563959422
-              ],
-              [
-                5.49613614676273,
-                52.071674481952
-              ],
-              [
-                5.49787596321302,
-                52.0570182082075
-              ],
-              [
-                5.484032184266,
-                52.0559618120712
-              ],
-              [
-                5.47719475850741,
-                52.0528549152197
-              ],
-              [
-                5.4657024632539,
-                52.0508144356916
-              ],
-              [
-                5.46087729420581,
-                52.0486379493849
-              ],
-              [
-                5.46113808278457,
-                52.0472590579665
-              ],
-              [
-                5.45352467172372,
-                52.0499799907175
-              ],
-              [
-                5.44852975956477,
-                52.0431232207269
-              ],
-              [
-                5.44570271462771,
-                52.0452588763214
-              ],
-              [
-                5.43518748227586,
-                52.0478498235975
-              ],
-              [
-                5.42863948175035,
-                52.0553443776362
-              ],
-              [
-                5.43033990584265,
-                52.05889093436
-              ],
-              [
-                5.43578740783488,
-                52.0602070048461
-              ],
-              [
-                5.43721261442565,
-                52.0721888382195
-              ],
-              [
-                5.42216265242544,
-                52.0729818016881
-              ],
-              [
-                5.41004658095101,
-                52.0694075914646
-              ],
-              [
-                5.40189632995302,
-                52.0642985705232
-              ],
-              [
-                5.39895634849313,
-                52.0669382662234
-              ],
-              [
-                5.40081595021796,
-                52.0678313593946
-              ],
-              [
-                5.39953689168823,
-                52.0692105842781
-              ],
-              [
-                5.40706122716278,
-                52.0725768204238
-              ],
-              [
-                5.40589384576697,
-                52.075220478736
-              ],
-              [
-                5.38834081781665,
-                52.0749319361671
-              ],
-              [
-                5.38452721598977,
-                52.0782684041892
-              ],
-              [
-                5.37959277011975,
-                52.0786654940501
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0355",
-        "name": "Zeist",
-        "regioFacet": "tcm:106-353734-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/zeist/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.21272719757031,
-                52.0976743260803
-              ],
-              [
-                5.21083903471528,
-                52.1007476353725
-              ],
-              [
-                5.22232888558977,
-                52.1038932439755
-              ],
-              [
-                5.21821918119546,
-                52.1136726620097
-              ],
-              [
-                5.23093038642207,
-                52.1167888838763
-              ],
-              [
-                5.22499882137363,
-                52.1656996094625
-              ],
-              [
-                5.23631578916449,
-                52.1674582491812
-              ],
-              [
-                5.23598540255646,
-                52.1710993270976
-              ],
-              [
-                5.23790072176829,
-                52.171250909064
-              ],
-              [
-                5.2383598050948,
-                52.1677747099009
-              ],
-              [
-                5.24306506807496,
-                52.1702772633946
-              ],
-              [
-                5.24516505792989,
-                52.1667876861343
-              ],
-              [
-                5.25492394572914,
-                52.149152306684
-              ],
-              [
-                5.25591556534354,
-                52.1367240583627
-              ],
-              [
-                5.26410182157954,
-                52.1250800682698
-              ],
-              [
-                5.27193611074462,
-                52.1270455315953
-              ],
-              [
-                5.28213830852901,
-                52.1120629245335
-              ],
-              [
-                5.29182345826533,
-                52.1145427543168
-              ],
-              [
-                5.30786457436724,
-                52.1096948369155
-              ],
-              [
-                5.32246238148539,
-                52.1019009192916
-              ],
-              [
-                5.29830071108785,
-                52.0964453967703
-              ],
-              [
-                5.33225088515795,
-                52.0841633773011
-              ],
-              [
-                5.33260927604571,
-                52.0787427765801
-              ],
-              [
-                5.32042276277817,
-                52.0703269022464
-              ],
-              [
-                5.29076014212463,
-                52.0865677202621
-              ],
-              [
-                5.25788976651855,
-                52.0658823141682
-              ],
-              [
-                5.25642021207403,
-                52.0630037741006
-              ],
-              [
-                5.24773496843244,
-                52.0587596922839
-              ],
-              [
-                5.21913193676999,
-                52.0597524509234
-              ],
-              [
-                5.22502198598348,
-                52.0649217362635
-              ],
-              [
-                5.20054815352138,
-                52.0768121731572
-              ],
-              [
-                5.19515497060471,
-                52.0773462840127
-              ],
-              [
-                5.19275205799096,
-                52.0860764469651
-              ],
-              [
-                5.20552461586224,
-                52.0848955887499
-              ],
-              [
-                5.20551720333786,
-                52.0868295450007
-              ],
-              [
-                5.20722975943574,
-                52.0864392671952
-              ],
-              [
-                5.21058763680523,
-                52.0903144248352
-              ],
-              [
-                5.20860875339233,
-                52.0936189091128
-              ],
-              [
-                5.21272719757031,
-                52.0976743260803
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0718",
-        "name": "Vlissingen",
-        "regioFacet": "tcm:106-353748-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/vlissingen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                3.56456713623325,
-                51.4704297517074
-              ],
-              [
-                3.56605766813857,
-                51.4782487012776
-              ],
-              [
-                3.570674960997,
-                51.4768753410339
-              ],
-              [
-                3.57429497292673,
-                51.4774394256156
-              ],
-              [
-                3.57570238215597,
-                51.4744472623029
-              ],
-              [
-                3.58234206096549,
-                51.4758120497455
-              ],
-              [
-                3.58393576391547,
-                51.4784653789351
-              ],
-              [
-                3.58937583717388,
-                51.4798629121604
-              ],
-              [
-                3.59965880278481,
-                51.4752945558445
-              ],
-              [
-                3.60176054818298,
-                51.4793262398308
-              ],
-              [
-                3.60890595949963,
-                51.4755248026101
-              ],
-              [
-                3.61772882972103,
-                51.4777549726989
-              ],
-              [
-                3.6216558635772,
-                51.4754845092783
-              ],
-              [
-                3.62921480889994,
-                51.4809144391279
-              ],
-              [
-                3.64821136108279,
-                51.469901068845
-              ],
-              [
-                3.66055675931875,
-                51.4682284834389
-              ],
-              [
-                3.69251342960845,
-                51.4799682866952
-              ],
-              [
-                3.70364793141337,
-                51.4822703467898
-              ],
-              [
-                3.70622803368283,
-                51.4774789221531
-              ],
-              [
-                3.69567808483348,
-                51.4711147149577
-              ],
-              [
-                3.6985066252929,
-                51.4696927910716
-              ],
-              [
-                3.71058453643611,
-                51.4715119281623
-              ],
-              [
-                3.71742353168671,
-                51.4696076721178
-              ],
-              [
-                3.70983668731084,
-                51.453247195483
-              ],
-              [
-                3.69639183078517,
-                51.4458511368932
-              ],
-              [
-                3.71805297198028,
-                51.4276513781448
-              ],
-              [
-                3.71042627124281,
-                51.4239380970914
-              ],
-              [
-                3.609680238244165,
-                51.44022322996938
-              ],
-              [
-                3.5997041250913346,
-                51.439826371422875
-              ],
-              [
-                3.5819835817719365,
-                51.43937679158416
-              ],
-              [
-                3.5756587232327877,
-                51.43809486864897
-              ],
-              [
-                3.52537844163394,
-                51.4622461440332
-              ],
-              [
-                3.54434590664893,
-                51.4664994351648
-              ],
-              [
-                3.55276116882829,
-                51.4709555064229
-              ],
-              [
-                3.55517066162652,
-                51.4679019879847
-              ],
-              [
-                3.56022675063778,
-                51.4711862560103
-              ],
-              [
-                3.56456713623325,
-                51.4704297517074
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0482",
-        "name": "Alblasserdam",
-        "regioFacet": "tcm:106-353750-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/alblasserdam/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.63554726599067,
-                51.8697244916913
-              ],
-              [
-                4.6263910544654,
-                51.8806577615459
-              ],
-              [
-                4.62877466406475,
-                51.8812121511845
-              ],
-              [
-                4.63707557592705,
-                51.8762944485057
-              ],
-              [
-                4.64213830226658,
-                51.8801996709152
-              ],
-              [
-                4.66398455937081,
-                51.8749808188043
-              ],
-              [
-                4.67291403496013,
-                51.8747919374668
-              ],
-              [
-                4.68781495158954,
-                51.8787176533774
-              ],
-              [
-                4.70023970430445,
-                51.879709878942
-              ],
-              [
-                4.69043893167043,
-                51.8668470464634
-              ],
-              [
-                4.68644944344843,
-                51.8662899460448
-              ],
-              [
-                4.68070889604395,
-                51.8596385623407
-              ],
-              [
-                4.68319067130829,
-                51.848491452695
-              ],
-              [
-                4.68134889447222,
-                51.8486781361829
-              ],
-              [
-                4.6803975248509,
-                51.8444394247066
-              ],
-              [
-                4.67260618827874,
-                51.8386503271932
-              ],
-              [
-                4.64884990128722,
-                51.8618422413877
-              ],
-              [
-                4.63554726599067,
-                51.8697244916913
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0613",
-        "name": "Albrandswaard",
-        "regioFacet": "tcm:106-353751-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/albrandswaard/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.42375281201865,
-                51.8400730503629
-              ],
-              [
-                4.40974408784425,
-                51.8432190103949
-              ],
-              [
-                4.38366452519404,
-                51.8421182535961
-              ],
-              [
-                4.38444957564059,
-                51.8616089908949
-              ],
-              [
-                4.38621994072284,
-                51.8662174265392
-              ],
-              [
-                4.39284118702439,
-                51.8718060073422
-              ],
-              [
-                4.40577093059429,
-                51.8731981946996
-              ],
-              [
-                4.41225288212748,
-                51.8714285808575
-              ],
-              [
-                4.41814858240375,
-                51.8723747404795
-              ],
-              [
-                4.43470030486663,
-                51.8691093796471
-              ],
-              [
-                4.44102022537672,
-                51.8659097411825
-              ],
-              [
-                4.44647541440022,
-                51.8660509146861
-              ],
-              [
-                4.46308755390807,
-                51.8623234575932
-              ],
-              [
-                4.48040261583466,
-                51.8623275600006
-              ],
-              [
-                4.48028751055323,
-                51.8551485874436
-              ],
-              [
-                4.49012517889936,
-                51.851315493402
-              ],
-              [
-                4.48718728917084,
-                51.8485491584043
-              ],
-              [
-                4.49222711505201,
-                51.8414442137275
-              ],
-              [
-                4.48973194307796,
-                51.8407545456106
-              ],
-              [
-                4.48909430414142,
-                51.8353955682926
-              ],
-              [
-                4.46711943869916,
-                51.8319438326646
-              ],
-              [
-                4.45297010170282,
-                51.8318638531785
-              ],
-              [
-                4.42375281201865,
-                51.8400730503629
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0489",
-        "name": "Barendrecht",
-        "regioFacet": "tcm:106-353753-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/barendrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.49012517889936,
-                51.851315493402
-              ],
-              [
-                4.48028751055323,
-                51.8551485874436
-              ],
-              [
-                4.47991551595265,
-                51.8652744617618
-              ],
-              [
-                4.49404026921609,
-                51.8689681791646
-              ],
-              [
-                4.52609543767934,
-                51.8697307572239
-              ],
-              [
-                4.53202663377358,
-                51.8687767114226
-              ],
-              [
-                4.54257866644455,
-                51.8707986533437
-              ],
-              [
-                4.54938313739876,
-                51.874027229187
-              ],
-              [
-                4.56410582488124,
-                51.8534733832115
-              ],
-              [
-                4.56596125672263,
-                51.8520863086445
-              ],
-              [
-                4.56997069604269,
-                51.8526205364115
-              ],
-              [
-                4.57134981194306,
-                51.8480028936182
-              ],
-              [
-                4.56001342470718,
-                51.8387912632234
-              ],
-              [
-                4.55360870097655,
-                51.8298969041529
-              ],
-              [
-                4.54574872493476,
-                51.8319449483597
-              ],
-              [
-                4.5254779429983,
-                51.8298542395766
-              ],
-              [
-                4.50218824854702,
-                51.8348659635163
-              ],
-              [
-                4.48909430414142,
-                51.8353955682926
-              ],
-              [
-                4.48973194307796,
-                51.8407545456106
-              ],
-              [
-                4.49222711505201,
-                51.8414442137275
-              ],
-              [
-                4.48718728917084,
-                51.8485491584043
-              ],
-              [
-                4.49012517889936,
-                51.851315493402
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0585",
-        "name": "Binnenmaas",
-        "regioFacet": "tcm:106-353756-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/binnenmaas/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.42933613737638,
-                51.7988982804349
-              ],
-              [
-                4.43049997838865,
-                51.8104944769246
-              ],
-              [
-                4.43517855988709,
-                51.8095189315028
-              ],
-              [
-                4.45216353400546,
-                51.812169299135
-              ],
-              [
-                4.44846414366669,
-                51.8230144090144
-              ],
-              [
-                4.45008298417337,
-                51.8321734806361
-              ],
-              [
-                4.46437164414241,
-                51.8317235090923
-              ],
-              [
-                4.4934399199614,
-                51.835549545492
-              ],
-              [
-                4.5254779429983,
-                51.8298542395766
-              ],
-              [
-                4.54284376530898,
-                51.8318686543627
-              ],
-              [
-                4.55156634533514,
-                51.8308779686312
-              ],
-              [
-                4.55723942480026,
-                51.8268626784146
-              ],
-              [
-                4.56265274742823,
-                51.8161242395002
-              ],
-              [
-                4.57028714262905,
-                51.8110222338761
-              ],
-              [
-                4.57955234098743,
-                51.8080039617197
-              ],
-              [
-                4.59903760246949,
-                51.8061053191062
-              ],
-              [
-                4.62122157602309,
-                51.8008036802427
-              ],
-              [
-                4.62749734917489,
-                51.7821635388916
-              ],
-              [
-                4.62634265514276,
-                51.7636173599027
-              ],
-              [
-                4.63159456611168,
-                51.7472486988695
-              ],
-              [
-                4.62657924456177,
-                51.7282562859652
-              ],
-              [
-                4.6059646048575,
-                51.7411230125229
-              ],
-              [
-                4.60296287349153,
-                51.740528196988
-              ],
-              [
-                4.59183463080468,
-                51.7485025727751
-              ],
-              [
-                4.59311666961676,
-                51.7509934511236
-              ],
-              [
-                4.61181958350555,
-                51.7572396888939
-              ],
-              [
-                4.6119573350955,
-                51.7604671587695
-              ],
-              [
-                4.57126346743245,
-                51.763341603637
-              ],
-              [
-                4.5706135778571,
-                51.7719742036157
-              ],
-              [
-                4.54606101652337,
-                51.7735996409421
-              ],
-              [
-                4.53690482894213,
-                51.7797378140431
-              ],
-              [
-                4.52670084292297,
-                51.7806160400494
-              ],
-              [
-                4.51811794342856,
-                51.7837492084816
-              ],
-              [
-                4.51536632285931,
-                51.78716316625
-              ],
-              [
-                4.50325912529047,
-                51.7866170068261
-              ],
-              [
-                4.50229300961856,
-                51.7838985466679
-              ],
-              [
-                4.49364355039751,
-                51.7810136027667
-              ],
-              [
-                4.48683075910179,
-                51.7815879902261
-              ],
-              [
-                4.48668312793067,
-                51.7772788081706
-              ],
-              [
-                4.47415040831701,
-                51.7764564850661
-              ],
-              [
-                4.46638690670699,
-                51.7849777903228
-              ],
-              [
-                4.46598477406932,
-                51.7883310426923
-              ],
-              [
-                4.43716353469973,
-                51.7813657162359
-              ],
-              [
-                4.42539106878988,
-                51.7847011255521
-              ],
-              [
-                4.42370393915607,
-                51.7866804787391
-              ],
-              [
-                4.42950557785362,
-                51.7954851202725
-              ],
-              [
-                4.42933613737638,
-                51.7988982804349
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1901",
-        "name": "Bodegraven-Reeuwijk",
-        "regioFacet": "tcm:106-353757-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/bodegraven-reeuwijk/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.71303426365543,
-                52.0769295052523
-              ],
-              [
-                4.71550585343971,
-                52.0811884107272
-              ],
-              [
-                4.70875813439951,
-                52.093643891905
-              ],
-              [
-                4.70473180124111,
-                52.0952408601618
-              ],
-              [
-                4.7348789412889,
-                52.1018909487318
-              ],
-              [
-                4.74022286329114,
-                52.1046480902113
-              ],
-              [
-                4.73885670029528,
-                52.1065074357989
-              ],
-              [
-                4.75456470357602,
-                52.1088103316862
-              ],
-              [
-                4.75932866116365,
-                52.1103446161968
-              ],
-              [
-                4.76253257213379,
-                52.1137116083922
-              ],
-              [
-                4.76921948678954,
-                52.11331151844
-              ],
-              [
-                4.77058880382491,
-                52.1150959898943
-              ],
-              [
-                4.77850740496729,
-                52.1158923042783
-              ],
-              [
-                4.77960920191977,
-                52.1177070336697
-              ],
-              [
-                4.78373832465236,
-                52.1173680979272
-              ],
-              [
-                4.78333643933489,
-                52.1204056606252
-              ],
-              [
-                4.79150984254196,
-                52.1203426179344
-              ],
-              [
-                4.79208018006477,
-                52.122287377839
-              ],
-              [
-                4.82537980144574,
-                52.1070871862691
-              ],
-              [
-                4.82305588657619,
-                52.1051928031308
-              ],
-              [
-                4.82334790361734,
-                52.0933685246257
-              ],
-              [
-                4.82627568810712,
-                52.0746435740339
-              ],
-              [
-                4.83304526000421,
-                52.0748269282901
-              ],
-              [
-                4.83403538898179,
-                52.0727358487494
-              ],
-              [
-                4.82980790362704,
-                52.066823401298
-              ],
-              [
-                4.84220378623563,
-                52.0690055864305
-              ],
-              [
-                4.87320041225178,
-                52.0687453916028
-              ],
-              [
-                4.86785833938303,
-                52.0630540872757
-              ],
-              [
-                4.85597052941786,
-                52.0590649074822
-              ],
-              [
-                4.84804850045139,
-                52.0581646832077
-              ],
-              [
-                4.82949028410266,
-                52.0498133986936
-              ],
-              [
-                4.81719293063217,
-                52.036931095164
-              ],
-              [
-                4.81182222848688,
-                52.0338245095269
-              ],
-              [
-                4.80670437056194,
-                52.0331673000291
-              ],
-              [
-                4.80993342004021,
-                52.0267645156089
-              ],
-              [
-                4.77877265525078,
-                52.0146148818448
-              ],
-              [
-                4.7667154029107,
-                52.0132418081988
-              ],
-              [
-                4.75099127760435,
-                52.0138987927723
-              ],
-              [
-                4.74601665390385,
-                52.0169763873514
-              ],
-              [
-                4.74369743672889,
-                52.0226080689005
-              ],
-              [
-                4.73678265091025,
-                52.0227482229741
-              ],
-              [
-                4.73440003121855,
-                52.0250201499961
-              ],
-              [
-                4.71984801432235,
-                52.0264843742911
-              ],
-              [
-                4.71987122767438,
-                52.0352008170858
-              ],
-              [
-                4.71758140436151,
-                52.0353067350877
-              ],
-              [
-                4.71626076383993,
-                52.0382226843519
-              ],
-              [
-                4.68705942242566,
-                52.0438360767267
-              ],
-              [
-                4.67731148531802,
-                52.0439710653915
-              ],
-              [
-                4.67980404400465,
-                52.0520724681768
-              ],
-              [
-                4.66900140711305,
-                52.0523415463001
-              ],
-              [
-                4.66969991089561,
-                52.0555046347743
-              ],
-              [
-                4.66696465745878,
-                52.0576273747468
-              ],
-              [
-                4.66402409075957,
-                52.0577050407496
-              ],
-              [
-                4.66054802440444,
-                52.0626882452078
-              ],
-              [
-                4.65996773066163,
-                52.0641043066233
-              ],
-              [
-                4.66591058250033,
-                52.0639516084121
-              ],
-              [
-                4.66566002226548,
-                52.0657239377681
-              ],
-              [
-                4.6772469845398,
-                52.0655205263125
-              ],
-              [
-                4.67929839636917,
-                52.0592158949473
-              ],
-              [
-                4.68197352694436,
-                52.0591040622686
-              ],
-              [
-                4.68529667989269,
-                52.0693947836225
-              ],
-              [
-                4.69441120350148,
-                52.0677673873576
-              ],
-              [
-                4.69652867682426,
-                52.0745467764574
-              ],
-              [
-                4.70490502944746,
-                52.076836409096
-              ],
-              [
-                4.71303426365543,
-                52.0769295052523
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0501",
-        "name": "Brielle",
-        "regioFacet": "tcm:106-353759-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/brielle/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.22922217334091,
-                51.8852453450547
-              ],
-              [
-                4.23233298913124,
-                51.8782225512296
-              ],
-              [
-                4.23938315140195,
-                51.8729859094409
-              ],
-              [
-                4.18527252798739,
-                51.8497076163062
-              ],
-              [
-                4.18289116782271,
-                51.8500957259947
-              ],
-              [
-                4.18270429958682,
-                51.8530552961663
-              ],
-              [
-                4.17562905228441,
-                51.855486256549
-              ],
-              [
-                4.18631581953955,
-                51.8624599097618
-              ],
-              [
-                4.16499764358482,
-                51.8678227847174
-              ],
-              [
-                4.16015247673481,
-                51.8670319073333
-              ],
-              [
-                4.15345463068883,
-                51.8758802717152
-              ],
-              [
-                4.15825336518322,
-                51.8782141162823
-              ],
-              [
-                4.15527885661112,
-                51.8816034331475
-              ],
-              [
-                4.1603491683723,
-                51.8833488057107
-              ],
-              [
-                4.13535075753713,
-                51.9063828168726
-              ],
-              [
-                4.13726102242739,
-                51.9123402406642
-              ],
-              [
-                4.14265191060542,
-                51.9145140481674
-              ],
-              [
-                4.14640585783218,
-                51.9137683600941
-              ],
-              [
-                4.15337816442427,
-                51.9221686015624
-              ],
-              [
-                4.14267839845583,
-                51.9267747494454
-              ],
-              [
-                4.14488967991353,
-                51.9314094596484
-              ],
-              [
-                4.16266851498686,
-                51.9266519454962
-              ],
-              [
-                4.16701818885872,
-                51.9231254859356
-              ],
-              [
-                4.16818680518225,
-                51.9190570158313
-              ],
-              [
-                4.17647462043577,
-                51.9179196719027
-              ],
-              [
-                4.21590741201214,
-                51.8989377156351
-              ],
-              [
-                4.22922217334091,
-                51.8852453450547
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0502",
-        "name": "Capelle aan den IJssel",
-        "regioFacet": "tcm:106-353760-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/capelle-aan-den-ijssel/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.57277964515161,
-                51.9434561111395
-              ],
-              [
-                4.5640634379892,
-                51.950808852336
-              ],
-              [
-                4.56205310884728,
-                51.9588745442297
-              ],
-              [
-                4.56436713113545,
-                51.9599245350308
-              ],
-              [
-                4.56801288090096,
-                51.958079905877
-              ],
-              [
-                4.58383197261358,
-                51.9655038396525
-              ],
-              [
-                4.60206826322309,
-                51.9494755967935
-              ],
-              [
-                4.59973400937092,
-                51.9476722952592
-              ],
-              [
-                4.61948862491547,
-                51.933492266917
-              ],
-              [
-                4.61543431086268,
-                51.9277658969306
-              ],
-              [
-                4.61072438985694,
-                51.9253410928297
-              ],
-              [
-                4.59859889249462,
-                51.9262332848884
-              ],
-              [
-                4.58211706883036,
-                51.9204754734704
-              ],
-              [
-                4.57786818200667,
-                51.9150544597633
-              ],
-              [
-                4.56766159514018,
-                51.9097959320349
-              ],
-              [
-                4.54990125962142,
-                51.9067284855502
-              ],
-              [
-                4.55105057902737,
-                51.909637944003
-              ],
-              [
-                4.55682830896494,
-                51.9103857060344
-              ],
-              [
-                4.55649588774665,
-                51.9148031381622
-              ],
-              [
-                4.55402493497616,
-                51.9147795535981
-              ],
-              [
-                4.5539958743627,
-                51.9166177993198
-              ],
-              [
-                4.550262687705,
-                51.9148179462373
-              ],
-              [
-                4.54769603462573,
-                51.907173800437
-              ],
-              [
-                4.54213595410097,
-                51.9062045556646
-              ],
-              [
-                4.53731170734832,
-                51.9135545396722
-              ],
-              [
-                4.53822209173301,
-                51.9153441593683
-              ],
-              [
-                4.54052258986392,
-                51.9152418607485
-              ],
-              [
-                4.53754956475637,
-                51.9197783769474
-              ],
-              [
-                4.55691246565784,
-                51.919912009442
-              ],
-              [
-                4.56437889062244,
-                51.9235031638723
-              ],
-              [
-                4.56623881440093,
-                51.9259088370148
-              ],
-              [
-                4.56059446102156,
-                51.9409100780848
-              ],
-              [
-                4.57277964515161,
-                51.9434561111395
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0505",
-        "name": "Dordrecht",
-        "regioFacet": "tcm:106-353764-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/dordrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.67736084590127,
-                51.8204008398723
-              ],
-              [
-                4.71344815000484,
-                51.8233087171199
-              ],
-              [
-                4.74886437863516,
-                51.8220711451202
-              ],
-              [
-                4.76503539960934,
-                51.8163500874578
-              ],
-              [
-                4.77209159938877,
-                51.8153190861215
-              ],
-              [
-                4.78535488712952,
-                51.815198389426
-              ],
-              [
-                4.80797825259738,
-                51.8184647819809
-              ],
-              [
-                4.83507807655815,
-                51.818567210911105
-              ],
-              [
-                4.851732403704765,
-                51.817885382809465
-              ],
-              [
-                4.871210438843415,
-                51.8166750951977
-              ],
-              [
-                4.877767451519255,
-                51.8170458649948
-              ],
-              [
-                4.882486069537671,
-                51.8176914432638
-              ],
-              [
-                4.882977865660854,
-                51.81749627087255
-              ],
-              [
-                4.876607884030765,
-                51.81388870579376
-              ],
-              [
-                4.86140964334794,
-                51.805295455231295
-              ],
-              [
-                4.85279614235582,
-                51.80193851295323
-              ],
-              [
-                4.842329503242098,
-                51.799706250682725
-              ],
-              [
-                4.83412051962325,
-                51.7995637574981
-              ],
-              [
-                4.828104439875307,
-                51.79987331348925
-              ],
-              [
-                4.8229203794639695,
-                51.800562301938136
-              ],
-              [
-                4.808138461801375,
-                51.802098541066385
-              ],
-              [
-                4.796645900837312,
-                51.801510282775006
-              ],
-              [
-                4.783520876254343,
-                51.79868311532505
-              ],
-              [
-                4.770615142094137,
-                51.794409371670994
-              ],
-              [
-                4.759108137622949,
-                51.787886101943116
-              ],
-              [
-                4.751109778069831,
-                51.781362682919585
-              ],
-              [
-                4.747702458264772,
-                51.776822598052945
-              ],
-              [
-                4.74265052415641,
-                51.76619934523356
-              ],
-              [
-                4.740234254454856,
-                51.76270824460691
-              ],
-              [
-                4.737233123140027,
-                51.759805225943126
-              ],
-              [
-                4.726552736851135,
-                51.75295865565673
-              ],
-              [
-                4.721166092566348,
-                51.74988653348079
-              ],
-              [
-                4.714646551299448,
-                51.7459779731682
-              ],
-              [
-                4.710731986548245,
-                51.74360076450553
-              ],
-              [
-                4.701152423960339,
-                51.73914147780367
-              ],
-              [
-                4.684844086709028,
-                51.73262167342486
-              ],
-              [
-                4.676534704563757,
-                51.73045942783218
-              ],
-              [
-                4.667474055232441,
-                51.729084631681545
-              ],
-              [
-                4.659774888783215,
-                51.72670442873377
-              ],
-              [
-                4.64219160090195,
-                51.723341614775386
-              ],
-              [
-                4.627586564443789,
-                51.71982813655637
-              ],
-              [
-                4.62424778162853,
-                51.71983154330538
-              ],
-              [
-                4.63163330924756,
-                51.7455584205478
-              ],
-              [
-                4.62634265514276,
-                51.7636173599027
-              ],
-              [
-                4.62749734917489,
-                51.7821635388916
-              ],
-              [
-                4.62122157602309,
-                51.8008036802427
-              ],
-              [
-                4.63833041468094,
-                51.8039934453503
-              ],
-              [
-                4.66500509751693,
-                51.8196969729068
-              ],
-              [
-                4.67736084590127,
-                51.8204008398723
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0689",
-        "name": "Giessenlanden",
-        "regioFacet": "tcm:106-353765-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/giessenlanden/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.96497657756851,
-                51.8615915756887
-              ],
-              [
-                4.95930956222185,
-                51.8628849337493
-              ],
-              [
-                4.95732936544431,
-                51.8594970751052
-              ],
-              [
-                4.93839867788595,
-                51.863629358702
-              ],
-              [
-                4.94272003713863,
-                51.8447863644174
-              ],
-              [
-                4.93693991261925,
-                51.8443784244301
-              ],
-              [
-                4.9317332201048,
-                51.8405153905769
-              ],
-              [
-                4.92182281477273,
-                51.8407888886014
-              ],
-              [
-                4.9248638318319,
-                51.8397184116222
-              ],
-              [
-                4.90848510454976,
-                51.8387156475368
-              ],
-              [
-                4.88282439748756,
-                51.8330969684621
-              ],
-              [
-                4.86792835423129,
-                51.8319936661637
-              ],
-              [
-                4.86645056983565,
-                51.8348369094366
-              ],
-              [
-                4.86300681981093,
-                51.8329408279766
-              ],
-              [
-                4.8479494699135,
-                51.8326173119896
-              ],
-              [
-                4.83808213926218,
-                51.8607346697193
-              ],
-              [
-                4.85455663868163,
-                51.8677946160835
-              ],
-              [
-                4.85559473158935,
-                51.8670990443916
-              ],
-              [
-                4.85789990660874,
-                51.8695673981312
-              ],
-              [
-                4.87842578465129,
-                51.8782178093336
-              ],
-              [
-                4.8964747492083,
-                51.8802732010224
-              ],
-              [
-                4.89430484262973,
-                51.8853692716449
-              ],
-              [
-                4.90541291383713,
-                51.8859575794532
-              ],
-              [
-                4.91076398659323,
-                51.8877055353051
-              ],
-              [
-                4.9277819300752,
-                51.9009141543472
-              ],
-              [
-                4.91962786622566,
-                51.9188156988727
-              ],
-              [
-                4.94769623489898,
-                51.9337246041445
-              ],
-              [
-                4.95615745315533,
-                51.915591062315
-              ],
-              [
-                4.96478522417896,
-                51.9135189594112
-              ],
-              [
-                4.96412818322141,
-                51.9106380007886
-              ],
-              [
-                4.97374062541147,
-                51.9076674108814
-              ],
-              [
-                4.97341069988177,
-                51.8972500480023
-              ],
-              [
-                4.99496805615035,
-                51.9022923319478
-              ],
-              [
-                4.99596168458035,
-                51.891962975561
-              ],
-              [
-                4.9994522738996,
-                51.8842835048615
-              ],
-              [
-                4.99504740023607,
-                51.8776163124086
-              ],
-              [
-                4.9962381254413,
-                51.8731191900124
-              ],
-              [
-                5.00126680685638,
-                51.8749922248334
-              ],
-              [
-                5.02695590889765,
-                51.8819182035372
-              ],
-              [
-                5.02725443197032,
-                51.8815278095764
-              ],
-              [
-                5.02325031299625,
-                51.8758018343394
-              ],
-              [
-                5.02174888731529,
-                51.8688196075856
-              ],
-              [
-                5.02738788278121,
-                51.8637390234808
-              ],
-              [
-                5.02690796101997,
-                51.8586077134767
-              ],
-              [
-                5.0049056165729,
-                51.8579655321447
-              ],
-              [
-                4.99489488025721,
-                51.8617723820773
-              ],
-              [
-                4.99387163331176,
-                51.8606492919879
-              ],
-              [
-                4.99856656136799,
-                51.854099710862
-              ],
-              [
-                4.99634190848505,
-                51.8492286229506
-              ],
-              [
-                4.98126029272513,
-                51.8518703049596
-              ],
-              [
-                4.98331860934036,
-                51.8571082497309
-              ],
-              [
-                4.96497657756851,
-                51.8615915756887
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0512",
-        "name": "Gorinchem",
-        "regioFacet": "tcm:106-353767-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/gorinchem/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.92658197566777,
-                51.8302434666404
-              ],
-              [
-                4.92484478835805,
-                51.8398335238648
-              ],
-              [
-                4.92182281477273,
-                51.8407888886014
-              ],
-              [
-                4.93014070345096,
-                51.8401100876662
-              ],
-              [
-                4.93725512423881,
-                51.8445004375014
-              ],
-              [
-                4.94272003713863,
-                51.8447863644174
-              ],
-              [
-                4.93839867788595,
-                51.863629358702
-              ],
-              [
-                4.95732936544431,
-                51.8594970751052
-              ],
-              [
-                4.95930956222185,
-                51.8628849337493
-              ],
-              [
-                4.97272865704527,
-                51.8599315909932
-              ],
-              [
-                4.98331860934036,
-                51.8571082497309
-              ],
-              [
-                4.98126029272513,
-                51.8518703049596
-              ],
-              [
-                4.99634190848505,
-                51.8492286229506
-              ],
-              [
-                4.99915128496728,
-                51.8522846447239
-              ],
-              [
-                5.00002098510741,
-                51.8443632594586
-              ],
-              [
-                5.03141500202702,
-                51.8410848008601
-              ],
-              [
-                5.02635176720462,
-                51.8188567383574
-              ],
-              [
-                5.0082806449329,
-                51.8210194422254
-              ],
-              [
-                4.9877569241907,
-                51.8210593619987
-              ],
-              [
-                4.93606411671055,
-                51.8283144980014
-              ],
-              [
-                4.92698658023276,
-                51.8283059597961
-              ],
-              [
-                4.92658197566777,
-                51.8302434666404
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0523",
-        "name": "Hardinxveld-Giessendam",
-        "regioFacet": "tcm:106-353769-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/hardinxveld-giessendam/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.80457506713134,
-                51.8289347385462
-              ],
-              [
-                4.8033785498349,
-                51.8463534050861
-              ],
-              [
-                4.83808213926218,
-                51.8607346697193
-              ],
-              [
-                4.8479494699135,
-                51.8326173119896
-              ],
-              [
-                4.86300681981093,
-                51.8329408279766
-              ],
-              [
-                4.86645056983565,
-                51.8348369094366
-              ],
-              [
-                4.86792835423129,
-                51.8319936661637
-              ],
-              [
-                4.87789866374681,
-                51.8325140389037
-              ],
-              [
-                4.90693808884795,
-                51.8384924649096
-              ],
-              [
-                4.9248638318319,
-                51.8397184116222
-              ],
-              [
-                4.92698658023276,
-                51.8283059597961
-              ],
-              [
-                4.91127350115937,
-                51.8259817794905
-              ],
-              [
-                4.88464251270353,
-                51.8164713963199
-              ],
-              [
-                4.88332290332668,
-                51.8186273960199
-              ],
-              [
-                4.87124593097793,
-                51.8175856170999
-              ],
-              [
-                4.83905200645396,
-                51.8192546294424
-              ],
-              [
-                4.81141583304626,
-                51.8186581954653
-              ],
-              [
-                4.81138410413862,
-                51.8211478798747
-              ],
-              [
-                4.80507545008408,
-                51.8219494259536
-              ],
-              [
-                4.80457506713134,
-                51.8289347385462
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0530",
-        "name": "Hellevoetsluis",
-        "regioFacet": "tcm:106-353770-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/hellevoetsluis/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.11265983190768,
-                51.8566681339121
-              ],
-              [
-                4.12083023664863,
-                51.8608424342984
-              ],
-              [
-                4.1193244309314,
-                51.8662064393313
-              ],
-              [
-                4.12742810317011,
-                51.8701297264416
-              ],
-              [
-                4.13042258045082,
-                51.8760984833268
-              ],
-              [
-                4.13672505165931,
-                51.8778573478306
-              ],
-              [
-                4.1370747220036,
-                51.8793807880192
-              ],
-              [
-                4.1402907855051,
-                51.8787179506154
-              ],
-              [
-                4.15527885661112,
-                51.8816034331475
-              ],
-              [
-                4.15825336518322,
-                51.8782141162823
-              ],
-              [
-                4.15374933635529,
-                51.8748800284782
-              ],
-              [
-                4.16015247673481,
-                51.8670319073333
-              ],
-              [
-                4.16499764358482,
-                51.8678227847174
-              ],
-              [
-                4.18631581953955,
-                51.8624599097618
-              ],
-              [
-                4.17562905228441,
-                51.855486256549
-              ],
-              [
-                4.18270429958682,
-                51.8530552961663
-              ],
-              [
-                4.18289116782271,
-                51.8500957259947
-              ],
-              [
-                4.19129669852023,
-                51.8489376372982
-              ],
-              [
-                4.19825723236875,
-                51.8498892734117
-              ],
-              [
-                4.19760995628988,
-                51.8470016881989
-              ],
-              [
-                4.18703978535358,
-                51.8389810100621
-              ],
-              [
-                4.18663836166946,
-                51.8354545785928
-              ],
-              [
-                4.1681898131536,
-                51.8261156400636
-              ],
-              [
-                4.1592942231818,
-                51.8233717035936
-              ],
-              [
-                4.15028257124008,
-                51.8050175958518
-              ],
-              [
-                4.13956696874895,
-                51.8072328597981
-              ],
-              [
-                4.12343291256372,
-                51.8065101101878
-              ],
-              [
-                4.10674218539841,
-                51.8126676768718
-              ],
-              [
-                4.06465611495999,
-                51.8211085137683
-              ],
-              [
-                4.04950277683077,
-                51.8312403045699
-              ],
-              [
-                4.0398349571435,
-                51.8421135393424
-              ],
-              [
-                4.0827412972696,
-                51.8472515243189
-              ],
-              [
-                4.08422836969776,
-                51.8503737462967
-              ],
-              [
-                4.08914778444221,
-                51.8528734282833
-              ],
-              [
-                4.09807493467352,
-                51.8531478910277
-              ],
-              [
-                4.11265983190768,
-                51.8566681339121
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0531",
-        "name": "Hendrik-Ido-Ambacht",
-        "regioFacet": "tcm:106-353771-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/hendrik-ido-ambacht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.66161823154051,
-                51.8315287572872
-              ],
-              [
-                4.63720863341433,
-                51.8253483160161
-              ],
-              [
-                4.60015490393778,
-                51.8387300768491
-              ],
-              [
-                4.60488536191649,
-                51.8483858779947
-              ],
-              [
-                4.61871526571396,
-                51.8512379124838
-              ],
-              [
-                4.62731864160403,
-                51.8546529158747
-              ],
-              [
-                4.63651597772028,
-                51.8496624225535
-              ],
-              [
-                4.64694804434574,
-                51.8566610613996
-              ],
-              [
-                4.63902189724426,
-                51.8636401428679
-              ],
-              [
-                4.63533358685363,
-                51.8698469246245
-              ],
-              [
-                4.64804077409281,
-                51.8625058974373
-              ],
-              [
-                4.67147619881679,
-                51.8406607203079
-              ],
-              [
-                4.67269254286383,
-                51.8367919108954
-              ],
-              [
-                4.66917656021763,
-                51.8293543877672
-              ],
-              [
-                4.6704388371088,
-                51.8235891173174
-              ],
-              [
-                4.66161823154051,
-                51.8315287572872
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0588",
-        "name": "Korendijk",
-        "regioFacet": "tcm:106-353775-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/korendijk/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.29903936336376,
-                51.8040609757605
-              ],
-              [
-                4.30736661706736,
-                51.8091845659264
-              ],
-              [
-                4.330698163291,
-                51.8129476399482
-              ],
-              [
-                4.33815049862731,
-                51.8167719127247
-              ],
-              [
-                4.35452783634598,
-                51.819191395313
-              ],
-              [
-                4.36908227301696,
-                51.8169814353766
-              ],
-              [
-                4.38213179839264,
-                51.8240791587486
-              ],
-              [
-                4.38451446860625,
-                51.8224169063361
-              ],
-              [
-                4.3827466797871,
-                51.8190319906667
-              ],
-              [
-                4.38937531614215,
-                51.8133744411539
-              ],
-              [
-                4.38841348501529,
-                51.8023317372021
-              ],
-              [
-                4.38287545091507,
-                51.802061901671
-              ],
-              [
-                4.37877698415326,
-                51.7874043549203
-              ],
-              [
-                4.38415992424088,
-                51.788439982775
-              ],
-              [
-                4.39359281950347,
-                51.7875370707735
-              ],
-              [
-                4.39275334596968,
-                51.7860290572957
-              ],
-              [
-                4.39544742455523,
-                51.7841294215422
-              ],
-              [
-                4.40604308349547,
-                51.7815711045395
-              ],
-              [
-                4.40975874992979,
-                51.7791697146771
-              ],
-              [
-                4.4141675813211,
-                51.7791337092674
-              ],
-              [
-                4.40020797167315,
-                51.7584973419226
-              ],
-              [
-                4.384772890714348,
-                51.73055383970811
-              ],
-              [
-                4.383061037367915,
-                51.731842556523986
-              ],
-              [
-                4.371060813198178,
-                51.73507743778208
-              ],
-              [
-                4.357097139865045,
-                51.73170434412973
-              ],
-              [
-                4.3361615528165,
-                51.726285416872244
-              ],
-              [
-                4.330127037012174,
-                51.726031857706204
-              ],
-              [
-                4.317278406860753,
-                51.726537699386434
-              ],
-              [
-                4.301521872082524,
-                51.72950270691153
-              ],
-              [
-                4.274722840985216,
-                51.74040586716697
-              ],
-              [
-                4.25784305745247,
-                51.748441597883115
-              ],
-              [
-                4.24944150529843,
-                51.7578682678953
-              ],
-              [
-                4.21324684490066,
-                51.7852673125677
-              ],
-              [
-                4.22283899277937,
-                51.7879058326381
-              ],
-              [
-                4.23756260226714,
-                51.7827717010585
-              ],
-              [
-                4.24261962277132,
-                51.7833286380325
-              ],
-              [
-                4.24663994831934,
-                51.7853475607459
-              ],
-              [
-                4.26561604077825,
-                51.803974722358
-              ],
-              [
-                4.27311369046348,
-                51.8050978865555
-              ],
-              [
-                4.29579170662554,
-                51.8021109290335
-              ],
-              [
-                4.29903936336376,
-                51.8040609757605
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0542",
-        "name": "Krimpen aan den IJssel",
-        "regioFacet": "tcm:106-353776-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/krimpen-aan-den-ijssel/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.58231097448558,
-                51.9205591282846
-              ],
-              [
-                4.59977720668564,
-                51.9265361087104
-              ],
-              [
-                4.61211186351456,
-                51.9256255969406
-              ],
-              [
-                4.61770393325208,
-                51.9310501402046
-              ],
-              [
-                4.64301910784961,
-                51.9125691674143
-              ],
-              [
-                4.63522519900426,
-                51.9100738986185
-              ],
-              [
-                4.62145632788911,
-                51.908330021157
-              ],
-              [
-                4.6014637167637,
-                51.9069976960018
-              ],
-              [
-                4.59679901208702,
-                51.9078184211571
-              ],
-              [
-                4.59517330498151,
-                51.9070821202012
-              ],
-              [
-                4.59674464453635,
-                51.9057635771328
-              ],
-              [
-                4.59351090097362,
-                51.9041015885937
-              ],
-              [
-                4.59221298226339,
-                51.9056011723364
-              ],
-              [
-                4.58874770712605,
-                51.9041874235653
-              ],
-              [
-                4.58909855656674,
-                51.9023674936716
-              ],
-              [
-                4.58261778549699,
-                51.9040944956304
-              ],
-              [
-                4.57632845447595,
-                51.903031718863
-              ],
-              [
-                4.55508755972862,
-                51.9056464572711
-              ],
-              [
-                4.54923843572362,
-                51.9048821240703
-              ],
-              [
-                4.54990125962142,
-                51.9067284855502
-              ],
-              [
-                4.56766159514018,
-                51.9097959320349
-              ],
-              [
-                4.57786818200667,
-                51.9150544597633
-              ],
-              [
-                4.58231097448558,
-                51.9205591282846
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1931",
-        "name": "Krimpenerwaard",
-        "regioFacet": "tcm:106-401748-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/krimpenerwaard/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.64983507,
-                51.9734288
-              ],
-              [
-                4.65592327,
-                51.9765531
-              ],
-              [
-                4.66959005,
-                51.9795331
-              ],
-              [
-                4.67291929,
-                51.9861697
-              ],
-              [
-                4.68869608,
-                51.9962631
-              ],
-              [
-                4.70049207,
-                51.9973603
-              ],
-              [
-                4.70721706,
-                52.0017622
-              ],
-              [
-                4.71886128,
-                51.9983695
-              ],
-              [
-                4.72756978,
-                52.0015828
-              ],
-              [
-                4.72279259,
-                52.005482
-              ],
-              [
-                4.728482,
-                52.0074014
-              ],
-              [
-                4.73729179,
-                52.0059351
-              ],
-              [
-                4.73773336,
-                52.0068414
-              ],
-              [
-                4.74546317,
-                52.0029079
-              ],
-              [
-                4.7573298,
-                52.0013416
-              ],
-              [
-                4.75087977,
-                52.0140883
-              ],
-              [
-                4.77594424,
-                52.0140641
-              ],
-              [
-                4.7987002,
-                52.0222717
-              ],
-              [
-                4.8035232,
-                52.0139561
-              ],
-              [
-                4.81561622,
-                52.0139807
-              ],
-              [
-                4.82499425,
-                52.0172366
-              ],
-              [
-                4.84017805,
-                52.0167069
-              ],
-              [
-                4.84689577,
-                52.0181817
-              ],
-              [
-                4.85712655,
-                52.0059595
-              ],
-              [
-                4.8178059,
-                51.9997668
-              ],
-              [
-                4.83521784,
-                51.986143
-              ],
-              [
-                4.84562186,
-                51.9749849
-              ],
-              [
-                4.86080596,
-                51.967784
-              ],
-              [
-                4.87122288,
-                51.9546434
-              ],
-              [
-                4.87779292,
-                51.9380306
-              ],
-              [
-                4.85293826,
-                51.9414414
-              ],
-              [
-                4.83553916,
-                51.940067
-              ],
-              [
-                4.81031909,
-                51.9241703
-              ],
-              [
-                4.76394736,
-                51.9149814
-              ],
-              [
-                4.73798532,
-                51.9027122
-              ],
-              [
-                4.71581192,
-                51.895949
-              ],
-              [
-                4.69997001,
-                51.8965893
-              ],
-              [
-                4.68067586,
-                51.893005
-              ],
-              [
-                4.67336721,
-                51.8902275
-              ],
-              [
-                4.66438545,
-                51.8894462
-              ],
-              [
-                4.63677229,
-                51.8909109
-              ],
-              [
-                4.62104376,
-                51.8894022
-              ],
-              [
-                4.59587227,
-                51.8939442
-              ],
-              [
-                4.58302042,
-                51.9012127
-              ],
-              [
-                4.57632845,
-                51.9030317
-              ],
-              [
-                4.58261778,
-                51.9040944
-              ],
-              [
-                4.58909855,
-                51.9023674
-              ],
-              [
-                4.5887477,
-                51.9041874
-              ],
-              [
-                4.59221298,
-                51.9056011
-              ],
-              [
-                4.5935109,
-                51.9041015
-              ],
-              [
-                4.59674464,
-                51.9057635
-              ],
-              [
-                4.5951733,
-                51.9070821
-              ],
-              [
-                4.59679901,
-                51.9078184
-              ],
-              [
-                4.60146371,
-                51.9069976
-              ],
-              [
-                4.62145632,
-                51.90833
-              ],
-              [
-                4.63522519,
-                51.9100738
-              ],
-              [
-                4.6430191,
-                51.9125691
-              ],
-              [
-                4.61770393,
-                51.9310501
-              ],
-              [
-                4.62268696,
-                51.9358484
-              ],
-              [
-                4.63423726,
-                51.9374569
-              ],
-              [
-                4.63951266,
-                51.950897
-              ],
-              [
-                4.64610496,
-                51.9544173
-              ],
-              [
-                4.63108146,
-                51.9689944
-              ],
-              [
-                4.63352094,
-                51.9705719
-              ],
-              [
-                4.64983507,
-                51.9734288
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1621",
-        "name": "Lansingerland",
-        "regioFacet": "tcm:106-353777-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/lansingerland/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.5047095086936,
-                52.0319305625686
-              ],
-              [
-                4.53466562140237,
-                52.0495632519913
-              ],
-              [
-                4.54533536237412,
-                52.0489362898585
-              ],
-              [
-                4.55053304073778,
-                52.0563040449578
-              ],
-              [
-                4.55780899431935,
-                52.0617411614044
-              ],
-              [
-                4.56520143681619,
-                52.0584962391392
-              ],
-              [
-                4.56105963406806,
-                52.0420608608913
-              ],
-              [
-                4.56345716989185,
-                52.0357769783372
-              ],
-              [
-                4.55821797342166,
-                52.0289084478038
-              ],
-              [
-                4.55487514720585,
-                52.0059485838681
-              ],
-              [
-                4.54633927570441,
-                51.9915141567088
-              ],
-              [
-                4.54806979877822,
-                51.9834276175923
-              ],
-              [
-                4.5538548727111,
-                51.9775431887544
-              ],
-              [
-                4.55252968741435,
-                51.9752652357408
-              ],
-              [
-                4.53995136041422,
-                51.9698851382671
-              ],
-              [
-                4.53568691306329,
-                51.9704150564389
-              ],
-              [
-                4.53498510741231,
-                51.9682723010152
-              ],
-              [
-                4.52101310546259,
-                51.9634525597282
-              ],
-              [
-                4.5185864772973,
-                51.9602862838775
-              ],
-              [
-                4.49579968799046,
-                51.9718444853346
-              ],
-              [
-                4.49525804654053,
-                51.9674029933702
-              ],
-              [
-                4.46901215030637,
-                51.9805491586639
-              ],
-              [
-                4.46324098446115,
-                51.977526511946
-              ],
-              [
-                4.46009839879173,
-                51.9687824783918
-              ],
-              [
-                4.45051351424854,
-                51.9658129067277
-              ],
-              [
-                4.44668207598489,
-                51.9627859523818
-              ],
-              [
-                4.42422152617324,
-                51.9792587288484
-              ],
-              [
-                4.44724792015525,
-                51.9924090542307
-              ],
-              [
-                4.45287764620339,
-                51.9972705324651
-              ],
-              [
-                4.44977590408964,
-                52.0005027663848
-              ],
-              [
-                4.45872811935402,
-                52.0022092112955
-              ],
-              [
-                4.4588999803665,
-                52.0046443531204
-              ],
-              [
-                4.45538895231141,
-                52.0067979928507
-              ],
-              [
-                4.46156168109808,
-                52.0126514131984
-              ],
-              [
-                4.45854683025317,
-                52.0156214951696
-              ],
-              [
-                4.4571992440968,
-                52.0221688753515
-              ],
-              [
-                4.46732368732518,
-                52.0254080626406
-              ],
-              [
-                4.47738443962518,
-                52.0317915724695
-              ],
-              [
-                4.5047095086936,
-                52.0319305625686
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0545",
-        "name": "Leerdam",
-        "regioFacet": "tcm:106-353778-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/leerdam/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                5.05213996295643,
-                51.8574923857504
-              ],
-              [
-                5.04385083229079,
-                51.8592260575258
-              ],
-              [
-                5.02690796101997,
-                51.8586077134767
-              ],
-              [
-                5.02738788278121,
-                51.8637390234808
-              ],
-              [
-                5.02174888731529,
-                51.8688196075856
-              ],
-              [
-                5.02322749863099,
-                51.875740831438
-              ],
-              [
-                5.02695590889765,
-                51.8819182035372
-              ],
-              [
-                5.06383746250785,
-                51.8923911164793
-              ],
-              [
-                5.05500545801335,
-                51.9045257601676
-              ],
-              [
-                5.06169196939755,
-                51.9098918282732
-              ],
-              [
-                5.0573595406785,
-                51.9113407872969
-              ],
-              [
-                5.06756277335957,
-                51.9183378186229
-              ],
-              [
-                5.0786611329504,
-                51.92984837461
-              ],
-              [
-                5.08701511977605,
-                51.9272664188093
-              ],
-              [
-                5.09881004144517,
-                51.9402322295261
-              ],
-              [
-                5.09731450968637,
-                51.9408554945308
-              ],
-              [
-                5.10144586553903,
-                51.9461260957624
-              ],
-              [
-                5.13013804499733,
-                51.9349116503481
-              ],
-              [
-                5.13335200803588,
-                51.9377546355204
-              ],
-              [
-                5.14857279226697,
-                51.9342720579949
-              ],
-              [
-                5.14845984819258,
-                51.9294851890313
-              ],
-              [
-                5.13567474584763,
-                51.9182227408624
-              ],
-              [
-                5.13643575346573,
-                51.9145084266385
-              ],
-              [
-                5.13227754634319,
-                51.9148817578143
-              ],
-              [
-                5.11940886370849,
-                51.8986052602702
-              ],
-              [
-                5.11689047313936,
-                51.898032423414
-              ],
-              [
-                5.11767129555002,
-                51.8925699005298
-              ],
-              [
-                5.11273680672669,
-                51.8877761783713
-              ],
-              [
-                5.09097455706619,
-                51.8889764266091
-              ],
-              [
-                5.08815941342757,
-                51.8870943567455
-              ],
-              [
-                5.0863395901515,
-                51.8807689634201
-              ],
-              [
-                5.08053240414626,
-                51.8755247253657
-              ],
-              [
-                5.05536225533701,
-                51.8730409302493
-              ],
-              [
-                5.05231058164504,
-                51.8699844853309
-              ],
-              [
-                5.05219884033121,
-                51.8666430072882
-              ],
-              [
-                5.0636816215325,
-                51.86082395245
-              ],
-              [
-                5.05918548430407,
-                51.8581544819968
-              ],
-              [
-                5.05213996295643,
-                51.8574923857504
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "1927",
-        "name": "Molenwaard",
-        "regioFacet": "tcm:106-353785-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/molenwaard/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.81128344403936,
-                51.924554630497
-              ],
-              [
-                4.8355391623454,
-                51.940067043532
-              ],
-              [
-                4.85293826356936,
-                51.9414414827362
-              ],
-              [
-                4.87531830973245,
-                51.9379916507218
-              ],
-              [
-                4.8851099736673,
-                51.938586713806
-              ],
-              [
-                4.91458258828736,
-                51.9425970306879
-              ],
-              [
-                4.92630129165071,
-                51.9509279427079
-              ],
-              [
-                4.93212649844121,
-                51.9475516400081
-              ],
-              [
-                4.93923505796472,
-                51.9289380698919
-              ],
-              [
-                4.93509673510266,
-                51.9281583820972
-              ],
-              [
-                4.93136389106119,
-                51.9240905677099
-              ],
-              [
-                4.91962786622566,
-                51.9188156988727
-              ],
-              [
-                4.9277819300752,
-                51.9009141543472
-              ],
-              [
-                4.91074783683381,
-                51.8876956544656
-              ],
-              [
-                4.90541291383713,
-                51.8859575794532
-              ],
-              [
-                4.89430484262973,
-                51.8853692716449
-              ],
-              [
-                4.8964747492083,
-                51.8802732010224
-              ],
-              [
-                4.8774701907713,
-                51.8778865054718
-              ],
-              [
-                4.85789990660874,
-                51.8695673981312
-              ],
-              [
-                4.85559473158935,
-                51.8670990443916
-              ],
-              [
-                4.85455663868163,
-                51.8677946160835
-              ],
-              [
-                4.80378605435308,
-                51.8464795891609
-              ],
-              [
-                4.79560124894991,
-                51.8449756977961
-              ],
-              [
-                4.78696157259531,
-                51.8449926571047
-              ],
-              [
-                4.78049137988179,
-                51.8466432197246
-              ],
-              [
-                4.76801391182325,
-                51.8438625596439
-              ],
-              [
-                4.7561287455264,
-                51.8449577373095
-              ],
-              [
-                4.74276393727947,
-                51.8410026874672
-              ],
-              [
-                4.7433946251896,
-                51.8427197403661
-              ],
-              [
-                4.73865383667289,
-                51.8435735149239
-              ],
-              [
-                4.73726391882639,
-                51.846463758364
-              ],
-              [
-                4.7152023931461,
-                51.8413232436655
-              ],
-              [
-                4.70306029093186,
-                51.8418286410729
-              ],
-              [
-                4.68319067130829,
-                51.848491452695
-              ],
-              [
-                4.68070889604395,
-                51.8596385623407
-              ],
-              [
-                4.68644944344843,
-                51.8662899460448
-              ],
-              [
-                4.69043893167043,
-                51.8668470464634
-              ],
-              [
-                4.70023970430445,
-                51.879709878942
-              ],
-              [
-                4.68781495158954,
-                51.8787176533774
-              ],
-              [
-                4.67291403496013,
-                51.8747919374668
-              ],
-              [
-                4.66398455937081,
-                51.8749808188043
-              ],
-              [
-                4.64213830226658,
-                51.8801996709152
-              ],
-              [
-                4.63707557592705,
-                51.8762944485057
-              ],
-              [
-                4.62877466406475,
-                51.8812121511845
-              ],
-              [
-                4.6263910544654,
-                51.8806577615459
-              ],
-              [
-                4.61924884523671,
-                51.8884422457458
-              ],
-              [
-                4.61957217078001,
-                51.8895226982516
-              ],
-              [
-                4.63677229302268,
-                51.8909109559002
-              ],
-              [
-                4.66816658750989,
-                51.8895853452733
-              ],
-              [
-                4.69997001566991,
-                51.8965893241722
-              ],
-              [
-                4.71581192056823,
-                51.8959490411261
-              ],
-              [
-                4.73798532898018,
-                51.9027122211614
-              ],
-              [
-                4.76394736069218,
-                51.9149814241834
-              ],
-              [
-                4.78142548209115,
-                51.9175060137084
-              ],
-              [
-                4.81128344403936,
-                51.924554630497
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0569",
-        "name": "Nieuwkoop",
-        "regioFacet": "tcm:106-353787-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/nieuwkoop/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.73433895127928,
-                52.2065164187573
-              ],
-              [
-                4.74347885137448,
-                52.2147245909857
-              ],
-              [
-                4.7479715658131,
-                52.2148678974529
-              ],
-              [
-                4.75329850618229,
-                52.217467800855
-              ],
-              [
-                4.76207448769631,
-                52.2181302696286
-              ],
-              [
-                4.77460319493158,
-                52.2245466839647
-              ],
-              [
-                4.77660741351858,
-                52.2274633974541
-              ],
-              [
-                4.78401671689524,
-                52.2287369949008
-              ],
-              [
-                4.79457569813664,
-                52.2267306636317
-              ],
-              [
-                4.79442987444678,
-                52.2234233987331
-              ],
-              [
-                4.80214966453662,
-                52.2176336010367
-              ],
-              [
-                4.80292512784945,
-                52.2010277555596
-              ],
-              [
-                4.81359431058614,
-                52.2018471170438
-              ],
-              [
-                4.8143998746193,
-                52.1993092514449
-              ],
-              [
-                4.82220913381478,
-                52.1961245803488
-              ],
-              [
-                4.82344480074159,
-                52.193265697136
-              ],
-              [
-                4.84392085582236,
-                52.18012162623
-              ],
-              [
-                4.85558182910959,
-                52.1789171737521
-              ],
-              [
-                4.89217825656838,
-                52.161797918799
-              ],
-              [
-                4.87362346477432,
-                52.1560825078137
-              ],
-              [
-                4.87451848125608,
-                52.1388314137199
-              ],
-              [
-                4.85523866730597,
-                52.1372096169464
-              ],
-              [
-                4.85147861250099,
-                52.1396744010765
-              ],
-              [
-                4.84287191124514,
-                52.1394595524909
-              ],
-              [
-                4.84027608137948,
-                52.1415607444697
-              ],
-              [
-                4.83736643465786,
-                52.1406495923432
-              ],
-              [
-                4.83670464255443,
-                52.1428799208872
-              ],
-              [
-                4.8341281950725,
-                52.1428764895668
-              ],
-              [
-                4.83285787491289,
-                52.1447623571809
-              ],
-              [
-                4.82647185196531,
-                52.1421763296712
-              ],
-              [
-                4.82054731004141,
-                52.1422324276526
-              ],
-              [
-                4.81691402913046,
-                52.1394679811188
-              ],
-              [
-                4.81326106512223,
-                52.1402274513466
-              ],
-              [
-                4.80909335592877,
-                52.1376421514367
-              ],
-              [
-                4.80806512525965,
-                52.1289479837211
-              ],
-              [
-                4.80260084352459,
-                52.1250597512784
-              ],
-              [
-                4.79311343431816,
-                52.123824506988
-              ],
-              [
-                4.79150984254196,
-                52.1203426179344
-              ],
-              [
-                4.78402880896823,
-                52.120755573179
-              ],
-              [
-                4.7839692192261,
-                52.1174372301273
-              ],
-              [
-                4.77960920191977,
-                52.1177070336697
-              ],
-              [
-                4.77492851266668,
-                52.1148427107586
-              ],
-              [
-                4.77069201487256,
-                52.1151221010438
-              ],
-              [
-                4.76843432705941,
-                52.1132301110237
-              ],
-              [
-                4.7624718912405,
-                52.1136911353074
-              ],
-              [
-                4.75932866116365,
-                52.1103446161968
-              ],
-              [
-                4.75456470357602,
-                52.1088103316862
-              ],
-              [
-                4.73885670029528,
-                52.1065074357989
-              ],
-              [
-                4.75324033250675,
-                52.1194419631249
-              ],
-              [
-                4.76007597005259,
-                52.1310173760619
-              ],
-              [
-                4.74183881080536,
-                52.1626732200061
-              ],
-              [
-                4.72681140881548,
-                52.1589952088248
-              ],
-              [
-                4.72605387441598,
-                52.1563968868878
-              ],
-              [
-                4.7038005654321,
-                52.1446201449866
-              ],
-              [
-                4.70567269377277,
-                52.1521566007714
-              ],
-              [
-                4.6982176865397,
-                52.1585279603543
-              ],
-              [
-                4.6812093721669,
-                52.1675042259791
-              ],
-              [
-                4.68894110165097,
-                52.191931394424
-              ],
-              [
-                4.69531593649081,
-                52.1891544845574
-              ],
-              [
-                4.7076657402736,
-                52.2050524434033
-              ],
-              [
-                4.7207743919634,
-                52.2012765024151
-              ],
-              [
-                4.72359180878683,
-                52.2116163923385
-              ],
-              [
-                4.73433895127928,
-                52.2065164187573
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0584",
-        "name": "Oud-Beijerland",
-        "regioFacet": "tcm:106-353791-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/oud-beijerland/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.39547980516657,
-                51.8304835906794
-              ],
-              [
-                4.39913663053189,
-                51.8314201827054
-              ],
-              [
-                4.42100268643563,
-                51.8298744120572
-              ],
-              [
-                4.4324823366137,
-                51.8370461452453
-              ],
-              [
-                4.45008298417337,
-                51.8321734806361
-              ],
-              [
-                4.44846414366669,
-                51.8230144090144
-              ],
-              [
-                4.45216353400546,
-                51.812169299135
-              ],
-              [
-                4.43517855988709,
-                51.8095189315028
-              ],
-              [
-                4.43049997838865,
-                51.8104944769246
-              ],
-              [
-                4.42989787940276,
-                51.7967512129276
-              ],
-              [
-                4.42435850985235,
-                51.7895194385806
-              ],
-              [
-                4.42453491368842,
-                51.7858751954209
-              ],
-              [
-                4.4141675813211,
-                51.7791337092674
-              ],
-              [
-                4.40975874992979,
-                51.7791697146771
-              ],
-              [
-                4.40604308349547,
-                51.7815711045395
-              ],
-              [
-                4.39544742455523,
-                51.7841294215422
-              ],
-              [
-                4.39275334596968,
-                51.7860290572957
-              ],
-              [
-                4.39359281950347,
-                51.7875370707735
-              ],
-              [
-                4.38415992424088,
-                51.788439982775
-              ],
-              [
-                4.37877698415326,
-                51.7874043549203
-              ],
-              [
-                4.38287545091507,
-                51.802061901671
-              ],
-              [
-                4.38832690972683,
-                51.8020536184627
-              ],
-              [
-                4.38952708312838,
-                51.8118863780023
-              ],
-              [
-                4.3881740943111,
-                51.815651155148
-              ],
-              [
-                4.38279886070759,
-                51.818937882419
-              ],
-              [
-                4.38286221826858,
-                51.8221695596738
-              ],
-              [
-                4.38451446860625,
-                51.8224169063361
-              ],
-              [
-                4.38213179839264,
-                51.8240791587486
-              ],
-              [
-                4.39547980516657,
-                51.8304835906794
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0590",
-        "name": "Papendrecht",
-        "regioFacet": "tcm:106-353793-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/papendrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.68451932511635,
-                51.847927222594
-              ],
-              [
-                4.70777722745503,
-                51.8410177452825
-              ],
-              [
-                4.71533266067636,
-                51.841343566391
-              ],
-              [
-                4.73726391882639,
-                51.846463758364
-              ],
-              [
-                4.73865383667289,
-                51.8435735149239
-              ],
-              [
-                4.74349410381632,
-                51.8422158734254
-              ],
-              [
-                4.72963867171026,
-                51.8221687376229
-              ],
-              [
-                4.70747375675684,
-                51.8233474020618
-              ],
-              [
-                4.68318043943953,
-                51.8206329511447
-              ],
-              [
-                4.67172945449501,
-                51.8207913061853
-              ],
-              [
-                4.66916552173622,
-                51.8291512574659
-              ],
-              [
-                4.67260618827874,
-                51.8386503271932
-              ],
-              [
-                4.6803975248509,
-                51.8444394247066
-              ],
-              [
-                4.68182675145425,
-                51.8490932621683
-              ],
-              [
-                4.68451932511635,
-                51.847927222594
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0597",
-        "name": "Ridderkerk",
-        "regioFacet": "tcm:106-353795-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/ridderkerk/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.56997069604269,
-                51.8526205364115
-              ],
-              [
-                4.56596125672263,
-                51.8520863086445
-              ],
-              [
-                4.56410582488124,
-                51.8534733832115
-              ],
-              [
-                4.54925330224498,
-                51.8742008908963
-              ],
-              [
-                4.56159029673291,
-                51.8782315630662
-              ],
-              [
-                4.56914614733444,
-                51.8781803549401
-              ],
-              [
-                4.57632845447595,
-                51.903031718863
-              ],
-              [
-                4.58302042239655,
-                51.9012127030947
-              ],
-              [
-                4.59362335380938,
-                51.8946002249587
-              ],
-              [
-                4.61957217078001,
-                51.8895226982516
-              ],
-              [
-                4.63902189724426,
-                51.8636401428679
-              ],
-              [
-                4.64733074310913,
-                51.8571224424906
-              ],
-              [
-                4.63651597772028,
-                51.8496624225535
-              ],
-              [
-                4.62731864160403,
-                51.8546529158747
-              ],
-              [
-                4.61871526571396,
-                51.8512379124838
-              ],
-              [
-                4.60488536191649,
-                51.8483858779947
-              ],
-              [
-                4.60015490393778,
-                51.8387300768491
-              ],
-              [
-                4.57105844640094,
-                51.8471167734287
-              ],
-              [
-                4.56997069604269,
-                51.8526205364115
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0603",
-        "name": "Rijswijk",
-        "regioFacet": "tcm:106-353797-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/rijswijk/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.29810985124226,
-                52.0448214996901
-              ],
-              [
-                4.31988199236423,
-                52.0519935894978
-              ],
-              [
-                4.32136054351173,
-                52.0546208088936
-              ],
-              [
-                4.34004718492707,
-                52.0618058931909
-              ],
-              [
-                4.34679960785435,
-                52.0568503489143
-              ],
-              [
-                4.34450385671337,
-                52.0540565073517
-              ],
-              [
-                4.35263870815154,
-                52.0490951740043
-              ],
-              [
-                4.34606270428845,
-                52.0440181696404
-              ],
-              [
-                4.35779028245525,
-                52.0268631955927
-              ],
-              [
-                4.32595624129907,
-                52.0127933119488
-              ],
-              [
-                4.31938946781537,
-                52.0199166402132
-              ],
-              [
-                4.30981926198592,
-                52.015634037459
-              ],
-              [
-                4.29792993092026,
-                52.0294100259713
-              ],
-              [
-                4.29095168968574,
-                52.0415729738952
-              ],
-              [
-                4.29810985124226,
-                52.0448214996901
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0606",
-        "name": "Schiedam",
-        "regioFacet": "tcm:106-353799-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/schiedam/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.36920364131464,
-                51.9089464483822
-              ],
-              [
-                4.36548330166087,
-                51.9216104316006
-              ],
-              [
-                4.36219201338674,
-                51.9240953146322
-              ],
-              [
-                4.36182571837014,
-                51.9265710960252
-              ],
-              [
-                4.36392208208413,
-                51.9276874612182
-              ],
-              [
-                4.36219764116902,
-                51.9357713252899
-              ],
-              [
-                4.35067135930857,
-                51.9515065189897
-              ],
-              [
-                4.3670711792315,
-                51.9552199067173
-              ],
-              [
-                4.36350980853467,
-                51.960870587717
-              ],
-              [
-                4.37930946578153,
-                51.9634066253013
-              ],
-              [
-                4.38891030243858,
-                51.9455298248614
-              ],
-              [
-                4.39518781536608,
-                51.9469270400371
-              ],
-              [
-                4.39344792286714,
-                51.9442595192052
-              ],
-              [
-                4.40392016801912,
-                51.9372526135658
-              ],
-              [
-                4.41080290174909,
-                51.9382677313173
-              ],
-              [
-                4.41549216997665,
-                51.9407585416991
-              ],
-              [
-                4.41771292742039,
-                51.9384765911105
-              ],
-              [
-                4.40946540239946,
-                51.9361515213671
-              ],
-              [
-                4.40814736948271,
-                51.9318081219917
-              ],
-              [
-                4.41701249255489,
-                51.9225218708407
-              ],
-              [
-                4.41525184634784,
-                51.9144839313934
-              ],
-              [
-                4.4090753217175,
-                51.9120497341279
-              ],
-              [
-                4.41567695494093,
-                51.9050247806135
-              ],
-              [
-                4.41233831789996,
-                51.9024211114924
-              ],
-              [
-                4.41371031596056,
-                51.9007546217073
-              ],
-              [
-                4.39759971387203,
-                51.8971658415321
-              ],
-              [
-                4.38834408298349,
-                51.8967791131752
-              ],
-              [
-                4.37062268499617,
-                51.89935552598
-              ],
-              [
-                4.36920364131464,
-                51.9089464483822
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0610",
-        "name": "Sliedrecht",
-        "regioFacet": "tcm:106-353801-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/sliedrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.74029685184705,
-                51.8221650532209
-              ],
-              [
-                4.72963867171026,
-                51.8221687376229
-              ],
-              [
-                4.74148223694493,
-                51.8408290778372
-              ],
-              [
-                4.7561287455264,
-                51.8449577373095
-              ],
-              [
-                4.76801391182325,
-                51.8438625596439
-              ],
-              [
-                4.78037973442677,
-                51.846639928256
-              ],
-              [
-                4.78814732726043,
-                51.8449374197986
-              ],
-              [
-                4.8033785498349,
-                51.8463534050861
-              ],
-              [
-                4.80507545008408,
-                51.8219494259536
-              ],
-              [
-                4.81138410413862,
-                51.8211478798747
-              ],
-              [
-                4.81141583304626,
-                51.8186581954653
-              ],
-              [
-                4.78680360938009,
-                51.815313672619
-              ],
-              [
-                4.77209159938877,
-                51.8153190861215
-              ],
-              [
-                4.76357722215345,
-                51.8167929017841
-              ],
-              [
-                4.75152128997994,
-                51.8215936545432
-              ],
-              [
-                4.74029685184705,
-                51.8221650532209
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0617",
-        "name": "Strijen",
-        "regioFacet": "tcm:106-353803-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/strijen/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.51879157550022,
-                51.7241245924501
-              ],
-              [
-                4.50871904941533,
-                51.7279866958026
-              ],
-              [
-                4.50470059461117,
-                51.7375243638052
-              ],
-              [
-                4.50995196529841,
-                51.7409810144063
-              ],
-              [
-                4.51624506457498,
-                51.7420739988715
-              ],
-              [
-                4.51808644298872,
-                51.7409580776451
-              ],
-              [
-                4.51823347218955,
-                51.744643056532
-              ],
-              [
-                4.50595743758053,
-                51.7520512324613
-              ],
-              [
-                4.48807447118874,
-                51.7564917681019
-              ],
-              [
-                4.48509670820247,
-                51.7590110558126
-              ],
-              [
-                4.48656165582289,
-                51.7706368835035
-              ],
-              [
-                4.48394465321535,
-                51.7713210261683
-              ],
-              [
-                4.48331241977928,
-                51.7770472752549
-              ],
-              [
-                4.48680103472562,
-                51.7773001182163
-              ],
-              [
-                4.48655812925836,
-                51.7814937340264
-              ],
-              [
-                4.49379556156771,
-                51.7810433813548
-              ],
-              [
-                4.50229300961856,
-                51.7838985466679
-              ],
-              [
-                4.50325912529047,
-                51.7866170068261
-              ],
-              [
-                4.51536632285931,
-                51.78716316625
-              ],
-              [
-                4.51811794342856,
-                51.7837492084816
-              ],
-              [
-                4.52670084292297,
-                51.7806160400494
-              ],
-              [
-                4.53690482894213,
-                51.7797378140431
-              ],
-              [
-                4.54606101652337,
-                51.7735996409421
-              ],
-              [
-                4.5706135778571,
-                51.7719742036157
-              ],
-              [
-                4.57126346743245,
-                51.763341603637
-              ],
-              [
-                4.6119573350955,
-                51.7604671587695
-              ],
-              [
-                4.61181958350555,
-                51.7572396888939
-              ],
-              [
-                4.59311666961676,
-                51.7509934511236
-              ],
-              [
-                4.59183463080468,
-                51.7485025727751
-              ],
-              [
-                4.60296287349153,
-                51.740528196988
-              ],
-              [
-                4.6059646048575,
-                51.7411230125229
-              ],
-              [
-                4.625204242470619,
-                51.72910559517776
-              ],
-              [
-                4.623726602973055,
-                51.72667043040126
-              ],
-              [
-                4.619709553568425,
-                51.7230660258315
-              ],
-              [
-                4.606169369029533,
-                51.71705188664413
-              ],
-              [
-                4.60043246015521,
-                51.71608911768612
-              ],
-              [
-                4.589565166525026,
-                51.71164293601741
-              ],
-              [
-                4.590057170071496,
-                51.71085395805149
-              ],
-              [
-                4.558377539076585,
-                51.7043945898468
-              ],
-              [
-                4.534921275331898,
-                51.70019722540405
-              ],
-              [
-                4.53492354424312,
-                51.7072303659012
-              ],
-              [
-                4.518075794407,
-                51.715088802619
-              ],
-              [
-                4.52452768860171,
-                51.7214607977973
-              ],
-              [
-                4.51879157550022,
-                51.7241245924501
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0614",
-        "name": "Westvoorne",
-        "regioFacet": "tcm:106-353811-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/westvoorne/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.0377712724676,
-                51.9261512347704
-              ],
-              [
-                4.06670334336346,
-                51.9327749011308
-              ],
-              [
-                4.08101422460873,
-                51.9337592858046
-              ],
-              [
-                4.09289389285003,
-                51.9325279395235
-              ],
-              [
-                4.10200273094084,
-                51.9235301560871
-              ],
-              [
-                4.10171964040216,
-                51.9270092775237
-              ],
-              [
-                4.10772382718376,
-                51.9361416062152
-              ],
-              [
-                4.14488967991353,
-                51.9314094596484
-              ],
-              [
-                4.14267839845583,
-                51.9267747494454
-              ],
-              [
-                4.15337816442427,
-                51.9221686015624
-              ],
-              [
-                4.14640585783218,
-                51.9137683600941
-              ],
-              [
-                4.14265191060542,
-                51.9145140481674
-              ],
-              [
-                4.13726102242739,
-                51.9123402406642
-              ],
-              [
-                4.13535075753713,
-                51.9063828168726
-              ],
-              [
-                4.15037095575735,
-                51.8940401801073
-              ],
-              [
-                4.15990985303142,
-                51.8826162722889
-              ],
-              [
-                4.1402907855051,
-                51.8787179506154
-              ],
-              [
-                4.1370747220036,
-                51.8793807880192
-              ],
-              [
-                4.13672505165931,
-                51.8778573478306
-              ],
-              [
-                4.13012564485457,
-                51.8758725522578
-              ],
-              [
-                4.12728201931034,
-                51.8700207377762
-              ],
-              [
-                4.11947620561085,
-                51.8664061113792
-              ],
-              [
-                4.12083023664863,
-                51.8608424342984
-              ],
-              [
-                4.11654826180541,
-                51.859187814049
-              ],
-              [
-                4.11724454291586,
-                51.8579583690319
-              ],
-              [
-                4.09807493467352,
-                51.8531478910277
-              ],
-              [
-                4.08914778444221,
-                51.8528734282833
-              ],
-              [
-                4.08422836969776,
-                51.8503737462967
-              ],
-              [
-                4.0827412972696,
-                51.8472515243189
-              ],
-              [
-                4.067515752467886,
-                51.84545172910405
-              ],
-              [
-                4.067129511214437,
-                51.85041001050904
-              ],
-              [
-                4.049112401056274,
-                51.86861792361937
-              ],
-              [
-                4.028923492879118,
-                51.88463016763363
-              ],
-              [
-                4.027256453974682,
-                51.88707776417702
-              ],
-              [
-                4.027557738891382,
-                51.88985279017585
-              ],
-              [
-                4.031644961534773,
-                51.89482264248519
-              ],
-              [
-                4.045135680592415,
-                51.90709587322999
-              ],
-              [
-                4.050867871869,
-                51.91258146090506
-              ],
-              [
-                4.0547066876204045,
-                51.915224473973666
-              ],
-              [
-                4.052709117629708,
-                51.9172670566925
-              ],
-              [
-                4.048771847178362,
-                51.92023826255449
-              ],
-              [
-                4.039840215572312,
-                51.92382139200973
-              ],
-              [
-                4.0336262659587785,
-                51.9224590202875
-              ],
-              [
-                4.02653820240192,
-                51.92018012880074
-              ],
-              [
-                4.024389166825452,
-                51.91992368991306
-              ],
-              [
-                4.017694292869655,
-                51.92020640576085
-              ],
-              [
-                4.00955723187673,
-                51.91814372779963
-              ],
-              [
-                4.0080634847283125,
-                51.91651250322453
-              ],
-              [
-                4.007247610577828,
-                51.91586443884316
-              ],
-              [
-                4.003780035251016,
-                51.91516148363972
-              ],
-              [
-                3.9935139269889457,
-                51.915692536908004
-              ],
-              [
-                4.0377712724676,
-                51.9261512347704
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0707",
-        "name": "Zederik",
-        "regioFacet": "tcm:106-353812-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/zederik/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.99496805615035,
-                51.9022923319478
-              ],
-              [
-                4.97341069988177,
-                51.8972500480023
-              ],
-              [
-                4.97374062541147,
-                51.9076674108814
-              ],
-              [
-                4.96412818322141,
-                51.9106380007886
-              ],
-              [
-                4.96478522417896,
-                51.9135189594112
-              ],
-              [
-                4.95615745315533,
-                51.915591062315
-              ],
-              [
-                4.94769623489898,
-                51.9337246041445
-              ],
-              [
-                4.93923505796472,
-                51.9289380698919
-              ],
-              [
-                4.93212649844121,
-                51.9475516400081
-              ],
-              [
-                4.92630129165071,
-                51.9509279427079
-              ],
-              [
-                4.9335835356271,
-                51.9597072705816
-              ],
-              [
-                4.93982050833845,
-                51.9634399592022
-              ],
-              [
-                4.94869120906893,
-                51.9634717487872
-              ],
-              [
-                4.96819398342657,
-                51.9593519118093
-              ],
-              [
-                4.97543610807983,
-                51.9628858949479
-              ],
-              [
-                4.98804536182628,
-                51.9739928492382
-              ],
-              [
-                4.99807352373156,
-                51.9778715033279
-              ],
-              [
-                5.003009511148,
-                51.9781382333176
-              ],
-              [
-                5.01776855199962,
-                51.9713666626836
-              ],
-              [
-                5.02724343244639,
-                51.9691600803581
-              ],
-              [
-                5.03612749019823,
-                51.9713285457448
-              ],
-              [
-                5.04040220479064,
-                51.9774436251702
-              ],
-              [
-                5.04779444330012,
-                51.9765576961926
-              ],
-              [
-                5.04884240472239,
-                51.9740600303291
-              ],
-              [
-                5.05438380392872,
-                51.9765854857731
-              ],
-              [
-                5.06464237017003,
-                51.9645910091343
-              ],
-              [
-                5.05846739985361,
-                51.9605537040854
-              ],
-              [
-                5.05445860407509,
-                51.9547913813409
-              ],
-              [
-                5.08761671394975,
-                51.9503033773086
-              ],
-              [
-                5.10144586553903,
-                51.9461260957624
-              ],
-              [
-                5.09731450968637,
-                51.9408554945308
-              ],
-              [
-                5.09881004144517,
-                51.9402322295261
-              ],
-              [
-                5.08701511977605,
-                51.9272664188093
-              ],
-              [
-                5.0786611329504,
-                51.92984837461
-              ],
-              [
-                5.06756277335957,
-                51.9183378186229
-              ],
-              [
-                5.0573595406785,
-                51.9113407872969
-              ],
-              [
-                5.06169196939755,
-                51.9098918282732
-              ],
-              [
-                5.05500545801335,
-                51.9045257601676
-              ],
-              [
-                5.06383746250785,
-                51.8923911164793
-              ],
-              [
-                4.9962381254413,
-                51.8731191900124
-              ],
-              [
-                4.99504740023607,
-                51.8776163124086
-              ],
-              [
-                4.9994522738996,
-                51.8842835048615
-              ],
-              [
-                4.99596168458035,
-                51.891962975561
-              ],
-              [
-                4.99496805615035,
-                51.9022923319478
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0637",
-        "name": "Zoetermeer",
-        "regioFacet": "tcm:106-353813-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/zoetermeer/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.47237304393929,
-                52.0382789322822
-              ],
-              [
-                4.47074367885301,
-                52.0404777085916
-              ],
-              [
-                4.45764380653775,
-                52.0386186580673
-              ],
-              [
-                4.45056413808226,
-                52.0492102058446
-              ],
-              [
-                4.42207240400165,
-                52.0516730866836
-              ],
-              [
-                4.415394623132,
-                52.0574413297315
-              ],
-              [
-                4.42248965472893,
-                52.0571940388819
-              ],
-              [
-                4.42140419990311,
-                52.0633034550036
-              ],
-              [
-                4.45546247153327,
-                52.0783131096943
-              ],
-              [
-                4.47565986061659,
-                52.0812963000769
-              ],
-              [
-                4.47427472739028,
-                52.0852606641087
-              ],
-              [
-                4.49301807418669,
-                52.087751912134
-              ],
-              [
-                4.49221892631207,
-                52.0900097618354
-              ],
-              [
-                4.49780589528576,
-                52.0878375611488
-              ],
-              [
-                4.49996703566302,
-                52.0916552013211
-              ],
-              [
-                4.50444961035148,
-                52.0932903302074
-              ],
-              [
-                4.53057997588237,
-                52.0804980306359
-              ],
-              [
-                4.53604737958986,
-                52.0769280949673
-              ],
-              [
-                4.53369495730176,
-                52.0730511295623
-              ],
-              [
-                4.5576863581817,
-                52.0609525301409
-              ],
-              [
-                4.5505655463131,
-                52.05633734448
-              ],
-              [
-                4.54533536237412,
-                52.0489362898585
-              ],
-              [
-                4.53466562140237,
-                52.0495632519913
-              ],
-              [
-                4.51529151315144,
-                52.0392159953585
-              ],
-              [
-                4.50572987810212,
-                52.0321399180561
-              ],
-              [
-                4.47702788629857,
-                52.0312805745779
-              ],
-              [
-                4.47237304393929,
-                52.0382789322822
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    },
-    {
-      "type": "Feature",
-      "properties": {
-        "code": "0642",
-        "name": "Zwijndrecht",
-        "regioFacet": "tcm:106-353816-1024",
-        "level": 4,
-        "url": "/regioinformatie/gemeente/zwijndrecht/"
-      },
-      "geometry": {
-        "coordinates": [
-          [
-            [
-              [
-                4.56674335408343,
-                51.8128291512275
-              ],
-              [
-                4.5611820548403,
-                51.8179318665737
-              ],
-              [
-                4.55723942480026,
-                51.8268626784146
-              ],
-              [
-                4.55360870097655,
-                51.8298969041529
-              ],
-              [
-                4.56001342470718,
-                51.8387912632234
-              ],
-              [
-                4.57105844640094,
-                51.8471167734287
-              ],
-              [
-                4.60019747885321,
-                51.8387191982949
-              ],
-              [
-                4.63720863341433,
-                51.8253483160161
-              ],
-              [
-                4.66188576188443,
-                51.8316078839115
-              ],
-              [
-                4.67170869006529,
-                51.8208539744178
-              ],
-              [
-                4.66189852044518,
-                51.8185116248907
-              ],
-              [
-                4.63833041468094,
-                51.8039934453503
-              ],
-              [
-                4.62329524835263,
-                51.8007599776896
-              ],
-              [
-                4.59903760246949,
-                51.8061053191062
-              ],
-              [
-                4.57955234098743,
-                51.8080039617197
-              ],
-              [
-                4.56674335408343,
-                51.8128291512275
-              ]
-            ]
-          ]
-        ],
-        "type": "MultiPolygon"
-      }
-    }
-  ]
-}
\ No newline at end of file